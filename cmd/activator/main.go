@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kedacore/keda/v2/pkg/scalers/externalscaler"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	flag "github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/kserve/kserve/pkg/activator"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+var (
+	log                = logf.Log.WithName("Activator")
+	drainSleepDuration = 30 * time.Second
+	isShuttingDown     = false
+)
+
+var (
+	httpPort      = flag.Int("http-port", constants.ActivatorHttpPort, "The port the activator proxies the requests on.")
+	mirrorPort    = flag.Int("mirror-port", constants.ActivatorMirrorPort, "The port the requests of the warm targets are mirrored to.")
+	scalerPort    = flag.Int("scaler-port", constants.ActivatorScalerPort, "The port of the KEDA external push scaler.")
+	metricsPort   = flag.Int("metrics-port", constants.ActivatorMetricsPort, "The port the metrics and health endpoints are served on.")
+	timeout       = flag.Duration("timeout", activator.DefaultTimeout, "The maximum time a request is buffered while its target scales from zero.")
+	probeInterval = flag.Duration("probe-interval", activator.DefaultProbeInterval, "The interval between the probes of a target scaling from zero.")
+	readyTTL      = flag.Duration("ready-ttl", activator.DefaultReadyTTL, "How long a target is considered ready after it was last probed or served a request.")
+	idleTimeout   = flag.Duration("idle-timeout", activator.DefaultIdleTimeout, "How long a target is reported active after its last request.")
+	peersService  = flag.String("peers-service", fmt.Sprintf("%s.%s.%s", constants.ActivatorPeersName, constants.KServeNamespace, constants.ClusterLocalDomain),
+		"The headless service the activator replicas sync their stats through.")
+)
+
+func main() {
+	flag.Parse()
+	logf.SetLogger(zap.New())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg, err := config.GetConfig()
+	if err != nil {
+		log.Error(err, "Failed to set up the client config")
+		os.Exit(1)
+	}
+	clientSet, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		log.Error(err, "Failed to create the clientSet")
+		os.Exit(1)
+	}
+	// The requests are only proxied to the activator enabled services found in the cache.
+	informerFactory := informers.NewSharedInformerFactory(clientSet, 0)
+	services := informerFactory.Core().V1().Services()
+	resolver := &activator.ServiceResolver{Lister: services.Lister()}
+	informerFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), services.Informer().HasSynced) {
+		log.Error(errors.New("timed out waiting for the caches to sync"), "Failed to sync the services cache")
+		os.Exit(1)
+	}
+
+	registry := prometheus.NewRegistry()
+	handler, err := activator.New(resolver, &activator.TCPProber{}, activator.Options{
+		Timeout:       *timeout,
+		ProbeInterval: *probeInterval,
+		ReadyTTL:      *readyTTL,
+		IdleTimeout:   *idleTimeout,
+	}, registry)
+	if err != nil {
+		log.Error(err, "Failed to create the activator")
+		os.Exit(1)
+	}
+	go handler.Run(ctx)
+	reporter := activator.NewPeerReporter(handler, activator.DNSPeerLookup(*peersService, os.Getenv("POD_IP")), *metricsPort,
+		activator.DefaultPeerSyncInterval)
+	go reporter.Run(ctx)
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", *httpPort),
+		Handler: handler,
+		// gRPC requests are received over cleartext HTTP/2.
+		Protocols:         new(http.Protocols),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	server.Protocols.SetHTTP1(true)
+	server.Protocols.SetUnencryptedHTTP2(true)
+	mirrorServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", *mirrorPort),
+		Handler:           handler.MirrorHandler(),
+		Protocols:         server.Protocols,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.Handle(activator.StatsPath, activator.StatsHandler(handler))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		if isShuttingDown {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	metricsServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", *metricsPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *scalerPort))
+	if err != nil {
+		log.Error(err, "Failed to listen on the scaler port", "port", *scalerPort)
+		os.Exit(1)
+	}
+	grpcServer := grpc.NewServer()
+	externalscaler.RegisterExternalScalerServer(grpcServer, activator.NewScaler(reporter, activator.DefaultStreamInterval))
+
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Error(err, "Failed to serve the scaler")
+			os.Exit(1)
+		}
+	}()
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error(err, "Failed to serve the metrics")
+			os.Exit(1)
+		}
+	}()
+	go func() {
+		if err := mirrorServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error(err, "Failed to serve the mirrored requests")
+			os.Exit(1)
+		}
+	}()
+	go func() {
+		log.Info("Starting the activator", "port", *httpPort)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error(err, "Failed to serve the activator")
+			os.Exit(1)
+		}
+	}()
+
+	handleSignals(server, mirrorServer, grpcServer)
+}
+
+func handleSignals(server *http.Server, mirrorServer *http.Server, grpcServer *grpc.Server) {
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM)
+
+	sig := <-signalChan
+	log.Info("Received shutdown signal", "signal", sig)
+	// Fail the readiness probe
+	isShuttingDown = true
+	log.Info(fmt.Sprintf("Sleeping %v to allow K8s propagation of non-ready state", drainSleepDuration))
+	time.Sleep(drainSleepDuration)
+	// Buffered requests are drained before the server shuts down.
+	if err := server.Shutdown(context.Background()); err != nil {
+		log.Error(err, "Failed to shutdown the server gracefully")
+		os.Exit(1)
+	}
+	if err := mirrorServer.Shutdown(context.Background()); err != nil {
+		log.Error(err, "Failed to shutdown the mirror server gracefully")
+		os.Exit(1)
+	}
+	grpcServer.GracefulStop()
+	log.Info("Server gracefully shutdown")
+}
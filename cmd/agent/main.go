@@ -18,6 +18,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
@@ -49,6 +50,8 @@ import (
 	"github.com/kserve/kserve/pkg/agent/storage"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/batcher"
+	"github.com/kserve/kserve/pkg/credentials/file"
+	"github.com/kserve/kserve/pkg/credentials/vault"
 	kfslogger "github.com/kserve/kserve/pkg/logger"
 )
 
@@ -59,6 +62,11 @@ var (
 	enablePuller = flag.Bool("enable-puller", false, "Enable model puller")
 	configDir    = flag.String("config-dir", "/mnt/configs", "directory for model config files")
 	modelDir     = flag.String("model-dir", "/mnt/models", "directory for model files")
+	// storage helper flags
+	storageHelperConfig  = flag.String("storage-helper-config", "", "JSON list of the storage helpers downloading the models with custom storage URIs")
+	installStorageHelper = flag.String("install-storage-helper", "", "Copy the agent binary to the path for the storage helpers and exit")
+	storageHelperDir     = flag.String("storage-helper-dir", "", "Run as storage helper serving the download requests of the directory with the command following --")
+	storageHelperTimeout = flag.Duration("storage-helper-timeout", storage.DefaultHelperTimeout, "Time to wait for a storage helper to download a model")
 	// logger flags
 	logUrl              = flag.String("log-url", "", "The URL to send request/response logs to")
 	workers             = flag.Int("workers", 5, "Number of workers")
@@ -66,6 +74,10 @@ var (
 	logMode             = flag.String("log-mode", string(v1beta1.LogAll), "Whether to log 'request', 'response' or 'all'")
 	logStorePath        = flag.String("log-store-path", "", "The path to the log output")
 	logStoreFormat      = flag.String("log-store-format", "json", "Output format for the log marshaller (json, csv, parquet)")
+	logStoreClass       = flag.String("log-store-storage-class", "", "Storage class (S3, GCS) or access tier (Azure) of the stored log objects")
+	logStoreKmsKeyId    = flag.String("log-store-kms-key-id", "", "KMS key (S3 SSE-KMS, GCS) or encryption scope (Azure) encrypting the stored log objects")
+	logStoreTags        = flag.StringSlice("log-store-tags", nil, "Tags (S3, Azure) or metadata (GCS) of the stored log objects, as key=value pairs")
+	logStoreTimeout     = flag.Duration("log-store-upload-timeout", kfslogger.DefaultUploadTimeout, "Time to marshal and upload a batch of logs to the log store")
 	logMarshallerUrl    = flag.String("log-marshaller-url", "http://localhost:9083/marshal", "URL of the log marshaller service")
 	logMarshallerPort   = flag.Int("log-marshaller-port", 9083, "Port for the embedded log marshaller HTTP server")
 	logBatchSize        = flag.Int("log-batch-size", 1, "Number of log records per batch for blob storage")
@@ -137,6 +149,22 @@ type batcherArgs struct {
 
 func main() {
 	flag.Parse()
+	// The storage helper modes run in the init container and the ClusterStorageContainer sidecars, without the agent environment
+	if *installStorageHelper != "" {
+		if err := storage.InstallHelper(*installStorageHelper); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	if *storageHelperDir != "" {
+		ctrl.SetLogger(zapr.NewLogger(zap.Must(zap.NewProduction())))
+		if err := storage.ServeHelperRequests(signals.NewContext(), *storageHelperDir, flag.Args()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 	// Parse the environment.
 	var env config
 	if err := envconfig.Process("", &env); err != nil {
@@ -152,25 +180,50 @@ func main() {
 		probe = buildProbe(logger, env.ServingReadinessProbe, env.EnableHTTP2AutoDetection, env.EnableMultiContainerProbes).ProbeContainer
 	}
 
+	ctx := signals.NewContext()
+	// The credentials of the external secret stores are exported before the storage clients of the puller and the
+	// logger are created
+	var credentialsExporter *vault.SecretExporter
+	if *enablePuller || *logUrl != "" {
+		credentialsExporter = loadStorageCredentials(ctx, logger)
+	}
+
+	var downloader *agent.Downloader
 	if *enablePuller {
 		logger.Infof("Initializing model agent with config-dir %s, model-dir %s", *configDir, *modelDir)
-		startModelPuller(logger)
+		downloader = startModelPuller(logger)
 	}
 
 	var loggerArgs *loggerArgs
+	var logStore kfslogger.Store
 	if *logUrl != "" {
 		logger.Info("Starting logger")
-		loggerArgs = startLogger(*workers, logStorePath, *logMarshallerUrl, *logMarshallerPort,
+		loggerArgs, logStore = startLogger(*workers, logStorePath, *logMarshallerUrl, *logMarshallerPort,
 			*logBatchSize, *logBatchInterval, logger)
 	}
 
+	if credentialsExporter != nil {
+		// The storage clients are created again with the refreshed credentials
+		credentialsExporter.OnChange = func() {
+			logger.Info("Storage credentials refreshed from vault")
+			if downloader != nil {
+				downloader.ResetProviders()
+			}
+			if blobStore, ok := logStore.(*kfslogger.BlobStore); ok {
+				if err := blobStore.ResetClient(); err != nil {
+					logger.Errorw("Failed to reset the client of the logger store", zap.Error(err))
+				}
+			}
+		}
+		go credentialsExporter.Run(ctx)
+	}
+
 	var batcherArgs *batcherArgs
 	if *enableBatcher {
 		logger.Info("Starting batcher")
 		batcherArgs = startBatcher(logger)
 	}
 	logger.Info("Starting agent http server...")
-	ctx := signals.NewContext()
 	mainServer, drain := buildServer(*port, *componentPort, loggerArgs, batcherArgs, probe, logger)
 	servers := map[string]*http.Server{
 		"main": mainServer,
@@ -274,7 +327,7 @@ func startBatcher(logger *zap.SugaredLogger) *batcherArgs {
 
 func startLogger(workers int, logStorePath *string, marshallerUrl string, marshallerPort int,
 	batchSize int, batchInterval time.Duration, log *zap.SugaredLogger,
-) *loggerArgs {
+) (*loggerArgs, kfslogger.Store) {
 	loggingMode := v1beta1.LoggerType(*logMode)
 	switch loggingMode {
 	case v1beta1.LogAll, v1beta1.LogRequest, v1beta1.LogResponse:
@@ -347,12 +400,33 @@ func startLogger(workers int, logStorePath *string, marshallerUrl string, marsha
 				}
 			}()
 
-			// Create HTTPMarshaller client pointing to the configured URL.
-			httpClient := &http.Client{Timeout: 30 * time.Second}
+			// Create HTTPMarshaller client pointing to the configured URL. The marshalled batches are streamed
+			// into the uploads, only the wait for the response headers is bounded by the client, the uploads are
+			// bounded by the store.
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.ResponseHeaderTimeout = 30 * time.Second
+			httpClient := &http.Client{Transport: transport}
 			marshaller := kfslogger.NewHTTPMarshaller(marshallerUrl, httpClient)
 
+			uploadOptions := storage.UploadOptions{
+				StorageClass: *logStoreClass,
+				KMSKeyID:     *logStoreKmsKeyId,
+			}
+			for _, tag := range *logStoreTags {
+				k, v, found := strings.Cut(tag, "=")
+				if !found {
+					log.Errorf("log store tag does not adhere to desired format got key: %s value: %s", k, v)
+					os.Exit(-1)
+				}
+				if uploadOptions.Tags == nil {
+					uploadOptions.Tags = map[string]string{}
+				}
+				uploadOptions.Tags[k] = v
+			}
+
 			log.Infow("Logger storage is enabled", "path", *logStorePath, "marshallerUrl", marshallerUrl)
-			store, err = kfslogger.NewStoreForScheme(logUrlParsed.Scheme, *logStorePath, marshaller, log)
+			store, err = kfslogger.NewStoreForScheme(logUrlParsed.Scheme, *logStorePath, marshaller, uploadOptions,
+				*logStoreTimeout, log)
 			if err != nil {
 				log.Errorw("Error creating logger store", zap.Error(err))
 				os.Exit(-1)
@@ -374,19 +448,46 @@ func startLogger(workers int, logStorePath *string, marshallerUrl string, marsha
 		annotations:      annotationKVPair,
 		certName:         *CaCertFile,
 		tlsSkipVerify:    *TlsSkipVerify,
+	}, store
+}
+
+// loadStorageCredentials exports the storage credentials of the external secret stores as env vars, and returns the
+// exporter refreshing the credentials of Vault if configured
+func loadStorageCredentials(ctx context.Context, logger *zap.SugaredLogger) *vault.SecretExporter {
+	if err := file.ExportCredentialEnvs(); err != nil {
+		logger.Fatalw("Failed to read storage credentials files", zap.Error(err))
+	}
+	exporter := vault.NewSecretExporter()
+	if exporter == nil {
+		return nil
+	}
+	if err := exporter.Export(ctx); err != nil {
+		logger.Fatalw("Failed to read storage credentials from vault", zap.Error(err))
 	}
+	return exporter
 }
 
-func startModelPuller(logger *zap.SugaredLogger) {
-	downloader := agent.Downloader{
+func startModelPuller(logger *zap.SugaredLogger) *agent.Downloader {
+	downloader := &agent.Downloader{
 		ModelDir:  *modelDir,
 		Providers: map[storage.Protocol]storage.Provider{},
 		Logger:    logger,
 	}
+	if *storageHelperConfig != "" {
+		helperConfigs := []storage.HelperConfig{}
+		if err := json.Unmarshal([]byte(*storageHelperConfig), &helperConfigs); err != nil {
+			logger.Fatalw("Failed to parse storage helper config", zap.Error(err))
+		}
+		for _, helperConfig := range helperConfigs {
+			logger.Infof("Using storage helper %s", helperConfig.Name)
+			downloader.Helpers = append(downloader.Helpers, &storage.HelperProvider{Config: helperConfig, Timeout: *storageHelperTimeout})
+		}
+	}
 	watcher := agent.NewWatcher(*configDir, *modelDir, logger)
 	logger.Info("Starting puller")
-	agent.StartPullerAndProcessModels(&downloader, watcher.ModelEvents, logger)
+	agent.StartPullerAndProcessModels(downloader, watcher.ModelEvents, logger)
 	go watcher.Start()
+	return downloader
 }
 
 func buildProbe(logger *zap.SugaredLogger, probeJSON string, autodetectHTTP2 bool, multiContainerProbes bool) *readiness.Probe {
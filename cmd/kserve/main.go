@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/preview"
+)
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:          "kserve",
+		Short:        "kserve is the command line tool of KServe",
+		SilenceUsage: true,
+	}
+	rootCmd.AddCommand(newPreviewCommand())
+
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func newPreviewCommand() *cobra.Command {
+	var filenames []string
+	var namespace string
+	var diffs bool
+	var verbose bool
+
+	cmd := &cobra.Command{
+		Use:   "preview -f inferenceservice.yaml -f inferenceservice-config.yaml",
+		Short: "Preview the workloads and the mutated pods of an InferenceService",
+		Long: `Renders offline the Deployments or Knative Services of an InferenceService the way the controller
+does, then runs the pod mutating webhook on their pods and prints the final pods with the changes made by each
+mutation. The manifests hold the InferenceService, the inferenceservice-config ConfigMap of the cluster and
+optionally the ServingRuntimes, ClusterServingRuntimes, ServiceAccounts, Secrets and ClusterStorageContainers
+the InferenceService uses.`,
+		Example: `  kubectl get configmap inferenceservice-config -n kserve -o yaml > config.yaml
+  kserve preview -f sklearn-iris.yaml -f config.yaml -f runtimes.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// The reconcilers and the webhook log to the controller-runtime logger
+			logger := logr.Discard()
+			if verbose {
+				logger = zap.New(zap.UseDevMode(true), zap.WriteTo(cmd.ErrOrStderr()))
+			}
+			ctrl.SetLogger(logger)
+			klog.SetLogger(logger)
+
+			manifests := make([][]byte, 0, len(filenames))
+			for _, filename := range filenames {
+				var data []byte
+				var err error
+				if filename == "-" {
+					data, err = io.ReadAll(cmd.InOrStdin())
+				} else {
+					data, err = os.ReadFile(filename)
+				}
+				if err != nil {
+					return err
+				}
+				manifests = append(manifests, data)
+			}
+
+			s, err := preview.NewScheme()
+			if err != nil {
+				return err
+			}
+			input, err := preview.Decode(s, manifests...)
+			if err != nil {
+				return err
+			}
+			if namespace != "" {
+				input.InferenceService.Namespace = namespace
+			}
+			result, err := preview.Render(cmd.Context(), s, input)
+			if err != nil {
+				return err
+			}
+			return result.Write(cmd.OutOrStdout(), diffs)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&filenames, "filename", "f", nil, "Manifests to preview, - reads the standard input")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace of the InferenceService, overrides the one of the manifest")
+	cmd.Flags().BoolVar(&diffs, "diff", true, "Print the changes made to the pods by each mutation")
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Print the logs of the controller and the webhook")
+	_ = cmd.MarkFlagRequired("filename")
+	return cmd
+}
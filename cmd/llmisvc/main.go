@@ -248,7 +248,7 @@ func main() {
 	}
 
 	// Register webhooks: validation (v1alpha1, v1alpha2) and conversion
-	v1alpha2LLMValidator := &v1alpha2.LLMInferenceServiceValidator{}
+	v1alpha2LLMValidator := &v1alpha2.LLMInferenceServiceValidator{Client: mgr.GetAPIReader()}
 	if err = v1alpha2LLMValidator.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "llminferenceservice-v1alpha2")
 		os.Exit(1)
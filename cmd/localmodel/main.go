@@ -35,7 +35,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
 	localmodelcontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/localmodel"
+	localmodelnodecontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/localmodelnode"
 	kservescheme "github.com/kserve/kserve/pkg/scheme"
 	kservetls "github.com/kserve/kserve/pkg/tls"
 	localmodelwebhook "github.com/kserve/kserve/pkg/webhook/admission/localmodelcache"
@@ -139,6 +142,25 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Create the secret authenticating the peer downloads, which the agents of the nodes only read
+	isvcConfigMap, err := v1beta1.GetInferenceServiceConfigMap(context.Background(), clientSet)
+	if err != nil {
+		setupLog.Error(err, "unable to get configmap", "name", constants.InferenceServiceConfigMapName, "namespace", constants.KServeNamespace)
+		os.Exit(1)
+	}
+	localModelConfig, err := v1beta1.NewLocalModelConfig(isvcConfigMap)
+	if err != nil {
+		setupLog.Error(err, "unable to get local model config")
+		os.Exit(1)
+	}
+	if peerDownload := localModelConfig.PeerDownload; peerDownload != nil && peerDownload.Enabled {
+		secretName := localmodelnodecontroller.GetPeerSecretName(peerDownload)
+		if err := localmodelnodecontroller.EnsurePeerSecret(context.Background(), clientSet, localModelConfig.JobNamespace, secretName); err != nil {
+			setupLog.Error(err, "unable to create peer secret", "secret", secretName, "namespace", localModelConfig.JobNamespace)
+			os.Exit(1)
+		}
+	}
+
 	// Setup LocalModel controller
 	localModelEventBroadcaster := record.NewBroadcaster()
 	setupLog.Info("Setting up v1alpha1 LocalModel controller")
@@ -165,6 +187,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup LocalModelNodeGroup controller
+	setupLog.Info("Setting up v1alpha1 LocalModelNodeGroup controller")
+	if err = (&localmodelcontroller.LocalModelNodeGroupReconciler{
+		Client: mgr.GetClient(),
+		Log:    ctrl.Log.WithName("v1alpha1Controllers").WithName("LocalModelNodeGroup"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "v1alpha1Controllers", "LocalModelNodeGroup")
+		os.Exit(1)
+	}
+
 	// Setup webhook
 	setupLog.Info("setting up webhook server")
 	if err = ctrl.NewWebhookManagedBy(mgr).
@@ -21,6 +21,7 @@ import (
 	"flag"
 	"os"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -33,6 +34,9 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1alpha1/localmodel/revision"
 	localmodelnodecontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/localmodelnode"
 	kservescheme "github.com/kserve/kserve/pkg/scheme"
 	kservetls "github.com/kserve/kserve/pkg/tls"
@@ -79,7 +83,49 @@ func GetOptions() Options {
 	return opts
 }
 
+// runPeerDownload downloads a model from a peer node, run by the download jobs of the local model agent
+func runPeerDownload(args []string) {
+	flags := flag.NewFlagSet(localmodelnodecontroller.PeerDownloadCommand, flag.ExitOnError)
+	peerURL := flags.String("peer", "", "URL of the model folder served by the peer node")
+	sourceModelUri := flags.String("source", "", "Source model URI to verify the downloaded files against")
+	sourceRevision := flags.String("revision", "", "Revision of the source model")
+	dest := flags.String("dest", "", "Folder to download the model into")
+	credentialsDir := flags.String("credentials", localmodelnodecontroller.PeerCredentialsMountPath,
+		"Folder with the token and the certificate of the peer servers")
+	zapOpts := zap.Options{}
+	zapOpts.BindFlags(flags)
+	_ = flags.Parse(args)
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&zapOpts)))
+
+	credentials, err := localmodelnodecontroller.LoadPeerClientCredentials(*credentialsDir)
+	if err != nil {
+		setupLog.Error(err, "unable to load peer credentials", "dir", *credentialsDir)
+		os.Exit(1)
+	}
+	httpClient, err := credentials.HTTPClient()
+	if err != nil {
+		setupLog.Error(err, "unable to set up peer client")
+		os.Exit(1)
+	}
+	downloader := &localmodelnodecontroller.PeerDownloader{
+		HTTPClient: httpClient,
+		Token:      credentials.Token,
+		Resolver:   revision.NewSourceResolver(),
+		Log:        ctrl.Log.WithName("PeerDownloader"),
+		// Set on the download jobs by the agent
+		ProgressFile: os.Getenv(localmodelnodecontroller.DownloadProgressFileEnvVar),
+	}
+	if err := downloader.Download(signals.SetupSignalHandler(), *peerURL, *sourceModelUri, *sourceRevision, *dest); err != nil {
+		setupLog.Error(err, "unable to download model from peer", "peer", *peerURL)
+		os.Exit(1)
+	}
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == localmodelnodecontroller.PeerDownloadCommand {
+		runPeerDownload(os.Args[2:])
+		return
+	}
 	options := GetOptions()
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&options.zapOpts)))
 
@@ -141,6 +187,7 @@ func main() {
 		Clientset: clientSet,
 		Log:       ctrl.Log.WithName("v1alpha1Controllers").WithName("LocalModelNode"),
 		Scheme:    mgr.GetScheme(),
+		Recorder:  localModelNodeEventBroadcaster.NewRecorder(mgr.GetScheme(), corev1.EventSource{Component: "LocalModelNodeController"}),
 	}
 
 	if err = reconciler.SetupWithManager(mgr); err != nil {
@@ -148,6 +195,42 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Serve the downloaded models to the download jobs of the peer nodes
+	isvcConfigMap, err := v1beta1.GetInferenceServiceConfigMap(context.Background(), clientSet)
+	if err != nil {
+		setupLog.Error(err, "unable to get configmap", "name", constants.InferenceServiceConfigMapName, "namespace", constants.KServeNamespace)
+		os.Exit(1)
+	}
+	localModelConfig, err := v1beta1.NewLocalModelConfig(isvcConfigMap)
+	if err != nil {
+		setupLog.Error(err, "unable to get local model config")
+		os.Exit(1)
+	}
+	if peerDownload := localModelConfig.PeerDownload; peerDownload != nil && peerDownload.Enabled {
+		port := peerDownload.Port
+		if port == 0 {
+			port = localmodelnodecontroller.DefaultPeerDownloadPort
+		}
+		secretName := localmodelnodecontroller.GetPeerSecretName(peerDownload)
+		credentials, err := localmodelnodecontroller.GetPeerCredentials(context.Background(), clientSet, localModelConfig.JobNamespace, secretName,
+			localmodelnodecontroller.PeerSecretTimeout)
+		if err != nil {
+			setupLog.Error(err, "unable to get peer credentials", "secret", secretName, "namespace", localModelConfig.JobNamespace)
+			os.Exit(1)
+		}
+		peerServer := &localmodelnodecontroller.PeerServer{
+			Client:      mgr.GetClient(),
+			Log:         ctrl.Log.WithName("PeerServer"),
+			Port:        port,
+			Credentials: credentials,
+		}
+		if err := mgr.Add(peerServer); err != nil {
+			setupLog.Error(err, "unable to set up peer server")
+			os.Exit(1)
+		}
+		reconciler.PeerAddress = localmodelnodecontroller.GetPeerAddress(port)
+	}
+
 	// Start the Cmd
 	setupLog.Info("Starting the Cmd.")
 	if err := mgr.Start(signals.SetupSignalHandler()); err != nil {
@@ -41,6 +41,7 @@ import (
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
 	graphcontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/inferencegraph"
+	runtimecontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/servingruntime"
 	trainedmodelcontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/trainedmodel"
 	"github.com/kserve/kserve/pkg/controller/v1alpha1/trainedmodel/reconcilers/modelconfig"
 	v1beta1controller "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice"
@@ -231,6 +232,26 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup ServingRuntime status controllers
+	setupLog.Info("Setting up ServingRuntime controller")
+	if err = (&runtimecontroller.ServingRuntimeReconciler{
+		Client: mgr.GetClient(),
+		Log:    ctrl.Log.WithName("v1alpha1Controllers").WithName("ServingRuntime"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "v1alpha1Controllers", "ServingRuntime")
+		os.Exit(1)
+	}
+	setupLog.Info("Setting up ClusterServingRuntime controller")
+	if err = (&runtimecontroller.ClusterServingRuntimeReconciler{
+		Client: mgr.GetClient(),
+		Log:    ctrl.Log.WithName("v1alpha1Controllers").WithName("ClusterServingRuntime"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "v1alpha1Controllers", "ClusterServingRuntime")
+		os.Exit(1)
+	}
+
 	setupLog.Info("setting up webhook server")
 	hookServer := mgr.GetWebhookServer()
 
@@ -275,7 +296,7 @@ func main() {
 	if err = ctrl.NewWebhookManagedBy(mgr).
 		For(&v1beta1.InferenceService{}).
 		WithDefaulter(&v1beta1.InferenceServiceDefaulter{}).
-		WithValidator(&v1beta1.InferenceServiceValidator{}).
+		WithValidator(&v1beta1.InferenceServiceValidator{Clientset: clientSet}).
 		Complete(); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "v1beta1")
 		os.Exit(1)
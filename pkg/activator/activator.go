@@ -0,0 +1,418 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package activator buffers the requests of Standard deployment mode predictors scaled to zero. The requests
+// are held while the predictor scales from zero and are counted toward the KEDA external push scaler
+// activating the predictor. Once the predictor is warm it is served directly, the activator only receives a
+// mirror of its requests keeping it active.
+package activator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+var log = logf.Log.WithName("Activator")
+
+const (
+	DefaultTimeout       = 5 * time.Minute
+	DefaultProbeInterval = 200 * time.Millisecond
+	DefaultReadyTTL      = 30 * time.Second
+	DefaultIdleTimeout   = 30 * time.Second
+	defaultProbeTimeout  = time.Second
+)
+
+// Target is the service the activator proxies a request to.
+type Target struct {
+	Name      string
+	Namespace string
+	Port      int32
+}
+
+// ParseTarget parses the activator target header value in the name.namespace:port format.
+func ParseTarget(value string) (Target, error) {
+	host, portStr, err := net.SplitHostPort(value)
+	if err != nil {
+		return Target{}, fmt.Errorf("invalid activator target %q: %w", value, err)
+	}
+	name, namespace, found := strings.Cut(host, ".")
+	if !found || name == "" || namespace == "" || strings.Contains(namespace, ".") {
+		return Target{}, fmt.Errorf("invalid activator target %q: expected name.namespace:port", value)
+	}
+	port, err := strconv.ParseInt(portStr, 10, 32)
+	if err != nil || port <= 0 {
+		return Target{}, fmt.Errorf("invalid activator target port %q", portStr)
+	}
+	return Target{Name: name, Namespace: namespace, Port: int32(port)}, nil
+}
+
+// Address returns the cluster local address of the target service.
+func (t Target) Address() string {
+	return net.JoinHostPort(t.Name+"."+t.Namespace+"."+constants.ClusterLocalDomain, strconv.Itoa(int(t.Port)))
+}
+
+func (t Target) key() string {
+	return t.Namespace + "/" + t.Name
+}
+
+// Prober checks whether a target has ready endpoints.
+type Prober interface {
+	Probe(ctx context.Context, address string) error
+}
+
+// TCPProber probes a target by opening a connection to its service, which only succeeds once the service
+// has a ready endpoint.
+type TCPProber struct {
+	Timeout time.Duration
+}
+
+func (p *TCPProber) Probe(ctx context.Context, address string) error {
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = defaultProbeTimeout
+	}
+	dialer := &net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// Options configures the request buffering of the activator.
+type Options struct {
+	// Timeout is the maximum time a request is buffered while its target scales from zero.
+	Timeout time.Duration
+	// ProbeInterval is the interval between the probes of a target scaling from zero.
+	ProbeInterval time.Duration
+	// ReadyTTL is how long a target is considered ready after it was last probed or served a request.
+	// It must be shorter than the KEDA cooldown period so a target scaled to zero is probed again.
+	ReadyTTL time.Duration
+	// IdleTimeout is how long a target is reported active to KEDA after its last request.
+	IdleTimeout time.Duration
+}
+
+type targetState struct {
+	// probeMu serializes the probes of the target between the buffered requests.
+	probeMu     sync.Mutex
+	concurrency int64
+	readyUntil  time.Time
+	lastRequest time.Time
+}
+
+// TargetStats are the requests of a target observed by an activator replica.
+type TargetStats struct {
+	// Concurrency is the number of requests buffered or in flight.
+	Concurrency int64 `json:"concurrency"`
+	// Active is whether the target received a request, proxied or mirrored, within the idle timeout.
+	Active bool `json:"active"`
+}
+
+// Activator is the http handler buffering and proxying the requests to their target.
+type Activator struct {
+	resolver     TargetResolver
+	prober       Prober
+	options      Options
+	metrics      *metrics
+	transport    http.RoundTripper
+	h2cTransport http.RoundTripper
+	now          func() time.Time
+
+	mu      sync.Mutex
+	targets map[string]*targetState
+}
+
+// New returns an Activator proxying the requests to the targets validated by the resolver, probing the targets
+// with the given prober and registering its metrics with the given registerer.
+func New(resolver TargetResolver, prober Prober, options Options, registerer prometheus.Registerer) (*Activator, error) {
+	if options.Timeout == 0 {
+		options.Timeout = DefaultTimeout
+	}
+	if options.ProbeInterval == 0 {
+		options.ProbeInterval = DefaultProbeInterval
+	}
+	if options.ReadyTTL == 0 {
+		options.ReadyTTL = DefaultReadyTTL
+	}
+	if options.IdleTimeout == 0 {
+		options.IdleTimeout = DefaultIdleTimeout
+	}
+	m, err := newMetrics(registerer)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// gRPC requests are proxied over cleartext HTTP/2.
+	h2cTransport := http.DefaultTransport.(*http.Transport).Clone()
+	h2cTransport.Protocols = new(http.Protocols)
+	h2cTransport.Protocols.SetUnencryptedHTTP2(true)
+
+	return &Activator{
+		resolver:     resolver,
+		prober:       prober,
+		options:      options,
+		metrics:      m,
+		transport:    transport,
+		h2cTransport: h2cTransport,
+		now:          time.Now,
+		targets:      make(map[string]*targetState),
+	}, nil
+}
+
+// Stats returns the requests of the service observed by this activator replica.
+func (a *Activator) Stats(namespace, service string) TargetStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if state, ok := a.targets[namespace+"/"+service]; ok {
+		return a.statsLocked(state)
+	}
+	return TargetStats{}
+}
+
+// AllStats returns the requests observed by this activator replica for the targets with requests buffered or
+// in flight, or received within the idle timeout. The stats are keyed by the namespace/name of the target.
+func (a *Activator) AllStats() map[string]TargetStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	all := make(map[string]TargetStats, len(a.targets))
+	for key, state := range a.targets {
+		if stats := a.statsLocked(state); stats.Concurrency > 0 || stats.Active {
+			all[key] = stats
+		}
+	}
+	return all
+}
+
+func (a *Activator) statsLocked(state *targetState) TargetStats {
+	return TargetStats{
+		Concurrency: state.concurrency,
+		Active:      state.concurrency > 0 || a.now().Sub(state.lastRequest) < a.options.IdleTimeout,
+	}
+}
+
+// Run prunes the idle targets until the context is done, so the targets scaled to zero and the deleted ones do
+// not accumulate.
+func (a *Activator) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.options.IdleTimeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.prune()
+		}
+	}
+}
+
+func (a *Activator) prune() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := a.now()
+	for key, state := range a.targets {
+		if state.concurrency > 0 || now.Sub(state.lastRequest) < a.options.IdleTimeout || now.Before(state.readyUntil) {
+			continue
+		}
+		delete(a.targets, key)
+		namespace, name, _ := strings.Cut(key, "/")
+		a.metrics.deleteTarget(namespace, name)
+	}
+}
+
+// resolve returns the target of the request, writing the error response when the request has no valid target.
+func (a *Activator) resolve(w http.ResponseWriter, r *http.Request) (Target, bool) {
+	target, err := a.resolver.Resolve(r.Header.Get(constants.ActivatorTargetHeader))
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, ErrUnknownTarget) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return Target{}, false
+	}
+	r.Header.Del(constants.ActivatorTargetHeader)
+	return target, true
+}
+
+func (a *Activator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	target, ok := a.resolve(w, r)
+	if !ok {
+		return
+	}
+
+	state := a.acquire(target)
+	defer a.release(target)
+
+	if err := a.waitReady(r.Context(), target, state); err != nil {
+		log.Error(err, "Target did not become ready", "namespace", target.Namespace, "name", target.Name)
+		a.metrics.bufferTimeouts.WithLabelValues(target.Namespace, target.Name).Inc()
+		http.Error(w, fmt.Sprintf("%s did not become ready: %v", target.Name, err), http.StatusServiceUnavailable)
+		return
+	}
+	a.proxy(target, state, r).ServeHTTP(w, r)
+}
+
+// MirrorHandler returns the handler of the requests mirrored to the activator while their target is served
+// directly. The mirrored requests are not proxied, they only keep their target active.
+func (a *Activator) MirrorHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target, ok := a.resolve(w, r)
+		if !ok {
+			return
+		}
+		a.mu.Lock()
+		a.stateLocked(target).lastRequest = a.now()
+		a.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (a *Activator) stateLocked(target Target) *targetState {
+	state, ok := a.targets[target.key()]
+	if !ok {
+		state = &targetState{}
+		a.targets[target.key()] = state
+	}
+	return state
+}
+
+// acquire counts the request toward the concurrency of its target.
+func (a *Activator) acquire(target Target) *targetState {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	state := a.stateLocked(target)
+	state.concurrency++
+	state.lastRequest = a.now()
+	a.metrics.concurrency.WithLabelValues(target.Namespace, target.Name).Set(float64(state.concurrency))
+	return state
+}
+
+func (a *Activator) release(target Target) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	state := a.targets[target.key()]
+	state.concurrency--
+	state.lastRequest = a.now()
+	a.metrics.concurrency.WithLabelValues(target.Namespace, target.Name).Set(float64(state.concurrency))
+}
+
+func (a *Activator) isReady(state *targetState) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.now().Before(state.readyUntil)
+}
+
+func (a *Activator) setReady(state *targetState, ready bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if ready {
+		state.readyUntil = a.now().Add(a.options.ReadyTTL)
+	} else {
+		state.readyUntil = time.Time{}
+	}
+}
+
+// waitReady buffers the request until the target has a ready endpoint. The time spent waiting for a target
+// scaling from zero is recorded as its cold start latency.
+func (a *Activator) waitReady(ctx context.Context, target Target, state *targetState) error {
+	if a.isReady(state) {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.options.Timeout)
+	defer cancel()
+	buffered := a.metrics.bufferedRequests.WithLabelValues(target.Namespace, target.Name)
+	buffered.Inc()
+	defer buffered.Dec()
+
+	start := a.now()
+	coldStart := false
+	ticker := time.NewTicker(a.options.ProbeInterval)
+	defer ticker.Stop()
+	for {
+		if a.probe(ctx, target, state) {
+			if coldStart {
+				a.metrics.coldStartDuration.WithLabelValues(target.Namespace, target.Name).Observe(a.now().Sub(start).Seconds())
+			}
+			return nil
+		}
+		coldStart = true
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *Activator) probe(ctx context.Context, target Target, state *targetState) bool {
+	state.probeMu.Lock()
+	defer state.probeMu.Unlock()
+	// Another buffered request may have found the target ready while this one was waiting.
+	if a.isReady(state) {
+		return true
+	}
+	if err := a.prober.Probe(ctx, target.Address()); err != nil {
+		return false
+	}
+	a.setReady(state, true)
+	return true
+}
+
+func (a *Activator) proxy(target Target, state *targetState, r *http.Request) *httputil.ReverseProxy {
+	targetURL := &url.URL{Scheme: "http", Host: target.Address()}
+	transport := a.transport
+	if r.ProtoMajor == 2 {
+		transport = a.h2cTransport
+	}
+	return &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.SetURL(targetURL)
+			pr.SetXForwarded()
+			pr.Out.Host = pr.In.Host
+		},
+		Transport: transport,
+		// Flush the responses immediately so streamed responses are not delayed.
+		FlushInterval: -1,
+		ModifyResponse: func(*http.Response) error {
+			a.setReady(state, true)
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, err error) {
+			if !errors.Is(err, context.Canceled) {
+				// The target may have scaled to zero, the next requests are buffered until it is ready again.
+				a.setReady(state, false)
+			}
+			log.Error(err, "Failed to proxy the request", "namespace", target.Namespace, "name", target.Name)
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+// fakeProber fails the probes until ready is set.
+type fakeProber struct {
+	ready  atomic.Bool
+	probes atomic.Int32
+}
+
+func (p *fakeProber) Probe(_ context.Context, _ string) error {
+	p.probes.Add(1)
+	if !p.ready.Load() {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+// newTestResolver returns a ServiceResolver finding the given services.
+func newTestResolver(t *testing.T, services ...*corev1.Service) *ServiceResolver {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, service := range services {
+		require.NoError(t, indexer.Add(service))
+	}
+	return &ServiceResolver{Lister: corev1listers.NewServiceLister(indexer)}
+}
+
+func newTestService(name string, annotations map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Annotations: annotations},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+	}
+}
+
+// newTestActivator returns an Activator proxying every activator enabled target to the backend server.
+func newTestActivator(t *testing.T, prober Prober, options Options, backend *httptest.Server) *Activator {
+	t.Helper()
+	resolver := newTestResolver(t,
+		newTestService("model-predictor", map[string]string{constants.ActivatorInternalAnnotationKey: "true"}),
+		newTestService("other", nil))
+	a, err := New(resolver, prober, options, prometheus.NewRegistry())
+	require.NoError(t, err)
+	backendAddr := backend.Listener.Addr().String()
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, backendAddr)
+	}
+	a.transport = transport
+	return a
+}
+
+func TestParseTarget(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		want    Target
+		wantErr bool
+	}{
+		"valid target": {
+			value: "model-predictor.default:80",
+			want:  Target{Name: "model-predictor", Namespace: "default", Port: 80},
+		},
+		"missing port": {
+			value:   "model-predictor.default",
+			wantErr: true,
+		},
+		"missing namespace": {
+			value:   "model-predictor:80",
+			wantErr: true,
+		},
+		"fully qualified host": {
+			value:   "model-predictor.default.svc.cluster.local:80",
+			wantErr: true,
+		},
+		"invalid port": {
+			value:   "model-predictor.default:http",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseTarget(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "model-predictor.default.svc.cluster.local:80", got.Address())
+		})
+	}
+}
+
+func TestActivatorRejectsMissingTarget(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer backend.Close()
+	a := newTestActivator(t, &fakeProber{}, Options{}, backend)
+
+	rec := httptest.NewRecorder()
+	a.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/models/model:predict", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestActivatorRejectsUnknownTarget(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("request must not be proxied")
+	}))
+	defer backend.Close()
+	a := newTestActivator(t, &fakeProber{}, Options{}, backend)
+
+	for _, target := range []string{
+		"missing.default:80",
+		"other.default:80",
+		"model-predictor.default:8080",
+		"kubernetes.default:443",
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/v1/models/model:predict", nil)
+		req.Header.Set(constants.ActivatorTargetHeader, target)
+		rec := httptest.NewRecorder()
+		a.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNotFound, rec.Code, target)
+	}
+	assert.Empty(t, a.AllStats())
+}
+
+func TestActivatorBuffersUntilReady(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get(constants.ActivatorTargetHeader))
+		assert.Equal(t, "model.example.com", r.Host)
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer backend.Close()
+	prober := &fakeProber{}
+	a := newTestActivator(t, prober, Options{ProbeInterval: 10 * time.Millisecond}, backend)
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() {
+		req := httptest.NewRequest(http.MethodPost, "http://model.example.com/v1/models/model:predict", nil)
+		req.Header.Set(constants.ActivatorTargetHeader, "model-predictor.default:80")
+		rec := httptest.NewRecorder()
+		a.ServeHTTP(rec, req)
+		done <- rec
+	}()
+
+	// The request is buffered and counted toward the concurrency while the target scales from zero.
+	assert.Eventually(t, func() bool {
+		return prober.probes.Load() > 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int64(1), a.Stats("default", "model-predictor").Concurrency)
+	assert.InDelta(t, 1, testutil.ToFloat64(a.metrics.bufferedRequests.WithLabelValues("default", "model-predictor")), 0)
+
+	prober.ready.Store(true)
+	var rec *httptest.ResponseRecorder
+	select {
+	case rec = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not proxied after the target became ready")
+	}
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "ok", rec.Body.String())
+	assert.Equal(t, int64(0), a.Stats("default", "model-predictor").Concurrency)
+	assert.InDelta(t, 0, testutil.ToFloat64(a.metrics.bufferedRequests.WithLabelValues("default", "model-predictor")), 0)
+	assert.Equal(t, 1, testutil.CollectAndCount(a.metrics.coldStartDuration))
+
+	// The target is ready, the next request is proxied without probing.
+	probes := prober.probes.Load()
+	req := httptest.NewRequest(http.MethodPost, "http://model.example.com/v1/models/model:predict", nil)
+	req.Header.Set(constants.ActivatorTargetHeader, "model-predictor.default:80")
+	rec = httptest.NewRecorder()
+	a.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, probes, prober.probes.Load())
+}
+
+func TestActivatorTimeout(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer backend.Close()
+	a := newTestActivator(t, &fakeProber{}, Options{Timeout: 50 * time.Millisecond, ProbeInterval: 10 * time.Millisecond}, backend)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/models/model:predict", nil)
+	req.Header.Set(constants.ActivatorTargetHeader, "model-predictor.default:80")
+	rec := httptest.NewRecorder()
+	a.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.InDelta(t, 1, testutil.ToFloat64(a.metrics.bufferTimeouts.WithLabelValues("default", "model-predictor")), 0)
+	assert.Equal(t, int64(0), a.Stats("default", "model-predictor").Concurrency)
+}
+
+func TestActivatorProxyErrorResetsReadiness(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	prober := &fakeProber{}
+	prober.ready.Store(true)
+	a := newTestActivator(t, prober, Options{Timeout: 50 * time.Millisecond, ProbeInterval: 10 * time.Millisecond}, backend)
+	// The target scaled to zero after it was found ready.
+	backend.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/models/model:predict", nil)
+	req.Header.Set(constants.ActivatorTargetHeader, "model-predictor.default:80")
+	rec := httptest.NewRecorder()
+	a.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	assert.True(t, a.targets["default/model-predictor"].readyUntil.IsZero())
+}
+
+func TestActivatorMirrorKeepsTargetActive(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("mirrored request must not be proxied")
+	}))
+	defer backend.Close()
+	prober := &fakeProber{}
+	a := newTestActivator(t, prober, Options{IdleTimeout: time.Minute}, backend)
+	now := time.Now()
+	a.now = func() time.Time { return now }
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/models/model:predict", nil)
+	req.Header.Set(constants.ActivatorTargetHeader, "model-predictor.default:80")
+	rec := httptest.NewRecorder()
+	a.MirrorHandler().ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, TargetStats{Active: true}, a.Stats("default", "model-predictor"))
+	assert.Equal(t, map[string]TargetStats{"default/model-predictor": {Active: true}}, a.AllStats())
+	assert.Equal(t, int32(0), prober.probes.Load())
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, TargetStats{}, a.Stats("default", "model-predictor"))
+	assert.Empty(t, a.AllStats())
+}
+
+func TestActivatorPrunesIdleTargets(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer backend.Close()
+	prober := &fakeProber{}
+	prober.ready.Store(true)
+	a := newTestActivator(t, prober, Options{IdleTimeout: time.Minute, ReadyTTL: time.Minute}, backend)
+	now := time.Now()
+	a.now = func() time.Time { return now }
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/models/model:predict", nil)
+	req.Header.Set(constants.ActivatorTargetHeader, "model-predictor.default:80")
+	a.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, 1, testutil.CollectAndCount(a.metrics.concurrency))
+
+	a.prune()
+	assert.Len(t, a.targets, 1)
+
+	now = now.Add(2 * time.Minute)
+	a.prune()
+	assert.Empty(t, a.targets)
+	assert.Equal(t, 0, testutil.CollectAndCount(a.metrics.concurrency))
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "kserve"
+	metricsSubsystem = "activator"
+)
+
+var metricLabels = []string{"namespace", "service"}
+
+type metrics struct {
+	concurrency       *prometheus.GaugeVec
+	bufferedRequests  *prometheus.GaugeVec
+	coldStartDuration *prometheus.HistogramVec
+	bufferTimeouts    *prometheus.CounterVec
+}
+
+func newMetrics(registerer prometheus.Registerer) (*metrics, error) {
+	m := &metrics{
+		concurrency: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "request_concurrency",
+			Help:      "Number of requests buffered or in flight through the activator.",
+		}, metricLabels),
+		bufferedRequests: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "buffered_requests",
+			Help:      "Number of requests waiting for their target to become ready.",
+		}, metricLabels),
+		coldStartDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "cold_start_duration_seconds",
+			Help:      "Time the requests were buffered while their target scaled from zero.",
+			Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12),
+		}, metricLabels),
+		bufferTimeouts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "buffer_timeouts_total",
+			Help:      "Number of requests failed because their target did not become ready in time.",
+		}, metricLabels),
+	}
+	for _, collector := range []prometheus.Collector{m.concurrency, m.bufferedRequests, m.coldStartDuration, m.bufferTimeouts} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// deleteTarget removes the series of a pruned target.
+func (m *metrics) deleteTarget(namespace, name string) {
+	m.concurrency.DeleteLabelValues(namespace, name)
+	m.bufferedRequests.DeleteLabelValues(namespace, name)
+	m.coldStartDuration.DeleteLabelValues(namespace, name)
+	m.bufferTimeouts.DeleteLabelValues(namespace, name)
+}
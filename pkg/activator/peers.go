@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultPeerSyncInterval = 500 * time.Millisecond
+	// StatsPath is the path the activator replicas serve their stats to their peers on.
+	StatsPath       = "/stats"
+	peerSyncTimeout = time.Second
+)
+
+// StatsHandler serves the stats of the activator replica to its peers.
+func StatsHandler(a *Activator) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(a.AllStats()); err != nil {
+			log.Error(err, "Failed to encode the activator stats")
+		}
+	})
+}
+
+// PeerLookup returns the addresses of the activator replicas.
+type PeerLookup func(ctx context.Context) ([]string, error)
+
+// DNSPeerLookup looks the activator replicas up from the headless peers service, excluding this replica.
+func DNSPeerLookup(host string, selfIP string) PeerLookup {
+	return func(ctx context.Context) ([]string, error) {
+		ips, err := net.DefaultResolver.LookupHost(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		peers := make([]string, 0, len(ips))
+		for _, ip := range ips {
+			if ip != selfIP {
+				peers = append(peers, ip)
+			}
+		}
+		return peers, nil
+	}
+}
+
+// PeerReporter reports the stats of a target summed over the activator replicas. KEDA reaches a single replica
+// through the activator service while the requests are spread over all the replicas, so the replica KEDA
+// reaches adds the stats its peers observed to its own.
+type PeerReporter struct {
+	local    *Activator
+	lookup   PeerLookup
+	port     int
+	interval time.Duration
+	client   *http.Client
+
+	mu     sync.RWMutex
+	remote map[string]TargetStats
+}
+
+// NewPeerReporter returns a PeerReporter syncing the stats served on the given port by the peers found with
+// the lookup every interval.
+func NewPeerReporter(local *Activator, lookup PeerLookup, port int, interval time.Duration) *PeerReporter {
+	if interval == 0 {
+		interval = DefaultPeerSyncInterval
+	}
+	return &PeerReporter{
+		local:    local,
+		lookup:   lookup,
+		port:     port,
+		interval: interval,
+		client:   &http.Client{Timeout: peerSyncTimeout},
+		remote:   map[string]TargetStats{},
+	}
+}
+
+func (p *PeerReporter) Stats(namespace, service string) TargetStats {
+	stats := p.local.Stats(namespace, service)
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	remote := p.remote[namespace+"/"+service]
+	return TargetStats{
+		Concurrency: stats.Concurrency + remote.Concurrency,
+		Active:      stats.Active || remote.Active,
+	}
+}
+
+// Run syncs the stats of the peers until the context is done.
+func (p *PeerReporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.sync(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *PeerReporter) sync(ctx context.Context) {
+	peers, err := p.lookup(ctx)
+	if err != nil {
+		log.V(1).Info("Failed to look the activator peers up", "error", err.Error())
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		remote = map[string]TargetStats{}
+	)
+	for _, peer := range peers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stats, err := p.fetch(ctx, peer)
+			if err != nil {
+				// A replica which cannot be reached has no request to report, e.g. it is shutting down.
+				log.V(1).Info("Failed to sync the stats of an activator peer", "peer", peer, "error", err.Error())
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for key, s := range stats {
+				sum := remote[key]
+				sum.Concurrency += s.Concurrency
+				sum.Active = sum.Active || s.Active
+				remote[key] = sum
+			}
+		}()
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.remote = remote
+}
+
+func (p *PeerReporter) fetch(ctx context.Context, peer string) (map[string]TargetStats, error) {
+	url := "http://" + net.JoinHostPort(peer, strconv.Itoa(p.port)) + StatsPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	stats := map[string]TargetStats{}
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
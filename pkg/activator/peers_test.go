@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerReporterSumsPeerStats(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer backend.Close()
+	local := newTestActivator(t, &fakeProber{}, Options{}, backend)
+	peer := newTestActivator(t, &fakeProber{}, Options{}, backend)
+	peer.acquire(Target{Name: "model-predictor", Namespace: "default", Port: 80})
+	peer.acquire(Target{Name: "model-predictor", Namespace: "default", Port: 80})
+	local.acquire(Target{Name: "model-predictor", Namespace: "default", Port: 80})
+
+	mux := http.NewServeMux()
+	mux.Handle(StatsPath, StatsHandler(peer))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	lookup := func(context.Context) ([]string, error) { return []string{host}, nil }
+	reporter := NewPeerReporter(local, lookup, portNumber, time.Minute)
+	reporter.sync(t.Context())
+	assert.Equal(t, TargetStats{Concurrency: 3, Active: true}, reporter.Stats("default", "model-predictor"))
+	assert.Equal(t, TargetStats{}, reporter.Stats("default", "other"))
+
+	// A peer which cannot be reached no longer reports its requests.
+	server.Close()
+	reporter.sync(t.Context())
+	assert.Equal(t, TargetStats{Concurrency: 1, Active: true}, reporter.Stats("default", "model-predictor"))
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+// ErrUnknownTarget is returned for the targets which are not activator enabled predictor services.
+var ErrUnknownTarget = errors.New("unknown activator target")
+
+// TargetResolver resolves the activator target header of a request to the service it is proxied to.
+type TargetResolver interface {
+	Resolve(value string) (Target, error)
+}
+
+// ServiceResolver resolves the targets from the services cache. The target header only selects the service,
+// the request is proxied to it only if the controller enabled the activator for it and the port is one of its
+// ports, so the activator cannot be used to reach any other service of the cluster.
+type ServiceResolver struct {
+	Lister corev1listers.ServiceLister
+}
+
+func (r *ServiceResolver) Resolve(value string) (Target, error) {
+	target, err := ParseTarget(value)
+	if err != nil {
+		return Target{}, err
+	}
+	service, err := r.Lister.Services(target.Namespace).Get(target.Name)
+	if err != nil {
+		return Target{}, fmt.Errorf("%w %s/%s: %w", ErrUnknownTarget, target.Namespace, target.Name, err)
+	}
+	if service.Annotations[constants.ActivatorInternalAnnotationKey] != "true" {
+		return Target{}, fmt.Errorf("%w %s/%s: the activator is not enabled", ErrUnknownTarget, target.Namespace, target.Name)
+	}
+	if !slices.ContainsFunc(service.Spec.Ports, func(port corev1.ServicePort) bool { return port.Port == target.Port }) {
+		return Target{}, fmt.Errorf("%w %s/%s: no port %d", ErrUnknownTarget, target.Namespace, target.Name, target.Port)
+	}
+	return target, nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/kedacore/keda/v2/pkg/scalers/externalscaler"
+	"google.golang.org/grpc"
+
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+const (
+	DefaultStreamInterval = 200 * time.Millisecond
+	concurrencyMetricName = "concurrency"
+)
+
+// StatsReporter reports the requests of a service observed by the activator replicas.
+type StatsReporter interface {
+	Stats(namespace, service string) TargetStats
+}
+
+// Scaler is the KEDA external push scaler activating the services with requests buffered or in flight
+// through the activator, or mirrored to it while they are served directly, and scaling them on the request
+// concurrency.
+type Scaler struct {
+	externalscaler.UnimplementedExternalScalerServer
+	reporter       StatsReporter
+	streamInterval time.Duration
+}
+
+// NewScaler returns a Scaler checking the stats reported by the reporter every stream interval to push the
+// activation of the services.
+func NewScaler(reporter StatsReporter, streamInterval time.Duration) *Scaler {
+	if streamInterval == 0 {
+		streamInterval = DefaultStreamInterval
+	}
+	return &Scaler{reporter: reporter, streamInterval: streamInterval}
+}
+
+func (s *Scaler) stats(ref *externalscaler.ScaledObjectRef) TargetStats {
+	service := ref.GetScalerMetadata()[constants.ActivatorScalerServiceKey]
+	if service == "" {
+		service = ref.GetName()
+	}
+	return s.reporter.Stats(ref.GetNamespace(), service)
+}
+
+func (s *Scaler) IsActive(_ context.Context, ref *externalscaler.ScaledObjectRef) (*externalscaler.IsActiveResponse, error) {
+	return &externalscaler.IsActiveResponse{Result: s.stats(ref).Active}, nil
+}
+
+// StreamIsActive pushes the activation of the service as soon as a request is received for it.
+func (s *Scaler) StreamIsActive(ref *externalscaler.ScaledObjectRef, stream grpc.ServerStreamingServer[externalscaler.IsActiveResponse]) error {
+	ticker := time.NewTicker(s.streamInterval)
+	defer ticker.Stop()
+	var active, sent bool
+	for {
+		if current := s.stats(ref).Active; !sent || current != active {
+			if err := stream.Send(&externalscaler.IsActiveResponse{Result: current}); err != nil {
+				return err
+			}
+			active, sent = current, true
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scaler) GetMetricSpec(_ context.Context, ref *externalscaler.ScaledObjectRef) (*externalscaler.GetMetricSpecResponse, error) {
+	target := int64(constants.DefaultActivatorTargetConcurrency)
+	if value, ok := ref.GetScalerMetadata()[constants.ActivatorScalerTargetKey]; ok {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed <= 0 {
+			log.Info("Ignoring invalid target concurrency", "value", value, "namespace", ref.GetNamespace(), "name", ref.GetName())
+		} else {
+			target = parsed
+		}
+	}
+	return &externalscaler.GetMetricSpecResponse{
+		MetricSpecs: []*externalscaler.MetricSpec{{
+			MetricName:      concurrencyMetricName,
+			TargetSize:      target,
+			TargetSizeFloat: float64(target),
+		}},
+	}, nil
+}
+
+func (s *Scaler) GetMetrics(_ context.Context, req *externalscaler.GetMetricsRequest) (*externalscaler.GetMetricsResponse, error) {
+	concurrency := s.stats(req.GetScaledObjectRef()).Concurrency
+	return &externalscaler.GetMetricsResponse{
+		MetricValues: []*externalscaler.MetricValue{{
+			MetricName:       concurrencyMetricName,
+			MetricValue:      concurrency,
+			MetricValueFloat: float64(concurrency),
+		}},
+	}, nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kedacore/keda/v2/pkg/scalers/externalscaler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+type fakeReporter struct {
+	mu          sync.Mutex
+	concurrency map[string]int64
+}
+
+func (r *fakeReporter) set(namespace, service string, value int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.concurrency[namespace+"/"+service] = value
+}
+
+func (r *fakeReporter) Stats(namespace, service string) TargetStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	concurrency := r.concurrency[namespace+"/"+service]
+	return TargetStats{Concurrency: concurrency, Active: concurrency > 0}
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan bool
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) Send(resp *externalscaler.IsActiveResponse) error {
+	s.sent <- resp.GetResult()
+	return nil
+}
+
+func newScaledObjectRef(metadata map[string]string) *externalscaler.ScaledObjectRef {
+	return &externalscaler.ScaledObjectRef{
+		Name:           "model-predictor",
+		Namespace:      "default",
+		ScalerMetadata: metadata,
+	}
+}
+
+func TestScalerIsActive(t *testing.T) {
+	reporter := &fakeReporter{concurrency: map[string]int64{}}
+	scaler := NewScaler(reporter, 0)
+	ref := newScaledObjectRef(map[string]string{constants.ActivatorScalerServiceKey: "model-predictor"})
+
+	resp, err := scaler.IsActive(t.Context(), ref)
+	require.NoError(t, err)
+	assert.False(t, resp.GetResult())
+
+	reporter.set("default", "model-predictor", 2)
+	resp, err = scaler.IsActive(t.Context(), ref)
+	require.NoError(t, err)
+	assert.True(t, resp.GetResult())
+
+	metrics, err := scaler.GetMetrics(t.Context(), &externalscaler.GetMetricsRequest{ScaledObjectRef: ref, MetricName: concurrencyMetricName})
+	require.NoError(t, err)
+	require.Len(t, metrics.GetMetricValues(), 1)
+	assert.Equal(t, int64(2), metrics.GetMetricValues()[0].GetMetricValue())
+}
+
+func TestScalerGetMetricSpec(t *testing.T) {
+	scaler := NewScaler(&fakeReporter{concurrency: map[string]int64{}}, 0)
+	tests := map[string]struct {
+		metadata map[string]string
+		want     int64
+	}{
+		"default target": {
+			metadata: map[string]string{},
+			want:     constants.DefaultActivatorTargetConcurrency,
+		},
+		"configured target": {
+			metadata: map[string]string{constants.ActivatorScalerTargetKey: "5"},
+			want:     5,
+		},
+		"invalid target": {
+			metadata: map[string]string{constants.ActivatorScalerTargetKey: "-1"},
+			want:     constants.DefaultActivatorTargetConcurrency,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := scaler.GetMetricSpec(t.Context(), newScaledObjectRef(tt.metadata))
+			require.NoError(t, err)
+			require.Len(t, resp.GetMetricSpecs(), 1)
+			assert.Equal(t, concurrencyMetricName, resp.GetMetricSpecs()[0].GetMetricName())
+			assert.Equal(t, tt.want, resp.GetMetricSpecs()[0].GetTargetSize())
+		})
+	}
+}
+
+func TestScalerStreamIsActive(t *testing.T) {
+	reporter := &fakeReporter{concurrency: map[string]int64{}}
+	scaler := NewScaler(reporter, 5*time.Millisecond)
+	ctx, cancel := context.WithCancel(t.Context())
+	stream := &fakeStream{ctx: ctx, sent: make(chan bool, 10)}
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- scaler.StreamIsActive(newScaledObjectRef(nil), stream)
+	}()
+
+	assert.False(t, <-stream.sent)
+	reporter.set("default", "model-predictor", 1)
+	assert.True(t, <-stream.sent)
+	reporter.set("default", "model-predictor", 0)
+	assert.False(t, <-stream.sent)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
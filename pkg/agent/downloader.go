@@ -35,7 +35,9 @@ type Downloader struct {
 	ModelDir  string
 	mu        sync.Mutex
 	Providers map[storage.Protocol]storage.Provider
-	Logger    *zap.SugaredLogger
+	// Helpers download the models with storage URIs of protocols the agent does not support
+	Helpers []*storage.HelperProvider
+	Logger  *zap.SugaredLogger
 }
 
 func (d *Downloader) DownloadModel(modelName string, modelSpec *v1alpha1.ModelSpec) error {
@@ -82,7 +84,17 @@ func (d *Downloader) DownloadModel(modelName string, modelSpec *v1alpha1.ModelSp
 func (d *Downloader) download(modelName string, storageUri string) error {
 	protocol, err := extractProtocol(storageUri)
 	if err != nil {
-		return errors.Wrapf(err, "unsupported protocol")
+		helper, helperErr := d.getHelper(storageUri)
+		if helperErr != nil {
+			return helperErr
+		}
+		if helper == nil {
+			return errors.Wrapf(err, "unsupported protocol")
+		}
+		if err := helper.DownloadModel(d.ModelDir, modelName, storageUri); err != nil {
+			return errors.Wrapf(err, "failed to download model")
+		}
+		return nil
 	}
 	d.mu.Lock()
 	provider, err := storage.GetProvider(d.Providers, protocol)
@@ -96,6 +108,27 @@ func (d *Downloader) download(modelName string, storageUri string) error {
 	return nil
 }
 
+// ResetProviders drops the storage clients, which are created again with the credentials exported since
+func (d *Downloader) ResetProviders() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.Providers = map[storage.Protocol]storage.Provider{}
+}
+
+// getHelper returns the first storage helper downloading the storage URI, or nil if none does
+func (d *Downloader) getHelper(storageUri string) (*storage.HelperProvider, error) {
+	for _, helper := range d.Helpers {
+		supported, err := helper.SupportsUri(storageUri)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid URI format of storage helper %s", helper.Config.Name)
+		}
+		if supported {
+			return helper, nil
+		}
+	}
+	return nil, nil
+}
+
 func extractProtocol(storageURI string) (storage.Protocol, error) {
 	if storageURI == "" {
 		return "", errors.New("there is no storageUri supplied")
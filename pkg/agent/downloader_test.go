@@ -17,8 +17,11 @@ limitations under the License.
 package agent
 
 import (
+	"context"
 	logger "log"
 	"os"
+	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -99,4 +102,35 @@ var _ = Describe("Downloader", func() {
 			Expect(err).Should(HaveOccurred())
 		})
 	})
+
+	Context("When a storage helper supports the protocol", func() {
+		It("Should download the model with the storage helper", func() {
+			helperDir := filepath.Join(modelDir, "helper")
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() {
+				_ = storage.ServeHelperRequests(ctx, helperDir, []string{"sh", "-c", `mkdir -p "$1" && touch "$1/model.bin"`})
+			}()
+			downloader.Helpers = []*storage.HelperProvider{
+				{
+					Config: storage.HelperConfig{
+						Name:                "custom",
+						Dir:                 helperDir,
+						SupportedUriFormats: []storage.HelperUriFormat{{Prefix: "custom://"}},
+					},
+					Timeout: 30 * time.Second,
+				},
+			}
+			modelConfig := modelconfig.ModelConfig{
+				Name: "model1",
+				Spec: v1alpha1.ModelSpec{
+					StorageURI: "custom://models/model1",
+					Framework:  "sklearn",
+				},
+			}
+			err := downloader.DownloadModel(modelConfig.Name, &modelConfig.Spec)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(filepath.Join(downloader.ModelDir, "model1", "model.bin")).To(BeARegularFile())
+		})
+	})
 })
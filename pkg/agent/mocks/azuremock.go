@@ -18,6 +18,7 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"os"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
@@ -75,14 +76,18 @@ func (m mockAzureClient) DownloadFile(ctx context.Context, bucket string, prefix
 	return 0, nil
 }
 
-func (m mockAzureClient) UploadBuffer(ctx context.Context, bucket string, key string, object []byte, o *azblob.UploadBufferOptions) (azblob.UploadBufferResponse, error) {
+func (m mockAzureClient) UploadStream(ctx context.Context, bucket string, key string, body io.Reader, o *azblob.UploadStreamOptions) (azblob.UploadStreamResponse, error) {
+	object, err := io.ReadAll(body)
+	if err != nil {
+		return azblob.UploadStreamResponse{}, err
+	}
 	m.buckets[bucket].objects[key] = &mockAzureObject{
 		blobItem: &container.BlobItem{
 			Name: &key,
 		},
 		buffer: object,
 	}
-	return azblob.UploadBufferResponse{}, nil
+	return azblob.UploadStreamResponse{}, nil
 }
 
 func NewMockAzureClient() *mockAzureClient {
@@ -159,3 +159,12 @@ func (w *mockWriter) Write(data []byte) (int, error) {
 	w.obj.MD5 = data
 	return int, err
 }
+
+func (w *mockWriter) ObjectAttrs() *gstorage.ObjectAttrs {
+	return w.obj
+}
+
+func (w *mockWriter) Close() error {
+	w.obj.MD5 = w.buf.Bytes()
+	return nil
+}
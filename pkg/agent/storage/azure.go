@@ -17,26 +17,33 @@ limitations under the License.
 package storage
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"k8s.io/utils/ptr"
 )
 
 type AzureClient interface {
 	NewListBlobsFlatPager(bucket string, options *azblob.ListBlobsFlatOptions) *runtime.Pager[azblob.ListBlobsFlatResponse]
 	DownloadFile(ctx context.Context, bucket string, prefix string, file *os.File, options *azblob.DownloadFileOptions) (int64, error)
-	UploadBuffer(ctx context.Context, bucket string, key string, object []byte, o *azblob.UploadBufferOptions) (azblob.UploadBufferResponse, error)
+	UploadStream(ctx context.Context, bucket string, key string, body io.Reader, o *azblob.UploadStreamOptions) (azblob.UploadStreamResponse, error)
 }
 
 type AzureProvider struct {
 	Client AzureClient
 }
 
-var _ Provider = (*AzureProvider)(nil)
+var (
+	_ Provider = (*AzureProvider)(nil)
+	_ Store    = (*AzureProvider)(nil)
+)
 
 func (a AzureProvider) DownloadModel(modelDir string, modelName string, storageUri string) error {
 	log.Info("Download model ", "modelName", modelName, "storageUri", storageUri, "modelDir", modelDir)
@@ -85,12 +92,30 @@ func (a AzureProvider) DownloadModel(modelDir string, modelName string, storageU
 }
 
 func (a AzureProvider) UploadObject(bucket string, key string, object []byte) error {
-	log.Info("Upload object ", "bucket", bucket, "key", key, "length", len(object))
+	return a.Upload(context.Background(), bucket, key, bytes.NewReader(object), UploadOptions{})
+}
 
-	_, err := a.Client.UploadBuffer(context.Background(), bucket, key, object, nil)
-	if err != nil {
+// Upload streams the object as a block blob, staging a block for each buffer read from the body and committing
+// the block list at the end.
+func (a AzureProvider) Upload(ctx context.Context, bucket string, key string, body io.Reader, options UploadOptions) error {
+	log.Info("Upload object ", "bucket", bucket, "key", key)
+
+	uploadOptions := &azblob.UploadStreamOptions{}
+	if options.ContentType != "" {
+		uploadOptions.HTTPHeaders = &blob.HTTPHeaders{BlobContentType: &options.ContentType}
+	}
+	if options.StorageClass != "" {
+		uploadOptions.AccessTier = ptr.To(blob.AccessTier(options.StorageClass))
+	}
+	if options.KMSKeyID != "" {
+		uploadOptions.CPKScopeInfo = &blob.CPKScopeInfo{EncryptionScope: &options.KMSKeyID}
+	}
+	if len(options.Tags) > 0 {
+		uploadOptions.Tags = options.Tags
+	}
+	if _, err := a.Client.UploadStream(ctx, bucket, key, body, uploadOptions); err != nil {
 		return err
 	}
-	log.Info("Wrote object to bucket ", "bucket", bucket, "key", key, "length", len(object))
+	log.Info("Wrote object to bucket ", "bucket", bucket, "key", key)
 	return nil
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package storage
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -34,6 +35,11 @@ type GCSProvider struct {
 	Client stiface.Client
 }
 
+var (
+	_ Provider = (*GCSProvider)(nil)
+	_ Store    = (*GCSProvider)(nil)
+)
+
 func (p *GCSProvider) DownloadModel(modelDir string, modelName string, storageUri string) error {
 	log.Info("Downloading model ", "modelName", modelName, "storageUri", storageUri, "modelDir", modelDir)
 	gcsUri := strings.TrimPrefix(storageUri, string(GCS))
@@ -61,8 +67,25 @@ func (p *GCSProvider) DownloadModel(modelDir string, modelName string, storageUr
 }
 
 func (p *GCSProvider) UploadObject(bucket string, key string, object []byte) error {
-	writer := p.Client.Bucket(bucket).Object(key).NewWriter(context.Background())
-	if _, err := writer.Write(object); err != nil {
+	return p.Upload(context.Background(), bucket, key, bytes.NewReader(object), UploadOptions{})
+}
+
+// Upload streams the object with a resumable upload, sent in chunks of the writer chunk size.
+func (p *GCSProvider) Upload(ctx context.Context, bucket string, key string, body io.Reader, options UploadOptions) error {
+	// Cancelling the context aborts the upload rather than committing a partial object
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writer := p.Client.Bucket(bucket).Object(key).NewWriter(ctx)
+	attrs := writer.ObjectAttrs()
+	attrs.ContentType = options.ContentType
+	attrs.StorageClass = options.StorageClass
+	attrs.KMSKeyName = options.KMSKeyID
+	if len(options.Tags) > 0 {
+		attrs.Metadata = options.Tags
+	}
+	if _, err := io.Copy(writer, body); err != nil {
+		cancel()
+		_ = writer.Close()
 		return fmt.Errorf("failed to write object to bucket %s with key %s: %w", bucket, key, err)
 	}
 	if err := writer.Close(); err != nil {
@@ -0,0 +1,262 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A storage helper is a sidecar running the container of a ClusterStorageContainer to download the models with
+// URI formats the agent does not support. The agent writes a download request file to the folder of the helper,
+// the helper runs the container command with the URI and the destination folder as arguments, and writes the
+// result file once the command exits.
+const (
+	helperRequestSuffix = ".request"
+	helperResultSuffix  = ".result"
+	helperPollInterval  = time.Second
+	// DefaultHelperTimeout is the time to wait for a storage helper to download a model when no timeout is set
+	DefaultHelperTimeout = time.Hour
+)
+
+// HelperConfig is the configuration of a storage helper sidecar passed to the agent
+type HelperConfig struct {
+	// Name of the ClusterStorageContainer run by the helper
+	Name string `json:"name"`
+	// Folder shared with the helper for the download requests
+	Dir string `json:"dir"`
+	// URI formats downloaded by the helper
+	SupportedUriFormats []HelperUriFormat `json:"supportedUriFormats"`
+}
+
+// HelperUriFormat is a supported URI format of a ClusterStorageContainer, either a prefix or a regex
+type HelperUriFormat struct {
+	Prefix string `json:"prefix,omitempty"`
+	Regex  string `json:"regex,omitempty"`
+}
+
+type helperRequest struct {
+	StorageUri string `json:"storageUri"`
+	ModelDir   string `json:"modelDir"`
+}
+
+type helperResult struct {
+	Error string `json:"error,omitempty"`
+}
+
+// HelperProvider downloads the models with a storage helper sidecar
+type HelperProvider struct {
+	Config HelperConfig
+	// Time to wait for the helper to download a model, DefaultHelperTimeout if zero
+	Timeout time.Duration
+}
+
+var _ Provider = (*HelperProvider)(nil)
+
+// SupportsUri returns whether the storage helper downloads the storage URI
+func (p *HelperProvider) SupportsUri(storageUri string) (bool, error) {
+	for _, format := range p.Config.SupportedUriFormats {
+		if format.Prefix != "" {
+			if strings.HasPrefix(storageUri, format.Prefix) {
+				return true, nil
+			}
+		} else if format.Regex != "" {
+			match, err := regexp.MatchString(format.Regex, storageUri)
+			if err != nil {
+				return false, err
+			}
+			if match {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (p *HelperProvider) DownloadModel(modelDir string, modelName string, storageUri string) error {
+	log.Info("Download model with storage helper", "helper", p.Config.Name, "modelName", modelName, "storageUri", storageUri)
+	// The request ID is unique among the requests of the agent, and the model folder is only downloaded once at a time
+	id := modelName + "-" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	request := helperRequest{StorageUri: storageUri, ModelDir: filepath.Join(modelDir, modelName)}
+	// The agent may write a request before the helper has started
+	if err := os.MkdirAll(p.Config.Dir, 0o777); err != nil { //nolint:gosec // G301: the agent and the helper run as different UIDs
+		return err
+	}
+	if err := writeHelperFile(filepath.Join(p.Config.Dir, id+helperRequestSuffix), request); err != nil {
+		return fmt.Errorf("failed to write download request for storage helper %s: %w", p.Config.Name, err)
+	}
+
+	resultPath := filepath.Join(p.Config.Dir, id+helperResultSuffix)
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultHelperTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		data, err := os.ReadFile(resultPath)
+		if err == nil {
+			_ = os.Remove(resultPath)
+			result := helperResult{}
+			if err := json.Unmarshal(data, &result); err != nil {
+				return fmt.Errorf("invalid result of storage helper %s: %w", p.Config.Name, err)
+			}
+			if result.Error != "" {
+				return fmt.Errorf("storage helper %s failed to download %s: %s", p.Config.Name, storageUri, result.Error)
+			}
+			return nil
+		}
+		if !os.IsNotExist(err) {
+			return err
+		}
+		if time.Now().After(deadline) {
+			_ = os.Remove(filepath.Join(p.Config.Dir, id+helperRequestSuffix))
+			return fmt.Errorf("storage helper %s did not download %s within %s", p.Config.Name, storageUri, timeout)
+		}
+		time.Sleep(helperPollInterval)
+	}
+}
+
+func (p *HelperProvider) UploadObject(bucket string, key string, object []byte) error {
+	return errors.New("upload not supported by storage helpers")
+}
+
+// writeHelperFile writes the file atomically so that it is never read partially
+func writeHelperFile(path string, content interface{}) error {
+	data, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil { //nolint:gosec // G306: the agent and the helper run as different UIDs
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// InstallHelper copies the running agent binary to the path, from where the storage helper sidecar runs it
+func InstallHelper(path string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	src, err := os.Open(executable)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	if err := os.MkdirAll(filepath.Dir(path), 0o777); err != nil { //nolint:gosec // G301: the agent and the helper run as different UIDs
+		return err
+	}
+	dst, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755) //nolint:gosec // G302: the helper runs the binary
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
+
+// ServeHelperRequests runs the command for each download request written to the folder, with the storage URI and
+// the model folder as additional arguments, until the context is done. The requests are served concurrently, the
+// running downloads are waited for before returning.
+func ServeHelperRequests(ctx context.Context, dir string, command []string) error {
+	if len(command) == 0 {
+		return errors.New("no command to run for the download requests")
+	}
+	if err := os.MkdirAll(dir, 0o777); err != nil { //nolint:gosec // G301: the agent and the helper run as different UIDs
+		return err
+	}
+	log.Info("Serving download requests", "dir", dir, "command", strings.Join(command, " "))
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	var mu sync.Mutex
+	// The requests being served, which are only removed once their result is written
+	serving := map[string]bool{}
+	for {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if !strings.HasSuffix(entry.Name(), helperRequestSuffix) {
+				continue
+			}
+			requestPath := filepath.Join(dir, entry.Name())
+			mu.Lock()
+			if serving[requestPath] {
+				mu.Unlock()
+				continue
+			}
+			serving[requestPath] = true
+			mu.Unlock()
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				serveHelperRequest(ctx, requestPath, command)
+				mu.Lock()
+				delete(serving, requestPath)
+				mu.Unlock()
+			}()
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(helperPollInterval):
+		}
+	}
+}
+
+func serveHelperRequest(ctx context.Context, requestPath string, command []string) {
+	resultPath := strings.TrimSuffix(requestPath, helperRequestSuffix) + helperResultSuffix
+	result := helperResult{}
+	request := helperRequest{}
+	data, err := os.ReadFile(requestPath)
+	if err == nil {
+		err = json.Unmarshal(data, &request)
+	}
+	if err == nil {
+		log.Info("Downloading model", "storageUri", request.StorageUri, "modelDir", request.ModelDir)
+		args := append(append([]string{}, command[1:]...), request.StorageUri, request.ModelDir)
+		cmd := exec.CommandContext(ctx, command[0], args...) // #nosec G204: the command is the command of the ClusterStorageContainer
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		err = cmd.Run()
+	}
+	if err != nil {
+		log.Error(err, "Failed to download model", "storageUri", request.StorageUri)
+		result.Error = err.Error()
+	}
+	if err := writeHelperFile(resultPath, result); err != nil {
+		log.Error(err, "Failed to write download result", "path", resultPath)
+	}
+	if err := os.Remove(requestPath); err != nil {
+		log.Error(err, "Failed to remove download request", "path", requestPath)
+	}
+}
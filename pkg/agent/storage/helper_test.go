@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestHelperProviderSupportsUri(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	provider := &HelperProvider{Config: HelperConfig{
+		Name: "custom",
+		SupportedUriFormats: []HelperUriFormat{
+			{Prefix: "custom://"},
+			{Regex: "^lakefs://[^/]+/main/"},
+		},
+	}}
+	for uri, expected := range map[string]bool{
+		"custom://bucket/model":      true,
+		"lakefs://repo/main/model":   true,
+		"lakefs://repo/branch/model": false,
+		"s3://bucket/model":          false,
+	} {
+		supported, err := provider.SupportsUri(uri)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(supported).To(gomega.Equal(expected), uri)
+	}
+
+	provider.Config.SupportedUriFormats = []HelperUriFormat{{Regex: "("}}
+	_, err := provider.SupportsUri("custom://bucket/model")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestHelperProviderDownloadModel(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	helperDir := t.TempDir()
+	modelDir := t.TempDir()
+	// The command writes the storage URI to a file of the model folder
+	command := []string{"sh", "-c", `mkdir -p "$1" && echo "$0" > "$1/uri"`}
+	go func() {
+		_ = ServeHelperRequests(ctx, helperDir, command)
+	}()
+
+	provider := &HelperProvider{Config: HelperConfig{Name: "custom", Dir: helperDir}, Timeout: 30 * time.Second}
+	g.Expect(provider.DownloadModel(modelDir, "model1", "custom://bucket/model1")).To(gomega.Succeed())
+	content, err := os.ReadFile(filepath.Join(modelDir, "model1", "uri"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(string(content)).To(gomega.Equal("custom://bucket/model1\n"))
+
+	// The request and result files are removed once the model is downloaded
+	entries, err := os.ReadDir(helperDir)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(entries).To(gomega.BeEmpty())
+}
+
+func TestServeHelperRequestsConcurrently(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	helperDir := t.TempDir()
+	modelDir := t.TempDir()
+	// Each download waits for the other one to start, they would fail if served one after the other
+	command := []string{"sh", "-c", `mkdir -p "$1"; for i in $(seq 50); do ` +
+		`[ -d "$(dirname "$1")/model1" ] && [ -d "$(dirname "$1")/model2" ] && exit 0; sleep 0.1; done; exit 1`}
+	go func() {
+		_ = ServeHelperRequests(ctx, helperDir, command)
+	}()
+
+	provider := &HelperProvider{Config: HelperConfig{Name: "custom", Dir: helperDir}, Timeout: 30 * time.Second}
+	errs := make(chan error, 2)
+	for _, name := range []string{"model1", "model2"} {
+		go func() {
+			errs <- provider.DownloadModel(modelDir, name, "custom://bucket/"+name)
+		}()
+	}
+	g.Expect(<-errs).To(gomega.Succeed())
+	g.Expect(<-errs).To(gomega.Succeed())
+}
+
+func TestHelperProviderDownloadModelFailure(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	helperDir := t.TempDir()
+	go func() {
+		_ = ServeHelperRequests(ctx, helperDir, []string{"sh", "-c", "exit 3"})
+	}()
+
+	provider := &HelperProvider{Config: HelperConfig{Name: "custom", Dir: helperDir}, Timeout: 30 * time.Second}
+	err := provider.DownloadModel(t.TempDir(), "model1", "custom://bucket/model1")
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("storage helper custom failed to download custom://bucket/model1")))
+}
+
+func TestHelperProviderDownloadModelTimeout(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	helperDir := t.TempDir()
+	provider := &HelperProvider{Config: HelperConfig{Name: "custom", Dir: helperDir}, Timeout: time.Millisecond}
+	err := provider.DownloadModel(t.TempDir(), "model1", "custom://bucket/model1")
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("did not download")))
+
+	// The request is withdrawn so that the helper does not download the model later
+	entries, err := os.ReadDir(helperDir)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(entries).To(gomega.BeEmpty())
+}
+
+func TestInstallHelper(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	path := filepath.Join(t.TempDir(), "bin", "agent")
+	g.Expect(InstallHelper(path)).To(gomega.Succeed())
+	info, err := os.Stat(path)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(info.Mode().Perm() & 0o111).NotTo(gomega.BeZero())
+}
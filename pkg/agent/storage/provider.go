@@ -16,11 +16,37 @@ limitations under the License.
 
 package storage
 
+import (
+	"context"
+	"io"
+)
+
 type Provider interface {
 	DownloadModel(modelDir string, modelName string, storageUri string) error
 	UploadObject(bucket string, key string, object []byte) error
 }
 
+// UploadOptions are the metadata of an uploaded object. The zero value uploads the object with the defaults of
+// the bucket.
+type UploadOptions struct {
+	// ContentType is the MIME type of the object.
+	ContentType string
+	// StorageClass is the storage class of the object, e.g. STANDARD_IA on S3 or NEARLINE on GCS, and its access
+	// tier on Azure, e.g. Cool.
+	StorageClass string
+	// KMSKeyID is the customer managed key encrypting the object: the AWS KMS key of the SSE-KMS encryption on S3,
+	// the Cloud KMS key name on GCS, and the encryption scope on Azure.
+	KMSKeyID string
+	// Tags are the tags of the object on S3 and Azure, and its custom metadata on GCS which has no object tags.
+	Tags map[string]string
+}
+
+// Store streams objects into the buckets of a storage. The body is uploaded in parts as it is read (S3 multipart
+// upload, GCS resumable upload, Azure block upload), so that the objects are never held entirely in memory.
+type Store interface {
+	Upload(ctx context.Context, bucket string, key string, body io.Reader, options UploadOptions) error
+}
+
 type Protocol string
 
 const (
@@ -17,14 +17,18 @@ limitations under the License.
 package storage
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/transfermanager"
+	tmtypes "github.com/aws/aws-sdk-go-v2/feature/s3/transfermanager/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -36,15 +40,41 @@ type S3Provider struct {
 
 var log = logf.Log.WithName("modelAgent")
 
-var _ Provider = (*S3Provider)(nil)
+var (
+	_ Provider = (*S3Provider)(nil)
+	_ Store    = (*S3Provider)(nil)
+)
 
 func (m *S3Provider) UploadObject(bucket string, key string, object []byte) error {
-	ctx := context.Background()
-	_, err := m.TransferClient.UploadObject(ctx, &transfermanager.UploadObjectInput{
+	return m.Upload(context.Background(), bucket, key, bytes.NewReader(object), UploadOptions{})
+}
+
+// Upload streams the object with the transfer manager, which switches to a multipart upload when the body
+// exceeds a part.
+func (m *S3Provider) Upload(ctx context.Context, bucket string, key string, body io.Reader, options UploadOptions) error {
+	input := &transfermanager.UploadObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
-		Body:   strings.NewReader(string(object)),
-	})
+		Body:   body,
+	}
+	if options.ContentType != "" {
+		input.ContentType = aws.String(options.ContentType)
+	}
+	if options.StorageClass != "" {
+		input.StorageClass = tmtypes.StorageClass(options.StorageClass)
+	}
+	if options.KMSKeyID != "" {
+		input.ServerSideEncryption = tmtypes.ServerSideEncryptionAwsKms
+		input.SSEKMSKeyID = aws.String(options.KMSKeyID)
+	}
+	if len(options.Tags) > 0 {
+		tags := url.Values{}
+		for k, v := range options.Tags {
+			tags.Set(k, v)
+		}
+		input.Tagging = aws.String(tags.Encode())
+	}
+	_, err := m.TransferClient.UploadObject(ctx, input)
 	return err
 }
 
@@ -17,12 +17,18 @@ limitations under the License.
 package storage
 
 import (
+	"context"
 	"errors"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/feature/s3/transfermanager"
+	tmtypes "github.com/aws/aws-sdk-go-v2/feature/s3/transfermanager/types"
+
 	"github.com/kserve/kserve/pkg/agent/mocks"
 )
 
@@ -252,3 +258,69 @@ func TestDownloadModel_NoPrefixInURI(t *testing.T) {
 		t.Error("expected model.pt to exist")
 	}
 }
+
+// uploadCapturingTransferClient records the inputs of the uploads, reading their bodies.
+type uploadCapturingTransferClient struct {
+	mocks.MockS3TransferClient
+	inputs []*transfermanager.UploadObjectInput
+	bodies []string
+}
+
+func (c *uploadCapturingTransferClient) UploadObject(_ context.Context, input *transfermanager.UploadObjectInput, _ ...func(*transfermanager.Options)) (*transfermanager.UploadObjectOutput, error) {
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.inputs = append(c.inputs, input)
+	c.bodies = append(c.bodies, string(body))
+	return &transfermanager.UploadObjectOutput{}, nil
+}
+
+func TestUpload_Options(t *testing.T) {
+	client := &uploadCapturingTransferClient{}
+	provider := &S3Provider{TransferClient: client}
+
+	err := provider.Upload(context.Background(), "bucket", "logs/0123-request.parquet", strings.NewReader("rows"), UploadOptions{
+		ContentType:  "application/octet-stream",
+		StorageClass: "STANDARD_IA",
+		KMSKeyID:     "arn:aws:kms:us-east-1:123456789012:key/logs",
+		Tags:         map[string]string{"team": "ml", "env": "prod"},
+	})
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+
+	input := client.inputs[0]
+	if *input.Bucket != "bucket" || *input.Key != "logs/0123-request.parquet" || client.bodies[0] != "rows" {
+		t.Errorf("unexpected upload of %s/%s: %q", *input.Bucket, *input.Key, client.bodies[0])
+	}
+	if *input.ContentType != "application/octet-stream" {
+		t.Errorf("expected the content type to be set, got %s", *input.ContentType)
+	}
+	if input.StorageClass != tmtypes.StorageClassStandardIa {
+		t.Errorf("expected the STANDARD_IA storage class, got %s", input.StorageClass)
+	}
+	if input.ServerSideEncryption != tmtypes.ServerSideEncryptionAwsKms || *input.SSEKMSKeyID != "arn:aws:kms:us-east-1:123456789012:key/logs" {
+		t.Errorf("expected SSE-KMS encryption, got %s with key %v", input.ServerSideEncryption, input.SSEKMSKeyID)
+	}
+	if *input.Tagging != "env=prod&team=ml" {
+		t.Errorf("expected the tags to be URL encoded, got %s", *input.Tagging)
+	}
+}
+
+func TestUploadObject_DefaultOptions(t *testing.T) {
+	client := &uploadCapturingTransferClient{}
+	provider := &S3Provider{TransferClient: client}
+
+	if err := provider.UploadObject("bucket", "key", []byte("object")); err != nil {
+		t.Fatalf("UploadObject failed: %v", err)
+	}
+
+	input := client.inputs[0]
+	if client.bodies[0] != "object" {
+		t.Errorf("expected the object to be uploaded, got %q", client.bodies[0])
+	}
+	if input.ContentType != nil || input.StorageClass != "" || input.ServerSideEncryption != "" || input.Tagging != nil {
+		t.Error("expected the bucket defaults to be used")
+	}
+}
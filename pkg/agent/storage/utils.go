@@ -134,7 +134,18 @@ func initializeAzureClient() (AzureClient, error) {
 		}
 		serviceUrl = fmt.Sprintf("https://%s.blob.core.windows.net/", accountName)
 	}
-	if _, ok := os.LookupEnv(azure.AzureStorageAccessKey); ok {
+	if _, ok := os.LookupEnv(azure.AzureFederatedTokenFile); ok {
+		// Azure Workload Identity, the federated token is exchanged for an access token of the client
+		workloadIdentityCred, err := azidentity.NewWorkloadIdentityCredential(nil)
+		if err != nil {
+			return nil, err
+		}
+		client, err := azblob.NewClient(serviceUrl, workloadIdentityCred, &clientOptions)
+		if err != nil {
+			return nil, err
+		}
+		azureClient = client
+	} else if _, ok := os.LookupEnv(azure.AzureStorageAccessKey); ok {
 		defaultCred, err := azidentity.NewDefaultAzureCredential(nil)
 		if err != nil {
 			return nil, err
@@ -166,7 +177,7 @@ func initializeAzureClient() (AzureClient, error) {
 		}
 		azureClient = client
 	} else {
-		return nil, fmt.Errorf("one of %s or %s must be provided", azure.AzureStorageAccessKey, azure.AzureAccessToken)
+		return nil, fmt.Errorf("one of %s, %s or %s must be provided", azure.AzureFederatedTokenFile, azure.AzureStorageAccessKey, azure.AzureAccessToken)
 	}
 	return azureClient, nil
 }
@@ -194,6 +205,9 @@ func GetProvider(providers map[Protocol]Provider, protocol Protocol) (Provider,
 			// GCS relies on environment variable GOOGLE_APPLICATION_CREDENTIALS to point to the service-account-key
 			// If set, it will be automatically be picked up by the client.
 			gcsClient, err = gstorage.NewClient(ctx)
+		} else if strings.ToLower(os.Getenv(gcscredential.GCSUseWorkloadIdentity)) == "true" {
+			// With GKE Workload Identity, the client gets the credentials from the metadata server
+			gcsClient, err = gstorage.NewClient(ctx)
 		} else {
 			gcsClient, err = gstorage.NewClient(ctx, option.WithoutAuthentication())
 		}
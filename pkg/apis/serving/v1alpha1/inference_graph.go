@@ -347,8 +347,62 @@ type InferenceGraphStatus struct {
 	URL *apis.URL `json:"url,omitempty"`
 	// InferenceGraph DeploymentMode
 	DeploymentMode string `json:"deploymentMode,omitempty"`
+	// Nodes reports the readiness of each router node and the targets resolved for its steps
+	// +optional
+	Nodes map[string]InferenceRouterStatus `json:"nodes,omitempty"`
+}
+
+// InferenceRouterStatus defines the observed state of an InferenceGraph router node
+// +k8s:openapi-gen=true
+type InferenceRouterStatus struct {
+	// Ready is true when all the hard dependency steps of the node are ready
+	Ready bool `json:"ready"`
+	// Steps reports the resolved target of each step, in the same order as the node steps
+	// +optional
+	// +listType=atomic
+	Steps []InferenceStepStatus `json:"steps,omitempty"`
+}
+
+// InferenceStepStatus defines the observed state of the target of an InferenceGraph step
+// +k8s:openapi-gen=true
+type InferenceStepStatus struct {
+	// Name of the step, if set
+	// +optional
+	Name string `json:"name,omitempty"`
+	// The node name the step routes to
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+	// The InferenceService the step routes to
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+	// URL resolved for the step target
+	// +optional
+	URL *apis.URL `json:"url,omitempty"`
+	// Dependency type of the step
+	// +optional
+	Dependency InferenceStepDependencyType `json:"dependency,omitempty"`
+	// Ready is true when the step target exists and is ready
+	Ready bool `json:"ready"`
+	// Reason for the step target not being ready
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message describing why the step target is not ready
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
+// InferenceGraph step target readiness reasons
+const (
+	// InferenceServiceNotFoundReason is set when the InferenceService referenced by a step does not exist
+	InferenceServiceNotFoundReason = "InferenceServiceNotFound"
+	// InferenceServiceNotReadyReason is set when the InferenceService referenced by a step is not ready
+	InferenceServiceNotReadyReason = "InferenceServiceNotReady"
+	// RouterNodeNotFoundReason is set when the node referenced by a step is not defined in the graph
+	RouterNodeNotFoundReason = "RouterNodeNotFound"
+	// RouterNodeNotReadyReason is set when the node referenced by a step is not ready
+	RouterNodeNotReadyReason = "RouterNodeNotReady"
+)
+
 // InferenceGraphList contains a list of InferenceGraph
 // +k8s:openapi-gen=true
 // +kubebuilder:object:root=true
@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1alpha1
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 type LocalModelCacheStatus struct {
 	// Status of the model on a node, like NodeDownloaded or NodeNotReady
 	NodeStatus map[string]NodeStatus `json:"nodeStatus,omitempty"`
@@ -27,6 +29,21 @@ type LocalModelCacheStatus struct {
 	InferenceServices []NamespacedName `json:"inferenceServices,omitempty"`
 	// LLM inference services using this local model
 	LLMInferenceServices []NamespacedName `json:"llmInferenceServices,omitempty"`
+	// Last time the inference services using this local model changed. Used to evict the least recently used models.
+	// +optional
+	LastReferencedTime *metav1.Time `json:"lastReferencedTime,omitempty"`
+	// Latest revision of the source model resolved by the refresh policy. Nodes switch to it once downloaded.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+	// Last time the revision of the source model was checked
+	// +optional
+	LastRevisionCheckTime *metav1.Time `json:"lastRevisionCheckTime,omitempty"`
+	// Where each node downloaded the model from, the source model URI or a peer node
+	// +optional
+	NodeSources map[string]ModelSource `json:"nodeSources,omitempty"`
+	// Progress of the download on each node downloading the model, or why the download failed
+	// +optional
+	NodeDownloads map[string]ModelDownload `json:"nodeDownloads,omitempty"`
 }
 
 type NamespacedName struct {
@@ -35,7 +52,7 @@ type NamespacedName struct {
 }
 
 // NodeStatus enum
-// +kubebuilder:validation:Enum="";NodeNotReady;NodeDownloadPending;NodeDownloading;NodeDownloaded;NodeDownloadError;
+// +kubebuilder:validation:Enum="";NodeNotReady;NodeDownloadPending;NodeDownloading;NodeDownloaded;NodeDownloadError;NodeEvicted;
 type NodeStatus string
 
 // NodeStatus Enum values
@@ -45,6 +62,7 @@ const (
 	NodeDownloading     NodeStatus = "NodeDownloading"
 	NodeDownloaded      NodeStatus = "NodeDownloaded"
 	NodeDownloadError   NodeStatus = "NodeDownloadError"
+	NodeEvicted         NodeStatus = "NodeEvicted"
 )
 
 type ModelCopies struct {
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,6 +32,14 @@ func GetStorageKey(sourceModelUri string) string {
 	return hex.EncodeToString(hash[:])[:16] // Use first 16 chars of hash
 }
 
+// GetRevisionStorageKey returns the content-addressed folder name of a revision of the sourceModelUri.
+// For model caches with a refresh policy, the folder of the storage key links to the folder of the revision
+// served on the node.
+func GetRevisionStorageKey(sourceModelUri string, revision string) string {
+	hash := sha256.Sum256([]byte(revision))
+	return GetStorageKey(sourceModelUri) + "-" + hex.EncodeToString(hash[:])[:16]
+}
+
 // LocalModelStorageSpec defines credential and storage configuration for model download
 // +k8s:openapi-gen=true
 type LocalModelStorageSpec struct {
@@ -42,6 +51,28 @@ type LocalModelStorageSpec struct {
 	Parameters *map[string]string `json:"parameters,omitempty"`
 }
 
+// LocalModelRefreshPolicy periodically checks the revision of a mutable model source, like the commit of a
+// Hugging Face repository, the ETags of the objects under an S3 prefix or the digest of an OCI tag.
+// A changed revision is downloaded into a new folder and served once the download succeeded.
+// +k8s:openapi-gen=true
+type LocalModelRefreshPolicy struct {
+	// Interval between two checks of the revision of the source model
+	// +kubebuilder:default="1h"
+	// +optional
+	Interval metav1.Duration `json:"interval,omitempty"`
+}
+
+// DefaultRefreshInterval is the interval between two revision checks of a model cache with a refresh policy
+const DefaultRefreshInterval = time.Hour
+
+// GetInterval returns the refresh interval, or the default interval if it is not set
+func (p *LocalModelRefreshPolicy) GetInterval() time.Duration {
+	if p.Interval.Duration <= 0 {
+		return DefaultRefreshInterval
+	}
+	return p.Interval.Duration
+}
+
 // LocalModelCacheSpec
 // +k8s:openapi-gen=true
 type LocalModelCacheSpec struct {
@@ -59,6 +90,16 @@ type LocalModelCacheSpec struct {
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 	// +optional
 	Storage *LocalModelStorageSpec `json:"storage,omitempty"`
+	// Models with a lower priority are evicted first from the node groups with an eviction policy
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+	// Pinned models are never evicted from the node groups with an eviction policy
+	// +optional
+	Pinned bool `json:"pinned,omitempty"`
+	// Periodically checks the revision of the source model and downloads the new revisions.
+	// Without a refresh policy the model is downloaded once.
+	// +optional
+	RefreshPolicy *LocalModelRefreshPolicy `json:"refreshPolicy,omitempty"`
 }
 
 // LocalModelCache
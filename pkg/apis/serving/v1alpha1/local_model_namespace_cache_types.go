@@ -37,6 +37,12 @@ type LocalModelNamespaceCacheSpec struct {
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 	// +optional
 	Storage *LocalModelStorageSpec `json:"storage,omitempty"`
+	// Models with a lower priority are evicted first from the node groups with an eviction policy
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+	// Pinned models are never evicted from the node groups with an eviction policy
+	// +optional
+	Pinned bool `json:"pinned,omitempty"`
 }
 
 // LocalModelNamespaceCache is a namespace-scoped version of LocalModelCache.
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"slices"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,12 +33,64 @@ type LocalModelNodeGroupSpec struct {
 	PersistentVolumeSpec corev1.PersistentVolumeSpec `json:"persistentVolumeSpec"`
 	// Used to create PersistentVolumeClaims for download and in inference service namespaces
 	PersistentVolumeClaimSpec corev1.PersistentVolumeClaimSpec `json:"persistentVolumeClaimSpec"`
+	// Evicts unused models from the nodes running out of storage. Models are kept until their model cache is deleted if not set.
+	// +optional
+	EvictionPolicy *LocalModelEvictionPolicy `json:"evictionPolicy,omitempty"`
+}
+
+// LocalModelEvictionPolicy evicts the least recently used models from a node once the models downloaded on it use
+// more than the high watermark of the node group storage limit, until they use less than the low watermark.
+// Pinned models and the models used by inference services are never evicted. The other models are evicted by
+// priority first, and then by the last time they were referenced by an inference service.
+// +k8s:openapi-gen=true
+type LocalModelEvictionPolicy struct {
+	// Percentage of the storage limit used on a node above which models are evicted
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=90
+	// +optional
+	HighWatermarkPercent int32 `json:"highWatermarkPercent,omitempty"`
+	// Percentage of the storage limit used on a node below which models stop being evicted
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=80
+	// +optional
+	LowWatermarkPercent int32 `json:"lowWatermarkPercent,omitempty"`
+	// Minimum time since a model was last referenced by an inference service before it can be evicted
+	// +optional
+	MinIdleDuration *metav1.Duration `json:"minIdleDuration,omitempty"`
+}
+
+const (
+	DefaultEvictionHighWatermarkPercent int32 = 90
+	DefaultEvictionLowWatermarkPercent  int32 = 80
+)
+
+// GetWatermarks returns the storage used on a node above which models are evicted and below which they stop
+// being evicted.
+func (p *LocalModelEvictionPolicy) GetWatermarks(storageLimit resource.Quantity) (resource.Quantity, resource.Quantity) {
+	high, low := p.HighWatermarkPercent, p.LowWatermarkPercent
+	if high == 0 {
+		high = DefaultEvictionHighWatermarkPercent
+	}
+	if low == 0 {
+		low = DefaultEvictionLowWatermarkPercent
+	}
+	low = min(low, high)
+	percentOf := func(percent int32) resource.Quantity {
+		return *resource.NewQuantity(storageLimit.Value()*int64(percent)/100, storageLimit.Format)
+	}
+	return percentOf(high), percentOf(low)
 }
 
 // +k8s:openapi-gen=true
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope="Cluster"
+// +kubebuilder:printcolumn:name="Limit",type="string",JSONPath=".spec.storageLimit"
+// +kubebuilder:printcolumn:name="Used",type="string",JSONPath=".status.used"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.available"
 type LocalModelNodeGroup struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -53,6 +107,58 @@ type LocalModelNodeGroupList struct {
 	Items           []LocalModelNodeGroup `json:"items"`
 }
 
+// GetStorageUsage returns the storage used on every node of the node group by the given model caches.
+// Model caches with the same source model URI share a folder on the nodes, which is counted once with the
+// largest size declared for it. The folder sizes reported by the LocalModelNodes of the node group are used
+// instead of the declared size when they are larger.
+func (g *LocalModelNodeGroup) GetStorageUsage(caches []LocalModelCache, namespaceCaches []LocalModelNamespaceCache,
+	localModelNodes []LocalModelNode,
+) resource.Quantity {
+	sizes := map[string]resource.Quantity{}
+	addSize := func(storageKey string, size resource.Quantity) {
+		if current, ok := sizes[storageKey]; !ok || size.Cmp(current) > 0 {
+			sizes[storageKey] = size
+		}
+	}
+	for _, cache := range caches {
+		if slices.Contains(cache.Spec.NodeGroups, g.Name) {
+			addSize(GetStorageKey(cache.Spec.SourceModelUri), cache.Spec.ModelSize)
+		}
+	}
+	for _, cache := range namespaceCaches {
+		if slices.Contains(cache.Spec.NodeGroups, g.Name) {
+			addSize(GetStorageKey(cache.Spec.SourceModelUri), cache.Spec.ModelSize)
+		}
+	}
+	for _, localModelNode := range localModelNodes {
+		for _, modelInfo := range localModelNode.Spec.LocalModels {
+			storageKey := GetStorageKey(modelInfo.SourceModelUri)
+			if _, cached := sizes[storageKey]; !cached || modelInfo.NodeGroup != g.Name {
+				continue
+			}
+			if size, ok := localModelNode.Status.ModelDiskUsage[modelInfo.GetStatusKey()]; ok {
+				addSize(storageKey, size)
+			}
+		}
+	}
+
+	used := resource.Quantity{}
+	for _, size := range sizes {
+		used.Add(size)
+	}
+	return used
+}
+
+// GetAvailableStorage returns the storage left on every node of the node group when the given storage is used.
+func (g *LocalModelNodeGroup) GetAvailableStorage(used resource.Quantity) resource.Quantity {
+	available := g.Spec.StorageLimit.DeepCopy()
+	available.Sub(used)
+	if available.Sign() < 0 {
+		return resource.Quantity{}
+	}
+	return available
+}
+
 func init() {
 	SchemeBuilder.Register(&LocalModelNodeGroup{}, &LocalModelNodeGroupList{})
 }
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLocalModelNodeGroupStorageUsage(t *testing.T) {
+	nodeGroup := &LocalModelNodeGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu"},
+		Spec:       LocalModelNodeGroupSpec{StorageLimit: resource.MustParse("10Gi")},
+	}
+	llama := "hf://meta-llama/meta-llama-3-8b-instruct"
+	mistral := "hf://mistral/mistral-7b-instruct"
+	caches := []LocalModelCache{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "llama"},
+			Spec:       LocalModelCacheSpec{SourceModelUri: llama, ModelSize: resource.MustParse("2Gi"), NodeGroups: []string{"gpu"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "mistral"},
+			Spec:       LocalModelCacheSpec{SourceModelUri: mistral, ModelSize: resource.MustParse("3Gi"), NodeGroups: []string{"cpu", "gpu"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other"},
+			Spec:       LocalModelCacheSpec{SourceModelUri: "gs://bucket/model", ModelSize: resource.MustParse("4Gi"), NodeGroups: []string{"cpu"}},
+		},
+	}
+	namespaceCaches := []LocalModelNamespaceCache{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "test"},
+			Spec:       LocalModelNamespaceCacheSpec{SourceModelUri: llama, ModelSize: resource.MustParse("1Gi"), NodeGroups: []string{"gpu"}},
+		},
+	}
+	localModelNodes := []LocalModelNode{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+			Spec: LocalModelNodeSpec{LocalModels: []LocalModelInfo{
+				{SourceModelUri: llama, ModelName: "llama", NodeGroup: "gpu"},
+				{SourceModelUri: mistral, ModelName: "mistral", NodeGroup: "gpu"},
+			}},
+			Status: LocalModelNodeStatus{ModelDiskUsage: map[string]resource.Quantity{
+				"llama":   resource.MustParse("1Gi"),
+				"mistral": resource.MustParse("4Gi"),
+			}},
+		},
+	}
+
+	tests := map[string]struct {
+		caches          []LocalModelCache
+		namespaceCaches []LocalModelNamespaceCache
+		localModelNodes []LocalModelNode
+		used            resource.Quantity
+		available       resource.Quantity
+	}{
+		"no model caches": {
+			used:      resource.MustParse("0"),
+			available: resource.MustParse("10Gi"),
+		},
+		"declared sizes of the node group model caches": {
+			caches:    caches,
+			used:      resource.MustParse("5Gi"),
+			available: resource.MustParse("5Gi"),
+		},
+		"model caches with the same URI are counted once": {
+			caches:          caches,
+			namespaceCaches: namespaceCaches,
+			used:            resource.MustParse("5Gi"),
+			available:       resource.MustParse("5Gi"),
+		},
+		"disk usage larger than declared size": {
+			caches:          caches,
+			localModelNodes: localModelNodes,
+			used:            resource.MustParse("6Gi"),
+			available:       resource.MustParse("4Gi"),
+		},
+		"usage over the storage limit": {
+			caches: append([]LocalModelCache{{
+				ObjectMeta: metav1.ObjectMeta{Name: "large"},
+				Spec:       LocalModelCacheSpec{SourceModelUri: "gs://bucket/large", ModelSize: resource.MustParse("8Gi"), NodeGroups: []string{"gpu"}},
+			}}, caches...),
+			used:      resource.MustParse("13Gi"),
+			available: resource.MustParse("0"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			used := nodeGroup.GetStorageUsage(tt.caches, tt.namespaceCaches, tt.localModelNodes)
+			g.Expect(used.Cmp(tt.used)).To(gomega.Equal(0), "used %s", used.String())
+			available := nodeGroup.GetAvailableStorage(used)
+			g.Expect(available.Cmp(tt.available)).To(gomega.Equal(0), "available %s", available.String())
+		})
+	}
+}
+
+func TestLocalModelEvictionPolicyWatermarks(t *testing.T) {
+	tests := map[string]struct {
+		policy LocalModelEvictionPolicy
+		high   resource.Quantity
+		low    resource.Quantity
+	}{
+		"defaults": {
+			policy: LocalModelEvictionPolicy{},
+			high:   resource.MustParse("90Gi"),
+			low:    resource.MustParse("80Gi"),
+		},
+		"custom watermarks": {
+			policy: LocalModelEvictionPolicy{HighWatermarkPercent: 75, LowWatermarkPercent: 50},
+			high:   resource.MustParse("75Gi"),
+			low:    resource.MustParse("50Gi"),
+		},
+		"low watermark above high watermark": {
+			policy: LocalModelEvictionPolicy{HighWatermarkPercent: 70},
+			high:   resource.MustParse("70Gi"),
+			low:    resource.MustParse("70Gi"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			high, low := tt.policy.GetWatermarks(resource.MustParse("100Gi"))
+			g.Expect(high.Cmp(tt.high)).To(gomega.Equal(0), "high watermark %s", high.String())
+			g.Expect(low.Cmp(tt.low)).To(gomega.Equal(0), "low watermark %s", low.String())
+		})
+	}
+}
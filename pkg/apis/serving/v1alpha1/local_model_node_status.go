@@ -16,13 +16,87 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type LocalModelNodeStatus struct {
 	// Status of each local model
 	ModelStatus map[string]ModelStatus `json:"modelStatus,omitempty"`
+	// Size of each downloaded model folder on the node's disk
+	ModelDiskUsage map[string]resource.Quantity `json:"modelDiskUsage,omitempty"`
+	// Why each evicted model was evicted from the node
+	ModelEvictions map[string]ModelEviction `json:"modelEvictions,omitempty"`
+	// Revision of each model served on the node, for model caches with a refresh policy
+	ModelRevisions map[string]string `json:"modelRevisions,omitempty"`
+	// Where each downloaded model was downloaded from
+	ModelSources map[string]ModelSource `json:"modelSources,omitempty"`
+	// Address of the agent serving the downloaded models to the peer nodes of the node group
+	// +optional
+	PeerAddress string `json:"peerAddress,omitempty"`
+	// Progress of each model being downloaded, or why its download failed
+	ModelDownloads map[string]ModelDownload `json:"modelDownloads,omitempty"`
+}
+
+// ModelDownload is the progress of the download job of a model on a node, or the reason it failed
+type ModelDownload struct {
+	// Name of the latest download job
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+	// Bytes downloaded so far
+	// +optional
+	DownloadedBytes int64 `json:"downloadedBytes,omitempty"`
+	// Total bytes to download, if reported by the download container
+	// +optional
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+	// Download throughput in bytes per second, measured between the last two changes of the downloaded bytes
+	// +optional
+	BytesPerSecond int64 `json:"bytesPerSecond,omitempty"`
+	// Last time the downloaded bytes changed
+	// +optional
+	LastProgressTime *metav1.Time `json:"lastProgressTime,omitempty"`
+	// Number of times the download was relaunched after a failed job
+	// +optional
+	Retries int32 `json:"retries,omitempty"`
+	// Termination message or end of the logs of the failed download container
+	// +optional
+	FailureMessage string `json:"failureMessage,omitempty"`
+}
+
+// ModelSourceType enum
+// +kubebuilder:validation:Enum=Origin;Peer
+type ModelSourceType string
+
+// ModelSourceType Enum values
+const (
+	// The model was downloaded from its source model URI
+	OriginModelSource ModelSourceType = "Origin"
+	// The model was downloaded from a peer node of the node group
+	PeerModelSource ModelSourceType = "Peer"
+)
+
+// ModelSource is where a node downloaded a model from
+type ModelSource struct {
+	Type ModelSourceType `json:"type"`
+	// Peer node the model was downloaded from
+	// +optional
+	Node string `json:"node,omitempty"`
+}
+
+type ModelEviction struct {
+	// Time the model was evicted
+	EvictionTime metav1.Time `json:"evictionTime"`
+	// Size of the model folder removed from the node
+	// +optional
+	Size resource.Quantity `json:"size,omitempty"`
+	// Explanation of the eviction
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ModelStatus enum
-// +kubebuilder:validation:Enum="";ModelDownloadPending;ModelDownloading;ModelDownloaded;ModelDownloadError
+// +kubebuilder:validation:Enum="";ModelDownloadPending;ModelDownloading;ModelDownloaded;ModelDownloadError;ModelEvicted
 type ModelStatus string
 
 // ModelStatus Enum values
@@ -31,4 +105,5 @@ const (
 	ModelDownloading     ModelStatus = "ModelDownloading"
 	ModelDownloaded      ModelStatus = "ModelDownloaded"
 	ModelDownloadError   ModelStatus = "ModelDownloadError"
+	ModelEvicted         ModelStatus = "ModelEvicted"
 )
@@ -36,6 +36,22 @@ type LocalModelInfo struct {
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 	// +optional
 	Storage *LocalModelStorageSpec `json:"storage,omitempty"`
+	// Eviction priority of the model cache. Models with a lower priority are evicted first.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+	// Pinned models are never evicted
+	// +optional
+	Pinned bool `json:"pinned,omitempty"`
+	// Whether inference services use the model. Models in use are never evicted.
+	// +optional
+	InUse bool `json:"inUse,omitempty"`
+	// Last time the inference services using the model changed
+	// +optional
+	LastReferencedTime *metav1.Time `json:"lastReferencedTime,omitempty"`
+	// Revision of the source model to serve, set for model caches with a refresh policy.
+	// Each revision is downloaded into its own folder.
+	// +optional
+	Revision string `json:"revision,omitempty"`
 }
 
 // GetStatusKey returns a unique key for the model in LocalModelNode status.
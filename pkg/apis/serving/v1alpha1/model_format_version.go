@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"k8s.io/utils/ptr"
+)
+
+var (
+	// plainVersionPattern matches the "major", "major.minor" and "major.minor.patch" model format versions
+	plainVersionPattern = regexp.MustCompile(`^v?\d+(\.\d+){0,2}$`)
+	// versionNumberPattern matches the versions of a version constraint
+	versionNumberPattern = regexp.MustCompile(`\d+(\.\d+){0,2}`)
+)
+
+// constraintOperators are the characters which make a model format version a semver constraint
+const constraintOperators = "<>=!~^,|*"
+
+// parseModelFormatVersion returns the range of versions of a model format version. A plain version matches the
+// versions it is a prefix of, "1.2" matches "1.2.0" up to excluding "1.3.0". Other versions are semver constraints
+// such as ">=2.1,<3". Returns nil if the version is neither a plain version nor a valid constraint.
+func parseModelFormatVersion(version string) *semver.Constraints {
+	version = strings.TrimSpace(version)
+	if plainVersionPattern.MatchString(version) {
+		version = strings.TrimPrefix(version, "v")
+		if strings.Count(version, ".") == 2 {
+			version = "=" + version
+		} else {
+			version += ".x"
+		}
+	}
+	constraints, err := semver.NewConstraint(version)
+	if err != nil {
+		return nil
+	}
+	return constraints
+}
+
+// getBoundaryVersions returns the versions of the version constraint and the versions following them, which are the
+// lowest versions of the ranges of the constraint
+func getBoundaryVersions(version string) []*semver.Version {
+	versions := []*semver.Version{semver.New(0, 0, 0, "", "")}
+	for _, match := range versionNumberPattern.FindAllString(version, -1) {
+		v, err := semver.NewVersion(match)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, v, ptr.To(v.IncPatch()), ptr.To(v.IncMinor()), ptr.To(v.IncMajor()))
+	}
+	return versions
+}
+
+// ModelFormatVersionsMatch returns whether both model format versions have a version in common. Versions which are
+// neither plain versions nor semver constraints only match the same version.
+func ModelFormatVersionsMatch(version string, other string) bool {
+	if version == other {
+		return true
+	}
+	constraints, otherConstraints := parseModelFormatVersion(version), parseModelFormatVersion(other)
+	if constraints == nil || otherConstraints == nil {
+		return false
+	}
+	// The lowest version of the intersection of two ranges is the lowest version of one of them
+	for _, v := range append(getBoundaryVersions(version), getBoundaryVersions(other)...) {
+		if constraints.Check(v) && otherConstraints.Check(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareModelFormatVersions compares the highest versions of both model format versions, the highest version of a
+// constraint being its highest bound. Versions which are neither plain versions nor semver constraints are the lowest.
+func CompareModelFormatVersions(version string, other string) int {
+	highest, otherHighest := getHighestVersion(version), getHighestVersion(other)
+	switch {
+	case highest == nil && otherHighest == nil:
+		return 0
+	case highest == nil:
+		return -1
+	case otherHighest == nil:
+		return 1
+	}
+	return highest.Compare(otherHighest)
+}
+
+func getHighestVersion(version string) *semver.Version {
+	if parseModelFormatVersion(version) == nil {
+		return nil
+	}
+	var highest *semver.Version
+	for _, match := range versionNumberPattern.FindAllString(version, -1) {
+		if v, err := semver.NewVersion(match); err == nil && (highest == nil || v.GreaterThan(highest)) {
+			highest = v
+		}
+	}
+	return highest
+}
+
+// ValidateModelFormatVersion returns an error if the model format version is an invalid semver constraint
+func ValidateModelFormatVersion(version string) error {
+	if strings.ContainsAny(strings.TrimSpace(version), constraintOperators) && parseModelFormatVersion(version) == nil {
+		return fmt.Errorf("model format version %q is not a valid semver constraint", version)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestModelFormatVersionsMatch(t *testing.T) {
+	scenarios := map[string]struct {
+		version string
+		other   string
+		matches bool
+	}{
+		"same major":                        {version: "1", other: "1", matches: true},
+		"different major":                   {version: "1", other: "2", matches: false},
+		"major prefix of minor":             {version: "2", other: "2.4", matches: true},
+		"different minor":                   {version: "2.3", other: "2.4", matches: false},
+		"minor prefix of patch":             {version: "2.4", other: "2.4.1", matches: true},
+		"v prefix":                          {version: "v2", other: "2.4", matches: true},
+		"version in range":                  {version: ">=2.1,<3", other: "2.4.1", matches: true},
+		"version below range":               {version: ">=2.1,<3", other: "2.0", matches: false},
+		"version above range":               {version: ">=2.1,<3", other: "3", matches: false},
+		"major overlapping range":           {version: ">=2.1,<3", other: "2", matches: true},
+		"overlapping ranges":                {version: ">=2.1,<3", other: ">=2.8", matches: true},
+		"disjoint ranges":                   {version: ">=2.1,<3", other: ">=3", matches: false},
+		"adjacent exclusive ranges":         {version: "<2.5", other: ">2.5", matches: false},
+		"tilde range":                       {version: "~1.2", other: "1.2.7", matches: true},
+		"caret range":                       {version: "^1.2", other: "1.9", matches: true},
+		"wildcard":                          {version: "*", other: "4.1", matches: true},
+		"non semver versions equal":         {version: "tf2-gpu", other: "tf2-gpu", matches: true},
+		"non semver versions different":     {version: "tf2-gpu", other: "tf2-cpu", matches: false},
+		"non semver version and semver one": {version: "tf2-gpu", other: "2", matches: false},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			g.Expect(ModelFormatVersionsMatch(scenario.version, scenario.other)).To(gomega.Equal(scenario.matches))
+			g.Expect(ModelFormatVersionsMatch(scenario.other, scenario.version)).To(gomega.Equal(scenario.matches))
+		})
+	}
+}
+
+func TestCompareModelFormatVersions(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(CompareModelFormatVersions("2.4", "2.10")).To(gomega.Equal(-1))
+	g.Expect(CompareModelFormatVersions("3", "2.10")).To(gomega.Equal(1))
+	g.Expect(CompareModelFormatVersions(">=2.1,<3", "2.5")).To(gomega.Equal(1))
+	g.Expect(CompareModelFormatVersions("2", "2.0.0")).To(gomega.Equal(0))
+	g.Expect(CompareModelFormatVersions("tf2-gpu", "1")).To(gomega.Equal(-1))
+	g.Expect(CompareModelFormatVersions("", "")).To(gomega.Equal(0))
+}
+
+func TestValidateModelFormatVersion(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	for _, version := range []string{"1", "1.2.3", ">=2.1,<3", "~1.2", "1.x", "tf2-gpu"} {
+		g.Expect(ValidateModelFormatVersion(version)).To(gomega.Succeed(), version)
+	}
+	for _, version := range []string{">=two", "<<2", ">=2.1,"} {
+		g.Expect(ValidateModelFormatVersion(version)).NotTo(gomega.Succeed(), version)
+	}
+}
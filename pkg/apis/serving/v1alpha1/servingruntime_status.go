@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"knative.dev/pkg/apis"
+)
+
+// ConditionType represents a ServingRuntime condition value
+const (
+	// RuntimeSpecValid is set when the pod spec, the worker spec and the model format priorities of the runtime are valid
+	RuntimeSpecValid apis.ConditionType = "SpecValid"
+	// ModelFormatPrioritiesUnique is set when no other enabled runtime auto-selects one of the model formats of the
+	// runtime with the same priority, in which case the selection between the runtimes depends on their creation time
+	ModelFormatPrioritiesUnique apis.ConditionType = "ModelFormatPrioritiesUnique"
+	// InferenceServicesCompatible is set when the runtime supports the model format, the protocol version and the
+	// image of all the inference services using it
+	InferenceServicesCompatible apis.ConditionType = "InferenceServicesCompatible"
+)
+
+// ServingRuntime condition reasons
+const (
+	InvalidRuntimeSpec         = "InvalidRuntimeSpec"
+	ConflictingPriority        = "ConflictingPriority"
+	RuntimeDisabled            = "RuntimeDisabled"
+	UnsupportedModelFormat     = "UnsupportedModelFormat"
+	UnsupportedProtocolVersion = "UnsupportedProtocolVersion"
+	MissingImage               = "MissingImage"
+)
+
+// ServingRuntime Ready condition is depending on the spec validity and the compatibility with inference services
+var servingRuntimeConditionSet = apis.NewLivingConditionSet(
+	RuntimeSpecValid,
+	ModelFormatPrioritiesUnique,
+	InferenceServicesCompatible,
+)
+
+var _ apis.ConditionsAccessor = (*ServingRuntimeStatus)(nil)
+
+func (ss *ServingRuntimeStatus) InitializeConditions() {
+	servingRuntimeConditionSet.Manage(ss).InitializeConditions()
+}
+
+// IsReady returns if the runtime spec is valid and compatible with the inference services using it.
+func (ss *ServingRuntimeStatus) IsReady() bool {
+	return servingRuntimeConditionSet.Manage(ss).IsHappy()
+}
+
+// GetCondition returns the condition by name.
+func (ss *ServingRuntimeStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return servingRuntimeConditionSet.Manage(ss).GetCondition(t)
+}
+
+// MarkTrue sets the condition to true and recomputes the Ready condition.
+func (ss *ServingRuntimeStatus) MarkTrue(t apis.ConditionType) {
+	servingRuntimeConditionSet.Manage(ss).MarkTrue(t)
+}
+
+// MarkFalse sets the condition to false with the reason and message and recomputes the Ready condition.
+func (ss *ServingRuntimeStatus) MarkFalse(t apis.ConditionType, reason, messageFormat string, messageA ...interface{}) {
+	servingRuntimeConditionSet.Manage(ss).MarkFalse(t, reason, messageFormat, messageA...)
+}
@@ -18,11 +18,14 @@ package v1alpha1
 
 import (
 	"errors"
+	"slices"
+	"strings"
 
 	"gopkg.in/go-playground/validator.v9"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/kserve/kserve/pkg/constants"
 )
@@ -34,7 +37,8 @@ type SupportedModelFormat struct {
 	Name string `json:"name"`
 	// Version of the model format.
 	// Used in validating that a predictor is supported by a runtime.
-	// Can be "major", "major.minor" or "major.minor.patch".
+	// Can be "major", "major.minor" or "major.minor.patch", matching the versions it is a prefix of,
+	// or a semver constraint such as ">=2.1,<3".
 	// +optional
 	Version *string `json:"version,omitempty"`
 	// Set to true to allow the ServingRuntime to be used for automatic model placement if
@@ -203,7 +207,16 @@ type ServingRuntimeSpec struct {
 
 // ServingRuntimeStatus defines the observed state of ServingRuntime
 // +k8s:openapi-gen=true
-type ServingRuntimeStatus struct{}
+type ServingRuntimeStatus struct {
+	// Conditions for the validity of the runtime spec and its compatibility with the inference services using it
+	duckv1.Status `json:",inline"`
+	// Inference services using the runtime
+	// +optional
+	InferenceServices []NamespacedName `json:"inferenceServices,omitempty"`
+	// Last time an inference service selected the runtime
+	// +optional
+	LastSelectedTime *metav1.Time `json:"lastSelectedTime,omitempty"`
+}
 
 // ServerType constant for specifying the runtime name
 // +k8s:openapi-gen=true
@@ -238,6 +251,7 @@ type BuiltInAdapter struct {
 // +k8s:openapi-gen=true
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Disabled",type="boolean",JSONPath=".spec.disabled"
 // +kubebuilder:printcolumn:name="ModelType",type="string",JSONPath=".spec.supportedModelFormats[*].name"
 // +kubebuilder:printcolumn:name="Containers",type="string",JSONPath=".spec.containers[*].name"
@@ -264,6 +278,7 @@ type ServingRuntimeList struct {
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope="Cluster"
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Disabled",type="boolean",JSONPath=".spec.disabled"
 // +kubebuilder:printcolumn:name="ModelType",type="string",JSONPath=".spec.supportedModelFormats[*].name"
 // +kubebuilder:printcolumn:name="Containers",type="string",JSONPath=".spec.containers[*].name"
@@ -350,6 +365,59 @@ func (m *SupportedModelFormat) IsAutoSelectEnabled() bool {
 	return m.AutoSelect != nil && *m.AutoSelect
 }
 
+// isSameModelFormat returns whether both model formats have the same name and versions in common. A model format
+// without version is selected for the predictors without version, like a model format of any version, so it
+// overlaps every version of the model format.
+func (m *SupportedModelFormat) isSameModelFormat(other *SupportedModelFormat) bool {
+	return strings.EqualFold(m.Name, other.Name) && (m.Version == nil || other.Version == nil ||
+		ModelFormatVersionsMatch(*m.Version, *other.Version))
+}
+
+// GetInconsistentPriorityModelFormat returns the name of a model format which is auto-selected with different
+// priorities by the runtime, or an empty string if the priorities are consistent
+func (srSpec *ServingRuntimeSpec) GetInconsistentPriorityModelFormat() string {
+	nameToPriority := make(map[string]*int32)
+	for _, modelFormat := range srSpec.SupportedModelFormats {
+		// Only validate priority if autoselect is true
+		if !modelFormat.IsAutoSelectEnabled() {
+			continue
+		}
+		if existingPriority, ok := nameToPriority[modelFormat.Name]; ok {
+			if existingPriority != nil && modelFormat.Priority != nil && *existingPriority != *modelFormat.Priority {
+				return modelFormat.Name
+			}
+		} else {
+			nameToPriority[modelFormat.Name] = modelFormat.Priority
+		}
+	}
+	return ""
+}
+
+// GetConflictingPriorityModelFormat returns the name of a model format auto-selected with the same priority by both
+// runtimes for a common protocol version, which makes the selection between them depend on their creation time.
+// Returns an empty string if there is no conflict or the other runtime is disabled.
+func (srSpec *ServingRuntimeSpec) GetConflictingPriorityModelFormat(other *ServingRuntimeSpec) string {
+	if srSpec.IsMultiModelRuntime() != other.IsMultiModelRuntime() || other.IsDisabled() {
+		return ""
+	}
+	// Only check the priorities if both runtimes support the same protocol version
+	if !slices.ContainsFunc(other.ProtocolVersions, func(protocolVersion constants.InferenceServiceProtocol) bool {
+		return slices.Contains(srSpec.ProtocolVersions, protocolVersion)
+	}) {
+		return ""
+	}
+	for _, otherModelFormat := range other.SupportedModelFormats {
+		for _, modelFormat := range srSpec.SupportedModelFormats {
+			// Only check the priority if autoselect is true
+			if otherModelFormat.IsAutoSelectEnabled() && modelFormat.IsAutoSelectEnabled() && otherModelFormat.isSameModelFormat(&modelFormat) &&
+				otherModelFormat.Priority != nil && modelFormat.Priority != nil && *otherModelFormat.Priority == *modelFormat.Priority {
+				return modelFormat.Name
+			}
+		}
+	}
+	return ""
+}
+
 func (srSpec *ServingRuntimeSpec) IsValid() bool {
 	if err := srSpec.validatePodSpecAndWorkerSpec(); err != nil {
 		return false
@@ -823,3 +823,76 @@ func TestSupportedModelFormat_IsAutoSelectEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestServingRuntimeSpec_GetConflictingPriorityModelFormat(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	newSpec := func(priority int32, protocolVersions ...constants.InferenceServiceProtocol) *ServingRuntimeSpec {
+		return &ServingRuntimeSpec{
+			ProtocolVersions: protocolVersions,
+			SupportedModelFormats: []SupportedModelFormat{
+				{Name: "sklearn", Version: proto.String("1"), AutoSelect: proto.Bool(true), Priority: proto.Int32(priority)},
+			},
+		}
+	}
+
+	scenarios := map[string]struct {
+		spec     *ServingRuntimeSpec
+		other    *ServingRuntimeSpec
+		expected string
+	}{
+		"same priority": {
+			spec:     newSpec(1, constants.ProtocolV1),
+			other:    newSpec(1, constants.ProtocolV1, constants.ProtocolV2),
+			expected: "sklearn",
+		},
+		"different priority": {
+			spec:     newSpec(1, constants.ProtocolV1),
+			other:    newSpec(2, constants.ProtocolV1),
+			expected: "",
+		},
+		"no common protocol version": {
+			spec:     newSpec(1, constants.ProtocolV1),
+			other:    newSpec(1, constants.ProtocolV2),
+			expected: "",
+		},
+		"other runtime disabled": {
+			spec: newSpec(1, constants.ProtocolV1),
+			other: func() *ServingRuntimeSpec {
+				spec := newSpec(1, constants.ProtocolV1)
+				spec.Disabled = proto.Bool(true)
+				return spec
+			}(),
+			expected: "",
+		},
+		"different model format version": {
+			spec: newSpec(1, constants.ProtocolV1),
+			other: func() *ServingRuntimeSpec {
+				spec := newSpec(1, constants.ProtocolV1)
+				spec.SupportedModelFormats[0].Version = proto.String("2")
+				return spec
+			}(),
+			expected: "",
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g.Expect(scenario.spec.GetConflictingPriorityModelFormat(scenario.other)).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
+func TestServingRuntimeSpec_GetInconsistentPriorityModelFormat(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	spec := ServingRuntimeSpec{
+		SupportedModelFormats: []SupportedModelFormat{
+			{Name: "sklearn", AutoSelect: proto.Bool(true), Priority: proto.Int32(1)},
+			{Name: "xgboost", AutoSelect: proto.Bool(true), Priority: proto.Int32(1)},
+		},
+	}
+	g.Expect(spec.GetInconsistentPriorityModelFormat()).To(gomega.BeEmpty())
+
+	spec.SupportedModelFormats = append(spec.SupportedModelFormats,
+		SupportedModelFormat{Name: "sklearn", AutoSelect: proto.Bool(true), Priority: proto.Int32(2)})
+	g.Expect(spec.GetInconsistentPriorityModelFormat()).To(gomega.Equal("sklearn"))
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/kserve/kserve/pkg/constants"
 	"k8s.io/api/autoscaling/v2"
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"knative.dev/pkg/apis"
@@ -86,7 +88,7 @@ func (in *ClusterServingRuntime) DeepCopyInto(out *ClusterServingRuntime) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServingRuntime.
@@ -513,6 +515,13 @@ func (in *InferenceGraphStatus) DeepCopyInto(out *InferenceGraphStatus) {
 		*out = new(apis.URL)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make(map[string]InferenceRouterStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceGraphStatus.
@@ -572,6 +581,28 @@ func (in *InferenceRouter) DeepCopy() *InferenceRouter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InferenceRouterStatus) DeepCopyInto(out *InferenceRouterStatus) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]InferenceStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceRouterStatus.
+func (in *InferenceRouterStatus) DeepCopy() *InferenceRouterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InferenceRouterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InferenceStep) DeepCopyInto(out *InferenceStep) {
 	*out = *in
@@ -593,6 +624,26 @@ func (in *InferenceStep) DeepCopy() *InferenceStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InferenceStepStatus) DeepCopyInto(out *InferenceStepStatus) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(apis.URL)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceStepStatus.
+func (in *InferenceStepStatus) DeepCopy() *InferenceStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InferenceStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InferenceTarget) DeepCopyInto(out *InferenceTarget) {
 	*out = *in
@@ -1017,6 +1068,11 @@ func (in *LocalModelCacheSpec) DeepCopyInto(out *LocalModelCacheSpec) {
 		*out = new(LocalModelStorageSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RefreshPolicy != nil {
+		in, out := &in.RefreshPolicy, &out.RefreshPolicy
+		*out = new(LocalModelRefreshPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelCacheSpec.
@@ -1054,6 +1110,28 @@ func (in *LocalModelCacheStatus) DeepCopyInto(out *LocalModelCacheStatus) {
 		*out = make([]NamespacedName, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastReferencedTime != nil {
+		in, out := &in.LastReferencedTime, &out.LastReferencedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRevisionCheckTime != nil {
+		in, out := &in.LastRevisionCheckTime, &out.LastRevisionCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NodeSources != nil {
+		in, out := &in.NodeSources, &out.NodeSources
+		*out = make(map[string]ModelSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeDownloads != nil {
+		in, out := &in.NodeDownloads, &out.NodeDownloads
+		*out = make(map[string]ModelDownload, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelCacheStatus.
@@ -1066,6 +1144,26 @@ func (in *LocalModelCacheStatus) DeepCopy() *LocalModelCacheStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalModelEvictionPolicy) DeepCopyInto(out *LocalModelEvictionPolicy) {
+	*out = *in
+	if in.MinIdleDuration != nil {
+		in, out := &in.MinIdleDuration, &out.MinIdleDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelEvictionPolicy.
+func (in *LocalModelEvictionPolicy) DeepCopy() *LocalModelEvictionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalModelEvictionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalModelInfo) DeepCopyInto(out *LocalModelInfo) {
 	*out = *in
@@ -1074,6 +1172,10 @@ func (in *LocalModelInfo) DeepCopyInto(out *LocalModelInfo) {
 		*out = new(LocalModelStorageSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastReferencedTime != nil {
+		in, out := &in.LastReferencedTime, &out.LastReferencedTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelInfo.
@@ -1263,6 +1365,11 @@ func (in *LocalModelNodeGroupSpec) DeepCopyInto(out *LocalModelNodeGroupSpec) {
 	out.StorageLimit = in.StorageLimit.DeepCopy()
 	in.PersistentVolumeSpec.DeepCopyInto(&out.PersistentVolumeSpec)
 	in.PersistentVolumeClaimSpec.DeepCopyInto(&out.PersistentVolumeClaimSpec)
+	if in.EvictionPolicy != nil {
+		in, out := &in.EvictionPolicy, &out.EvictionPolicy
+		*out = new(LocalModelEvictionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelNodeGroupSpec.
@@ -1356,6 +1463,41 @@ func (in *LocalModelNodeStatus) DeepCopyInto(out *LocalModelNodeStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.ModelDiskUsage != nil {
+		in, out := &in.ModelDiskUsage, &out.ModelDiskUsage
+		*out = make(map[string]resource.Quantity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ModelEvictions != nil {
+		in, out := &in.ModelEvictions, &out.ModelEvictions
+		*out = make(map[string]ModelEviction, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ModelRevisions != nil {
+		in, out := &in.ModelRevisions, &out.ModelRevisions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ModelSources != nil {
+		in, out := &in.ModelSources, &out.ModelSources
+		*out = make(map[string]ModelSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ModelDownloads != nil {
+		in, out := &in.ModelDownloads, &out.ModelDownloads
+		*out = make(map[string]ModelDownload, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelNodeStatus.
@@ -1368,6 +1510,22 @@ func (in *LocalModelNodeStatus) DeepCopy() *LocalModelNodeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalModelRefreshPolicy) DeepCopyInto(out *LocalModelRefreshPolicy) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelRefreshPolicy.
+func (in *LocalModelRefreshPolicy) DeepCopy() *LocalModelRefreshPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalModelRefreshPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalModelStorageSpec) DeepCopyInto(out *LocalModelStorageSpec) {
 	*out = *in
@@ -1414,6 +1572,57 @@ func (in *ModelCopies) DeepCopy() *ModelCopies {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelDownload) DeepCopyInto(out *ModelDownload) {
+	*out = *in
+	if in.LastProgressTime != nil {
+		in, out := &in.LastProgressTime, &out.LastProgressTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelDownload.
+func (in *ModelDownload) DeepCopy() *ModelDownload {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelDownload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelEviction) DeepCopyInto(out *ModelEviction) {
+	*out = *in
+	in.EvictionTime.DeepCopyInto(&out.EvictionTime)
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelEviction.
+func (in *ModelEviction) DeepCopy() *ModelEviction {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelEviction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSource) DeepCopyInto(out *ModelSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSource.
+func (in *ModelSource) DeepCopy() *ModelSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
 	*out = *in
@@ -1740,7 +1949,7 @@ func (in *ServingRuntime) DeepCopyInto(out *ServingRuntime) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServingRuntime.
@@ -1943,6 +2152,16 @@ func (in *ServingRuntimeSpec) DeepCopy() *ServingRuntimeSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServingRuntimeStatus) DeepCopyInto(out *ServingRuntimeStatus) {
 	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.InferenceServices != nil {
+		in, out := &in.InferenceServices, &out.InferenceServices
+		*out = make([]NamespacedName, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSelectedTime != nil {
+		in, out := &in.LastSelectedTime, &out.LastSelectedTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServingRuntimeStatus.
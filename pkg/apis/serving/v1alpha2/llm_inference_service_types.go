@@ -329,7 +329,8 @@ type LLMModelSpec struct {
 // LoRASpec defines the configuration for LoRA adapters.
 type LoRASpec struct {
 	// Adapters is a list of LoRA (Low-Rank Adaptation) adapters to attach to the base model.
-	// Each adapter is specified by name and URI (supports hf://, s3://, and pvc:// schemes).
+	// Each adapter is specified by name and URI (supports the pvc:// scheme and the storage-initializer schemes:
+	// hf://, modelscope://, s3://, gs://, hdfs://, webhdfs://, https:// and http://).
 	// The controller automatically downloads adapters and configures the runtime to use them.
 	// +optional
 	// This type is recursive https://github.com/kubernetes-sigs/controller-tools/issues/585
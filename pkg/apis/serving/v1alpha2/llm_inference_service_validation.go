@@ -26,17 +26,20 @@ import (
 
 	"k8s.io/utils/ptr"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/utils"
 	kservevalidation "github.com/kserve/kserve/pkg/validation"
 )
@@ -57,7 +60,11 @@ const (
 // LLMInferenceServiceValidator is responsible for validating the LLMInferenceService resource
 // when it is created, updated, or deleted.
 // +kubebuilder:object:generate=false
-type LLMInferenceServiceValidator struct{}
+type LLMInferenceServiceValidator struct {
+	// Client reads the pod security policy from the inferenceservice-config ConfigMap.
+	// The policy is not enforced when not set.
+	Client client.Reader
+}
 
 var _ webhook.CustomValidator = &LLMInferenceServiceValidator{}
 
@@ -126,6 +133,13 @@ func (l *LLMInferenceServiceValidator) validate(ctx context.Context, prev *LLMIn
 	warnings = append(warnings, confidentialWarnings...)
 	allErrs = append(allErrs, confidentialErrs...)
 
+	podSecurityWarnings, podSecurityErrs, err := l.validatePodSecurity(ctx, llmSvc)
+	if err != nil {
+		return warnings, err
+	}
+	warnings = append(warnings, podSecurityWarnings...)
+	allErrs = append(allErrs, podSecurityErrs...)
+
 	if len(allErrs) == 0 {
 		logger.V(2).Info("LLMInferenceService v1alpha2 is valid", "llmisvc", llmSvc)
 		return warnings, nil
@@ -862,6 +876,53 @@ func (l *LLMInferenceServiceValidator) validateConfidential(llmSvc *LLMInference
 	)
 }
 
+// validatePodSecurity validates the containers of the LLMInferenceService templates against the pod security
+// policy of the inferenceservice-config ConfigMap.
+func (l *LLMInferenceServiceValidator) validatePodSecurity(ctx context.Context, llmSvc *LLMInferenceService) (admission.Warnings, field.ErrorList, error) {
+	if l.Client == nil {
+		return nil, nil, nil
+	}
+	configMap := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: constants.KServeNamespace, Name: constants.InferenceServiceConfigMapName}
+	if err := l.Client.Get(ctx, key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get the %s ConfigMap: %w", constants.InferenceServiceConfigMapName, err)
+	}
+	policy, err := kservevalidation.NewPodSecurityPolicy(configMap)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !policy.Enabled() {
+		return nil, nil, nil
+	}
+
+	var allErrs field.ErrorList
+	validateWorkload := func(basePath *field.Path, workload *WorkloadSpec) {
+		for _, pod := range []struct {
+			spec *corev1.PodSpec
+			path *field.Path
+		}{
+			{workload.Template, basePath.Child("template")},
+			{workload.Worker, basePath.Child("worker")},
+		} {
+			if pod.spec == nil {
+				continue
+			}
+			allErrs = append(allErrs, policy.ValidateContainers(pod.spec.Containers, pod.path.Child("containers"))...)
+			allErrs = append(allErrs, policy.ValidateContainers(pod.spec.InitContainers, pod.path.Child("initContainers"))...)
+		}
+	}
+	validateWorkload(field.NewPath("spec"), &llmSvc.Spec.WorkloadSpec)
+	if llmSvc.Spec.Prefill != nil {
+		validateWorkload(field.NewPath("spec", "prefill"), llmSvc.Spec.Prefill)
+	}
+
+	warnings, errs := policy.Report(allErrs)
+	return warnings, errs, nil
+}
+
 func (l *LLMInferenceServiceValidator) validateTrafficFields(
 	llmSvc *LLMInferenceService,
 ) field.ErrorList {
@@ -31,7 +31,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kserve/kserve/pkg/constants"
+	kservevalidation "github.com/kserve/kserve/pkg/validation"
 )
 
 func TestParentRefsMatchGatewayRefs(t *testing.T) {
@@ -1738,6 +1743,86 @@ func TestValidateConfidential(t *testing.T) {
 	}
 }
 
+func TestValidatePodSecurity(t *testing.T) {
+	newLLMSvc := func() *LLMInferenceService {
+		return &LLMInferenceService{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-llm-isvc", Namespace: "default"},
+			Spec: LLMInferenceServiceSpec{
+				WorkloadSpec: WorkloadSpec{
+					Template: &corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "main", Image: "registry.io/vllm:latest"}},
+					},
+				},
+				Prefill: &WorkloadSpec{
+					Worker: &corev1.PodSpec{
+						InitContainers: []corev1.Container{{Name: "init", Env: []corev1.EnvVar{{Name: "LD_PRELOAD"}}}},
+					},
+				},
+			},
+		}
+	}
+	newClient := func(podSecurity *string) client.Reader {
+		builder := fake.NewClientBuilder()
+		if podSecurity != nil {
+			builder = builder.WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      constants.InferenceServiceConfigMapName,
+					Namespace: constants.KServeNamespace,
+				},
+				Data: map[string]string{kservevalidation.PodSecurityConfigMapKeyName: *podSecurity},
+			})
+		}
+		return builder.Build()
+	}
+
+	tests := []struct {
+		name         string
+		client       client.Reader
+		wantErrs     []string
+		wantWarnings int
+	}{
+		{
+			name:   "no client",
+			client: nil,
+		},
+		{
+			name:   "no ConfigMap",
+			client: newClient(nil),
+		},
+		{
+			name:   "policy disabled",
+			client: newClient(ptr.To(`{"blockedEnvVars": ["LD_PRELOAD"]}`)),
+		},
+		{
+			name:   "policy enforced",
+			client: newClient(ptr.To(`{"mode": "enforce", "blockedEnvVars": ["LD_PRELOAD"], "allowedRegistries": ["quay.io"]}`)),
+			wantErrs: []string{
+				"spec.template.containers[0].image",
+				"spec.prefill.worker.initContainers[0].env[0].name",
+			},
+		},
+		{
+			name:         "policy warned",
+			client:       newClient(ptr.To(`{"mode": "warn", "blockedEnvVars": ["LD_PRELOAD"], "allowedRegistries": ["quay.io"]}`)),
+			wantWarnings: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &LLMInferenceServiceValidator{Client: tt.client}
+			warnings, errs, err := validator.validatePodSecurity(t.Context(), newLLMSvc())
+			require.NoError(t, err)
+			assert.Len(t, warnings, tt.wantWarnings)
+			fields := make([]string, 0, len(errs))
+			for _, e := range errs {
+				fields = append(fields, e.Field)
+			}
+			assert.ElementsMatch(t, tt.wantErrs, fields)
+		})
+	}
+}
+
 func TestValidateKVCacheOffloading(t *testing.T) {
 	validator := &LLMInferenceServiceValidator{}
 
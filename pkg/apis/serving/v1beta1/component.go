@@ -56,7 +56,8 @@ const (
 
 // SupportedStorageSpecURIPrefixList Constants
 var (
-	SupportedStorageSpecURIPrefixList = []string{"s3://", "hdfs://", "webhdfs://"}
+	SupportedStorageSpecURIPrefixList = []string{"s3://", "hdfs://", "webhdfs://", "gs://", "hf://", "https://"}
+	SupportedStorageSpecTypeList      = []string{"s3", "hdfs", "webhdfs", "gs", "azure", "hf", "https"}
 )
 
 // ComponentImplementation interface is implemented by predictor, transformer, and explainer implementations
@@ -360,10 +361,10 @@ func validateStorageSpec(storageSpec *ModelStorageSpec, storageURI *string) erro
 	if storageSpec.Parameters != nil {
 		for k, v := range *storageSpec.Parameters {
 			if k == "type" {
-				if utils.IsPrefixSupported(v+"://", SupportedStorageSpecURIPrefixList) {
+				if utils.Includes(SupportedStorageSpecTypeList, v) {
 					return nil
 				} else {
-					return fmt.Errorf(UnsupportedStorageSpecFormatError, strings.Join(SupportedStorageSpecURIPrefixList, ", "), v)
+					return fmt.Errorf(UnsupportedStorageSpecFormatError, strings.Join(SupportedStorageSpecTypeList, ", "), v)
 				}
 			}
 		}
@@ -101,7 +101,7 @@ func TestComponentExtensionSpec_validateStorageSpec(t *testing.T) {
 			storageUri: proto.String("s3://test/model"),
 			matcher:    gomega.BeNil(),
 		},
-		"StorageSpecWithInvalidStorageURI": {
+		"ValidGCSStoragespecWithStorageURI": {
 			spec: &ModelStorageSpec{
 				StorageSpec: StorageSpec{
 					Parameters: &map[string]string{
@@ -110,18 +110,53 @@ func TestComponentExtensionSpec_validateStorageSpec(t *testing.T) {
 				},
 			},
 			storageUri: proto.String("gs://test/model"),
-			matcher:    gomega.MatchError(fmt.Errorf(UnsupportedStorageURIFormatError, strings.Join(SupportedStorageSpecURIPrefixList, ", "), "gs://test/model")),
+			matcher:    gomega.BeNil(),
+		},
+		"ValidAzureStoragespec": {
+			spec: &ModelStorageSpec{
+				StorageSpec: StorageSpec{
+					Parameters: &map[string]string{
+						"type":         "azure",
+						"account_name": "models",
+						"container":    "test",
+					},
+				},
+			},
+			storageUri: nil,
+			matcher:    gomega.BeNil(),
+		},
+		"ValidHuggingFaceStoragespec": {
+			spec: &ModelStorageSpec{
+				StorageSpec: StorageSpec{
+					Parameters: &map[string]string{
+						"type": "hf",
+					},
+				},
+			},
+			storageUri: nil,
+			matcher:    gomega.BeNil(),
+		},
+		"StorageSpecWithInvalidStorageURI": {
+			spec: &ModelStorageSpec{
+				StorageSpec: StorageSpec{
+					Parameters: &map[string]string{
+						"type": "ftp",
+					},
+				},
+			},
+			storageUri: proto.String("ftp://test/model"),
+			matcher:    gomega.MatchError(fmt.Errorf(UnsupportedStorageURIFormatError, strings.Join(SupportedStorageSpecURIPrefixList, ", "), "ftp://test/model")),
 		},
 		"InvalidStoragespec": {
 			spec: &ModelStorageSpec{
 				StorageSpec: StorageSpec{
 					Parameters: &map[string]string{
-						"type": "gs",
+						"type": "ftp",
 					},
 				},
 			},
 			storageUri: nil,
-			matcher:    gomega.MatchError(fmt.Errorf(UnsupportedStorageSpecFormatError, strings.Join(SupportedStorageSpecURIPrefixList, ", "), "gs")),
+			matcher:    gomega.MatchError(fmt.Errorf(UnsupportedStorageSpecFormatError, strings.Join(SupportedStorageSpecTypeList, ", "), "ftp")),
 		},
 	}
 	for name, scenario := range scenarios {
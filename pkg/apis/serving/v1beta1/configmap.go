@@ -167,6 +167,36 @@ type LocalModelConfig struct {
 	JobTTLSecondsAfterFinished   *int32 `json:"jobTTLSecondsAfterFinished,omitempty"`
 	ReconcilationFrequencyInSecs *int64 `json:"reconcilationFrequencyInSecs,omitempty"`
 	DisableVolumeManagement      bool   `json:"disableVolumeManagement,omitempty"`
+	// PeerDownload lets the nodes download the models cached on the other nodes of their node group
+	PeerDownload *LocalModelPeerDownloadConfig `json:"peerDownload,omitempty"`
+	// DownloadRetry relaunches failed download jobs with an exponential backoff.
+	// Failed jobs are not relaunched if unset.
+	DownloadRetry *LocalModelDownloadRetryConfig `json:"downloadRetry,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+type LocalModelDownloadRetryConfig struct {
+	// Maximum number of times a failed download job is relaunched
+	MaxRetries int32 `json:"maxRetries"`
+	// Backoff before relaunching a failed download job for the first time, doubled for each retry. Defaults to 60.
+	InitialBackoffSeconds int64 `json:"initialBackoffSeconds,omitempty"`
+	// Maximum backoff before relaunching a failed download job. Defaults to 3600.
+	MaxBackoffSeconds int64 `json:"maxBackoffSeconds,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+type LocalModelPeerDownloadConfig struct {
+	// Enabled makes the local model agents serve their downloaded models to peer nodes, and download jobs fetch
+	// models from a peer before falling back to the source model URI
+	Enabled bool `json:"enabled"`
+	// Port of the local model agents serving the downloaded models
+	Port int32 `json:"port,omitempty"`
+	// Image of the jobs downloading models from peers, the local model agent image
+	Image string `json:"image,omitempty"`
+	// SecretName is the secret of the job namespace with the token authenticating the download jobs and the TLS
+	// certificate of the local model agents, created by the agents if it does not exist.
+	// Defaults to kserve-localmodel-peer.
+	SecretName string `json:"secretName,omitempty"`
 }
 
 // +kubebuilder:object:generate=false
@@ -480,5 +510,22 @@ func GetStorageInitializerConfigs(configMap *corev1.ConfigMap) (*types.StorageIn
 		}
 	}
 
+	if policy := storageInitializerConfig.OciVerification; policy != nil {
+		if len(policy.PublicKeys) == 0 && len(policy.Identities) == 0 {
+			return nil, fmt.Errorf("invalid %q.ociVerification: at least one of publicKeys or identities must be set",
+				StorageInitializerConfigMapKeyName)
+		}
+		if len(policy.Identities) != 0 && (len(policy.FulcioRoots) == 0 || len(policy.RekorPublicKeys) == 0) {
+			return nil, fmt.Errorf("invalid %q.ociVerification: fulcioRoots and rekorPublicKeys must be set to verify keyless identities",
+				StorageInitializerConfigMapKeyName)
+		}
+		for _, identity := range policy.Identities {
+			if identity.Issuer == "" || (identity.Subject == "" && identity.SubjectRegExp == "") {
+				return nil, fmt.Errorf("invalid %q.ociVerification: identities must have an issuer and a subject or subjectRegExp",
+					StorageInitializerConfigMapKeyName)
+			}
+		}
+	}
+
 	return storageInitializerConfig, nil
 }
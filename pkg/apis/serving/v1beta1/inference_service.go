@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -40,6 +41,28 @@ type InferenceServiceSpec struct {
 	// +optional
 	// +listType=atomic
 	Canary []CanarySpec `json:"canary,omitempty"`
+	// Shadow defines an optional predictor receiving a mirrored copy of the predictor traffic.
+	// The shadow responses are discarded, set the shadow predictor logger to capture them.
+	// The Deployment or Knative Service is named {isvc}-predictor-shadow.
+	// +optional
+	Shadow *ShadowSpec `json:"shadow,omitempty"`
+}
+
+// ShadowSpec defines a shadow deployment validating a new model version against production
+// traffic without affecting the responses. The shadow predictor spec is merged over the
+// stable predictor spec. The shadow uses fixed replicas (no autoscaling).
+// Requests are mirrored by the ingress, so only requests routed by the ingress to the
+// predictor are mirrored.
+type ShadowSpec struct {
+	// Predictor spec for the shadow variant.
+	// +required
+	Predictor PredictorSpec `json:"predictor"`
+	// MirrorPercent is the percentage of the predictor requests mirrored to the shadow.
+	// +optional
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MirrorPercent *int32 `json:"mirrorPercent,omitempty"`
 }
 
 // CanarySpec defines a canary deployment for progressive rollout of a new
@@ -56,6 +79,90 @@ type CanarySpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=100
 	TrafficPercent int32 `json:"trafficPercent"`
+	// Rollout defines an optional progressive rollout policy for this canary. When set, the controller
+	// steps the canary traffic through the rollout steps, analyzing each step before moving forward,
+	// and rolls the canary traffic back to 0 when the analysis fails. TrafficPercent is then ignored.
+	// +optional
+	Rollout *ProgressiveRolloutSpec `json:"rollout,omitempty"`
+}
+
+// ProgressiveRolloutSpec defines how the traffic of a canary is progressively increased.
+type ProgressiveRolloutSpec struct {
+	// Steps defines the traffic percentage of each rollout step, applied in order.
+	// +required
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	Steps []RolloutStep `json:"steps"`
+	// Analysis defines the success criteria evaluated at the end of each step. When not set, the
+	// rollout moves to the next step once the hold duration has elapsed.
+	// +optional
+	Analysis *RolloutAnalysisSpec `json:"analysis,omitempty"`
+}
+
+// RolloutStep defines a single step of a progressive rollout.
+type RolloutStep struct {
+	// TrafficPercent is the percentage of inference traffic routed to the canary during this step.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	TrafficPercent int32 `json:"trafficPercent"`
+	// Hold is how long the step is held, once the canary is ready, before it is analyzed. e.g. "5m"
+	// +optional
+	Hold *metav1.Duration `json:"hold,omitempty"`
+}
+
+// RolloutAnalysisSpec defines the success criteria of a rollout step. All the configured criteria must pass.
+type RolloutAnalysisSpec struct {
+	// Prometheus defines metric based success criteria.
+	// +optional
+	Prometheus *PrometheusAnalysisSpec `json:"prometheus,omitempty"`
+	// Webhook defines an external endpoint judging the step.
+	// +optional
+	Webhook *WebhookAnalysisSpec `json:"webhook,omitempty"`
+}
+
+// PrometheusAnalysisSpec defines success criteria evaluated against a Prometheus server.
+type PrometheusAnalysisSpec struct {
+	// Address of the Prometheus server, e.g. http://prometheus.monitoring.svc:9090
+	// +required
+	Address string `json:"address"`
+	// Metrics evaluated at the end of each step.
+	// +required
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	Metrics []AnalysisMetric `json:"metrics"`
+}
+
+// AnalysisMetric defines a Prometheus query and the range its result must be within.
+// The query is a Go template with the .Name, .Namespace, .Canary and .CanaryService fields available,
+// e.g. histogram_quantile(0.99, sum(rate(request_duration_seconds_bucket{service="{{ .CanaryService }}"}[1m])) by (le))
+type AnalysisMetric struct {
+	// Name of the metric, used in the rollout status.
+	// +required
+	Name string `json:"name"`
+	// Query is the PromQL query, it must evaluate to a single value.
+	// +required
+	Query string `json:"query"`
+	// Max is the maximum accepted value of the query result, e.g. an error rate of "0.01".
+	// +optional
+	Max *resource.Quantity `json:"max,omitempty"`
+	// Min is the minimum accepted value of the query result, e.g. a success rate of "0.99".
+	// +optional
+	Min *resource.Quantity `json:"min,omitempty"`
+}
+
+// WebhookAnalysisSpec defines an endpoint that judges a rollout step. The endpoint receives a POST request
+// describing the step and passes the step by returning a 2xx status code. 4xx status codes fail the step,
+// 5xx status codes and connection errors are retried until the analysis deadline, after which the step fails.
+type WebhookAnalysisSpec struct {
+	// URL of the webhook.
+	// +required
+	URL string `json:"url"`
+	// TimeoutSeconds of the webhook request, defaults to 10 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=60
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
 }
 
 // StorageSpec defines a spec for an object in an object store
@@ -165,6 +272,8 @@ type Batcher struct {
 // +kubebuilder:printcolumn:name="Latest",type="integer",JSONPath=".status.components.predictor.traffic[?(@.latestRevision==true)].percent"
 // +kubebuilder:printcolumn:name="PrevRolledoutRevision",type="string",JSONPath=".status.components.predictor.traffic[?(@.tag=='prev')].revisionName"
 // +kubebuilder:printcolumn:name="LatestReadyRevision",type="string",JSONPath=".status.components.predictor.traffic[?(@.latestRevision==true)].revisionName"
+// +kubebuilder:printcolumn:name="Runtime",type="string",JSONPath=".status.runtimeSelection.name"
+// +kubebuilder:printcolumn:name="RuntimeKind",type="string",JSONPath=".status.runtimeSelection.kind",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:path=inferenceservices,shortName=isvc
 // +kubebuilder:storageversion
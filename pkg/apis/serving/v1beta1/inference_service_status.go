@@ -28,6 +28,7 @@ import (
 	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
 )
 
 // InferenceServiceStatus defines the observed state of InferenceService
@@ -61,6 +62,79 @@ type InferenceServiceStatus struct {
 	ServingRuntimeName string `json:"servingRuntimeName,omitempty"`
 	// ClusterServingRuntimeName is the name of the ClusterServingRuntime that the InferenceService is using
 	ClusterServingRuntimeName string `json:"clusterServingRuntimeName,omitempty"`
+	// RuntimeSelection explains the runtime selected for the model of the predictor
+	// +optional
+	RuntimeSelection *RuntimeSelection `json:"runtimeSelection,omitempty"`
+}
+
+// RuntimeKind is the kind of a runtime
+// +kubebuilder:validation:Enum=ServingRuntime;ClusterServingRuntime
+type RuntimeKind string
+
+// RuntimeKind enum values
+const (
+	ServingRuntimeKind        RuntimeKind = "ServingRuntime"
+	ClusterServingRuntimeKind RuntimeKind = "ClusterServingRuntime"
+)
+
+// RuntimeRejectionReason enum
+// +kubebuilder:validation:Enum=RuntimeDisabled;MultiModelMismatch;MultiNodeMismatch;ModelFormatMismatch;ModelFormatVersionMismatch;AutoSelectDisabled;ProtocolVersionUnsupported;LowerPriority
+type RuntimeRejectionReason string
+
+// RuntimeRejectionReason enum values
+const (
+	// The runtime is disabled
+	RuntimeRejectedDisabled RuntimeRejectionReason = "RuntimeDisabled"
+	// The runtime is a ModelMesh runtime while the predictor is served by a single model runtime, or the other way around
+	MultiModelMismatch RuntimeRejectionReason = "MultiModelMismatch"
+	// The runtime has a worker spec while multi-node serving is not requested, or the other way around
+	MultiNodeMismatch RuntimeRejectionReason = "MultiNodeMismatch"
+	// The runtime does not support the model format
+	ModelFormatMismatch RuntimeRejectionReason = "ModelFormatMismatch"
+	// The runtime supports the model format but not its version
+	ModelFormatVersionMismatch RuntimeRejectionReason = "ModelFormatVersionMismatch"
+	// The runtime supports the model format only when it is set as the runtime of the model
+	AutoSelectDisabled RuntimeRejectionReason = "AutoSelectDisabled"
+	// The runtime does not support the protocol version of the model
+	ProtocolVersionUnsupported RuntimeRejectionReason = "ProtocolVersionUnsupported"
+	// The runtime supports the model but another runtime is preferred
+	LowerPriority RuntimeRejectionReason = "LowerPriority"
+)
+
+// RuntimeSelection describes the runtime used by the predictor and, when it was auto-selected, the runtimes
+// considered for the model format
+type RuntimeSelection struct {
+	// Name of the selected runtime, empty if no runtime supports the model
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Kind of the selected runtime
+	// +optional
+	Kind RuntimeKind `json:"kind,omitempty"`
+	// AutoSelected is true when the runtime is not set in the model spec and was selected by model format
+	// +optional
+	AutoSelected bool `json:"autoSelected,omitempty"`
+	// Candidates are the auto-selection candidates, the selected runtime first, followed by the runtimes supporting
+	// the model in order of preference and the rejected runtimes
+	// +optional
+	// +listType=atomic
+	Candidates []RuntimeCandidate `json:"candidates,omitempty"`
+}
+
+// RuntimeCandidate is a runtime considered for the auto-selection of the runtime of the predictor
+type RuntimeCandidate struct {
+	// Name of the runtime
+	Name string `json:"name"`
+	// Kind of the runtime
+	Kind RuntimeKind `json:"kind"`
+	// Priority of the model format in the runtime, if set
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+	// Reason the runtime was not selected, empty for the selected runtime
+	// +optional
+	Reason RuntimeRejectionReason `json:"reason,omitempty"`
+	// Message detailing the reason the runtime was not selected
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ComponentStatusSpec describes the state of the component
@@ -107,6 +181,73 @@ type CanaryStatus struct {
 	// ModelStatus tracks the canary model's loading state and transitions.
 	// +optional
 	ModelStatus ModelStatus `json:"modelStatus,omitempty"`
+	// Rollout is the observed state of the canary progressive rollout, if any.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+}
+
+// RolloutPhase is the phase of a canary progressive rollout
+// +kubebuilder:validation:Enum="";Progressing;Succeeded;RolledBack
+type RolloutPhase string
+
+// RolloutPhase Enum
+const (
+	// RolloutProgressing the canary is moving through the rollout steps
+	RolloutProgressing RolloutPhase = "Progressing"
+	// RolloutSucceeded all the rollout steps passed the analysis
+	RolloutSucceeded RolloutPhase = "Succeeded"
+	// RolloutRolledBack a rollout step failed the analysis and the canary traffic was set back to 0
+	RolloutRolledBack RolloutPhase = "RolledBack"
+)
+
+// RolloutStepResult is the outcome of a rollout step
+// +kubebuilder:validation:Enum=Passed;Failed
+type RolloutStepResult string
+
+// RolloutStepResult Enum
+const (
+	RolloutStepPassed RolloutStepResult = "Passed"
+	RolloutStepFailed RolloutStepResult = "Failed"
+)
+
+// RolloutStatus represents the observed state of a canary progressive rollout.
+type RolloutStatus struct {
+	// Phase of the rollout.
+	Phase RolloutPhase `json:"phase"`
+	// CurrentStep is the index of the rollout step currently applied.
+	CurrentStep int32 `json:"currentStep"`
+	// StepStartTime is when the hold of the current step started, it is unset until the canary is ready.
+	// +optional
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+	// SpecHash identifies the canary spec the rollout was started for, a new rollout starts when it changes.
+	// +optional
+	SpecHash string `json:"specHash,omitempty"`
+	// Message describes the last transition of the rollout.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// History records the outcome of every completed rollout step.
+	// +optional
+	// +listType=atomic
+	History []RolloutStepStatus `json:"history,omitempty"`
+}
+
+// RolloutStepStatus records the outcome of a rollout step.
+type RolloutStepStatus struct {
+	// Step is the index of the rollout step.
+	Step int32 `json:"step"`
+	// TrafficPercent routed to the canary during the step.
+	TrafficPercent int32 `json:"trafficPercent"`
+	// Result of the step analysis.
+	Result RolloutStepResult `json:"result"`
+	// StartTime of the step hold.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime of the step analysis.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Message details the analysis outcome, e.g. the measured metric values.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ComponentType contains the different types of components of the service
@@ -117,6 +258,7 @@ const (
 	PredictorComponent   ComponentType = "predictor"
 	ExplainerComponent   ComponentType = "explainer"
 	TransformerComponent ComponentType = "transformer"
+	ShadowComponent      ComponentType = "shadow"
 )
 
 // ConditionType represents a Service condition value
@@ -147,8 +289,15 @@ const (
 	LatestDeploymentReady apis.ConditionType = "LatestDeploymentReady"
 	// CanaryPredictorReady is set when all canary predictor deployments have reported readiness.
 	CanaryPredictorReady apis.ConditionType = "CanaryPredictorReady"
+	// ShadowReady is set when the shadow predictor has reported readiness.
+	ShadowReady apis.ConditionType = "ShadowReady"
+	// ShadowMirrored is set when the ingress mirrors the predictor requests to the shadow predictor.
+	ShadowMirrored apis.ConditionType = "ShadowMirrored"
 	// Stopped is set when the inference service has been stopped and all related objects are deleted
 	Stopped apis.ConditionType = "Stopped"
+	// ModelRevisionsResolved is set when the revisions of the model sources of the predictor are pinned, it is false
+	// while a revision cannot be resolved and the model source is downloaded unpinned.
+	ModelRevisionsResolved apis.ConditionType = "ModelRevisionsResolved"
 )
 
 type ModelStatus struct {
@@ -167,6 +316,26 @@ type ModelStatus struct {
 	// Model copy information of the predictor's model.
 	// +optional
 	ModelCopies *ModelCopies `json:"copies,omitempty"`
+
+	// Immutable revisions of the model sources of the predictor, resolved when their storage URIs are first deployed
+	// so that all the replicas download the same model. Only set when the pinModelRevisions storage initializer
+	// config is enabled.
+	// +optional
+	// +listType=map
+	// +listMapKey=storageUri
+	ResolvedSources []ResolvedModelSource `json:"resolvedSources,omitempty"`
+}
+
+// ResolvedModelSource is the immutable revision a model source was resolved to
+type ResolvedModelSource struct {
+	// Storage URI of the model source, as specified in the predictor
+	StorageUri string `json:"storageUri"`
+	// Immutable revision of the model source: the commit sha for Hugging Face, the manifest digest for OCI, and
+	// the digest of the keys and ETags of the objects for S3 or of the names and generations of the objects for GCS
+	Revision string `json:"revision"`
+	// Time at which the revision was resolved
+	// +optional
+	ResolvedTime *metav1.Time `json:"resolvedTime,omitempty"`
 }
 
 type ModelRevisionStates struct {
@@ -223,6 +392,13 @@ const (
 // Stopped Inference Service reason
 const StoppedISVCReason = "Stopped"
 
+// ShadowMirroringUnsupportedReason is the ShadowMirrored reason when the ingress configuration cannot mirror requests
+const ShadowMirroringUnsupportedReason = "MirroringUnsupported"
+
+// ModelRevisionResolutionFailedReason is the ModelRevisionsResolved reason when the revision of a model source cannot
+// be resolved
+const ModelRevisionResolutionFailedReason = "RevisionResolutionFailed"
+
 // FailureReason enum
 // +kubebuilder:validation:Enum=ModelLoadFailed;RuntimeUnhealthy;RuntimeDisabled;NoSupportingRuntime;RuntimeNotRecognized;InvalidPredictorSpec
 type FailureReason string
@@ -272,6 +448,7 @@ var readyConditionsMap = map[ComponentType]apis.ConditionType{
 	PredictorComponent:   PredictorReady,
 	ExplainerComponent:   ExplainerReady,
 	TransformerComponent: TransformerReady,
+	ShadowComponent:      ShadowReady,
 }
 
 var routeConditionsMap = map[ComponentType]apis.ConditionType{
@@ -299,6 +476,45 @@ var conditionSet = apis.NewLivingConditionSet(
 
 var _ apis.ConditionsAccessor = (*InferenceServiceStatus)(nil)
 
+// GetCanaryTrafficPercent returns the traffic percentage currently routed to the canary. A canary with a
+// progressive rollout receives the traffic of its current rollout step, and none once rolled back.
+func (ss *InferenceServiceStatus) GetCanaryTrafficPercent(canary *CanarySpec) int32 {
+	if canary.Rollout == nil || len(canary.Rollout.Steps) == 0 {
+		return canary.TrafficPercent
+	}
+	steps := canary.Rollout.Steps
+	for _, cs := range ss.CanaryStatuses {
+		if cs.Name != canary.Predictor.Name || cs.Rollout == nil {
+			continue
+		}
+		if cs.Rollout.Phase == RolloutRolledBack {
+			return 0
+		}
+		return steps[min(int(cs.Rollout.CurrentStep), len(steps)-1)].TrafficPercent
+	}
+	return steps[0].TrafficPercent
+}
+
+// GetMirrorPercent returns the percentage of the predictor requests mirrored to the shadow.
+func (s *ShadowSpec) GetMirrorPercent() int32 {
+	if s.MirrorPercent == nil {
+		return 100
+	}
+	return *s.MirrorPercent
+}
+
+// GetMaxTrafficPercent returns the highest traffic percentage the canary can receive.
+func (c *CanarySpec) GetMaxTrafficPercent() int32 {
+	if c.Rollout == nil || len(c.Rollout.Steps) == 0 {
+		return c.TrafficPercent
+	}
+	var maxPercent int32
+	for _, step := range c.Rollout.Steps {
+		maxPercent = max(maxPercent, step.TrafficPercent)
+	}
+	return maxPercent
+}
+
 func (ss *InferenceServiceStatus) InitializeConditions() {
 	conditionSet.Manage(ss).InitializeConditions()
 }
@@ -620,16 +836,18 @@ func (ss *InferenceServiceStatus) PropagateStatus(component ComponentType, servi
 	}
 	readyConditionType := readyConditionsMap[component]
 	ss.SetCondition(readyConditionType, readyCondition)
-	// propagate route condition for each component
-	routeCondition := serviceStatus.GetCondition("RoutesReady")
-	routeConditionType := routeConditionsMap[component]
-	ss.SetCondition(routeConditionType, routeCondition)
+	// propagate route condition for each component, the shadow only reports its readiness
+	if routeConditionType, ok := routeConditionsMap[component]; ok {
+		routeCondition := serviceStatus.GetCondition("RoutesReady")
+		ss.SetCondition(routeConditionType, routeCondition)
+	}
 	// propagate configuration condition for each component
-	configurationCondition := serviceStatus.GetCondition("ConfigurationsReady")
-	configurationConditionType := configurationConditionsMap[component]
+	if configurationConditionType, ok := configurationConditionsMap[component]; ok {
+		configurationCondition := serviceStatus.GetCondition("ConfigurationsReady")
+		ss.SetCondition(configurationConditionType, configurationCondition)
+	}
 	// propagate traffic status for each component
 	statusSpec.Traffic = serviceStatus.Traffic
-	ss.SetCondition(configurationConditionType, configurationCondition)
 
 	ss.Components[component] = statusSpec
 	ss.ObservedGeneration = serviceStatus.ObservedGeneration
@@ -647,6 +865,17 @@ func (ss *InferenceServiceStatus) SetCondition(conditionType apis.ConditionType,
 	}
 }
 
+// GetResolvedModelSource returns the resolved revision of the model source with the storage URI, or nil if it was
+// not resolved
+func (ss *InferenceServiceStatus) GetResolvedModelSource(storageUri string) *ResolvedModelSource {
+	for i := range ss.ModelStatus.ResolvedSources {
+		if ss.ModelStatus.ResolvedSources[i].StorageUri == storageUri {
+			return &ss.ModelStatus.ResolvedSources[i]
+		}
+	}
+	return nil
+}
+
 func (ss *InferenceServiceStatus) ClearCondition(conditionType apis.ConditionType) {
 	if conditionSet.Manage(ss).GetCondition(conditionType) != nil {
 		if err := conditionSet.Manage(ss).ClearCondition(conditionType); err != nil {
@@ -745,11 +974,11 @@ func (ss *InferenceServiceStatus) PropagateModelStatus(statusSpec ComponentStatu
 		return true
 	}
 
-	// Update model state to 'Loading' if storage initializer is running.
-	// If the storage initializer is terminated due to error or crashloopbackoff, update model
+	// Update model state to 'Loading' if a storage initializer is running.
+	// If a storage initializer is terminated due to error or crashloopbackoff, update model
 	// state to 'ModelLoadFailed' with failure info.
 	for _, cs := range podList.Items[0].Status.InitContainerStatuses {
-		if cs.Name == constants.StorageInitializerContainerName {
+		if utils.IsStorageInitializerContainer(cs.Name) {
 			switch {
 			case cs.State.Running != nil:
 				// Double check that we aren't missing an error because the cs is looping between an error state and running
@@ -2272,3 +2272,48 @@ func TestInferenceServiceStatus_ClearCondition(t *testing.T) {
 	// Try clearing a condition that was never set
 	status.ClearCondition(TransformerReady)
 }
+
+func TestGetCanaryTrafficPercent(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	canary := &CanarySpec{
+		TrafficPercent: 30,
+		Predictor:      PredictorSpec{Name: "v2"},
+		Rollout: &ProgressiveRolloutSpec{
+			Steps: []RolloutStep{{TrafficPercent: 10}, {TrafficPercent: 50}},
+		},
+	}
+
+	scenarios := map[string]struct {
+		rollout  *RolloutStatus
+		expected int32
+	}{
+		"no rollout status uses the first step": {
+			expected: 10,
+		},
+		"progressing rollout uses the current step": {
+			rollout:  &RolloutStatus{Phase: RolloutProgressing, CurrentStep: 1},
+			expected: 50,
+		},
+		"succeeded rollout keeps the final step": {
+			rollout:  &RolloutStatus{Phase: RolloutSucceeded, CurrentStep: 1},
+			expected: 50,
+		},
+		"rolled back rollout receives no traffic": {
+			rollout:  &RolloutStatus{Phase: RolloutRolledBack, CurrentStep: 1},
+			expected: 0,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			status := &InferenceServiceStatus{}
+			if scenario.rollout != nil {
+				status.CanaryStatuses = []CanaryStatus{{Name: "v2", Rollout: scenario.rollout}}
+			}
+			g.Expect(status.GetCanaryTrafficPercent(canary)).To(gomega.Equal(scenario.expected))
+		})
+	}
+
+	g.Expect(canary.GetMaxTrafficPercent()).To(gomega.Equal(int32(50)))
+	canary.Rollout = nil
+	g.Expect((&InferenceServiceStatus{}).GetCanaryTrafficPercent(canary)).To(gomega.Equal(int32(30)))
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"reflect"
 	"regexp"
 	"slices"
@@ -32,10 +33,12 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
 	"knative.dev/serving/pkg/apis/autoscaling"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/utils"
 	"github.com/kserve/kserve/pkg/validation"
@@ -63,7 +66,11 @@ var (
 //
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as this struct is used only for temporary operations and does not need to be deeply copied.
-type InferenceServiceValidator struct{}
+type InferenceServiceValidator struct {
+	// Clientset reads the pod security policy from the inferenceservice-config ConfigMap.
+	// The policy is not enforced when not set.
+	Clientset kubernetes.Interface
+}
 
 // +kubebuilder:webhook:verbs=create;update,path=/validate-inferenceservices,mutating=false,failurePolicy=fail,groups=serving.kserve.io,resources=inferenceservices,versions=v1beta1,name=inferenceservice.kserve-webhook-server.validator
 var _ webhook.CustomValidator = &InferenceServiceValidator{}
@@ -76,7 +83,15 @@ func (v *InferenceServiceValidator) ValidateCreate(ctx context.Context, obj runt
 		return nil, err
 	}
 	validatorLogger.Info("validate create", "name", isvc.Name)
-	return validateInferenceService(isvc)
+	warnings, err := validateInferenceService(isvc)
+	if err != nil {
+		return warnings, err
+	}
+	if err := v.validateActivatorIngress(ctx, isvc); err != nil {
+		return warnings, err
+	}
+	podSecurityWarnings, err := v.validatePodSecurity(ctx, isvc)
+	return append(warnings, podSecurityWarnings...), err
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -102,7 +117,15 @@ func (v *InferenceServiceValidator) ValidateUpdate(ctx context.Context, oldObj,
 	if err := validatePredictorNameChange(isvc, oldIsvc); err != nil {
 		return nil, err
 	}
-	return validateInferenceService(isvc)
+	warnings, err := validateInferenceService(isvc)
+	if err != nil {
+		return warnings, err
+	}
+	if err := v.validateActivatorIngress(ctx, isvc); err != nil {
+		return warnings, err
+	}
+	podSecurityWarnings, err := v.validatePodSecurity(ctx, isvc)
+	return append(warnings, podSecurityWarnings...), err
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -177,6 +200,14 @@ func validateInferenceService(isvc *InferenceService) (admission.Warnings, error
 		return allWarnings, err
 	}
 
+	if err := validateShadowSpec(isvc); err != nil {
+		return allWarnings, err
+	}
+
+	if err := validateActivator(isvc); err != nil {
+		return allWarnings, err
+	}
+
 	return allWarnings, nil
 }
 
@@ -216,7 +247,14 @@ func validateCanarySpecs(isvc *InferenceService) error {
 		if canary.TrafficPercent < 0 || canary.TrafficPercent > 100 {
 			return fmt.Errorf("canary %q trafficPercent must be between 0 and 100, got %d", canary.Predictor.Name, canary.TrafficPercent)
 		}
-		totalTraffic += canary.TrafficPercent
+		if err := validateCanaryRollout(canary.Rollout); err != nil {
+			return fmt.Errorf("canary %q rollout: %w", canary.Predictor.Name, err)
+		}
+		if canary.Rollout != nil && isvc.Spec.Predictor.CanaryTrafficPercent != nil {
+			// The rollout steps drive the canary traffic, they do not apply to the predictor canaryTrafficPercent.
+			return fmt.Errorf("canary %q rollout cannot be combined with predictor.canaryTrafficPercent", canary.Predictor.Name)
+		}
+		totalTraffic += canary.GetMaxTrafficPercent()
 
 		if err := validateExactlyOneImplementation(&canary.Predictor); err != nil {
 			return fmt.Errorf("canary %q predictor: %w", canary.Predictor.Name, err)
@@ -265,6 +303,153 @@ func validateCanarySpecs(isvc *InferenceService) error {
 	return nil
 }
 
+// validateShadowSpec validates the shadow predictor receiving the mirrored traffic.
+func validateShadowSpec(isvc *InferenceService) error {
+	shadow := isvc.Spec.Shadow
+	if shadow == nil {
+		return nil
+	}
+
+	if isvc.Annotations[constants.DeploymentMode] == string(constants.ModelMeshDeployment) {
+		return fmt.Errorf("shadow is not supported in %s deployment mode", constants.ModelMeshDeployment)
+	}
+
+	if isvc.Spec.Predictor.GetImplementation().GetStorageUri() == nil && isvc.Spec.Predictor.Model == nil {
+		return errors.New("shadow requires a stable predictor with a model (multi-model serving is not supported with shadow)")
+	}
+
+	if isvc.Spec.Transformer != nil {
+		return errors.New("shadow is not supported with a transformer (only the requests routed to the predictor are mirrored)")
+	}
+
+	if shadow.MirrorPercent != nil && (*shadow.MirrorPercent < 0 || *shadow.MirrorPercent > 100) {
+		return fmt.Errorf("shadow mirrorPercent must be between 0 and 100, got %d", *shadow.MirrorPercent)
+	}
+
+	if err := validateExactlyOneImplementation(&shadow.Predictor); err != nil {
+		return fmt.Errorf("shadow predictor: %w", err)
+	}
+	if err := utils.FirstNonNilError([]error{
+		shadow.Predictor.GetImplementation().Validate(),
+		shadow.Predictor.GetExtensions().Validate(),
+	}); err != nil {
+		return fmt.Errorf("shadow predictor: %w", err)
+	}
+
+	if shadow.Predictor.WorkerSpec != nil {
+		return errors.New("shadow must not set workerSpec (multi-node is not supported for shadow)")
+	}
+
+	ext := shadow.Predictor.GetExtensions()
+	if ext.MaxReplicas > 0 || ext.ScaleTarget != nil || ext.ScaleMetric != nil || ext.AutoScaling != nil {
+		return errors.New("shadow must not set autoscaling fields (shadow uses fixed replicas)")
+	}
+	return nil
+}
+
+// validateActivator validates that an InferenceService enabling the activator can be scaled from zero by it. The
+// activator only receives the requests of the ingress route of the predictor, so the predictor must be the only
+// component serving the InferenceService.
+func validateActivator(isvc *InferenceService) error {
+	if isvc.Annotations[constants.EnableActivatorAnnotationKey] != "true" {
+		return nil
+	}
+	if mode, ok := isvc.Annotations[constants.DeploymentMode]; ok && constants.ParseDeploymentMode(mode) != constants.Standard {
+		return fmt.Errorf("the activator is only supported in %s deployment mode", constants.Standard)
+	}
+	predictor := isvc.Spec.Predictor
+	if predictor.MinReplicas == nil || *predictor.MinReplicas != 0 {
+		return errors.New("the activator requires the predictor minReplicas to be 0")
+	}
+	if predictor.WorkerSpec != nil {
+		return errors.New("the activator is not supported with a workerSpec (multi-node predictors are not scaled to zero)")
+	}
+	if isvc.Spec.Transformer != nil || isvc.Spec.Explainer != nil {
+		return errors.New("the activator is not supported with a transformer or an explainer")
+	}
+	if len(isvc.Spec.Canary) > 0 || isvc.Spec.Shadow != nil {
+		return errors.New("the activator is not supported with a canary or a shadow")
+	}
+	switch class := constants.AutoscalerClassType(isvc.Annotations[constants.AutoscalerClass]); class {
+	case "", constants.AutoscalerClassHPA, constants.AutoscalerClassKeda:
+		return nil
+	default:
+		return fmt.Errorf("the activator is not supported with the %s autoscaler class", class)
+	}
+}
+
+// validateActivatorIngress validates that the activator is routed to, which requires the Gateway API. The ingress is
+// not validated when the inferenceservice-config ConfigMap cannot be read, the controller does not enable the
+// activator without the Gateway API either.
+func (v *InferenceServiceValidator) validateActivatorIngress(ctx context.Context, isvc *InferenceService) error {
+	if v.Clientset == nil || isvc.Annotations[constants.EnableActivatorAnnotationKey] != "true" {
+		return nil
+	}
+	configMap, err := GetInferenceServiceConfigMap(ctx, v.Clientset)
+	if err != nil {
+		validatorLogger.Error(err, "Failed to get the inferenceservice-config ConfigMap, the activator ingress is not validated")
+		return nil
+	}
+	ingressConfig, err := NewIngressConfig(configMap)
+	if err != nil {
+		validatorLogger.Error(err, "Failed to read the ingress config, the activator ingress is not validated")
+		return nil
+	}
+	if !ingressConfig.EnableGatewayAPI {
+		return errors.New("the activator requires the Gateway API ingress (enableGatewayApi), the Kubernetes Ingress does not route to it")
+	}
+	return nil
+}
+
+// validateCanaryRollout validates the progressive rollout steps and analysis of a canary.
+func validateCanaryRollout(rollout *ProgressiveRolloutSpec) error {
+	if rollout == nil {
+		return nil
+	}
+	if len(rollout.Steps) == 0 {
+		return errors.New("at least one step is required")
+	}
+	for i, step := range rollout.Steps {
+		if step.TrafficPercent < 0 || step.TrafficPercent > 100 {
+			return fmt.Errorf("step %d trafficPercent must be between 0 and 100, got %d", i, step.TrafficPercent)
+		}
+		if step.Hold != nil && step.Hold.Duration < 0 {
+			return fmt.Errorf("step %d hold must not be negative", i)
+		}
+	}
+	if rollout.Analysis == nil {
+		return nil
+	}
+	if rollout.Analysis.Prometheus == nil && rollout.Analysis.Webhook == nil {
+		return errors.New("analysis requires prometheus or webhook to be set")
+	}
+	if prometheus := rollout.Analysis.Prometheus; prometheus != nil {
+		if _, err := url.ParseRequestURI(prometheus.Address); err != nil {
+			return fmt.Errorf("invalid prometheus address %q: %w", prometheus.Address, err)
+		}
+		if len(prometheus.Metrics) == 0 {
+			return errors.New("prometheus analysis requires at least one metric")
+		}
+		for _, metric := range prometheus.Metrics {
+			if metric.Name == "" || metric.Query == "" {
+				return errors.New("prometheus analysis metrics require a name and a query")
+			}
+			if metric.Max == nil && metric.Min == nil {
+				return fmt.Errorf("prometheus analysis metric %q requires max or min", metric.Name)
+			}
+		}
+	}
+	if webhook := rollout.Analysis.Webhook; webhook != nil {
+		if _, err := url.ParseRequestURI(webhook.URL); err != nil {
+			return fmt.Errorf("invalid webhook url %q: %w", webhook.URL, err)
+		}
+		if webhook.TimeoutSeconds != nil && (*webhook.TimeoutSeconds < 1 || *webhook.TimeoutSeconds > 60) {
+			return fmt.Errorf("webhook timeoutSeconds must be between 1 and 60, got %d", *webhook.TimeoutSeconds)
+		}
+	}
+	return nil
+}
+
 func validatePredictor(isvc *InferenceService) error {
 	predictor := isvc.Spec.Predictor
 
@@ -295,6 +480,8 @@ func validatePredictor(isvc *InferenceService) error {
 		return errors.New("the 'name' field is not allowed in standard predictor")
 	case predictor.Model != nil && predictor.Model.Name != "":
 		return errors.New("the 'name' field is not allowed in standard predictor")
+	case predictor.Model != nil && predictor.Model.ModelFormat.Version != nil:
+		return v1alpha1.ValidateModelFormatVersion(*predictor.Model.ModelFormat.Version)
 	}
 	return nil
 }
@@ -308,7 +495,7 @@ func validateBlockedEnvVars(isvc *InferenceService) error {
 		return fmt.Errorf("the InferenceService %q is invalid: %w", isvc.Name, err)
 	}
 
-	if c := predictorFrameworkContainer(&isvc.Spec.Predictor); c != nil {
+	if _, c := predictorFrameworkContainer(&isvc.Spec.Predictor); c != nil {
 		if err := validation.ValidateBlockedEnvVars([]corev1.Container{*c}, validation.DefaultBlockedEnvVars); err != nil {
 			return fmt.Errorf("the InferenceService %q is invalid: %w", isvc.Name, err)
 		}
@@ -353,32 +540,98 @@ func validateBlockedEnvVars(isvc *InferenceService) error {
 	return nil
 }
 
-func predictorFrameworkContainer(p *PredictorSpec) *corev1.Container {
+// validatePodSecurity validates the containers of the InferenceService against the pod security policy of the
+// inferenceservice-config ConfigMap. The policy is disabled when the ConfigMap cannot be read or does not hold a
+// valid policy, the pod mutating webhook still validates the pods of the InferenceService.
+func (v *InferenceServiceValidator) validatePodSecurity(ctx context.Context, isvc *InferenceService) (admission.Warnings, error) {
+	if v.Clientset == nil {
+		return nil, nil
+	}
+	configMap, err := GetInferenceServiceConfigMap(ctx, v.Clientset)
+	if err != nil {
+		validatorLogger.Error(err, "Failed to get the inferenceservice-config ConfigMap, the pod security policy is not validated")
+		return nil, nil
+	}
+	policy, err := validation.NewPodSecurityPolicy(configMap)
+	if err != nil {
+		validatorLogger.Error(err, "Failed to read the pod security policy, the pod security policy is not validated")
+		return nil, nil
+	}
+	if !policy.Enabled() {
+		return nil, nil
+	}
+
+	specPath := field.NewPath("spec")
+	allErrs := validatePredictorPodSecurity(policy, &isvc.Spec.Predictor, specPath.Child("predictor"))
+	for i := range isvc.Spec.Canary {
+		allErrs = append(allErrs, validatePredictorPodSecurity(policy, &isvc.Spec.Canary[i].Predictor,
+			specPath.Child("canary").Index(i).Child("predictor"))...)
+	}
+	if isvc.Spec.Shadow != nil {
+		allErrs = append(allErrs, validatePredictorPodSecurity(policy, &isvc.Spec.Shadow.Predictor,
+			specPath.Child("shadow", "predictor"))...)
+	}
+	if transformer := isvc.Spec.Transformer; transformer != nil {
+		transformerPath := specPath.Child("transformer")
+		allErrs = append(allErrs, policy.ValidateContainers(transformer.Containers, transformerPath.Child("containers"))...)
+		allErrs = append(allErrs, policy.ValidateContainers(transformer.InitContainers, transformerPath.Child("initContainers"))...)
+	}
+	if explainer := isvc.Spec.Explainer; explainer != nil {
+		explainerPath := specPath.Child("explainer")
+		allErrs = append(allErrs, policy.ValidateContainers(explainer.Containers, explainerPath.Child("containers"))...)
+		allErrs = append(allErrs, policy.ValidateContainers(explainer.InitContainers, explainerPath.Child("initContainers"))...)
+		if explainer.ART != nil {
+			allErrs = append(allErrs, policy.ValidateContainer(&explainer.ART.Container, explainerPath.Child("art"))...)
+		}
+	}
+
+	warnings, errs := policy.Report(allErrs)
+	if len(errs) > 0 {
+		return warnings, fmt.Errorf("the InferenceService %q is invalid: %w", isvc.Name, errs.ToAggregate())
+	}
+	return warnings, nil
+}
+
+func validatePredictorPodSecurity(policy *validation.PodSecurityPolicy, predictor *PredictorSpec, path *field.Path) field.ErrorList {
+	allErrs := policy.ValidateContainers(predictor.Containers, path.Child("containers"))
+	allErrs = append(allErrs, policy.ValidateContainers(predictor.InitContainers, path.Child("initContainers"))...)
+	if name, c := predictorFrameworkContainer(predictor); c != nil {
+		allErrs = append(allErrs, policy.ValidateContainer(c, path.Child(name))...)
+	}
+	if predictor.WorkerSpec != nil {
+		workerPath := path.Child("workerSpec")
+		allErrs = append(allErrs, policy.ValidateContainers(predictor.WorkerSpec.Containers, workerPath.Child("containers"))...)
+		allErrs = append(allErrs, policy.ValidateContainers(predictor.WorkerSpec.InitContainers, workerPath.Child("initContainers"))...)
+	}
+	return allErrs
+}
+
+func predictorFrameworkContainer(p *PredictorSpec) (string, *corev1.Container) {
 	switch {
 	case p.Model != nil:
-		return &p.Model.Container
+		return "model", &p.Model.Container
 	case p.SKLearn != nil:
-		return &p.SKLearn.Container
+		return "sklearn", &p.SKLearn.Container
 	case p.XGBoost != nil:
-		return &p.XGBoost.Container
+		return "xgboost", &p.XGBoost.Container
 	case p.Tensorflow != nil:
-		return &p.Tensorflow.Container
+		return "tensorflow", &p.Tensorflow.Container
 	case p.PyTorch != nil:
-		return &p.PyTorch.Container
+		return "pytorch", &p.PyTorch.Container
 	case p.Triton != nil:
-		return &p.Triton.Container
+		return "triton", &p.Triton.Container
 	case p.ONNX != nil:
-		return &p.ONNX.Container
+		return "onnx", &p.ONNX.Container
 	case p.HuggingFace != nil:
-		return &p.HuggingFace.Container
+		return "huggingface", &p.HuggingFace.Container
 	case p.PMML != nil:
-		return &p.PMML.Container
+		return "pmml", &p.PMML.Container
 	case p.LightGBM != nil:
-		return &p.LightGBM.Container
+		return "lightgbm", &p.LightGBM.Container
 	case p.Paddle != nil:
-		return &p.Paddle.Container
+		return "paddle", &p.Paddle.Container
 	default:
-		return nil
+		return "", nil
 	}
 }
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kserve/kserve/pkg/constants"
 
@@ -30,6 +31,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/ptr"
 )
 
@@ -2414,6 +2416,29 @@ func TestValidateCanarySpecs(t *testing.T) {
 		}
 	}
 
+	rolloutCanary := func(name string, rollout *ProgressiveRolloutSpec) CanarySpec {
+		canary := validCanary(name, 0)
+		canary.Rollout = rollout
+		return canary
+	}
+
+	validRollout := func() *ProgressiveRolloutSpec {
+		maxErrorRate := resource.MustParse("0.01")
+		return &ProgressiveRolloutSpec{
+			Steps: []RolloutStep{
+				{TrafficPercent: 10, Hold: &metav1.Duration{Duration: 5 * time.Minute}},
+				{TrafficPercent: 50, Hold: &metav1.Duration{Duration: 5 * time.Minute}},
+			},
+			Analysis: &RolloutAnalysisSpec{
+				Prometheus: &PrometheusAnalysisSpec{
+					Address: "http://prometheus.monitoring:9090",
+					Metrics: []AnalysisMetric{{Name: "error-rate", Query: "vector(0)", Max: &maxErrorRate}},
+				},
+				Webhook: &WebhookAnalysisSpec{URL: "http://analysis.default.svc/check"},
+			},
+		}
+	}
+
 	scenarios := map[string]struct {
 		isvc       *InferenceService
 		errMatcher gomega.OmegaMatcher
@@ -2643,6 +2668,69 @@ func TestValidateCanarySpecs(t *testing.T) {
 			},
 			errMatcher: gomega.MatchError(gomega.ContainSubstring("requires a stable predictor with a model")),
 		},
+		"Canary with valid rollout accepted": {
+			isvc:       makeISVC([]CanarySpec{rolloutCanary("v2", validRollout())}),
+			errMatcher: gomega.BeNil(),
+		},
+		"Canary rollout max step counts toward traffic sum": {
+			isvc:       makeISVC([]CanarySpec{rolloutCanary("v2", validRollout()), validCanary("v3", 60)}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("must be <= 100")),
+		},
+		"Canary rollout without steps rejected": {
+			isvc:       makeISVC([]CanarySpec{rolloutCanary("v2", &ProgressiveRolloutSpec{})}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("at least one step is required")),
+		},
+		"Canary rollout step traffic out of range rejected": {
+			isvc: makeISVC([]CanarySpec{rolloutCanary("v2", &ProgressiveRolloutSpec{
+				Steps: []RolloutStep{{TrafficPercent: 120}},
+			})}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("step 0 trafficPercent must be between 0 and 100")),
+		},
+		"Canary rollout analysis without provider rejected": {
+			isvc: makeISVC([]CanarySpec{rolloutCanary("v2", &ProgressiveRolloutSpec{
+				Steps:    []RolloutStep{{TrafficPercent: 10}},
+				Analysis: &RolloutAnalysisSpec{},
+			})}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("requires prometheus or webhook")),
+		},
+		"Canary rollout metric without bounds rejected": {
+			isvc: makeISVC([]CanarySpec{rolloutCanary("v2", &ProgressiveRolloutSpec{
+				Steps: []RolloutStep{{TrafficPercent: 10}},
+				Analysis: &RolloutAnalysisSpec{
+					Prometheus: &PrometheusAnalysisSpec{
+						Address: "http://prometheus.monitoring:9090",
+						Metrics: []AnalysisMetric{{Name: "error-rate", Query: "vector(0)"}},
+					},
+				},
+			})}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("requires max or min")),
+		},
+		"Canary rollout invalid webhook url rejected": {
+			isvc: makeISVC([]CanarySpec{rolloutCanary("v2", &ProgressiveRolloutSpec{
+				Steps: []RolloutStep{{TrafficPercent: 10}},
+				Analysis: &RolloutAnalysisSpec{
+					Webhook: &WebhookAnalysisSpec{URL: "not a url"},
+				},
+			})}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("invalid webhook url")),
+		},
+		"Canary rollout webhook timeout out of range rejected": {
+			isvc: makeISVC([]CanarySpec{rolloutCanary("v2", &ProgressiveRolloutSpec{
+				Steps: []RolloutStep{{TrafficPercent: 10}},
+				Analysis: &RolloutAnalysisSpec{
+					Webhook: &WebhookAnalysisSpec{URL: "http://analysis.default.svc/check", TimeoutSeconds: ptr.To(int64(600))},
+				},
+			})}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("webhook timeoutSeconds must be between 1 and 60")),
+		},
+		"Canary rollout with predictor canaryTrafficPercent rejected": {
+			isvc: func() *InferenceService {
+				isvc := makeISVC([]CanarySpec{rolloutCanary("v2", validRollout())})
+				isvc.Spec.Predictor.CanaryTrafficPercent = ptr.To(int64(20))
+				return isvc
+			}(),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("cannot be combined with predictor.canaryTrafficPercent")),
+		},
 	}
 
 	for name, scenario := range scenarios {
@@ -2707,3 +2795,286 @@ func TestValidatePredictorNameChange(t *testing.T) {
 		g.Expect(err).ShouldNot(gomega.HaveOccurred())
 	})
 }
+
+func TestValidateShadowSpec(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	validator := InferenceServiceValidator{}
+
+	makeISVC := func(shadow *ShadowSpec) *InferenceService {
+		return &InferenceService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo", Namespace: "default",
+				Annotations: map[string]string{
+					constants.DeploymentMode: string(constants.Standard),
+				},
+			},
+			Spec: InferenceServiceSpec{
+				Predictor: PredictorSpec{
+					Model: &ModelSpec{
+						ModelFormat: ModelFormat{Name: "sklearn"},
+						PredictorExtensionSpec: PredictorExtensionSpec{
+							StorageURI: proto.String("gs://test/model-v1"),
+						},
+					},
+				},
+				Shadow: shadow,
+			},
+		}
+	}
+
+	validShadow := func() *ShadowSpec {
+		return &ShadowSpec{
+			MirrorPercent: proto.Int32(50),
+			Predictor: PredictorSpec{
+				Model: &ModelSpec{
+					ModelFormat: ModelFormat{Name: "sklearn"},
+					PredictorExtensionSpec: PredictorExtensionSpec{
+						StorageURI: proto.String("gs://test/model-v2"),
+					},
+				},
+			},
+		}
+	}
+
+	scenarios := map[string]struct {
+		isvc       *InferenceService
+		errMatcher gomega.OmegaMatcher
+	}{
+		"Valid shadow accepted": {
+			isvc:       makeISVC(validShadow()),
+			errMatcher: gomega.BeNil(),
+		},
+		"Shadow accepted in Knative mode": {
+			isvc: func() *InferenceService {
+				isvc := makeISVC(validShadow())
+				isvc.Annotations = nil
+				return isvc
+			}(),
+			errMatcher: gomega.BeNil(),
+		},
+		"Shadow rejected in ModelMesh mode": {
+			isvc: func() *InferenceService {
+				isvc := makeISVC(validShadow())
+				isvc.Annotations[constants.DeploymentMode] = string(constants.ModelMeshDeployment)
+				return isvc
+			}(),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("not supported in ModelMesh deployment mode")),
+		},
+		"Shadow with transformer rejected": {
+			isvc: func() *InferenceService {
+				isvc := makeISVC(validShadow())
+				isvc.Spec.Transformer = &TransformerSpec{
+					PodSpec: PodSpec{Containers: []corev1.Container{{Image: "some-image"}}},
+				}
+				return isvc
+			}(),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("shadow is not supported with a transformer")),
+		},
+		"Mirror percent out of range rejected": {
+			isvc: func() *InferenceService {
+				shadow := validShadow()
+				shadow.MirrorPercent = proto.Int32(150)
+				return makeISVC(shadow)
+			}(),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("mirrorPercent must be between 0 and 100")),
+		},
+		"Shadow with maxReplicas rejected": {
+			isvc: func() *InferenceService {
+				shadow := validShadow()
+				shadow.Predictor.MaxReplicas = 3
+				return makeISVC(shadow)
+			}(),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("must not set autoscaling fields")),
+		},
+		"Shadow with workerSpec rejected": {
+			isvc: func() *InferenceService {
+				shadow := validShadow()
+				shadow.Predictor.WorkerSpec = &WorkerSpec{}
+				return makeISVC(shadow)
+			}(),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("must not set workerSpec")),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			_, err := validator.ValidateCreate(t.Context(), scenario.isvc)
+			g.Expect(err).Should(scenario.errMatcher)
+		})
+	}
+}
+
+func TestValidatePodSecurity(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	newValidator := func(podSecurity string) *InferenceServiceValidator {
+		return &InferenceServiceValidator{
+			Clientset: fakeclientset.NewSimpleClientset(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      constants.InferenceServiceConfigMapName,
+					Namespace: constants.KServeNamespace,
+				},
+				Data: map[string]string{"podSecurity": podSecurity},
+			}),
+		}
+	}
+	newIsvc := func() *InferenceService {
+		isvc := makeTestInferenceService()
+		isvc.Spec.Predictor.Tensorflow.Env = []corev1.EnvVar{{Name: "LD_PRELOAD", Value: "/lib/hook.so"}}
+		isvc.Spec.Transformer = &TransformerSpec{
+			PodSpec: PodSpec{
+				Containers: []corev1.Container{{Name: constants.InferenceServiceContainerName, Image: "registry.io/transformer:latest"}},
+			},
+		}
+		return &isvc
+	}
+
+	scenarios := map[string]struct {
+		validator       *InferenceServiceValidator
+		errMatcher      gomega.OmegaMatcher
+		warningsMatcher gomega.OmegaMatcher
+	}{
+		"policy enforced": {
+			validator: newValidator(`{"mode": "enforce", "blockedEnvVars": ["LD_PRELOAD"], "allowedRegistries": ["docker.io/kserve"]}`),
+			errMatcher: gomega.MatchError(gomega.And(
+				gomega.ContainSubstring("spec.predictor.tensorflow.env[0].name"),
+				gomega.ContainSubstring("spec.transformer.containers[0].image"),
+			)),
+			warningsMatcher: gomega.BeEmpty(),
+		},
+		"policy warned": {
+			validator:  newValidator(`{"mode": "warn", "blockedEnvVars": ["LD_PRELOAD"], "allowedRegistries": ["docker.io/kserve"]}`),
+			errMatcher: gomega.Succeed(),
+			warningsMatcher: gomega.ConsistOf(
+				gomega.ContainSubstring("spec.predictor.tensorflow.env[0].name"),
+				gomega.ContainSubstring("spec.transformer.containers[0].image"),
+			),
+		},
+		"policy disabled": {
+			validator:       newValidator(`{"blockedEnvVars": ["LD_PRELOAD"]}`),
+			errMatcher:      gomega.Succeed(),
+			warningsMatcher: gomega.BeEmpty(),
+		},
+		"no clientset": {
+			validator:       &InferenceServiceValidator{},
+			errMatcher:      gomega.Succeed(),
+			warningsMatcher: gomega.BeEmpty(),
+		},
+		"config missing": {
+			validator:       &InferenceServiceValidator{Clientset: fakeclientset.NewSimpleClientset()},
+			errMatcher:      gomega.Succeed(),
+			warningsMatcher: gomega.BeEmpty(),
+		},
+		"policy unreadable": {
+			validator:       newValidator(`{"mode": "audit"}`),
+			errMatcher:      gomega.Succeed(),
+			warningsMatcher: gomega.BeEmpty(),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			warnings, err := scenario.validator.ValidateCreate(t.Context(), newIsvc())
+			g.Expect(err).Should(scenario.errMatcher)
+			g.Expect(warnings).Should(scenario.warningsMatcher)
+		})
+	}
+}
+
+func TestValidateActivator(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	newValidator := func(ingress string) *InferenceServiceValidator {
+		return &InferenceServiceValidator{
+			Clientset: fakeclientset.NewSimpleClientset(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      constants.InferenceServiceConfigMapName,
+					Namespace: constants.KServeNamespace,
+				},
+				Data: map[string]string{IngressConfigKeyName: ingress},
+			}),
+		}
+	}
+	gatewayAPI := newValidator(`{"enableGatewayApi": true, "kserveIngressGateway": "kserve/kserve-ingress-gateway", "ingressGateway": "knative-serving/knative-ingress-gateway"}`)
+	newIsvc := func(update func(isvc *InferenceService)) *InferenceService {
+		isvc := makeTestInferenceService()
+		isvc.Annotations = map[string]string{
+			constants.DeploymentMode:               string(constants.Standard),
+			constants.EnableActivatorAnnotationKey: "true",
+		}
+		isvc.Spec.Predictor.MinReplicas = ptr.To(int32(0))
+		if update != nil {
+			update(&isvc)
+		}
+		return &isvc
+	}
+
+	scenarios := map[string]struct {
+		validator  *InferenceServiceValidator
+		isvc       *InferenceService
+		errMatcher gomega.OmegaMatcher
+	}{
+		"activator accepted": {
+			validator:  gatewayAPI,
+			isvc:       newIsvc(nil),
+			errMatcher: gomega.Succeed(),
+		},
+		"activator not enabled": {
+			validator: newValidator(`{"ingressGateway": "knative-serving/knative-ingress-gateway"}`),
+			isvc: newIsvc(func(isvc *InferenceService) {
+				delete(isvc.Annotations, constants.EnableActivatorAnnotationKey)
+				isvc.Spec.Predictor.MinReplicas = ptr.To(int32(1))
+			}),
+			errMatcher: gomega.Succeed(),
+		},
+		"kubernetes ingress rejected": {
+			validator:  newValidator(`{"ingressGateway": "knative-serving/knative-ingress-gateway"}`),
+			isvc:       newIsvc(nil),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("requires the Gateway API ingress")),
+		},
+		"knative mode rejected": {
+			validator: gatewayAPI,
+			isvc: newIsvc(func(isvc *InferenceService) {
+				isvc.Annotations[constants.DeploymentMode] = string(constants.Knative)
+			}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("only supported in Standard deployment mode")),
+		},
+		"min replicas not zero rejected": {
+			validator: gatewayAPI,
+			isvc: newIsvc(func(isvc *InferenceService) {
+				isvc.Spec.Predictor.MinReplicas = ptr.To(int32(1))
+			}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("minReplicas to be 0")),
+		},
+		"transformer rejected": {
+			validator: gatewayAPI,
+			isvc: newIsvc(func(isvc *InferenceService) {
+				isvc.Spec.Transformer = &TransformerSpec{
+					PodSpec: PodSpec{
+						Containers: []corev1.Container{{Name: constants.InferenceServiceContainerName, Image: "kserve/transformer:latest"}},
+					},
+				}
+			}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("not supported with a transformer")),
+		},
+		"external autoscaler rejected": {
+			validator: gatewayAPI,
+			isvc: newIsvc(func(isvc *InferenceService) {
+				isvc.Annotations[constants.AutoscalerClass] = string(constants.AutoscalerClassExternal)
+			}),
+			errMatcher: gomega.MatchError(gomega.ContainSubstring("external autoscaler class")),
+		},
+		"config missing": {
+			validator:  &InferenceServiceValidator{Clientset: fakeclientset.NewSimpleClientset()},
+			isvc:       newIsvc(nil),
+			errMatcher: gomega.Succeed(),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			_, err := scenario.validator.ValidateCreate(t.Context(), scenario.isvc)
+			g.Expect(err).Should(scenario.errMatcher)
+		})
+	}
+}
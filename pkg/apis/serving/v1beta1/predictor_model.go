@@ -18,11 +18,13 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	corev1 "k8s.io/api/core/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
@@ -35,7 +37,8 @@ type ModelFormat struct {
 	Name string `json:"name"`
 	// Version of the model format.
 	// Used in validating that a predictor is supported by a runtime.
-	// Can be "major", "major.minor" or "major.minor.patch".
+	// Can be "major", "major.minor" or "major.minor.patch", matching the versions it is a prefix of,
+	// or a semver constraint such as ">=2.1,<3".
 	// +optional
 	Version *string `json:"version,omitempty"`
 }
@@ -70,28 +73,23 @@ func (m *ModelSpec) GetProtocol() constants.InferenceServiceProtocol {
 	return constants.ProtocolV1
 }
 
-type stringSet map[string]struct{}
-
-func (ss stringSet) add(s string) {
-	ss[s] = struct{}{}
-}
-
-func (ss stringSet) contains(s string) bool {
-	_, found := ss[s]
-	return found
-}
-
 // GetSupportingRuntimes Get a list of ServingRuntimeSpecs that correspond to ServingRuntimes and ClusterServingRuntimes that
 // support the given model. If the `isMMS` argument is true, this function will only return ServingRuntimes that are
 // ModelMesh compatible, otherwise only single-model serving compatible runtimes will be returned.
 // If `isMultinode` is true, this function will only return ServingRuntimes configured with workers.
 func (m *ModelSpec) GetSupportingRuntimes(ctx context.Context, cl client.Client, namespace string, isMMS bool, isMultinode bool) ([]v1alpha1.SupportedRuntime, error) {
-	modelProtocolVersion := m.GetProtocol()
+	srSpecs, _, err := m.GetRuntimeCandidates(ctx, cl, namespace, isMMS, isMultinode)
+	return srSpecs, err
+}
 
+// GetRuntimeCandidates returns the runtimes supporting the given model in order of preference, like
+// GetSupportingRuntimes, and all the runtimes considered with the reason each runtime was not selected.
+// The first supporting runtime is the selected one.
+func (m *ModelSpec) GetRuntimeCandidates(ctx context.Context, cl client.Client, namespace string, isMMS bool, isMultinode bool) ([]v1alpha1.SupportedRuntime, []RuntimeCandidate, error) {
 	// List all namespace-scoped runtimes.
 	runtimes := &v1alpha1.ServingRuntimeList{}
 	if err := cl.List(ctx, runtimes, client.InNamespace(namespace)); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	// Sort namespace-scoped runtimes by created timestamp desc and name asc.
 	sortServingRuntimeList(runtimes)
@@ -100,7 +98,7 @@ func (m *ModelSpec) GetSupportingRuntimes(ctx context.Context, cl client.Client,
 	clusterRuntimes := &v1alpha1.ClusterServingRuntimeList{}
 	if err := cl.List(ctx, clusterRuntimes); err != nil {
 		if !apimeta.IsNoMatchError(err) {
-			return nil, err
+			return nil, nil, err
 		}
 		// CSR CRD not installed - treat as empty.
 	}
@@ -108,58 +106,141 @@ func (m *ModelSpec) GetSupportingRuntimes(ctx context.Context, cl client.Client,
 	sortClusterServingRuntimeList(clusterRuntimes)
 
 	srSpecs := []v1alpha1.SupportedRuntime{}
-	var clusterSrSpecs []v1alpha1.SupportedRuntime
+	rejected := []RuntimeCandidate{}
 	for i := range runtimes.Items {
 		rt := &runtimes.Items[i]
-		if !rt.Spec.IsDisabled() && rt.Spec.IsMultiModelRuntime() == isMMS &&
-			m.RuntimeSupportsModel(&rt.Spec) && rt.Spec.IsProtocolVersionSupported(modelProtocolVersion) && rt.Spec.IsMultiNodeRuntime() == isMultinode {
-			srSpecs = append(srSpecs, v1alpha1.SupportedRuntime{Name: rt.GetName(), Spec: rt.Spec})
+		if reason, message := m.getRuntimeRejection(&rt.Spec, isMMS, isMultinode); reason != "" {
+			rejected = append(rejected, RuntimeCandidate{
+				Name: rt.GetName(), Kind: ServingRuntimeKind, Priority: rt.Spec.GetPriority(m.ModelFormat.Name), Reason: reason, Message: message,
+			})
+			continue
 		}
+		srSpecs = append(srSpecs, v1alpha1.SupportedRuntime{Name: rt.GetName(), Spec: rt.Spec})
 	}
 	sortSupportedRuntimeByPriority(srSpecs, m.ModelFormat)
+	var clusterSrSpecs []v1alpha1.SupportedRuntime
 	for i := range clusterRuntimes.Items {
 		crt := &clusterRuntimes.Items[i]
-		if !crt.Spec.IsDisabled() && crt.Spec.IsMultiModelRuntime() == isMMS &&
-			m.RuntimeSupportsModel(&crt.Spec) && crt.Spec.IsProtocolVersionSupported(modelProtocolVersion) && crt.Spec.IsMultiNodeRuntime() == isMultinode {
-			clusterSrSpecs = append(clusterSrSpecs, v1alpha1.SupportedRuntime{Name: crt.GetName(), Spec: crt.Spec})
+		if reason, message := m.getRuntimeRejection(&crt.Spec, isMMS, isMultinode); reason != "" {
+			rejected = append(rejected, RuntimeCandidate{
+				Name: crt.GetName(), Kind: ClusterServingRuntimeKind, Priority: crt.Spec.GetPriority(m.ModelFormat.Name), Reason: reason, Message: message,
+			})
+			continue
 		}
+		clusterSrSpecs = append(clusterSrSpecs, v1alpha1.SupportedRuntime{Name: crt.GetName(), Spec: crt.Spec})
 	}
 	sortSupportedRuntimeByPriority(clusterSrSpecs, m.ModelFormat)
 	srSpecs = append(srSpecs, clusterSrSpecs...)
-	return srSpecs, nil
+
+	candidates := make([]RuntimeCandidate, 0, len(srSpecs)+len(rejected))
+	for i, srSpec := range srSpecs {
+		candidate := RuntimeCandidate{Name: srSpec.Name, Kind: ServingRuntimeKind, Priority: srSpec.Spec.GetPriority(m.ModelFormat.Name)}
+		if i >= len(srSpecs)-len(clusterSrSpecs) {
+			candidate.Kind = ClusterServingRuntimeKind
+		}
+		if i > 0 {
+			candidate.Reason = LowerPriority
+			candidate.Message = getLowerPriorityMessage(&candidate, &candidates[0])
+		}
+		candidates = append(candidates, candidate)
+	}
+	candidates = append(candidates, rejected...)
+	return srSpecs, candidates, nil
+}
+
+// getRuntimeRejection returns the reason and message why the runtime cannot be auto-selected for the model, or an
+// empty reason if the runtime supports the model
+func (m *ModelSpec) getRuntimeRejection(srSpec *v1alpha1.ServingRuntimeSpec, isMMS bool, isMultinode bool) (RuntimeRejectionReason, string) {
+	if srSpec.IsDisabled() {
+		return RuntimeRejectedDisabled, "runtime is disabled"
+	}
+	if srSpec.IsMultiModelRuntime() != isMMS {
+		if isMMS {
+			return MultiModelMismatch, "runtime is not a multi-model runtime"
+		}
+		return MultiModelMismatch, "runtime is a multi-model runtime"
+	}
+	if srSpec.IsMultiNodeRuntime() != isMultinode {
+		if isMultinode {
+			return MultiNodeMismatch, "runtime has no worker spec for multi-node serving"
+		}
+		return MultiNodeMismatch, "runtime is a multi-node runtime"
+	}
+	if !m.RuntimeSupportsModel(srSpec) {
+		modelFormat := m.ModelFormat.Name
+		if m.ModelFormat.Version != nil {
+			modelFormat += ":" + *m.ModelFormat.Version
+		}
+		// Runtimes supporting the model format when set explicitly do not auto-select it
+		explicitModel := m.DeepCopy()
+		explicitModel.Runtime = ptr.To("")
+		if explicitModel.RuntimeSupportsModel(srSpec) {
+			return AutoSelectDisabled, "auto-select is disabled for model format " + modelFormat
+		}
+		if len(m.getMatchingModelFormats(srSpec.SupportedModelFormats, false)) > 0 {
+			return ModelFormatVersionMismatch, "model format version " + modelFormat + " is not supported"
+		}
+		return ModelFormatMismatch, "model format " + m.ModelFormat.Name + " is not supported"
+	}
+	if protocolVersion := m.GetProtocol(); !srSpec.IsProtocolVersionSupported(protocolVersion) {
+		return ProtocolVersionUnsupported, "protocol version " + string(protocolVersion) + " is not supported"
+	}
+	return "", ""
+}
+
+// getLowerPriorityMessage explains why the supporting runtime candidate was not preferred over the selected runtime
+func getLowerPriorityMessage(candidate *RuntimeCandidate, selected *RuntimeCandidate) string {
+	switch {
+	case candidate.Kind != selected.Kind:
+		return "ServingRuntime " + selected.Name + " of the namespace takes precedence over ClusterServingRuntimes"
+	case candidate.Priority == nil && selected.Priority != nil:
+		return fmt.Sprintf("no priority is set for the model format while the selected runtime %s has priority %d", selected.Name, *selected.Priority)
+	case candidate.Priority != nil && selected.Priority != nil && *candidate.Priority < *selected.Priority:
+		return fmt.Sprintf("priority %d is lower than the priority %d of the selected runtime %s", *candidate.Priority, *selected.Priority, selected.Name)
+	}
+	return "the selected runtime " + selected.Name + " has the same priority and is preferred by model format version, protocol version, creation time and name"
 }
 
 // RuntimeSupportsModel Check if the given runtime supports the specified model.
 func (m *ModelSpec) RuntimeSupportsModel(srSpec *v1alpha1.ServingRuntimeSpec) bool {
-	// assignment to a runtime depends on the model format labels
-	runtimeLabelSet := m.getServingRuntimeSupportedModelFormatLabelSet(srSpec.SupportedModelFormats)
-	modelLabel := m.getModelFormatLabel()
-	// if the runtime has the model's label, then it supports that model.
-	return runtimeLabelSet.contains(modelLabel)
+	return len(m.getMatchingModelFormats(srSpec.SupportedModelFormats, true)) > 0
 }
 
-func (m *ModelSpec) getModelFormatLabel() string {
-	mt := m.ModelFormat
-	if mt.Version != nil {
-		return "mt:" + mt.Name + ":" + *mt.Version
+// getMatchingModelFormats returns the supported model formats of the runtime with the name of the model format, and
+// if matchVersion is true, with a version matching the version of the model format
+func (m *ModelSpec) getMatchingModelFormats(supportedModelFormats []v1alpha1.SupportedModelFormat, matchVersion bool) []v1alpha1.SupportedModelFormat {
+	matching := []v1alpha1.SupportedModelFormat{}
+	for _, t := range supportedModelFormats {
+		// If runtime isn't explicitly set, only match modelFormats where AutoSelect is true.
+		if m.Runtime == nil && !t.IsAutoSelectEnabled() {
+			continue
+		}
+		if t.Name == m.ModelFormat.Name && (!matchVersion || m.ModelFormat.matchesVersion(t.Version)) {
+			matching = append(matching, t)
+		}
 	}
-	return "mt:" + mt.Name
+	return matching
 }
 
-func (m *ModelSpec) getServingRuntimeSupportedModelFormatLabelSet(supportedModelFormats []v1alpha1.SupportedModelFormat) stringSet {
-	set := make(stringSet, 2*len(supportedModelFormats)+1)
+// matchesVersion returns whether the version of the model format has versions in common with the version supported
+// by a runtime. Model formats without version match all the versions.
+func (mf *ModelFormat) matchesVersion(version *string) bool {
+	if mf.Version == nil {
+		return true
+	}
+	return version != nil && v1alpha1.ModelFormatVersionsMatch(*version, *mf.Version)
+}
 
-	// model format labels
-	for _, t := range supportedModelFormats {
-		// If runtime isn't explicitly set, only add labels for modelFormats where AutoSelect is true.
-		if m.Runtime != nil || (t.AutoSelect != nil && *t.AutoSelect) {
-			set.add("mt:" + t.Name)
-			if t.Version != nil {
-				set.add("mt:" + t.Name + ":" + *t.Version)
-			}
+// getHighestMatchingVersion returns the highest version of the model format auto-selected by the runtime
+func getHighestMatchingVersion(srSpec *v1alpha1.ServingRuntimeSpec, modelFormat ModelFormat) string {
+	highest := ""
+	for _, t := range srSpec.SupportedModelFormats {
+		if t.IsAutoSelectEnabled() && t.Name == modelFormat.Name && t.Version != nil && modelFormat.matchesVersion(t.Version) &&
+			(highest == "" || v1alpha1.CompareModelFormatVersions(*t.Version, highest) > 0) {
+			highest = *t.Version
 		}
 	}
-	return set
+	return highest
 }
 
 func sortServingRuntimeList(runtimes *v1alpha1.ServingRuntimeList) {
@@ -211,14 +292,17 @@ func sortSupportedRuntimeByPriority(runtimes []v1alpha1.SupportedRuntime, modelF
 		p2 := runtimes[j].Spec.GetPriority(modelFormat.Name)
 
 		switch {
-		case p1 == nil && p2 == nil: // if both runtimes does not specify the priority, the order is kept.
-			return false
 		case p1 == nil && p2 != nil: // runtime with priority specified takes precedence
 			return false
 		case p1 != nil && p2 == nil:
 			return true
+		case p1 != nil && p2 != nil && *p1 != *p2:
+			return *p1 > *p2
 		}
-		return *p1 > *p2
+		// With the same priority, the runtime supporting the highest version of the model format takes precedence,
+		// otherwise the order is kept.
+		return v1alpha1.CompareModelFormatVersions(getHighestMatchingVersion(&runtimes[i].Spec, modelFormat),
+			getHighestMatchingVersion(&runtimes[j].Spec, modelFormat)) > 0
 	})
 }
 
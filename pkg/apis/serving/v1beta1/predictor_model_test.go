@@ -497,6 +497,151 @@ func TestGetSupportingRuntimes(t *testing.T) {
 	}
 }
 
+func TestGetRuntimeCandidates(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	namespace := "default"
+	protocolV1 := constants.ProtocolV1
+	protocolV2 := constants.ProtocolV2
+
+	newSpec := func(version string, autoSelect bool, priority int32, protocolVersions ...constants.InferenceServiceProtocol) v1alpha1.ServingRuntimeSpec {
+		return v1alpha1.ServingRuntimeSpec{
+			SupportedModelFormats: []v1alpha1.SupportedModelFormat{
+				{Name: "sklearn", Version: proto.String(version), AutoSelect: proto.Bool(autoSelect), Priority: proto.Int32(priority)},
+			},
+			ProtocolVersions: protocolVersions,
+			ServingRuntimePodSpec: v1alpha1.ServingRuntimePodSpec{
+				Containers: []corev1.Container{{Name: "kserve-container", Image: "kserve/sklearnserver"}},
+			},
+		}
+	}
+	disabledSpec := newSpec("1", true, 1, protocolV1)
+	disabledSpec.Disabled = proto.Bool(true)
+	mmsSpec := newSpec("1", true, 1, protocolV1)
+	mmsSpec.MultiModel = proto.Bool(true)
+
+	runtimes := &v1alpha1.ServingRuntimeList{
+		Items: []v1alpha1.ServingRuntime{
+			{ObjectMeta: metav1.ObjectMeta{Name: "sklearn-low", Namespace: namespace}, Spec: newSpec("1", true, 1, protocolV1)},
+			{ObjectMeta: metav1.ObjectMeta{Name: "sklearn-high", Namespace: namespace}, Spec: newSpec("1", true, 2, protocolV1)},
+			{ObjectMeta: metav1.ObjectMeta{Name: "sklearn-disabled", Namespace: namespace}, Spec: disabledSpec},
+			{ObjectMeta: metav1.ObjectMeta{Name: "sklearn-mms", Namespace: namespace}, Spec: mmsSpec},
+			{ObjectMeta: metav1.ObjectMeta{Name: "sklearn-v0", Namespace: namespace}, Spec: newSpec("0", true, 1, protocolV1)},
+			{ObjectMeta: metav1.ObjectMeta{Name: "sklearn-manual", Namespace: namespace}, Spec: newSpec("1", false, 1, protocolV1)},
+			{ObjectMeta: metav1.ObjectMeta{Name: "sklearn-v2", Namespace: namespace}, Spec: newSpec("1", true, 1, protocolV2)},
+		},
+	}
+	clusterRuntimes := &v1alpha1.ClusterServingRuntimeList{
+		Items: []v1alpha1.ClusterServingRuntime{
+			{ObjectMeta: metav1.ObjectMeta{Name: "cluster-sklearn"}, Spec: newSpec("1", true, 3, protocolV1)},
+			{ObjectMeta: metav1.ObjectMeta{Name: "cluster-xgboost"}, Spec: v1alpha1.ServingRuntimeSpec{
+				SupportedModelFormats: []v1alpha1.SupportedModelFormat{{Name: "xgboost", AutoSelect: proto.Bool(true)}},
+			}},
+		},
+	}
+
+	s := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(s); err != nil {
+		t.Errorf("unable to add scheme : %v", err)
+	}
+	mockClient := fake.NewClientBuilder().WithLists(runtimes, clusterRuntimes).WithScheme(s).Build()
+
+	spec := &ModelSpec{
+		ModelFormat:            ModelFormat{Name: "sklearn", Version: proto.String("1")},
+		PredictorExtensionSpec: PredictorExtensionSpec{ProtocolVersion: &protocolV1},
+	}
+	res, candidates, err := spec.GetRuntimeCandidates(t.Context(), mockClient, namespace, false, false)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(res).To(gomega.HaveLen(3))
+	g.Expect(res[0].Name).To(gomega.Equal("sklearn-high"))
+
+	reasons := map[string]RuntimeRejectionReason{}
+	kinds := map[string]RuntimeKind{}
+	for _, candidate := range candidates {
+		reasons[candidate.Name] = candidate.Reason
+		kinds[candidate.Name] = candidate.Kind
+	}
+	g.Expect(candidates[0].Name).To(gomega.Equal("sklearn-high"))
+	g.Expect(reasons).To(gomega.Equal(map[string]RuntimeRejectionReason{
+		"sklearn-high":     "",
+		"sklearn-low":      LowerPriority,
+		"cluster-sklearn":  LowerPriority,
+		"sklearn-disabled": RuntimeRejectedDisabled,
+		"sklearn-mms":      MultiModelMismatch,
+		"sklearn-v0":       ModelFormatVersionMismatch,
+		"sklearn-manual":   AutoSelectDisabled,
+		"sklearn-v2":       ProtocolVersionUnsupported,
+		"cluster-xgboost":  ModelFormatMismatch,
+	}))
+	g.Expect(kinds["cluster-sklearn"]).To(gomega.Equal(ClusterServingRuntimeKind))
+	g.Expect(kinds["sklearn-low"]).To(gomega.Equal(ServingRuntimeKind))
+	g.Expect(candidates[1].Message).To(gomega.Equal("priority 1 is lower than the priority 2 of the selected runtime sklearn-high"))
+	g.Expect(candidates[2].Message).To(gomega.Equal("ServingRuntime sklearn-high of the namespace takes precedence over ClusterServingRuntimes"))
+}
+
+func TestGetSupportingRuntimesVersionRanges(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	namespace := "default"
+
+	newRuntime := func(name string, version string) v1alpha1.ServingRuntime {
+		return v1alpha1.ServingRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: v1alpha1.ServingRuntimeSpec{
+				SupportedModelFormats: []v1alpha1.SupportedModelFormat{
+					{Name: "sklearn", Version: proto.String(version), AutoSelect: proto.Bool(true), Priority: proto.Int32(1)},
+				},
+				ProtocolVersions: []constants.InferenceServiceProtocol{constants.ProtocolV1},
+			},
+		}
+	}
+	runtimes := &v1alpha1.ServingRuntimeList{
+		Items: []v1alpha1.ServingRuntime{
+			newRuntime("sklearn-1", "1"),
+			newRuntime("sklearn-2-range", ">=2.1,<2.5"),
+			newRuntime("sklearn-2-latest", ">=2.5,<3"),
+			newRuntime("sklearn-3", "3.0.1"),
+		},
+	}
+
+	s := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(s); err != nil {
+		t.Errorf("unable to add scheme : %v", err)
+	}
+	mockClient := fake.NewClientBuilder().WithLists(runtimes).WithScheme(s).Build()
+
+	scenarios := map[string]struct {
+		version  *string
+		expected []string
+	}{
+		"plain version in a range": {
+			version:  proto.String("2.3"),
+			expected: []string{"sklearn-2-range"},
+		},
+		"major version selects the highest runtime version first": {
+			version:  proto.String("2"),
+			expected: []string{"sklearn-2-latest", "sklearn-2-range"},
+		},
+		"version constraint": {
+			version:  proto.String(">=2.4"),
+			expected: []string{"sklearn-3", "sklearn-2-latest", "sklearn-2-range"},
+		},
+		"no version": {
+			expected: []string{"sklearn-3", "sklearn-2-latest", "sklearn-2-range", "sklearn-1"},
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			spec := &ModelSpec{ModelFormat: ModelFormat{Name: "sklearn", Version: scenario.version}}
+			res, err := spec.GetSupportingRuntimes(t.Context(), mockClient, namespace, false, false)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			names := []string{}
+			for _, rt := range res {
+				names = append(names, rt.Name)
+			}
+			g.Expect(names).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
 func TestModelPredictorGetContainer(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	storageUri := "s3://test/model"
@@ -25,6 +25,7 @@ import (
 	"k8s.io/api/apps/v1"
 	"k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -47,6 +48,31 @@ func (in *ARTExplainerSpec) DeepCopy() *ARTExplainerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalysisMetric) DeepCopyInto(out *AnalysisMetric) {
+	*out = *in
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisMetric.
+func (in *AnalysisMetric) DeepCopy() *AnalysisMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalysisMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationRef) DeepCopyInto(out *AuthenticationRef) {
 	*out = *in
@@ -138,6 +164,11 @@ func (in *Batcher) DeepCopy() *Batcher {
 func (in *CanarySpec) DeepCopyInto(out *CanarySpec) {
 	*out = *in
 	in.Predictor.DeepCopyInto(&out.Predictor)
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ProgressiveRolloutSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanarySpec.
@@ -154,6 +185,11 @@ func (in *CanarySpec) DeepCopy() *CanarySpec {
 func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
 	*out = *in
 	in.ModelStatus.DeepCopyInto(&out.ModelStatus)
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
@@ -608,6 +644,11 @@ func (in *InferenceServiceSpec) DeepCopyInto(out *InferenceServiceSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Shadow != nil {
+		in, out := &in.Shadow, &out.Shadow
+		*out = new(ShadowSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceServiceSpec.
@@ -649,6 +690,11 @@ func (in *InferenceServiceStatus) DeepCopyInto(out *InferenceServiceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RuntimeSelection != nil {
+		in, out := &in.RuntimeSelection, &out.RuntimeSelection
+		*out = new(RuntimeSelection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceServiceStatus.
@@ -902,6 +948,13 @@ func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
 		*out = new(ModelCopies)
 		**out = **in
 	}
+	if in.ResolvedSources != nil {
+		in, out := &in.ResolvedSources, &out.ResolvedSources
+		*out = make([]ResolvedModelSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
@@ -1344,6 +1397,74 @@ func (in *PredictorSpec) DeepCopy() *PredictorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProgressiveRolloutSpec) DeepCopyInto(out *ProgressiveRolloutSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]RolloutStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(RolloutAnalysisSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProgressiveRolloutSpec.
+func (in *ProgressiveRolloutSpec) DeepCopy() *ProgressiveRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProgressiveRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusAnalysisSpec) DeepCopyInto(out *PrometheusAnalysisSpec) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]AnalysisMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusAnalysisSpec.
+func (in *PrometheusAnalysisSpec) DeepCopy() *PrometheusAnalysisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusAnalysisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedModelSource) DeepCopyInto(out *ResolvedModelSource) {
+	*out = *in
+	if in.ResolvedTime != nil {
+		in, out := &in.ResolvedTime, &out.ResolvedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedModelSource.
+func (in *ResolvedModelSource) DeepCopy() *ResolvedModelSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedModelSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceMetricSource) DeepCopyInto(out *ResourceMetricSource) {
 	*out = *in
@@ -1360,6 +1481,31 @@ func (in *ResourceMetricSource) DeepCopy() *ResourceMetricSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutAnalysisSpec) DeepCopyInto(out *RolloutAnalysisSpec) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusAnalysisSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookAnalysisSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutAnalysisSpec.
+func (in *RolloutAnalysisSpec) DeepCopy() *RolloutAnalysisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutAnalysisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 	*out = *in
@@ -1375,6 +1521,117 @@ func (in *RolloutSpec) DeepCopy() *RolloutSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.StepStartTime != nil {
+		in, out := &in.StepStartTime, &out.StepStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]RolloutStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStep) DeepCopyInto(out *RolloutStep) {
+	*out = *in
+	if in.Hold != nil {
+		in, out := &in.Hold, &out.Hold
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStep.
+func (in *RolloutStep) DeepCopy() *RolloutStep {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStepStatus) DeepCopyInto(out *RolloutStepStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStepStatus.
+func (in *RolloutStepStatus) DeepCopy() *RolloutStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeCandidate) DeepCopyInto(out *RuntimeCandidate) {
+	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeCandidate.
+func (in *RuntimeCandidate) DeepCopy() *RuntimeCandidate {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeCandidate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeSelection) DeepCopyInto(out *RuntimeSelection) {
+	*out = *in
+	if in.Candidates != nil {
+		in, out := &in.Candidates, &out.Candidates
+		*out = make([]RuntimeCandidate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeSelection.
+func (in *RuntimeSelection) DeepCopy() *RuntimeSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SKLearnSpec) DeepCopyInto(out *SKLearnSpec) {
 	*out = *in
@@ -1391,6 +1648,27 @@ func (in *SKLearnSpec) DeepCopy() *SKLearnSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShadowSpec) DeepCopyInto(out *ShadowSpec) {
+	*out = *in
+	in.Predictor.DeepCopyInto(&out.Predictor)
+	if in.MirrorPercent != nil {
+		in, out := &in.MirrorPercent, &out.MirrorPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShadowSpec.
+func (in *ShadowSpec) DeepCopy() *ShadowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShadowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageSpec) DeepCopyInto(out *StorageSpec) {
 	*out = *in
@@ -1512,6 +1790,26 @@ func (in *TritonSpec) DeepCopy() *TritonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookAnalysisSpec) DeepCopyInto(out *WebhookAnalysisSpec) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookAnalysisSpec.
+func (in *WebhookAnalysisSpec) DeepCopy() *WebhookAnalysisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookAnalysisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 	*out = *in
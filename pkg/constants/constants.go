@@ -60,6 +60,7 @@ var (
 	InferenceServiceAPIName               = "inferenceservices"
 	InferenceServicePodLabelKey           = KServeAPIGroupName + "/" + InferenceServiceName
 	InferenceServiceGenerationPodLabelKey = "isvc.generation"
+	ModelPrepullLabelKey                  = KServeAPIGroupName + "/model-prepull"
 	InferenceServiceConfigMapName         = "inferenceservice-config"
 )
 
@@ -77,6 +78,7 @@ const (
 // TrainedModel Constants
 var (
 	TrainedModelAllocated = KServeAPIGroupName + "/" + "trainedmodel-allocated"
+	TrainedModelShard     = KServeAPIGroupName + "/" + "trainedmodel-shard"
 )
 
 // InferenceService MultiModel Constants
@@ -105,6 +107,9 @@ const (
 	LoggerCaCertMountPath           = "/etc/tls/logger"
 	LoggerDefaultFormat             = "json"
 	LoggerFormatKey                 = "format"
+	LoggerStorageClassKey           = "storageClass"
+	LoggerKmsKeyIdKey               = "kmsKeyId"
+	LoggerTagsKey                   = "tags"
 	LoggerDefaultStorageKey         = "credentials"
 	LoggerDefaultServiceAccountName = "logger-sa"
 )
@@ -114,6 +119,7 @@ var (
 	InferenceServiceGKEAcceleratorAnnotationKey = KServeAPIGroupName + "/gke-accelerator"
 	DeploymentMode                              = KServeAPIGroupName + "/deploymentMode"
 	EnableRoutingTagAnnotationKey               = KServeAPIGroupName + "/enable-tag-routing"
+	EnableActivatorAnnotationKey                = KServeAPIGroupName + "/enable-activator"
 	DisableLocalModelKey                        = KServeAPIGroupName + "/disable-localmodel"
 	AutoscalerClass                             = KServeAPIGroupName + "/autoscalerClass"
 	AutoscalerMetrics                           = KServeAPIGroupName + "/metrics"
@@ -138,6 +144,7 @@ var (
 	DisableAutoUpdateAnnotationKey              = KServeAPIGroupName + "/disable-auto-update"
 	ModelFormatAnnotationKey                    = "modelFormat"
 	InferencePoolMigratedAnnotationKey          = KServeAPIGroupName + "/inferencepool-migrated"
+	ModelPrepullAnnotationKey                   = KServeAPIGroupName + "/model-prepull"
 	// Managed DRA Experimental Annotations
 	// These annotations provide an intentionally limited-scope convenience feature for basic DRA use cases.
 	// Complex DRA topologies should use native Kubernetes ResourceClaimTemplate objects directly.
@@ -160,6 +167,8 @@ var (
 	StorageSpecParamAnnotationKey                    = InferenceServiceInternalAnnotationsPrefix + "/storage-spec-param"
 	StorageSpecKeyAnnotationKey                      = InferenceServiceInternalAnnotationsPrefix + "/storage-spec-key"
 	StorageContainerNameAnnotationKey                = InferenceServiceInternalAnnotationsPrefix + "/storage-container-name"
+	StorageRevisionsInternalAnnotationKey            = InferenceServiceInternalAnnotationsPrefix + "/storage-revisions"
+	ModelPrepullInternalAnnotationKey                = InferenceServiceInternalAnnotationsPrefix + "/model-prepull"
 	LoggerInternalAnnotationKey                      = InferenceServiceInternalAnnotationsPrefix + "/logger"
 	LoggerSinkUrlInternalAnnotationKey               = InferenceServiceInternalAnnotationsPrefix + "/logger-sink-url"
 	LoggerModeInternalAnnotationKey                  = InferenceServiceInternalAnnotationsPrefix + "/logger-mode"
@@ -180,6 +189,7 @@ var (
 	LocalModelPVCNameAnnotationKey                   = InferenceServiceInternalAnnotationsPrefix + "/localmodel-pvc-name"
 	ConfidentialEnabledAnnotationKey                 = InferenceServiceInternalAnnotationsPrefix + "/confidential-enabled"
 	ConfidentialResourceIdAnnotationKey              = InferenceServiceInternalAnnotationsPrefix + "/confidential-resource-id"
+	ActivatorInternalAnnotationKey                   = InferenceServiceInternalAnnotationsPrefix + "/activator"
 )
 
 // kserve networking constants
@@ -200,16 +210,23 @@ var (
 )
 
 const (
+	GsURIPrefix        = "gs://"
+	HdfsURIPrefix      = "hdfs://"
 	HfURIPrefix        = "hf://"
+	HttpURIPrefix      = "http://"
+	HttpsURIPrefix     = "https://"
 	MsURIPrefix        = "modelscope://"
 	OciURIPrefix       = "oci://"
 	OciNativeURIPrefix = "oci+native://"
 	OciFetchURIPrefix  = "oci+fetch://"
 	PvcURIPrefix       = "pvc://"
 	S3URIPrefix        = "s3://"
+	WebHdfsURIPrefix   = "webhdfs://"
 
 	PvcSourceMountName           = "kserve-pvc-source"
 	StorageInitializerVolumeName = "kserve-provision-location"
+	ModelCacheVolumeName         = "kserve-model-cache"
+	ModelCacheMountPath          = "/mnt/model-cache"
 
 	StorageInitializerContainerImage        = "kserve/storage-initializer"
 	StorageInitializerContainerImageVersion = "latest"
@@ -481,6 +498,27 @@ const (
 	InferenceServiceCanary = "canary"
 )
 
+// InferenceService shadow constants
+const (
+	InferenceServiceShadow = "shadow"
+)
+
+// Activator constants, the activator buffers the requests of Standard deployment mode predictors scaled to zero
+const (
+	ActivatorName                     = "kserve-activator"
+	ActivatorTargetHeader             = "KServe-Activator-Target"
+	ActivatorPeersName                = "kserve-activator-peers"
+	ActivatorServicePort              = 80
+	ActivatorHttpPort                 = 8080
+	ActivatorMirrorServicePort        = 81
+	ActivatorMirrorPort               = 8081
+	ActivatorScalerPort               = 9090
+	ActivatorMetricsPort              = 9091
+	ActivatorScalerServiceKey         = "service"
+	ActivatorScalerTargetKey          = "targetConcurrency"
+	DefaultActivatorTargetConcurrency = 100
+)
+
 // InferenceService model server args
 const (
 	ArgumentModelName      = "--model_name"
@@ -525,6 +563,24 @@ const (
 	CaBundleVolumeMountPathEnvVarKey = "CA_BUNDLE_VOLUME_MOUNT_POINT"
 )
 
+// StorageRevisionsEnvVarKey is the storage initializer env var with the JSON map of the storage URIs to the
+// revisions their downloaded objects must match
+const StorageRevisionsEnvVarKey = "STORAGE_REVISIONS"
+
+// StorageParallelDownloadsEnvVarKey is the storage initializer env var with the number of storage URIs it
+// downloads concurrently
+const StorageParallelDownloadsEnvVarKey = "STORAGE_PARALLEL_DOWNLOADS"
+
+// Node-local model cache Environment Variables
+const (
+	// StorageCacheDirEnvVarKey is the directory of the node-local cache the storage initializer downloads the
+	// models through
+	StorageCacheDirEnvVarKey = "STORAGE_CACHE_DIR"
+	// StorageCachePruneEnvVarKey makes the storage initializer remove the other cache directories of the
+	// InferenceService once the models are downloaded
+	StorageCachePruneEnvVarKey = "STORAGE_CACHE_PRUNE"
+)
+
 // Multi-model InferenceService
 const (
 	ModelConfigVolumeName = "model-config"
@@ -814,6 +870,29 @@ func CanaryPredictorServiceName(name string) string {
 	return name + "-" + string(Predictor) + "-" + InferenceServiceCanary
 }
 
+func ShadowPredictorServiceName(name string) string {
+	return name + "-" + string(Predictor) + "-" + InferenceServiceShadow
+}
+
+// PredictorShardServiceName returns the name of the multi-model predictor serving the TrainedModels of the shard.
+// Shard 0 is served by the predictor itself.
+func PredictorShardServiceName(predictorName string, shardId int) string {
+	if shardId == 0 {
+		return predictorName
+	}
+	return fmt.Sprintf("%s-shard-%d", predictorName, shardId)
+}
+
+// ActivatorScalerAddress returns the address of the activator KEDA external scaler.
+func ActivatorScalerAddress() string {
+	return fmt.Sprintf("%s.%s.%s:%d", ActivatorName, KServeNamespace, ClusterLocalDomain, ActivatorScalerPort)
+}
+
+// ActivatorTarget returns the value of the activator target header for the given service.
+func ActivatorTarget(name string, namespace string, port int32) string {
+	return fmt.Sprintf("%s.%s:%d", name, namespace, port)
+}
+
 func ExplainerServiceName(name string) string {
 	return name + "-" + string(Explainer)
 }
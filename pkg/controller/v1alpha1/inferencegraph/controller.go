@@ -16,6 +16,7 @@ limitations under the License.
 
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=inferencegraphs;inferencegraphs/finalizers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=inferencegraphs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices,verbs=get;list;watch
 // +kubebuilder:rbac:groups=serving.knative.dev,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=serving.knative.dev,resources=services/finalizers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=serving.knative.dev,resources=services/status,verbs=get;update;patch
@@ -42,8 +43,10 @@ import (
 	"knative.dev/pkg/apis"
 	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
@@ -156,30 +159,25 @@ func (r *InferenceGraphReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return reconcile.Result{}, err
 	}
 	// resolve service urls
+	var targets *graphTargets
 	if !forceStopRuntime {
-		for node, router := range graph.Spec.Nodes {
-			for i, route := range router.Steps {
-				isvc := v1beta1.InferenceService{}
-				if route.ServiceName == "" {
-					continue
-				}
-				err := r.Get(ctx, types.NamespacedName{Namespace: graph.Namespace, Name: route.ServiceName}, &isvc)
-				if err == nil {
-					if graph.Spec.Nodes[node].Steps[i].ServiceURL == "" {
-						serviceUrl, err := isvcutils.GetPredictorEndpoint(ctx, r.Client, &isvc)
-						if err == nil {
-							graph.Spec.Nodes[node].Steps[i].ServiceURL = serviceUrl
-						} else {
-							r.Log.Info("inference service is not ready", "name", route.ServiceName)
-							return reconcile.Result{Requeue: true}, errors.Wrapf(err, "service %s is not ready", route.ServiceName)
-						}
-					}
-				} else {
-					r.Log.Info("inference service is not found", "name", route.ServiceName)
-					return reconcile.Result{Requeue: true}, errors.Wrapf(err, "Failed to find graph service %s", route.ServiceName)
-				}
+		targets, err = r.resolveGraphTargets(ctx, graph)
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, "fails to resolve inference graph targets")
+		}
+		graph.Status.Nodes = targets.nodes
+		if targets.unresolved != nil {
+			// The router cannot be rendered until every target has an url. The InferenceService watch
+			// triggers a new reconcile once the target shows up.
+			setGraphNotReady(&graph.Status, targets.unresolved)
+			if err := r.updateStatus(ctx, graph); err != nil {
+				r.Recorder.Eventf(graph, corev1.EventTypeWarning, "InternalError", err.Error())
+				return reconcile.Result{}, err
 			}
+			return reconcile.Result{}, nil
 		}
+	} else {
+		graph.Status.Nodes = nil
 	}
 
 	isvcConfigMap, err := v1beta1.GetInferenceServiceConfigMap(ctx, r.Clientset)
@@ -268,6 +266,11 @@ func (r *InferenceGraphReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
+	// Hold the graph not ready while any hard dependency target is not ready.
+	if targets != nil && targets.notReady != nil {
+		setGraphNotReady(&graph.Status, targets.notReady)
+	}
+
 	// Handle InferenceGraph status updates based on the force stop annotation.
 	// If true, transition the service to a stopped and unready state; otherwise, ensure it's not marked as stopped.
 	transition_time := apis.VolatileTime{Inner: metav1.Now()}
@@ -371,7 +374,8 @@ func (r *InferenceGraphReconciler) SetupWithManager(mgr ctrl.Manager, deployConf
 
 	ctrlBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.InferenceGraph{}).
-		Owns(&appsv1.Deployment{})
+		Owns(&appsv1.Deployment{}).
+		Watches(&v1beta1.InferenceService{}, handler.EnqueueRequestsFromMapFunc(r.inferenceServiceFunc), builder.WithPredicates(inferenceServicePredicate()))
 
 	if ksvcFound {
 		ctrlBuilder = ctrlBuilder.Owns(&knservingv1.Service{})
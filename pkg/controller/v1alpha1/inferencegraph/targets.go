@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferencegraph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	isvcutils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
+)
+
+// graphTargets holds the outcome of resolving the step targets of an InferenceGraph.
+type graphTargets struct {
+	// nodes is the per node status to publish on the graph
+	nodes map[string]v1alpha1.InferenceRouterStatus
+	// unresolved is the first step whose target URL could not be resolved, the router cannot be rendered without it
+	unresolved *v1alpha1.InferenceStepStatus
+	// notReady is the first hard dependency step whose target is not ready
+	notReady *v1alpha1.InferenceStepStatus
+}
+
+// resolveGraphTargets looks up the InferenceService referenced by every step of the graph, fills in the
+// ServiceURL the router uses to reach it and reports the readiness of each step and node.
+func (r *InferenceGraphReconciler) resolveGraphTargets(ctx context.Context, graph *v1alpha1.InferenceGraph) (*graphTargets, error) {
+	targets := &graphTargets{nodes: make(map[string]v1alpha1.InferenceRouterStatus, len(graph.Spec.Nodes))}
+
+	// Visit the nodes in a stable order so the reported reason does not flap between reconciles.
+	nodeNames := make([]string, 0, len(graph.Spec.Nodes))
+	for name := range graph.Spec.Nodes {
+		nodeNames = append(nodeNames, name)
+	}
+	sort.Strings(nodeNames)
+
+	for _, node := range nodeNames {
+		router := graph.Spec.Nodes[node]
+		steps := make([]v1alpha1.InferenceStepStatus, 0, len(router.Steps))
+		for i := range router.Steps {
+			step := &router.Steps[i]
+			stepStatus, err := r.resolveStepTarget(ctx, graph.Namespace, step)
+			if err != nil {
+				return nil, err
+			}
+			if stepStatus.URL == nil && step.NodeName == "" && targets.unresolved == nil {
+				targets.unresolved = &stepStatus
+			}
+			steps = append(steps, stepStatus)
+		}
+		targets.nodes[node] = v1alpha1.InferenceRouterStatus{Steps: steps}
+	}
+
+	// Node readiness depends on the nodes it routes to, so it is only known once every step is resolved.
+	visiting := map[string]bool{}
+	for _, node := range nodeNames {
+		markNodeReadiness(node, targets.nodes, visiting)
+	}
+	for _, node := range nodeNames {
+		for i := range targets.nodes[node].Steps {
+			step := &targets.nodes[node].Steps[i]
+			if step.Dependency == v1alpha1.Hard && !step.Ready && targets.notReady == nil {
+				targets.notReady = step
+			}
+		}
+	}
+	return targets, nil
+}
+
+// resolveStepTarget resolves the target of a single step. The step ServiceURL is filled in with the
+// predictor endpoint of the referenced InferenceService when it is not set explicitly.
+func (r *InferenceGraphReconciler) resolveStepTarget(ctx context.Context, namespace string, step *v1alpha1.InferenceStep) (v1alpha1.InferenceStepStatus, error) {
+	status := v1alpha1.InferenceStepStatus{
+		Name:        step.StepName,
+		NodeName:    step.NodeName,
+		ServiceName: step.ServiceName,
+		Dependency:  step.Dependency,
+	}
+	if step.ServiceName == "" {
+		if step.ServiceURL != "" {
+			status.URL, _ = apis.ParseURL(step.ServiceURL)
+			status.Ready = true
+		}
+		return status, nil
+	}
+
+	isvc := &v1beta1.InferenceService{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: step.ServiceName}, isvc); err != nil {
+		if apierr.IsNotFound(err) {
+			r.Log.Info("inference service is not found", "name", step.ServiceName)
+			status.Reason = v1alpha1.InferenceServiceNotFoundReason
+			status.Message = fmt.Sprintf("InferenceService %q is not found", step.ServiceName)
+			return status, nil
+		}
+		return status, err
+	}
+
+	if step.ServiceURL == "" {
+		serviceUrl, err := isvcutils.GetPredictorEndpoint(ctx, r.Client, isvc)
+		if err != nil {
+			r.Log.Info("inference service is not ready", "name", step.ServiceName)
+			status.Reason = v1alpha1.InferenceServiceNotReadyReason
+			status.Message = fmt.Sprintf("InferenceService %q has no endpoint: %v", step.ServiceName, err)
+			return status, nil
+		}
+		step.ServiceURL = serviceUrl
+	}
+	status.URL, _ = apis.ParseURL(step.ServiceURL)
+
+	if !isvc.Status.IsReady() {
+		status.Reason = v1alpha1.InferenceServiceNotReadyReason
+		status.Message = fmt.Sprintf("InferenceService %q is not ready", step.ServiceName)
+		if cond := isvc.Status.GetCondition(apis.ConditionReady); cond != nil && cond.Message != "" {
+			status.Message = fmt.Sprintf("%s: %s", status.Message, cond.Message)
+		}
+		return status, nil
+	}
+	status.Ready = true
+	return status, nil
+}
+
+// markNodeReadiness computes the readiness of a node and the steps routing to other nodes. A node is ready
+// when all its hard dependency steps are ready. Cycles are treated as ready as they are rejected by the
+// InferenceGraph validation.
+func markNodeReadiness(node string, nodes map[string]v1alpha1.InferenceRouterStatus, visiting map[string]bool) bool {
+	nodeStatus, ok := nodes[node]
+	if !ok {
+		return false
+	}
+	if visiting[node] {
+		return true
+	}
+	visiting[node] = true
+	defer delete(visiting, node)
+
+	ready := true
+	for i := range nodeStatus.Steps {
+		step := &nodeStatus.Steps[i]
+		if step.NodeName != "" {
+			if _, exists := nodes[step.NodeName]; !exists {
+				step.Reason = v1alpha1.RouterNodeNotFoundReason
+				step.Message = fmt.Sprintf("node %q is not defined in the graph", step.NodeName)
+			} else if step.Ready = markNodeReadiness(step.NodeName, nodes, visiting); !step.Ready {
+				step.Reason = v1alpha1.RouterNodeNotReadyReason
+				step.Message = fmt.Sprintf("node %q is not ready", step.NodeName)
+			}
+		}
+		if step.Dependency == v1alpha1.Hard && !step.Ready {
+			ready = false
+		}
+	}
+	nodeStatus.Ready = ready
+	nodes[node] = nodeStatus
+	return ready
+}
+
+// setGraphNotReady marks the graph Ready condition false with the reason of the given step.
+func setGraphNotReady(graphStatus *v1alpha1.InferenceGraphStatus, step *v1alpha1.InferenceStepStatus) {
+	conditions := make(apis.Conditions, 0, len(graphStatus.Conditions)+1)
+	for _, cond := range graphStatus.Conditions {
+		if cond.Type != apis.ConditionReady {
+			conditions = append(conditions, cond)
+		}
+	}
+	conditions = append(conditions, apis.Condition{
+		Type:    apis.ConditionReady,
+		Status:  corev1.ConditionFalse,
+		Reason:  step.Reason,
+		Message: step.Message,
+	})
+	graphStatus.SetConditions(conditions)
+	graphStatus.URL = nil
+}
+
+// graphReferencesService returns true if any step of the graph routes to the named InferenceService.
+func graphReferencesService(graph *v1alpha1.InferenceGraph, serviceName string) bool {
+	for _, router := range graph.Spec.Nodes {
+		for _, step := range router.Steps {
+			if step.ServiceName == serviceName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// inferenceServiceFunc enqueues the InferenceGraphs in the same namespace that route to the InferenceService.
+func (r *InferenceGraphReconciler) inferenceServiceFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	graphs := &v1alpha1.InferenceGraphList{}
+	if err := r.List(ctx, graphs, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "unable to list InferenceGraphs", "namespace", obj.GetNamespace())
+		return nil
+	}
+	requests := []reconcile.Request{}
+	for i := range graphs.Items {
+		if graphReferencesService(&graphs.Items[i], obj.GetName()) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: graphs.Items[i].Namespace, Name: graphs.Items[i].Name},
+			})
+		}
+	}
+	return requests
+}
+
+// inferenceServicePredicate filters InferenceService updates to those changing the readiness or the address
+// that the graph steps resolve to.
+func inferenceServicePredicate() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldIsvc, ok := e.ObjectOld.(*v1beta1.InferenceService)
+			if !ok {
+				return false
+			}
+			newIsvc, ok := e.ObjectNew.(*v1beta1.InferenceService)
+			if !ok {
+				return false
+			}
+			return oldIsvc.Status.IsReady() != newIsvc.Status.IsReady() ||
+				!equality.Semantic.DeepEqual(oldIsvc.Status.Address, newIsvc.Status.Address)
+		},
+		CreateFunc:  func(e event.CreateEvent) bool { return true },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return true },
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferencegraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+)
+
+func newTargetsTestISVC(name string, ready bool) *v1beta1.InferenceService {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+	return &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{
+				SKLearn: &v1beta1.SKLearnSpec{
+					PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{StorageURI: ptrString("gs://bucket/model")},
+				},
+			},
+		},
+		Status: v1beta1.InferenceServiceStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{
+					{Type: apis.ConditionReady, Status: readyStatus, Message: "predictor is loading"},
+				},
+			},
+			Address: &duckv1.Addressable{
+				URL: &apis.URL{Scheme: "http", Host: name + ".default.svc.cluster.local"},
+			},
+		},
+	}
+}
+
+func ptrString(s string) *string {
+	return &s
+}
+
+func TestResolveGraphTargets(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, v1beta1.AddToScheme(s))
+	require.NoError(t, v1alpha1.AddToScheme(s))
+
+	scenarios := map[string]struct {
+		objects           []runtime.Object
+		nodes             map[string]v1alpha1.InferenceRouter
+		expectUnresolved  string
+		expectNotReady    string
+		expectNodeReady   map[string]bool
+		expectServiceURLs map[string]string
+	}{
+		"all targets ready": {
+			objects: []runtime.Object{newTargetsTestISVC("model1", true), newTargetsTestISVC("model2", true)},
+			nodes: map[string]v1alpha1.InferenceRouter{
+				v1alpha1.GraphRootNodeName: {
+					RouterType: v1alpha1.Sequence,
+					Steps: []v1alpha1.InferenceStep{
+						{InferenceTarget: v1alpha1.InferenceTarget{ServiceName: "model1"}, Dependency: v1alpha1.Hard},
+						{InferenceTarget: v1alpha1.InferenceTarget{NodeName: "ensemble"}, Dependency: v1alpha1.Hard},
+					},
+				},
+				"ensemble": {
+					RouterType: v1alpha1.Ensemble,
+					Steps: []v1alpha1.InferenceStep{
+						{InferenceTarget: v1alpha1.InferenceTarget{ServiceName: "model2"}, Dependency: v1alpha1.Hard},
+						{InferenceTarget: v1alpha1.InferenceTarget{ServiceURL: "http://external.example.com"}},
+					},
+				},
+			},
+			expectNodeReady: map[string]bool{v1alpha1.GraphRootNodeName: true, "ensemble": true},
+			expectServiceURLs: map[string]string{
+				"model1": "http://model1.default.svc.cluster.local/v1/models/model1:predict",
+				"model2": "http://model2.default.svc.cluster.local/v1/models/model2:predict",
+			},
+		},
+		"missing service": {
+			objects: []runtime.Object{newTargetsTestISVC("model1", true)},
+			nodes: map[string]v1alpha1.InferenceRouter{
+				v1alpha1.GraphRootNodeName: {
+					RouterType: v1alpha1.Sequence,
+					Steps: []v1alpha1.InferenceStep{
+						{InferenceTarget: v1alpha1.InferenceTarget{ServiceName: "model1"}},
+						{InferenceTarget: v1alpha1.InferenceTarget{ServiceName: "missing"}, Dependency: v1alpha1.Hard},
+					},
+				},
+			},
+			expectUnresolved: v1alpha1.InferenceServiceNotFoundReason,
+			expectNotReady:   v1alpha1.InferenceServiceNotFoundReason,
+			expectNodeReady:  map[string]bool{v1alpha1.GraphRootNodeName: false},
+		},
+		"hard dependency not ready propagates to parent node": {
+			objects: []runtime.Object{newTargetsTestISVC("model1", true), newTargetsTestISVC("model2", false)},
+			nodes: map[string]v1alpha1.InferenceRouter{
+				v1alpha1.GraphRootNodeName: {
+					RouterType: v1alpha1.Sequence,
+					Steps: []v1alpha1.InferenceStep{
+						{InferenceTarget: v1alpha1.InferenceTarget{ServiceName: "model1"}},
+						{InferenceTarget: v1alpha1.InferenceTarget{NodeName: "child"}, Dependency: v1alpha1.Hard},
+					},
+				},
+				"child": {
+					RouterType: v1alpha1.Sequence,
+					Steps: []v1alpha1.InferenceStep{
+						{InferenceTarget: v1alpha1.InferenceTarget{ServiceName: "model2"}, Dependency: v1alpha1.Hard},
+					},
+				},
+			},
+			expectNotReady:  v1alpha1.InferenceServiceNotReadyReason,
+			expectNodeReady: map[string]bool{v1alpha1.GraphRootNodeName: false, "child": false},
+		},
+		"soft dependency not ready keeps node ready": {
+			objects: []runtime.Object{newTargetsTestISVC("model1", false)},
+			nodes: map[string]v1alpha1.InferenceRouter{
+				v1alpha1.GraphRootNodeName: {
+					RouterType: v1alpha1.Sequence,
+					Steps: []v1alpha1.InferenceStep{
+						{InferenceTarget: v1alpha1.InferenceTarget{ServiceName: "model1"}, Dependency: v1alpha1.Soft},
+					},
+				},
+			},
+			expectNodeReady: map[string]bool{v1alpha1.GraphRootNodeName: true},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			r := &InferenceGraphReconciler{
+				Client: fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(scenario.objects...).Build(),
+				Log:    ctrl.Log.WithName("test"),
+			}
+			graph := &v1alpha1.InferenceGraph{
+				ObjectMeta: metav1.ObjectMeta{Name: "graph", Namespace: "default"},
+				Spec:       v1alpha1.InferenceGraphSpec{Nodes: scenario.nodes},
+			}
+
+			targets, err := r.resolveGraphTargets(context.Background(), graph)
+			require.NoError(t, err)
+
+			if scenario.expectUnresolved == "" {
+				assert.Nil(t, targets.unresolved)
+			} else {
+				require.NotNil(t, targets.unresolved)
+				assert.Equal(t, scenario.expectUnresolved, targets.unresolved.Reason)
+			}
+			if scenario.expectNotReady == "" {
+				assert.Nil(t, targets.notReady)
+			} else {
+				require.NotNil(t, targets.notReady)
+				assert.Equal(t, scenario.expectNotReady, targets.notReady.Reason)
+			}
+			for node, ready := range scenario.expectNodeReady {
+				assert.Equal(t, ready, targets.nodes[node].Ready, "node %s", node)
+			}
+			for _, router := range graph.Spec.Nodes {
+				for _, step := range router.Steps {
+					if expected, ok := scenario.expectServiceURLs[step.ServiceName]; ok {
+						assert.Equal(t, expected, step.ServiceURL)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSetGraphNotReady(t *testing.T) {
+	status := &v1alpha1.InferenceGraphStatus{
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{
+				{Type: apis.ConditionReady, Status: corev1.ConditionTrue},
+				{Type: v1beta1.Stopped, Status: corev1.ConditionFalse},
+			},
+		},
+		URL: &apis.URL{Scheme: "http", Host: "graph.default.example.com"},
+	}
+
+	setGraphNotReady(status, &v1alpha1.InferenceStepStatus{
+		Reason:  v1alpha1.InferenceServiceNotReadyReason,
+		Message: `InferenceService "model1" is not ready`,
+	})
+
+	ready := status.GetCondition(apis.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, corev1.ConditionFalse, ready.Status)
+	assert.Equal(t, v1alpha1.InferenceServiceNotReadyReason, ready.Reason)
+	assert.NotNil(t, status.GetCondition(v1beta1.Stopped))
+	assert.Nil(t, status.URL)
+}
@@ -19,6 +19,7 @@ package reconcilers
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -38,24 +39,29 @@ import (
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha2"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1alpha1/localmodel/revision"
 	controllerutils "github.com/kserve/kserve/pkg/controller/v1alpha1/utils"
+	"github.com/kserve/kserve/pkg/credentials"
 	"github.com/kserve/kserve/pkg/utils"
 )
 
 // LocalModelReconciler reconciles cluster-scoped LocalModelCache resources
 type LocalModelReconciler struct {
 	client.Client
-	Clientset                *kubernetes.Clientset
-	Log                      logr.Logger
-	Scheme                   *runtime.Scheme
+	Clientset *kubernetes.Clientset
+	Log       logr.Logger
+	Scheme    *runtime.Scheme
+	// RevisionResolver overrides the resolver created with the credentials of each model cache
+	RevisionResolver         revision.Resolver
 	llmInferenceServiceCRDUp bool
 }
 
 // Reconcile
 // Step 1 - Checks if the CR is in the deletion process. Deletion completes when all LocalModelNodes have been updated
-// Step 2 - Adds this model to LocalModelNode resources in the node group
-// Step 3 - Creates PV & PVC for model download
-// Step 4 - Creates PV & PVCs for namespaces with isvcs using this cached model
+// Step 2 - Resolves the revision of the source model if the model cache has a refresh policy
+// Step 3 - Adds this model to LocalModelNode resources in the node group
+// Step 4 - Creates PV & PVC for model download
+// Step 5 - Creates PV & PVCs for namespaces with isvcs using this cached model
 func (c *LocalModelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	c.Log.Info("Reconciling localmodel", "name", req.Name)
 	isvcConfigMap, err := v1beta1.GetInferenceServiceConfigMap(ctx, c.Clientset)
@@ -104,12 +110,15 @@ func (c *LocalModelReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return DeleteModelFromNodes(ctx, c.Client, c.Clientset, c.Log, localModel, nil, nodeGroups)
 	}
 
-	// Step 2 - Adds this model to LocalModelNode resources in the node group
+	// Step 2 - Resolves the revision of the source model
+	result := c.refreshRevision(ctx, localModel, isvcConfigMap, localModelConfig.JobNamespace)
+
+	// Step 3 - Adds this model to LocalModelNode resources in the node group
 	if err := ReconcileLocalModelNode(ctx, c.Client, c.Log, localModel, nil, nodeGroups); err != nil {
 		c.Log.Error(err, "failed to reconcile LocalModelNode")
 	}
 
-	// Step 3 - Creates PV & PVC for model download
+	// Step 4 - Creates PV & PVC for model download
 	for _, nodeGroup := range nodeGroups {
 		pvSpec := nodeGroup.Spec.PersistentVolumeSpec
 		pv := corev1.PersistentVolume{Spec: pvSpec, ObjectMeta: metav1.ObjectMeta{
@@ -133,12 +142,85 @@ func (c *LocalModelReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	if localModelConfig.DisableVolumeManagement {
-		return ctrl.Result{}, nil
+		return result, nil
 	}
 
-	// Step 4 - Creates PV & PVCs for namespaces with isvcs using this model
+	// Step 5 - Creates PV & PVCs for namespaces with isvcs using this model
 	err = ReconcileForIsvcs(ctx, c.Client, c.Clientset, c.Scheme, c.Log, localModel, nil, nodeGroups, defaultNodeGroup, c.llmInferenceServiceCRDUp)
-	return ctrl.Result{}, err
+	return result, err
+}
+
+// refreshRevision checks the revision of the source model once the refresh interval elapsed and records it in the
+// model cache status. The nodes download a new revision into a new folder and serve it once the download succeeded.
+// Returns the result to requeue the model cache at the next check.
+func (c *LocalModelReconciler) refreshRevision(ctx context.Context, localModel *v1alpha1.LocalModelCache,
+	isvcConfigMap *corev1.ConfigMap, jobNamespace string,
+) ctrl.Result {
+	policy := localModel.Spec.RefreshPolicy
+	if policy == nil {
+		return ctrl.Result{}
+	}
+	interval := policy.GetInterval()
+	if lastCheck := localModel.Status.LastRevisionCheckTime; lastCheck != nil && localModel.Status.Revision != "" {
+		if nextCheck := lastCheck.Add(interval); time.Now().Before(nextCheck) {
+			return ctrl.Result{RequeueAfter: time.Until(nextCheck)}
+		}
+	}
+
+	resolver := c.RevisionResolver
+	if resolver == nil {
+		var err error
+		if resolver, err = c.newRevisionResolver(ctx, localModel, isvcConfigMap, jobNamespace); err != nil {
+			c.Log.Error(err, "Failed to read the storage credentials of source model", "name", localModel.Name)
+			return ctrl.Result{RequeueAfter: interval}
+		}
+	}
+	resolveCtx, cancel := context.WithTimeout(ctx, revision.DefaultResolveTimeout)
+	defer cancel()
+	resolved, err := resolver.Resolve(resolveCtx, localModel.Spec.SourceModelUri)
+	if err != nil {
+		// Keep serving the last resolved revision, the revision is checked again at the next interval
+		c.Log.Error(err, "Failed to resolve revision of source model", "name", localModel.Name, "sourceModelUri", localModel.Spec.SourceModelUri)
+		return ctrl.Result{RequeueAfter: interval}
+	}
+	if resolved != localModel.Status.Revision {
+		c.Log.Info("Resolved new revision of source model", "name", localModel.Name, "previous", localModel.Status.Revision, "revision", resolved)
+	}
+	now := metav1.Now()
+	localModel.Status.Revision = resolved
+	localModel.Status.LastRevisionCheckTime = &now
+	if err := c.Status().Update(ctx, localModel); err != nil {
+		c.Log.Error(err, "cannot update revision status", "name", localModel.Name)
+	}
+	return ctrl.Result{RequeueAfter: interval}
+}
+
+// newRevisionResolver creates a resolver with the credentials the nodes download the model with: the storage spec
+// or the ServiceAccount of the model cache in the job namespace. Sources without credentials are resolved anonymously.
+func (c *LocalModelReconciler) newRevisionResolver(ctx context.Context, localModel *v1alpha1.LocalModelCache,
+	isvcConfigMap *corev1.ConfigMap, jobNamespace string,
+) (revision.Resolver, error) {
+	credentialBuilder := credentials.NewCredentialBuilder(c.Client, c.Clientset, isvcConfigMap)
+	container := &corev1.Container{Args: []string{localModel.Spec.SourceModelUri}}
+	volumes := []corev1.Volume{}
+	var err error
+	if storage := localModel.Spec.Storage; storage != nil && storage.StorageKey != nil {
+		var params map[string]string
+		if storage.Parameters != nil {
+			params = *storage.Parameters
+		}
+		err = credentialBuilder.CreateStorageSpecSecretEnvs(ctx, jobNamespace, nil, *storage.StorageKey, params, container)
+	} else if localModel.Spec.ServiceAccountName != "" {
+		err = credentialBuilder.CreateSecretVolumeAndEnv(ctx, jobNamespace, nil, localModel.Spec.ServiceAccountName, container, &volumes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	creds, err := revision.CredentialsFromContainer(ctx, c.Clientset, jobNamespace, container, volumes)
+	if err != nil {
+		return nil, err
+	}
+	return revision.NewSourceResolverWithCredentials(creds, revision.DefaultResolveTimeout), nil
 }
 
 // Reconciles corresponding model cache CR when we found an update on an isvc
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+)
+
+// LocalModelNodeGroupReconciler publishes the storage used by the models cached on LocalModelNodeGroups
+type LocalModelNodeGroupReconciler struct {
+	client.Client
+	Log logr.Logger
+}
+
+// Reconcile accounts the models cached on the node group and updates its Used and Available storage.
+// The size of a model is the larger of the size declared on its model caches and the size of its folder
+// reported by the LocalModelNodes of the node group.
+func (c *LocalModelNodeGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	nodeGroup := &v1alpha1.LocalModelNodeGroup{}
+	if err := c.Get(ctx, req.NamespacedName, nodeGroup); err != nil {
+		// Ignore not-found errors, we can get them on deleted requests.
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	localModelCaches := &v1alpha1.LocalModelCacheList{}
+	if err := c.List(ctx, localModelCaches); err != nil {
+		return reconcile.Result{}, err
+	}
+	localModelNamespaceCaches := &v1alpha1.LocalModelNamespaceCacheList{}
+	if err := c.List(ctx, localModelNamespaceCaches); err != nil {
+		return reconcile.Result{}, err
+	}
+	localModelNodes := &v1alpha1.LocalModelNodeList{}
+	if err := c.List(ctx, localModelNodes); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	used := nodeGroup.GetStorageUsage(localModelCaches.Items, localModelNamespaceCaches.Items, localModelNodes.Items)
+	available := nodeGroup.GetAvailableStorage(used)
+	if nodeGroup.Status.Used.Equal(used) && nodeGroup.Status.Available.Equal(available) {
+		return ctrl.Result{}, nil
+	}
+	nodeGroup.Status.Used = used
+	nodeGroup.Status.Available = available
+	if err := c.Status().Update(ctx, nodeGroup); err != nil {
+		c.Log.Error(err, "Failed to update LocalModelNodeGroup status", "name", nodeGroup.Name)
+		return ctrl.Result{}, err
+	}
+	c.Log.Info("Updated LocalModelNodeGroup storage", "name", nodeGroup.Name, "used", used.String(), "available", available.String())
+	return ctrl.Result{}, nil
+}
+
+func nodeGroupRequests(nodeGroups []string) []reconcile.Request {
+	requests := make([]reconcile.Request, 0, len(nodeGroups))
+	for _, nodeGroup := range nodeGroups {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: nodeGroup}})
+	}
+	return requests
+}
+
+// Reconciles the node groups of a model cache when it is created, updated or deleted
+func (c *LocalModelNodeGroupReconciler) localModelCacheFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	return nodeGroupRequests(obj.(*v1alpha1.LocalModelCache).Spec.NodeGroups)
+}
+
+// Reconciles the node groups of a namespace model cache when it is created, updated or deleted
+func (c *LocalModelNodeGroupReconciler) localModelNamespaceCacheFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	return nodeGroupRequests(obj.(*v1alpha1.LocalModelNamespaceCache).Spec.NodeGroups)
+}
+
+// Reconciles the node groups of the models on a LocalModelNode when their disk usage changes
+func (c *LocalModelNodeGroupReconciler) localModelNodeFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	localModelNode := obj.(*v1alpha1.LocalModelNode)
+	nodeGroups := []string{}
+	for _, modelInfo := range localModelNode.Spec.LocalModels {
+		if modelInfo.NodeGroup != "" && !slices.Contains(nodeGroups, modelInfo.NodeGroup) {
+			nodeGroups = append(nodeGroups, modelInfo.NodeGroup)
+		}
+	}
+	return nodeGroupRequests(nodeGroups)
+}
+
+func (c *LocalModelNodeGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	localModelNodePredicates := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode := e.ObjectOld.(*v1alpha1.LocalModelNode)
+			newNode := e.ObjectNew.(*v1alpha1.LocalModelNode)
+			return !reflect.DeepEqual(oldNode.Status.ModelDiskUsage, newNode.Status.ModelDiskUsage)
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.LocalModelNodeGroup{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&v1alpha1.LocalModelCache{}, handler.EnqueueRequestsFromMapFunc(c.localModelCacheFunc), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&v1alpha1.LocalModelNamespaceCache{}, handler.EnqueueRequestsFromMapFunc(c.localModelNamespaceCacheFunc), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&v1alpha1.LocalModelNode{}, handler.EnqueueRequestsFromMapFunc(c.localModelNodeFunc), builder.WithPredicates(localModelNodePredicates)).
+		Complete(c)
+}
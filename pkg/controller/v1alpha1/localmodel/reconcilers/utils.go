@@ -85,6 +85,11 @@ type LocalModelParams struct {
 	Finalizers         []string
 	FinalizerName      string
 	IsNamespaceScoped  bool
+	Priority           int32
+	Pinned             bool
+	InUse              bool
+	LastReferencedTime *metav1.Time
+	Revision           string
 }
 
 // ExtractLocalModelParams extracts common parameters from either LocalModelCache or LocalModelNamespaceCache
@@ -101,6 +106,11 @@ func ExtractLocalModelParams(localModelCache *v1alpha1.LocalModelCache, localMod
 			Finalizers:         localModelCache.Finalizers,
 			FinalizerName:      FinalizerName,
 			IsNamespaceScoped:  false,
+			Priority:           localModelCache.Spec.Priority,
+			Pinned:             localModelCache.Spec.Pinned,
+			InUse:              isReferenced(localModelCache.Status),
+			LastReferencedTime: getLastReferencedTime(localModelCache.ObjectMeta, localModelCache.Status),
+			Revision:           getRevision(localModelCache),
 		}
 	}
 	if localModelNamespaceCache != nil {
@@ -114,11 +124,63 @@ func ExtractLocalModelParams(localModelCache *v1alpha1.LocalModelCache, localMod
 			Finalizers:         localModelNamespaceCache.Finalizers,
 			FinalizerName:      NamespaceCacheFinalizerName,
 			IsNamespaceScoped:  true,
+			Priority:           localModelNamespaceCache.Spec.Priority,
+			Pinned:             localModelNamespaceCache.Spec.Pinned,
+			InUse:              isReferenced(localModelNamespaceCache.Status),
+			LastReferencedTime: getLastReferencedTime(localModelNamespaceCache.ObjectMeta, localModelNamespaceCache.Status),
 		}
 	}
 	return LocalModelParams{}
 }
 
+// getRevision returns the revision of the source model to download for model caches with a refresh policy
+func getRevision(localModelCache *v1alpha1.LocalModelCache) string {
+	if localModelCache.Spec.RefreshPolicy == nil {
+		return ""
+	}
+	return localModelCache.Status.Revision
+}
+
+// isReferenced returns whether inference services use the cached model
+func isReferenced(status v1alpha1.LocalModelCacheStatus) bool {
+	return len(status.InferenceServices) > 0 || len(status.LLMInferenceServices) > 0
+}
+
+// getLastReferencedTime returns the last time the inference services using the cached model changed, or the
+// creation time of the model cache if no inference service used it yet.
+func getLastReferencedTime(meta metav1.ObjectMeta, status v1alpha1.LocalModelCacheStatus) *metav1.Time {
+	if status.LastReferencedTime != nil {
+		return status.LastReferencedTime
+	}
+	return &meta.CreationTimestamp
+}
+
+// updateReferences sets the inference services using the cached model and records when they changed
+func updateReferences(status *v1alpha1.LocalModelCacheStatus, isvcNames, llmSvcNames []v1alpha1.NamespacedName) {
+	if !sameNamespacedNames(status.InferenceServices, isvcNames) || !sameNamespacedNames(status.LLMInferenceServices, llmSvcNames) {
+		now := metav1.Now()
+		status.LastReferencedTime = &now
+	}
+	status.InferenceServices = isvcNames
+	status.LLMInferenceServices = llmSvcNames
+}
+
+func sameNamespacedNames(a, b []v1alpha1.NamespacedName) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	names := make(map[v1alpha1.NamespacedName]struct{}, len(a))
+	for _, name := range a {
+		names[name] = struct{}{}
+	}
+	for _, name := range b {
+		if _, ok := names[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // CreateLocalModelInfo creates a LocalModelInfo from either LocalModelCache or LocalModelNamespaceCache
 // nodeGroupName specifies which LocalModelNodeGroup this model belongs to, used by the agent
 // to construct the correct PVC name when multiple nodegroups have overlapping node affinity.
@@ -131,6 +193,11 @@ func CreateLocalModelInfo(localModelCache *v1alpha1.LocalModelCache, localModelN
 		NodeGroup:          nodeGroupName,
 		ServiceAccountName: params.ServiceAccountName,
 		Storage:            params.Storage,
+		Priority:           params.Priority,
+		Pinned:             params.Pinned,
+		InUse:              params.InUse,
+		LastReferencedTime: params.LastReferencedTime,
+		Revision:           params.Revision,
 	}
 }
 
@@ -202,6 +269,8 @@ func NodeStatusFromLocalModelStatus(modelStatus v1alpha1.ModelStatus) v1alpha1.N
 		return v1alpha1.NodeDownloadError
 	case v1alpha1.ModelDownloaded:
 		return v1alpha1.NodeDownloaded
+	case v1alpha1.ModelEvicted:
+		return v1alpha1.NodeEvicted
 	}
 	return v1alpha1.NodeDownloadPending
 }
@@ -561,14 +630,12 @@ func ReconcileForIsvcs(
 	}
 
 	if localModelCache != nil {
-		localModelCache.Status.InferenceServices = isvcNames
-		localModelCache.Status.LLMInferenceServices = llmSvcNames
+		updateReferences(&localModelCache.Status, isvcNames, llmSvcNames)
 		if err := c.Status().Update(ctx, localModelCache); err != nil {
 			log.Error(err, "cannot update status", "name", params.Name)
 		}
 	} else if localModelNamespaceCache != nil {
-		localModelNamespaceCache.Status.InferenceServices = isvcNames
-		localModelNamespaceCache.Status.LLMInferenceServices = llmSvcNames
+		updateReferences(&localModelNamespaceCache.Status, isvcNames, llmSvcNames)
 		if err := c.Status().Update(ctx, localModelNamespaceCache); err != nil {
 			log.Error(err, "cannot update status", "name", params.Name)
 		}
@@ -651,7 +718,12 @@ func UpdateLocalModelNode(
 			needsUpdate := modelInfo.SourceModelUri != params.SourceModelUri ||
 				modelInfo.ServiceAccountName != params.ServiceAccountName ||
 				modelInfo.NodeGroup != nodeGroupName ||
-				!StorageSpecEqual(modelInfo.Storage, params.Storage)
+				!StorageSpecEqual(modelInfo.Storage, params.Storage) ||
+				modelInfo.Priority != params.Priority ||
+				modelInfo.Pinned != params.Pinned ||
+				modelInfo.InUse != params.InUse ||
+				!modelInfo.LastReferencedTime.Equal(params.LastReferencedTime) ||
+				modelInfo.Revision != params.Revision
 			if !needsUpdate {
 				return nil
 			}
@@ -697,6 +769,8 @@ func ReconcileLocalModelNode(
 		}
 		nodeStatus = localModelNamespaceCache.Status.NodeStatus
 	}
+	nodeSources := map[string]v1alpha1.ModelSource{}
+	nodeDownloads := map[string]v1alpha1.ModelDownload{}
 
 	for nodeGroupName, nodeGroup := range nodeGroups {
 		modelInfo := CreateLocalModelInfo(localModelCache, localModelNamespaceCache, nodeGroupName)
@@ -744,6 +818,12 @@ func ReconcileLocalModelNode(
 			}
 			modelStatus := localModelNode.Status.ModelStatus[statusKey]
 			nodeStatus[node.Name] = NodeStatusFromLocalModelStatus(modelStatus)
+			if source, ok := localModelNode.Status.ModelSources[statusKey]; ok {
+				nodeSources[node.Name] = source
+			}
+			if download, ok := localModelNode.Status.ModelDownloads[statusKey]; ok {
+				nodeDownloads[node.Name] = download
+			}
 		}
 
 		successfulNodes := 0
@@ -760,11 +840,15 @@ func ReconcileLocalModelNode(
 		modelCopies := &v1alpha1.ModelCopies{Total: len(nodeStatus), Available: successfulNodes, Failed: failedNodes}
 		if localModelCache != nil {
 			localModelCache.Status.ModelCopies = modelCopies
+			localModelCache.Status.NodeSources = nodeSources
+			localModelCache.Status.NodeDownloads = nodeDownloads
 			if err := c.Status().Update(ctx, localModelCache); err != nil {
 				log.Error(err, "cannot update model status from node", "name", params.Name)
 			}
 		} else if localModelNamespaceCache != nil {
 			localModelNamespaceCache.Status.ModelCopies = modelCopies
+			localModelNamespaceCache.Status.NodeSources = nodeSources
+			localModelNamespaceCache.Status.NodeDownloads = nodeDownloads
 			if err := c.Status().Update(ctx, localModelNamespaceCache); err != nil {
 				log.Error(err, "cannot update model status from node", "name", params.Name, "namespace", params.Namespace)
 			}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+)
+
+func TestUpdateReferences(t *testing.T) {
+	isvcA := v1alpha1.NamespacedName{Namespace: "default", Name: "a"}
+	isvcB := v1alpha1.NamespacedName{Namespace: "default", Name: "b"}
+	lastReferenced := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	tests := map[string]struct {
+		status  v1alpha1.LocalModelCacheStatus
+		isvcs   []v1alpha1.NamespacedName
+		updated bool
+	}{
+		"no inference services": {
+			status:  v1alpha1.LocalModelCacheStatus{LastReferencedTime: &lastReferenced},
+			isvcs:   []v1alpha1.NamespacedName{},
+			updated: false,
+		},
+		"same inference services in a different order": {
+			status:  v1alpha1.LocalModelCacheStatus{InferenceServices: []v1alpha1.NamespacedName{isvcA, isvcB}, LastReferencedTime: &lastReferenced},
+			isvcs:   []v1alpha1.NamespacedName{isvcB, isvcA},
+			updated: false,
+		},
+		"inference service added": {
+			status:  v1alpha1.LocalModelCacheStatus{InferenceServices: []v1alpha1.NamespacedName{isvcA}, LastReferencedTime: &lastReferenced},
+			isvcs:   []v1alpha1.NamespacedName{isvcA, isvcB},
+			updated: true,
+		},
+		"last inference service removed": {
+			status:  v1alpha1.LocalModelCacheStatus{InferenceServices: []v1alpha1.NamespacedName{isvcA}, LastReferencedTime: &lastReferenced},
+			isvcs:   []v1alpha1.NamespacedName{},
+			updated: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			status := tt.status
+			updateReferences(&status, tt.isvcs, nil)
+			if len(status.InferenceServices) != len(tt.isvcs) {
+				t.Errorf("expected inference services %v, got %v", tt.isvcs, status.InferenceServices)
+			}
+			if updated := !status.LastReferencedTime.Equal(&lastReferenced); updated != tt.updated {
+				t.Errorf("expected last referenced time updated %v, got %v", tt.updated, updated)
+			}
+		})
+	}
+}
+
+func TestCreateLocalModelInfoRevision(t *testing.T) {
+	tests := map[string]struct {
+		refreshPolicy *v1alpha1.LocalModelRefreshPolicy
+		revision      string
+	}{
+		"without refresh policy": {
+			revision: "",
+		},
+		"with refresh policy": {
+			refreshPolicy: &v1alpha1.LocalModelRefreshPolicy{Interval: metav1.Duration{Duration: time.Hour}},
+			revision:      "abc123",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			localModelCache := &v1alpha1.LocalModelCache{
+				ObjectMeta: metav1.ObjectMeta{Name: "llama"},
+				Spec:       v1alpha1.LocalModelCacheSpec{SourceModelUri: "hf://meta-llama/llama", RefreshPolicy: tt.refreshPolicy},
+				Status:     v1alpha1.LocalModelCacheStatus{Revision: "abc123"},
+			}
+			modelInfo := CreateLocalModelInfo(localModelCache, nil, "gpu")
+			if modelInfo.Revision != tt.revision {
+				t.Errorf("expected revision %q, got %q", tt.revision, modelInfo.Revision)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscredentials "github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kserve/kserve/pkg/credentials"
+	"github.com/kserve/kserve/pkg/credentials/gcs"
+	"github.com/kserve/kserve/pkg/credentials/hf"
+	s3credential "github.com/kserve/kserve/pkg/credentials/s3"
+)
+
+// DefaultResolveTimeout bounds the resolution of a revision, it runs within the reconcile of the controllers.
+const DefaultResolveTimeout = 30 * time.Second
+
+// storageSpecEnvs maps the keys of the storage spec secrets to the env vars of the credentials, like the
+// storage initializer does.
+var storageSpecEnvs = map[string][][2]string{
+	"s3": {
+		{s3credential.AWSEndpointUrl, "endpoint_url"},
+		{s3credential.AWSAccessKeyId, "access_key_id"},
+		{s3credential.AWSSecretAccessKey, "secret_access_key"},
+		{s3credential.AWSRegion, "region"},
+		{s3credential.AWSAnonymousCredential, "anonymous"},
+	},
+	"hf": {
+		{hf.HFTokenKey, "token"},
+		{HuggingFaceEndpointEnv, "endpoint"},
+	},
+}
+
+// Credentials are the storage credentials of a model source, read from the secrets of the ServiceAccount or of
+// the storage spec the model is downloaded with rather than from the environment of the controller.
+type Credentials struct {
+	// Env are the env vars the storage initializer would be given
+	Env map[string]string
+	// GCSCredentialsJSON is the GCS service account key, if any
+	GCSCredentialsJSON []byte
+}
+
+// CredentialsFromContainer reads the credentials the CredentialBuilder injected into the container from the
+// secrets of the namespace. Keyless credentials like workload identities are bound to the pods of the
+// ServiceAccount and cannot be used by the controller, the revisions of such sources are resolved anonymously.
+func CredentialsFromContainer(ctx context.Context, clientset kubernetes.Interface, namespace string,
+	container *corev1.Container, volumes []corev1.Volume,
+) (*Credentials, error) {
+	secrets := map[string]*corev1.Secret{}
+	getSecret := func(name string) (*corev1.Secret, error) {
+		if secret, ok := secrets[name]; ok {
+			return secret, nil
+		}
+		secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get storage secret %s: %w", name, err)
+		}
+		secrets[name] = secret
+		return secret, nil
+	}
+
+	creds := &Credentials{Env: map[string]string{}}
+	for _, env := range container.Env {
+		switch {
+		case env.ValueFrom == nil:
+			creds.Env[env.Name] = env.Value
+		case env.ValueFrom.SecretKeyRef != nil:
+			ref := env.ValueFrom.SecretKeyRef
+			secret, err := getSecret(ref.Name)
+			if err != nil {
+				return nil, err
+			}
+			if value, ok := secret.Data[ref.Key]; ok {
+				creds.Env[env.Name] = string(value)
+			}
+		}
+	}
+
+	if err := creds.applyStorageSpec(); err != nil {
+		return nil, err
+	}
+
+	if path := creds.Env[gcs.GCSCredentialEnvKey]; path != "" && creds.GCSCredentialsJSON == nil {
+		for _, mount := range container.VolumeMounts {
+			rel, err := filepath.Rel(mount.MountPath, path)
+			if err != nil || strings.HasPrefix(rel, "..") {
+				continue
+			}
+			for _, volume := range volumes {
+				if volume.Name != mount.Name || volume.Secret == nil {
+					continue
+				}
+				secret, err := getSecret(volume.Secret.SecretName)
+				if err != nil {
+					return nil, err
+				}
+				creds.GCSCredentialsJSON = secret.Data[rel]
+			}
+		}
+	}
+	return creds, nil
+}
+
+// applyStorageSpec sets the env vars of the storage spec config and its overrides
+func (c *Credentials) applyStorageSpec() error {
+	spec := map[string]string{}
+	for _, key := range []string{credentials.StorageConfigEnvKey, credentials.StorageOverrideConfigEnvKey} {
+		if value := c.Env[key]; value != "" {
+			if err := json.Unmarshal([]byte(value), &spec); err != nil {
+				return fmt.Errorf("invalid storage spec config %s: %w", key, err)
+			}
+		}
+	}
+	for _, mapping := range storageSpecEnvs[spec["type"]] {
+		if value, ok := spec[mapping[1]]; ok {
+			c.Env[mapping[0]] = value
+		}
+	}
+	if spec["type"] == "gs" && spec["service_account_json"] != "" {
+		c.GCSCredentialsJSON = []byte(spec["service_account_json"])
+	}
+	return nil
+}
+
+// NewSourceResolverWithCredentials creates a SourceResolver accessing the model sources with the credentials,
+// the requests to the sources are bounded by the timeout.
+func NewSourceResolverWithCredentials(creds *Credentials, timeout time.Duration) *SourceResolver {
+	endpoint := DefaultHuggingFaceEndpoint
+	if value := creds.Env[HuggingFaceEndpointEnv]; value != "" {
+		endpoint = value
+	}
+	return &SourceResolver{
+		HTTPClient:          &http.Client{Timeout: timeout},
+		HuggingFaceEndpoint: endpoint,
+		HuggingFaceToken:    creds.Env[hf.HFTokenKey],
+		credentials:         creds,
+	}
+}
+
+// newS3ClientWithCredentials creates an S3 client with the static credentials, or an anonymous one without them
+func newS3ClientWithCredentials(creds *Credentials) S3ListClient {
+	cfg := aws.Config{
+		Region:      creds.Env[s3credential.AWSRegion],
+		Credentials: aws.AnonymousCredentials{},
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	accessKey, secretKey := creds.Env[s3credential.AWSAccessKeyId], creds.Env[s3credential.AWSSecretAccessKey]
+	if accessKey != "" && secretKey != "" && strings.ToLower(creds.Env[s3credential.AWSAnonymousCredential]) != "true" {
+		cfg.Credentials = awscredentials.NewStaticCredentialsProvider(accessKey, secretKey, "")
+	}
+	return s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if useVirtualBucket, ok := creds.Env[s3credential.S3UseVirtualBucket]; ok {
+			o.UsePathStyle = strings.ToLower(useVirtualBucket) == "false"
+		}
+		if endpoint := creds.Env[s3credential.AWSEndpointUrl]; endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+}
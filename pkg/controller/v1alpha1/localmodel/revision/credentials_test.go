@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kserve/kserve/pkg/credentials"
+	"github.com/kserve/kserve/pkg/credentials/gcs"
+	"github.com/kserve/kserve/pkg/credentials/hf"
+	s3credential "github.com/kserve/kserve/pkg/credentials/s3"
+)
+
+func secretKeyRef(name string, key string) *corev1.EnvVarSource {
+	return &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		Key:                  key,
+	}}
+}
+
+func TestCredentialsFromContainer(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "s3-secret", Namespace: "kserve"},
+			Data: map[string][]byte{
+				"awsAccessKeyID":     []byte("access-key"),
+				"awsSecretAccessKey": []byte("secret-key"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "gcs-secret", Namespace: "kserve"},
+			Data:       map[string][]byte{gcs.GCSCredentialFileName: []byte(`{"type":"service_account"}`)},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "storage-config", Namespace: "kserve"},
+			Data: map[string][]byte{
+				"hf": []byte(`{"type":"hf","token":"hf-token","endpoint":"https://hf.example.com"}`),
+			},
+		},
+	)
+
+	scenarios := map[string]struct {
+		container *corev1.Container
+		volumes   []corev1.Volume
+		expected  *Credentials
+	}{
+		"s3 secret envs": {
+			container: &corev1.Container{Env: []corev1.EnvVar{
+				{Name: s3credential.AWSAccessKeyId, ValueFrom: secretKeyRef("s3-secret", "awsAccessKeyID")},
+				{Name: s3credential.AWSSecretAccessKey, ValueFrom: secretKeyRef("s3-secret", "awsSecretAccessKey")},
+				{Name: s3credential.AWSEndpointUrl, Value: "http://minio:9000"},
+			}},
+			expected: &Credentials{Env: map[string]string{
+				s3credential.AWSAccessKeyId:     "access-key",
+				s3credential.AWSSecretAccessKey: "secret-key",
+				s3credential.AWSEndpointUrl:     "http://minio:9000",
+			}},
+		},
+		"gcs secret volume": {
+			container: &corev1.Container{
+				Env: []corev1.EnvVar{
+					{Name: gcs.GCSCredentialEnvKey, Value: gcs.GCSCredentialVolumeMountPath + gcs.GCSCredentialFileName},
+				},
+				VolumeMounts: []corev1.VolumeMount{{Name: "gcs-volume", MountPath: gcs.GCSCredentialVolumeMountPath}},
+			},
+			volumes: []corev1.Volume{{
+				Name:         "gcs-volume",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "gcs-secret"}},
+			}},
+			expected: &Credentials{
+				Env:                map[string]string{gcs.GCSCredentialEnvKey: gcs.GCSCredentialVolumeMountPath + gcs.GCSCredentialFileName},
+				GCSCredentialsJSON: []byte(`{"type":"service_account"}`),
+			},
+		},
+		"storage spec": {
+			container: &corev1.Container{Env: []corev1.EnvVar{
+				{Name: credentials.StorageConfigEnvKey, ValueFrom: secretKeyRef("storage-config", "hf")},
+			}},
+			expected: &Credentials{Env: map[string]string{
+				credentials.StorageConfigEnvKey: `{"type":"hf","token":"hf-token","endpoint":"https://hf.example.com"}`,
+				hf.HFTokenKey:                   "hf-token",
+				HuggingFaceEndpointEnv:          "https://hf.example.com",
+			}},
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			creds, err := CredentialsFromContainer(context.Background(), clientset, "kserve", scenario.container, scenario.volumes)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(creds).To(gomega.Equal(scenario.expected))
+		})
+	}
+
+	t.Run("missing secret", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		container := &corev1.Container{Env: []corev1.EnvVar{
+			{Name: hf.HFTokenKey, ValueFrom: secretKeyRef("missing", "token")},
+		}}
+		_, err := CredentialsFromContainer(context.Background(), clientset, "kserve", container, nil)
+		g.Expect(err).To(gomega.HaveOccurred())
+	})
+}
+
+func TestNewSourceResolverWithCredentials(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	resolver := NewSourceResolverWithCredentials(&Credentials{Env: map[string]string{
+		hf.HFTokenKey:          "hf-token",
+		HuggingFaceEndpointEnv: "https://hf.example.com",
+	}}, time.Second)
+	g.Expect(resolver.HuggingFaceToken).To(gomega.Equal("hf-token"))
+	g.Expect(resolver.HuggingFaceEndpoint).To(gomega.Equal("https://hf.example.com"))
+	g.Expect(resolver.HTTPClient.Timeout).To(gomega.Equal(time.Second))
+
+	// The controller environment is not used for the models with credentials
+	t.Setenv(hf.HFTokenKey, "controller-token")
+	resolver = NewSourceResolverWithCredentials(&Credentials{Env: map[string]string{}}, time.Second)
+	g.Expect(resolver.HuggingFaceToken).To(gomega.BeEmpty())
+	g.Expect(resolver.HuggingFaceEndpoint).To(gomega.Equal(DefaultHuggingFaceEndpoint))
+}
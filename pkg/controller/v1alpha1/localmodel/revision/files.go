@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"crypto/md5"  //nolint:gosec // G501: S3 ETags of single part uploads are MD5 digests
+	"crypto/sha1" //nolint:gosec // G505: git object ids are SHA-1 digests
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// SourceFile is a file of a model source with the checksums published by the source
+type SourceFile struct {
+	// Path of the file relative to the model folder
+	Path string
+	Size int64
+	// SHA256 of the content, for files stored with Git LFS on Hugging Face
+	SHA256 string
+	// GitBlobSHA1 is the git object id, for files stored in git on Hugging Face
+	GitBlobSHA1 string
+	// MD5 of the content, from the ETag of S3 objects uploaded in a single part
+	MD5 string
+	// MultipartETag is the ETag of S3 objects uploaded in parts of PartSize bytes, the MD5 of the MD5 digests of the
+	// parts followed by the number of parts
+	MultipartETag string
+	PartSize      int64
+}
+
+// HasChecksum returns whether the content of the file can be verified against a checksum published by the source
+func (f SourceFile) HasChecksum() bool {
+	return f.SHA256 != "" || f.GitBlobSHA1 != "" || f.MD5 != "" || f.MultipartETag != ""
+}
+
+// SupportsFileListing returns whether the files of the model source can be listed with their checksums
+func SupportsFileListing(sourceModelUri string) bool {
+	return strings.HasPrefix(sourceModelUri, HuggingFacePrefix) || strings.HasPrefix(sourceModelUri, S3Prefix)
+}
+
+// ListFiles lists the files of the model source with their checksums, so a copy of the model obtained from
+// somewhere else can be verified against the source. For Hugging Face, the files of the given revision are listed
+// if it is set.
+func (r *SourceResolver) ListFiles(ctx context.Context, sourceModelUri string, revision string) ([]SourceFile, error) {
+	switch {
+	case strings.HasPrefix(sourceModelUri, HuggingFacePrefix):
+		return r.listHuggingFaceFiles(ctx, strings.TrimPrefix(sourceModelUri, HuggingFacePrefix), revision)
+	case strings.HasPrefix(sourceModelUri, S3Prefix):
+		return r.listS3Files(ctx, strings.TrimPrefix(sourceModelUri, S3Prefix))
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedScheme, sourceModelUri)
+}
+
+var nextLinkRegexp = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+func (r *SourceResolver) listHuggingFaceFiles(ctx context.Context, repo string, revision string) ([]SourceFile, error) {
+	repoID, gitRevision, _ := strings.Cut(repo, ":")
+	if strings.Count(repoID, "/") != 1 {
+		return nil, fmt.Errorf("invalid Hugging Face URI %q, expected hf://owner/model[:revision]", HuggingFacePrefix+repo)
+	}
+	if revision != "" {
+		gitRevision = revision
+	}
+	if gitRevision == "" {
+		gitRevision = "main"
+	}
+	endpoint := strings.TrimSuffix(r.HuggingFaceEndpoint, "/")
+	pageURL := fmt.Sprintf("%s/api/models/%s/tree/%s?recursive=true", endpoint, repoID, url.PathEscape(gitRevision))
+
+	files := []SourceFile{}
+	for pageURL != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		if r.HuggingFaceToken != "" {
+			req.Header.Set("Authorization", "Bearer "+r.HuggingFaceToken)
+		}
+		resp, err := r.HTTPClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		entries := []struct {
+			Type string `json:"type"`
+			Oid  string `json:"oid"`
+			Size int64  `json:"size"`
+			Path string `json:"path"`
+			Lfs  *struct {
+				Oid string `json:"oid"`
+			} `json:"lfs"`
+		}{}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to list files of revision %s of Hugging Face model %s: %s", gitRevision, repoID, resp.Status)
+		}
+		err = json.NewDecoder(resp.Body).Decode(&entries)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode Hugging Face model files: %w", err)
+		}
+		for _, entry := range entries {
+			if entry.Type != "file" {
+				continue
+			}
+			file := SourceFile{Path: entry.Path, Size: entry.Size}
+			if entry.Lfs != nil {
+				file.SHA256 = entry.Lfs.Oid
+			} else {
+				file.GitBlobSHA1 = entry.Oid
+			}
+			files = append(files, file)
+		}
+		pageURL = ""
+		if match := nextLinkRegexp.FindStringSubmatch(resp.Header.Get("Link")); match != nil {
+			pageURL = match[1]
+		}
+	}
+	return files, nil
+}
+
+var multipartETagRegexp = regexp.MustCompile(`^[0-9a-fA-F]{32}-([0-9]+)$`)
+
+// listS3Files lists the objects of the prefix, or the object named by the prefix. The prefix is bounded to a folder,
+// so s3://bucket/models/foo does not list the objects of s3://bucket/models/foobar.
+func (r *SourceResolver) listS3Files(ctx context.Context, uriPath string) ([]SourceFile, error) {
+	bucket, prefix, _ := strings.Cut(uriPath, "/")
+	client, err := r.getS3Client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+	folder := prefix
+	if folder != "" && !strings.HasSuffix(folder, "/") {
+		folder += "/"
+	}
+	files := []SourceFile{}
+	paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list objects of %s: %w", S3Prefix+uriPath, err)
+		}
+		for _, object := range page.Contents {
+			key := aws.ToString(object.Key)
+			file := SourceFile{Size: aws.ToInt64(object.Size)}
+			switch {
+			case strings.HasSuffix(key, "/"):
+				continue
+			case key == prefix:
+				// The prefix names a single object, which is downloaded into the model folder
+				file.Path = path.Base(key)
+			case strings.HasPrefix(key, folder):
+				file.Path = strings.TrimPrefix(key, folder)
+			default:
+				continue
+			}
+			etag := strings.Trim(aws.ToString(object.ETag), `"`)
+			if len(etag) == md5.Size*2 {
+				file.MD5 = etag
+			} else if match := multipartETagRegexp.FindStringSubmatch(etag); match != nil {
+				// ETags of multipart uploads are not the MD5 of the content, they are verified from the part size
+				partSize, err := getS3PartSize(ctx, client, bucket, key, file.Size, match[1])
+				if err != nil {
+					return nil, err
+				}
+				if partSize > 0 {
+					file.MultipartETag = etag
+					file.PartSize = partSize
+				}
+			}
+			files = append(files, file)
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%s has no objects or does not exist", S3Prefix+uriPath)
+	}
+	return files, nil
+}
+
+// getS3PartSize returns the size of the parts of an object uploaded in parts, from the size of its first part. It
+// returns 0 if the object was not uploaded in parts of the same size, so its ETag cannot be computed.
+func getS3PartSize(ctx context.Context, client S3ListClient, bucket string, key string, size int64, parts string) (int64, error) {
+	partsCount, err := strconv.ParseInt(parts, 10, 32)
+	if err != nil || partsCount < 1 {
+		return 0, nil
+	}
+	output, err := client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket:     aws.String(bucket),
+		Key:        aws.String(key),
+		PartNumber: aws.Int32(1),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to get the first part of %s: %w", S3Prefix+bucket+"/"+key, err)
+	}
+	partSize := aws.ToInt64(output.ContentLength)
+	if partSize <= 0 || int64(aws.ToInt32(output.PartsCount)) != partsCount || (size+partSize-1)/partSize != partsCount {
+		return 0, nil
+	}
+	return partSize, nil
+}
+
+// FileVerifier computes the checksums of the content written to it and compares them with the source file
+type FileVerifier struct {
+	file      SourceFile
+	size      int64
+	hashes    []hash.Hash
+	digests   []string
+	multipart *multipartETag
+}
+
+// NewFileVerifier creates a verifier of the content of the source file
+func NewFileVerifier(file SourceFile) *FileVerifier {
+	v := &FileVerifier{file: file}
+	if file.SHA256 != "" {
+		v.hashes = append(v.hashes, sha256.New())
+		v.digests = append(v.digests, file.SHA256)
+	}
+	if file.GitBlobSHA1 != "" {
+		blob := sha1.New() //nolint:gosec // G401: git object ids are SHA-1 digests
+		fmt.Fprintf(blob, "blob %d\x00", file.Size)
+		v.hashes = append(v.hashes, blob)
+		v.digests = append(v.digests, file.GitBlobSHA1)
+	}
+	if file.MD5 != "" {
+		v.hashes = append(v.hashes, md5.New()) //nolint:gosec // G401: S3 ETags of single part uploads are MD5 digests
+		v.digests = append(v.digests, file.MD5)
+	}
+	if file.MultipartETag != "" && file.PartSize > 0 {
+		v.multipart = newMultipartETag(file.PartSize)
+	}
+	return v
+}
+
+var _ io.Writer = (*FileVerifier)(nil)
+
+func (v *FileVerifier) Write(p []byte) (int, error) {
+	for _, h := range v.hashes {
+		h.Write(p)
+	}
+	if v.multipart != nil {
+		v.multipart.Write(p)
+	}
+	v.size += int64(len(p))
+	return len(p), nil
+}
+
+// Verify returns an error if the size or a checksum of the written content does not match the source file
+func (v *FileVerifier) Verify() error {
+	if v.size != v.file.Size {
+		return fmt.Errorf("size of %s is %d bytes, expected %d bytes", v.file.Path, v.size, v.file.Size)
+	}
+	for i, h := range v.hashes {
+		if digest := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(digest, v.digests[i]) {
+			return fmt.Errorf("checksum of %s is %s, expected %s", v.file.Path, digest, v.digests[i])
+		}
+	}
+	if v.multipart != nil {
+		if etag := v.multipart.ETag(); !strings.EqualFold(etag, v.file.MultipartETag) {
+			return fmt.Errorf("multipart ETag of %s is %s, expected %s", v.file.Path, etag, v.file.MultipartETag)
+		}
+	}
+	return nil
+}
+
+// multipartETag computes the ETag of an S3 object uploaded in parts of the given size
+type multipartETag struct {
+	partSize int64
+	part     hash.Hash
+	// written is the size of the content written to the current part
+	written int64
+	digests hash.Hash
+	parts   int
+}
+
+func newMultipartETag(partSize int64) *multipartETag {
+	return &multipartETag{partSize: partSize, part: md5.New(), digests: md5.New()} //nolint:gosec // G401: S3 ETags of multipart uploads are MD5 digests
+}
+
+func (m *multipartETag) Write(p []byte) {
+	for len(p) > 0 {
+		n := min(int64(len(p)), m.partSize-m.written)
+		m.part.Write(p[:n])
+		m.written += n
+		p = p[n:]
+		if m.written == m.partSize {
+			m.endPart()
+		}
+	}
+}
+
+func (m *multipartETag) endPart() {
+	m.digests.Write(m.part.Sum(nil))
+	m.part.Reset()
+	m.written = 0
+	m.parts++
+}
+
+// ETag returns the ETag of the content written, it must not be written to afterwards
+func (m *multipartETag) ETag() string {
+	if m.written > 0 || m.parts == 0 {
+		m.endPart()
+	}
+	return hex.EncodeToString(m.digests.Sum(nil)) + "-" + strconv.Itoa(m.parts)
+}
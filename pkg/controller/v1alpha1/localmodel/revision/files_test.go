@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // G501: S3 ETags are MD5 digests
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestListHuggingFaceFiles(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/models/org/llama/tree/abc123" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("cursor") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/models/org/llama/tree/abc123?recursive=true&cursor=2>; rel="next"`, server.URL))
+			fmt.Fprint(w, `[{"type": "directory", "path": "weights"},
+				{"type": "file", "path": "config.json", "size": 10, "oid": "0123"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"type": "file", "path": "weights/model.bin", "size": 20, "oid": "4567", "lfs": {"oid": "89ab"}}]`)
+	}))
+	defer server.Close()
+	resolver := &SourceResolver{HTTPClient: server.Client(), HuggingFaceEndpoint: server.URL}
+
+	files, err := resolver.ListFiles(context.Background(), "hf://org/llama:main", "abc123")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(files).To(gomega.Equal([]SourceFile{
+		{Path: "config.json", Size: 10, GitBlobSHA1: "0123"},
+		{Path: "weights/model.bin", Size: 20, SHA256: "89ab"},
+	}))
+
+	_, err = resolver.ListFiles(context.Background(), "hf://org/llama", "")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestListS3Files(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	md5 := "0123456789abcdef0123456789abcdef"
+	client := &mockS3ListClient{
+		objects: map[string]string{
+			"model/":               "",
+			"model/config.json":    `"` + md5 + `"`,
+			"model/weights.bin":    `"` + md5 + `-3"`,
+			"model/tokenizer.bin":  `"` + md5 + `-3"`,
+			"model-v2/config.json": `"` + md5 + `"`,
+		},
+		sizes:     map[string]int64{"model/config.json": 10, "model/weights.bin": 25, "model/tokenizer.bin": 30},
+		partSizes: map[string]int64{"model/weights.bin": 10, "model/tokenizer.bin": 20},
+	}
+	resolver := &SourceResolver{S3Client: client}
+
+	files, err := resolver.ListFiles(context.Background(), "s3://bucket/model", "")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(files).To(gomega.Equal([]SourceFile{
+		{Path: "config.json", Size: 10, MD5: md5},
+		// The parts of the tokenizer do not have the same size, so its ETag cannot be computed
+		{Path: "tokenizer.bin", Size: 30},
+		{Path: "weights.bin", Size: 25, MultipartETag: md5 + "-3", PartSize: 10},
+	}))
+	g.Expect(files[1].HasChecksum()).To(gomega.BeFalse())
+
+	files, err = resolver.ListFiles(context.Background(), "s3://bucket/model/config.json", "")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(files).To(gomega.Equal([]SourceFile{{Path: "config.json", Size: 10, MD5: md5}}))
+
+	_, err = resolver.ListFiles(context.Background(), "s3://bucket/mod", "")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestFileVerifier(t *testing.T) {
+	content := "hello world\n"
+	tests := map[string]struct {
+		file    SourceFile
+		content string
+		wantErr bool
+	}{
+		"sha256": {
+			file:    SourceFile{Path: "a", Size: 12, SHA256: "a948904f2f0f479b8f8197694b30184b0d2ed1c1cd2a1ec0fb85d299a192a447"},
+			content: content,
+		},
+		"git blob sha1": {
+			file:    SourceFile{Path: "a", Size: 12, GitBlobSHA1: "3b18e512dba79e4c8300dd08aeb37f8e728b8dad"},
+			content: content,
+		},
+		"md5": {
+			file:    SourceFile{Path: "a", Size: 12, MD5: "6F5902AC237024BDD0C176CB93063DC4"},
+			content: content,
+		},
+		"multipart etag": {
+			file:    SourceFile{Path: "a", Size: 12, MultipartETag: computeMultipartETag(content, 5), PartSize: 5},
+			content: content,
+		},
+		"multipart etag mismatch": {
+			file:    SourceFile{Path: "a", Size: 12, MultipartETag: computeMultipartETag(content, 4), PartSize: 5},
+			content: content,
+			wantErr: true,
+		},
+		"size mismatch": {
+			file:    SourceFile{Path: "a", Size: 13},
+			content: content,
+			wantErr: true,
+		},
+		"checksum mismatch": {
+			file:    SourceFile{Path: "a", Size: 12, SHA256: "a948904f2f0f479b8f8197694b30184b0d2ed1c1cd2a1ec0fb85d299a192a447"},
+			content: "hello world!",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			verifier := NewFileVerifier(tt.file)
+			fmt.Fprint(verifier, tt.content)
+			if tt.wantErr {
+				g.Expect(verifier.Verify()).To(gomega.HaveOccurred())
+			} else {
+				g.Expect(verifier.Verify()).To(gomega.Succeed())
+			}
+		})
+	}
+}
+
+// computeMultipartETag computes the ETag of the content uploaded to S3 in parts of the given size
+func computeMultipartETag(content string, partSize int) string {
+	digests := []byte{}
+	parts := 0
+	for start := 0; start < len(content); start += partSize {
+		digest := md5.Sum([]byte(content[start:min(start+partSize, len(content))])) //nolint:gosec // G401: S3 ETags are MD5 digests
+		digests = append(digests, digest[:]...)
+		parts++
+	}
+	digest := md5.Sum(digests) //nolint:gosec // G401: S3 ETags are MD5 digests
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(digest[:]), parts)
+}
@@ -0,0 +1,485 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package revision resolves the current revision of mutable model sources, so model caches with a refresh
+// policy download the model again when the source changes and inference services can be pinned to the revision
+// they were deployed with, and lists the files of model sources with their checksums to verify copies of the
+// model downloaded from peer nodes.
+package revision
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	gstorage "cloud.google.com/go/storage"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	"github.com/kserve/kserve/pkg/credentials/hf"
+	s3credential "github.com/kserve/kserve/pkg/credentials/s3"
+)
+
+const (
+	HuggingFacePrefix = "hf://"
+	S3Prefix          = "s3://"
+	GCSPrefix         = "gs://"
+	OCIPrefix         = "oci://"
+
+	DefaultHuggingFaceEndpoint = "https://huggingface.co"
+	// HuggingFaceEndpointEnv overrides the Hugging Face Hub endpoint, like for the huggingface_hub library
+	HuggingFaceEndpointEnv = "HF_ENDPOINT"
+)
+
+// ErrUnsupportedScheme is returned for model sources without a revision to check, like HTTP URLs
+var ErrUnsupportedScheme = errors.New("revision check is not supported for the storage URI scheme")
+
+// Resolver resolves the current revision of a model source
+type Resolver interface {
+	Resolve(ctx context.Context, sourceModelUri string) (string, error)
+}
+
+// S3ListClient abstracts the S3 ListObjectsV2 and HeadObject operations for dependency injection and testing.
+type S3ListClient interface {
+	ListObjectsV2(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
+	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+}
+
+// GCSObject is an object of a GCS bucket with its generation
+type GCSObject struct {
+	Name       string
+	Generation int64
+}
+
+// GCSListClient abstracts the listing of the objects of GCS prefixes for dependency injection and testing.
+type GCSListClient interface {
+	ListObjects(ctx context.Context, bucket string, prefix string) ([]GCSObject, error)
+}
+
+// SourceResolver resolves the revision of Hugging Face repositories, S3 and GCS prefixes and OCI tags.
+// Unless created with the credentials of a model, credentials are read from the environment of the controller:
+// HF_TOKEN for Hugging Face, the default AWS credential chain for S3 and the application default credentials for
+// GCS. OCI registries are accessed anonymously.
+type SourceResolver struct {
+	HTTPClient          *http.Client
+	HuggingFaceEndpoint string
+	HuggingFaceToken    string
+	// OCIRegistryScheme is the scheme used to access OCI registries, https unless set
+	OCIRegistryScheme string
+	// S3Client lists the objects of S3 prefixes, created from the environment unless set
+	S3Client S3ListClient
+	// GCSClient lists the objects of GCS prefixes, created from the environment unless set
+	GCSClient GCSListClient
+
+	// credentials replace the environment of the controller when set
+	credentials *Credentials
+
+	s3Once    sync.Once
+	s3Client  S3ListClient
+	s3Err     error
+	gcsOnce   sync.Once
+	gcsClient GCSListClient
+	gcsErr    error
+}
+
+var _ Resolver = (*SourceResolver)(nil)
+
+// NewSourceResolver creates a SourceResolver configured from the environment
+func NewSourceResolver() *SourceResolver {
+	endpoint := DefaultHuggingFaceEndpoint
+	if value, ok := os.LookupEnv(HuggingFaceEndpointEnv); ok && value != "" {
+		endpoint = value
+	}
+	return &SourceResolver{
+		HTTPClient:          http.DefaultClient,
+		HuggingFaceEndpoint: endpoint,
+		HuggingFaceToken:    os.Getenv(hf.HFTokenKey),
+	}
+}
+
+// Resolve returns the revision of the model source:
+// the commit sha for hf://owner/model[:revision], a hash of the keys and ETags of the objects for s3://bucket/prefix,
+// a hash of the names and generations of the objects for gs://bucket/prefix and the manifest digest for
+// oci://registry/repository:tag.
+func (r *SourceResolver) Resolve(ctx context.Context, sourceModelUri string) (string, error) {
+	switch {
+	case strings.HasPrefix(sourceModelUri, HuggingFacePrefix):
+		return r.resolveHuggingFace(ctx, strings.TrimPrefix(sourceModelUri, HuggingFacePrefix))
+	case strings.HasPrefix(sourceModelUri, S3Prefix):
+		return r.resolveS3(ctx, strings.TrimPrefix(sourceModelUri, S3Prefix))
+	case strings.HasPrefix(sourceModelUri, GCSPrefix):
+		return r.resolveGCS(ctx, strings.TrimPrefix(sourceModelUri, GCSPrefix))
+	case strings.HasPrefix(sourceModelUri, OCIPrefix):
+		return r.resolveOCI(ctx, strings.TrimPrefix(sourceModelUri, OCIPrefix))
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedScheme, sourceModelUri)
+}
+
+func (r *SourceResolver) resolveHuggingFace(ctx context.Context, repo string) (string, error) {
+	repoID, gitRevision, _ := strings.Cut(repo, ":")
+	if strings.Count(repoID, "/") != 1 {
+		return "", fmt.Errorf("invalid Hugging Face URI %q, expected hf://owner/model[:revision]", HuggingFacePrefix+repo)
+	}
+	if gitRevision == "" {
+		gitRevision = "main"
+	}
+	endpoint := strings.TrimSuffix(r.HuggingFaceEndpoint, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/api/models/%s/revision/%s", endpoint, repoID, url.PathEscape(gitRevision)), nil)
+	if err != nil {
+		return "", err
+	}
+	if r.HuggingFaceToken != "" {
+		req.Header.Set("Authorization", "Bearer "+r.HuggingFaceToken)
+	}
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get revision %s of Hugging Face model %s: %s", gitRevision, repoID, resp.Status)
+	}
+	model := struct {
+		Sha string `json:"sha"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&model); err != nil {
+		return "", fmt.Errorf("failed to decode Hugging Face model info: %w", err)
+	}
+	if model.Sha == "" {
+		return "", fmt.Errorf("no commit sha found for revision %s of Hugging Face model %s", gitRevision, repoID)
+	}
+	return model.Sha, nil
+}
+
+func (r *SourceResolver) getS3Client(ctx context.Context) (S3ListClient, error) {
+	if r.S3Client != nil {
+		return r.S3Client, nil
+	}
+	r.s3Once.Do(func() {
+		if r.credentials != nil {
+			r.s3Client = newS3ClientWithCredentials(r.credentials)
+			return
+		}
+		configOpts := []func(*awsconfig.LoadOptions) error{}
+		if region, ok := os.LookupEnv(s3credential.AWSRegion); ok {
+			configOpts = append(configOpts, awsconfig.WithRegion(region))
+		}
+		cfg, err := awsconfig.LoadDefaultConfig(ctx, configOpts...)
+		if err != nil {
+			r.s3Err = err
+			return
+		}
+		r.s3Client = s3.NewFromConfig(cfg, func(o *s3.Options) {
+			if useVirtualBucket, ok := os.LookupEnv(s3credential.S3UseVirtualBucket); ok {
+				o.UsePathStyle = strings.ToLower(useVirtualBucket) == "false"
+			}
+			if endpoint, ok := os.LookupEnv(s3credential.AWSEndpointUrl); ok {
+				o.BaseEndpoint = aws.String(endpoint)
+			}
+		})
+	})
+	return r.s3Client, r.s3Err
+}
+
+// resolveS3 hashes the manifest of the keys and ETags of the objects under the prefix, so adding, removing or
+// overwriting any object changes the revision.
+func (r *SourceResolver) resolveS3(ctx context.Context, path string) (string, error) {
+	bucket, prefix, _ := strings.Cut(path, "/")
+	client, err := r.getS3Client(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to create S3 client: %w", err)
+	}
+	manifest := sha256.New()
+	objects := 0
+	paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("unable to list objects of %s: %w", S3Prefix+path, err)
+		}
+		// Objects are listed in ascending order of their keys
+		for _, object := range page.Contents {
+			if strings.HasSuffix(aws.ToString(object.Key), "/") {
+				continue
+			}
+			fmt.Fprintf(manifest, "%s\t%s\n", aws.ToString(object.Key), aws.ToString(object.ETag))
+			objects++
+		}
+	}
+	if objects == 0 {
+		return "", fmt.Errorf("%s has no objects or does not exist", S3Prefix+path)
+	}
+	return "sha256:" + hex.EncodeToString(manifest.Sum(nil)), nil
+}
+
+type gcsListClient struct {
+	client *gstorage.Client
+}
+
+func (c *gcsListClient) ListObjects(ctx context.Context, bucket string, prefix string) ([]GCSObject, error) {
+	objects := []GCSObject{}
+	it := c.client.Bucket(bucket).Objects(ctx, &gstorage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, GCSObject{Name: attrs.Name, Generation: attrs.Generation})
+	}
+}
+
+func (r *SourceResolver) getGCSClient(ctx context.Context) (GCSListClient, error) {
+	if r.GCSClient != nil {
+		return r.GCSClient, nil
+	}
+	r.gcsOnce.Do(func() {
+		var opts []option.ClientOption
+		if r.credentials != nil {
+			if r.credentials.GCSCredentialsJSON != nil {
+				opts = append(opts, option.WithCredentialsJSON(r.credentials.GCSCredentialsJSON))
+			} else {
+				opts = append(opts, option.WithoutAuthentication())
+			}
+		}
+		client, err := gstorage.NewClient(ctx, opts...)
+		if err != nil {
+			r.gcsErr = err
+			return
+		}
+		r.gcsClient = &gcsListClient{client: client}
+	})
+	return r.gcsClient, r.gcsErr
+}
+
+// resolveGCS hashes the manifest of the names and generations of the objects under the prefix. Overwriting an
+// object creates a new generation, so the revision changes with any change of the objects.
+func (r *SourceResolver) resolveGCS(ctx context.Context, path string) (string, error) {
+	bucket, prefix, _ := strings.Cut(path, "/")
+	client, err := r.getGCSClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to create GCS client: %w", err)
+	}
+	objects, err := client.ListObjects(ctx, bucket, prefix)
+	if err != nil {
+		return "", fmt.Errorf("unable to list objects of %s: %w", GCSPrefix+path, err)
+	}
+	return GCSManifestDigest(objects, GCSPrefix+path)
+}
+
+// GCSManifestDigest returns the revision of the objects of a GCS prefix, listed in ascending order of their names
+func GCSManifestDigest(objects []GCSObject, sourceModelUri string) (string, error) {
+	manifest := sha256.New()
+	count := 0
+	for _, object := range objects {
+		if strings.HasSuffix(object.Name, "/") {
+			continue
+		}
+		fmt.Fprintf(manifest, "%s\t%d\n", object.Name, object.Generation)
+		count++
+	}
+	if count == 0 {
+		return "", fmt.Errorf("%s has no objects or does not exist", sourceModelUri)
+	}
+	return "sha256:" + hex.EncodeToString(manifest.Sum(nil)), nil
+}
+
+// PinURI returns the storage URI pinned to the revision for the model sources which can be downloaded at a given
+// revision: hf://owner/model:sha for Hugging Face and oci://registry/repository@digest for OCI, including the
+// oci+<mode>:// schemes. Other storage URIs are returned unchanged with false, the storage initializer downloads
+// the versions of the S3 objects or the generations of the GCS objects matching the revision instead, and fails
+// when the bucket has no versioning and the objects changed.
+func PinURI(storageUri string, revision string) (string, bool) {
+	scheme, reference, ok := strings.Cut(storageUri, "://")
+	if !ok || revision == "" {
+		return storageUri, false
+	}
+	switch {
+	case scheme+"://" == HuggingFacePrefix:
+		repoID, _, _ := strings.Cut(reference, ":")
+		return HuggingFacePrefix + repoID + ":" + revision, true
+	case scheme+"://" == OCIPrefix || strings.HasPrefix(scheme, "oci+"):
+		name, _, _ := strings.Cut(reference, "@")
+		if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+			name = name[:i]
+		}
+		return scheme + "://" + name + "@" + revision, true
+	}
+	return storageUri, false
+}
+
+// ociManifestMediaTypes are the manifest media types accepted when resolving the digest of a tag
+var ociManifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// parseOCIReference splits registry/repository[:tag|@digest] into the registry host, the repository and the
+// tag or digest, with the same defaults as docker for Docker Hub images.
+func parseOCIReference(reference string) (string, string, string, error) {
+	name, digest, hasDigest := strings.Cut(reference, "@")
+	tag := "latest"
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	if hasDigest {
+		tag = digest
+	}
+	registry, repository, ok := strings.Cut(name, "/")
+	if !ok || (!strings.ContainsAny(registry, ".:") && registry != "localhost") {
+		registry, repository = "docker.io", name
+	}
+	if registry == "docker.io" {
+		registry = "registry-1.docker.io"
+		if !strings.Contains(repository, "/") {
+			repository = "library/" + repository
+		}
+	}
+	if repository == "" || tag == "" {
+		return "", "", "", fmt.Errorf("invalid OCI reference %q", reference)
+	}
+	return registry, repository, tag, nil
+}
+
+func (r *SourceResolver) resolveOCI(ctx context.Context, reference string) (string, error) {
+	registry, repository, tag, err := parseOCIReference(reference)
+	if err != nil {
+		return "", err
+	}
+	// Digests are immutable
+	if strings.Contains(tag, ":") {
+		return tag, nil
+	}
+	scheme := r.OCIRegistryScheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	manifestURL := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", scheme, registry, repository, tag)
+
+	resp, err := r.headManifest(ctx, manifestURL, "")
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		token, err := r.getRegistryToken(ctx, resp.Header.Get("WWW-Authenticate"), repository)
+		if err != nil {
+			return "", err
+		}
+		if resp, err = r.headManifest(ctx, manifestURL, token); err != nil {
+			return "", err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get manifest of %s: %s", OCIPrefix+reference, resp.Status)
+	}
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return "", fmt.Errorf("registry returned no digest for %s", OCIPrefix+reference)
+	}
+	return digest, nil
+}
+
+func (r *SourceResolver) headManifest(ctx context.Context, manifestURL string, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, manifestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join(ociManifestMediaTypes, ", "))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	return resp, nil
+}
+
+// getRegistryToken gets an anonymous pull token from the token service of the registry challenge
+func (r *SourceResolver) getRegistryToken(ctx context.Context, challenge string, repository string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", fmt.Errorf("unsupported registry authentication challenge %q", challenge)
+	}
+	values := map[string]string{}
+	for _, param := range strings.Split(params, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		values[key] = strings.Trim(value, `"`)
+	}
+	if values["realm"] == "" {
+		return "", fmt.Errorf("no realm in registry authentication challenge %q", challenge)
+	}
+	tokenURL, err := url.Parse(values["realm"])
+	if err != nil {
+		return "", err
+	}
+	query := tokenURL.Query()
+	if service := values["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := values["scope"]
+	if scope == "" {
+		scope = "repository:" + repository + ":pull"
+	}
+	query.Set("scope", scope)
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("failed to get registry token: %s %s", resp.Status, body)
+	}
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode registry token: %w", err)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	return token.AccessToken, nil
+}
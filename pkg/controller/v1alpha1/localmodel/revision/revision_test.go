@@ -0,0 +1,318 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/onsi/gomega"
+)
+
+func TestResolveHuggingFace(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/models/meta-llama/llama/revision/main":
+			fmt.Fprint(w, `{"id": "meta-llama/llama", "sha": "abc123"}`)
+		case "/api/models/meta-llama/llama/revision/v1":
+			fmt.Fprint(w, `{"id": "meta-llama/llama", "sha": "def456"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	resolver := &SourceResolver{HTTPClient: server.Client(), HuggingFaceEndpoint: server.URL, HuggingFaceToken: "token"}
+
+	revision, err := resolver.Resolve(context.Background(), "hf://meta-llama/llama")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revision).To(gomega.Equal("abc123"))
+
+	revision, err = resolver.Resolve(context.Background(), "hf://meta-llama/llama:v1")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revision).To(gomega.Equal("def456"))
+
+	_, err = resolver.Resolve(context.Background(), "hf://meta-llama/missing")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	_, err = resolver.Resolve(context.Background(), "hf://llama")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+type mockS3ListClient struct {
+	// objects are the ETags of the object keys
+	objects map[string]string
+	// sizes and partSizes of the objects, the objects without a part size were uploaded in a single part
+	sizes     map[string]int64
+	partSizes map[string]int64
+}
+
+func (m *mockS3ListClient) ListObjectsV2(_ context.Context, params *s3.ListObjectsV2Input, _ ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	if aws.ToString(params.Bucket) != "bucket" {
+		return nil, errors.New("NoSuchBucket")
+	}
+	output := &s3.ListObjectsV2Output{}
+	for _, key := range slices.Sorted(maps.Keys(m.objects)) {
+		if strings.HasPrefix(key, aws.ToString(params.Prefix)) {
+			output.Contents = append(output.Contents, s3types.Object{
+				Key:  aws.String(key),
+				ETag: aws.String(m.objects[key]),
+				Size: aws.Int64(m.sizes[key]),
+			})
+		}
+	}
+	return output, nil
+}
+
+func (m *mockS3ListClient) HeadObject(_ context.Context, params *s3.HeadObjectInput, _ ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	key := aws.ToString(params.Key)
+	partSize, ok := m.partSizes[key]
+	if !ok || aws.ToInt32(params.PartNumber) != 1 {
+		return nil, errors.New("NoSuchKey")
+	}
+	size := m.sizes[key]
+	return &s3.HeadObjectOutput{
+		ContentLength: aws.Int64(min(partSize, size)),
+		PartsCount:    aws.Int32(int32((size + partSize - 1) / partSize)),
+	}, nil
+}
+
+func TestResolveS3(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	client := &mockS3ListClient{objects: map[string]string{"model/": "", "model/config.json": "1", "model/weights.bin": "2"}}
+	resolver := &SourceResolver{S3Client: client}
+
+	revision, err := resolver.Resolve(context.Background(), "s3://bucket/model/")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revision).To(gomega.HavePrefix("sha256:"))
+
+	same, err := resolver.Resolve(context.Background(), "s3://bucket/model/")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(same).To(gomega.Equal(revision))
+
+	client.objects["model/weights.bin"] = "3"
+	changed, err := resolver.Resolve(context.Background(), "s3://bucket/model/")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changed).NotTo(gomega.Equal(revision))
+
+	_, err = resolver.Resolve(context.Background(), "s3://bucket/other/")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	_, err = resolver.Resolve(context.Background(), "s3://missing/model/")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+type mockGCSListClient struct {
+	objects []GCSObject
+}
+
+func (m *mockGCSListClient) ListObjects(_ context.Context, bucket string, prefix string) ([]GCSObject, error) {
+	if bucket != "bucket" {
+		return nil, errors.New("storage: bucket doesn't exist")
+	}
+	objects := []GCSObject{}
+	for _, object := range m.objects {
+		if strings.HasPrefix(object.Name, prefix) {
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
+
+func TestResolveGCS(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	client := &mockGCSListClient{objects: []GCSObject{
+		{Name: "model/", Generation: 1},
+		{Name: "model/config.json", Generation: 1700000000000001},
+		{Name: "model/weights.bin", Generation: 1700000000000002},
+	}}
+	resolver := &SourceResolver{GCSClient: client}
+
+	revision, err := resolver.Resolve(context.Background(), "gs://bucket/model/")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revision).To(gomega.HavePrefix("sha256:"))
+
+	// Overwriting an object creates a new generation
+	client.objects[2].Generation = 1700000000000003
+	changed, err := resolver.Resolve(context.Background(), "gs://bucket/model/")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changed).NotTo(gomega.Equal(revision))
+
+	_, err = resolver.Resolve(context.Background(), "gs://bucket/other/")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	_, err = resolver.Resolve(context.Background(), "gs://missing/model/")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestPinURI(t *testing.T) {
+	tests := map[string]struct {
+		storageUri string
+		revision   string
+		expected   string
+		pinned     bool
+	}{
+		"hugging face": {
+			storageUri: "hf://meta-llama/llama",
+			revision:   "abc123",
+			expected:   "hf://meta-llama/llama:abc123",
+			pinned:     true,
+		},
+		"hugging face branch": {
+			storageUri: "hf://meta-llama/llama:main",
+			revision:   "abc123",
+			expected:   "hf://meta-llama/llama:abc123",
+			pinned:     true,
+		},
+		"oci tag": {
+			storageUri: "oci://localhost:5000/models/llama:v1",
+			revision:   "sha256:abc",
+			expected:   "oci://localhost:5000/models/llama@sha256:abc",
+			pinned:     true,
+		},
+		"oci without tag": {
+			storageUri: "oci+native://quay.io/models/llama",
+			revision:   "sha256:abc",
+			expected:   "oci+native://quay.io/models/llama@sha256:abc",
+			pinned:     true,
+		},
+		"oci digest": {
+			storageUri: "oci://quay.io/models/llama:v1@sha256:abc",
+			revision:   "sha256:abc",
+			expected:   "oci://quay.io/models/llama@sha256:abc",
+			pinned:     true,
+		},
+		"s3": {
+			storageUri: "s3://bucket/model",
+			revision:   "sha256:abc",
+			expected:   "s3://bucket/model",
+		},
+		"no revision": {
+			storageUri: "hf://meta-llama/llama",
+			expected:   "hf://meta-llama/llama",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			pinnedUri, pinned := PinURI(tt.storageUri, tt.revision)
+			g.Expect(pinnedUri).To(gomega.Equal(tt.expected))
+			g.Expect(pinned).To(gomega.Equal(tt.pinned))
+		})
+	}
+}
+
+func TestResolveOCI(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	digest := "sha256:0123456789abcdef"
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			g.Expect(r.URL.Query().Get("scope")).To(gomega.Equal("repository:models/llama:pull"))
+			fmt.Fprint(w, `{"token": "anonymous"}`)
+		case "/v2/models/llama/manifests/v1":
+			if r.Header.Get("Authorization") != "Bearer anonymous" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			g.Expect(r.Header.Get("Accept")).To(gomega.ContainSubstring("application/vnd.oci.image.manifest.v1+json"))
+			w.Header().Set("Docker-Content-Digest", digest)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	resolver := &SourceResolver{HTTPClient: server.Client(), OCIRegistryScheme: "http"}
+	registry := strings.TrimPrefix(server.URL, "http://")
+
+	revision, err := resolver.Resolve(context.Background(), "oci://"+registry+"/models/llama:v1")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revision).To(gomega.Equal(digest))
+
+	revision, err = resolver.Resolve(context.Background(), "oci://"+registry+"/models/llama@sha256:fedcba")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revision).To(gomega.Equal("sha256:fedcba"))
+
+	_, err = resolver.Resolve(context.Background(), "oci://"+registry+"/models/llama:v2")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestParseOCIReference(t *testing.T) {
+	tests := map[string]struct {
+		reference  string
+		registry   string
+		repository string
+		tag        string
+	}{
+		"registry with port": {
+			reference:  "localhost:5000/models/llama:v1",
+			registry:   "localhost:5000",
+			repository: "models/llama",
+			tag:        "v1",
+		},
+		"default tag": {
+			reference:  "quay.io/models/llama",
+			registry:   "quay.io",
+			repository: "models/llama",
+			tag:        "latest",
+		},
+		"docker hub": {
+			reference:  "llama:v1",
+			registry:   "registry-1.docker.io",
+			repository: "library/llama",
+			tag:        "v1",
+		},
+		"digest": {
+			reference:  "ghcr.io/org/llama:v1@sha256:abc",
+			registry:   "ghcr.io",
+			repository: "org/llama",
+			tag:        "sha256:abc",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			registry, repository, tag, err := parseOCIReference(tt.reference)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(registry).To(gomega.Equal(tt.registry))
+			g.Expect(repository).To(gomega.Equal(tt.repository))
+			g.Expect(tag).To(gomega.Equal(tt.tag))
+		})
+	}
+}
+
+func TestResolveUnsupportedScheme(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	_, err := NewSourceResolver().Resolve(context.Background(), "https://example.com/model.tar.gz")
+	g.Expect(errors.Is(err, ErrUnsupportedScheme)).To(gomega.BeTrue())
+}
@@ -98,11 +98,19 @@ var _ = BeforeSuite(func(ctx SpecContext) {
 		}
 
 		// Set up LocalModelNamespaceCache reconciler
-		return (&LocalModelNamespaceCacheReconciler{
+		if err := (&LocalModelNamespaceCacheReconciler{
 			Client:    mgr.GetClient(),
 			Clientset: clientset,
 			Scheme:    mgr.GetScheme(),
 			Log:       ctrl.Log.WithName("v1alpha1LocalModelNamespaceCacheController"),
+		}).SetupWithManager(mgr); err != nil {
+			return err
+		}
+
+		// Set up LocalModelNodeGroup reconciler
+		return (&LocalModelNodeGroupReconciler{
+			Client: mgr.GetClient(),
+			Log:    ctrl.Log.WithName("v1alpha1LocalModelNodeGroupController"),
 		}).SetupWithManager(mgr)
 	}
 
@@ -19,8 +19,10 @@ limitations under the License.
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=localmodelnodes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=localmodelnodes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=nodes/status,verbs=get;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
@@ -29,20 +31,28 @@ package localmodelnode
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/fs"
 	"maps"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -53,9 +63,12 @@ import (
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1alpha1/localmodel/revision"
 	"github.com/kserve/kserve/pkg/controller/v1alpha1/utils"
+	isvcutils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
 	"github.com/kserve/kserve/pkg/credentials"
 	pkgtypes "github.com/kserve/kserve/pkg/types"
+	kserveutils "github.com/kserve/kserve/pkg/utils"
 )
 
 type ensureModelRootFolderResult struct {
@@ -70,11 +83,16 @@ type LocalModelNodeReconciler struct {
 	Scheme            *runtime.Scheme
 	CredentialBuilder *credentials.CredentialBuilder
 	IsvcConfigMap     *corev1.ConfigMap
+	Recorder          record.EventRecorder
+	// Address of the agent serving the downloaded models to the peer nodes, empty if peer download is disabled
+	PeerAddress string
 }
 
 const (
 	DownloadContainerName = "kserve-localmodel-download"
 	PvcSourceMountName    = "kserve-pvc-source"
+	// Label of the jobs downloading a revision of a model cache with a refresh policy
+	ModelFolderLabel = "modelFolder"
 )
 
 var (
@@ -87,6 +105,7 @@ var (
 	modelsRootFolder                         = filepath.Join(MountPath, "models")
 	fsHelper                   FileSystemInterface
 	storageInitializerConfig   *pkgtypes.StorageInitializerConfig
+	peerDownloadConfig         *v1beta1.LocalModelPeerDownloadConfig
 )
 
 // Returns the first matching nodegroup for a node.
@@ -114,7 +133,9 @@ func (c *LocalModelNodeReconciler) getNodeGroupFromNode(ctx context.Context, nod
 	return nil, fmt.Errorf("did not find matching nodegroup for node: %s", nodeName)
 }
 
-func (c *LocalModelNodeReconciler) launchJob(ctx context.Context, localModelNode v1alpha1.LocalModelNode, modelInfo v1alpha1.LocalModelInfo) (*batchv1.Job, error) {
+// launchJob launches a job downloading the model from the peer node if set, or from the source model URI otherwise.
+// Retries is the number of times the download was relaunched after a failed job.
+func (c *LocalModelNodeReconciler) launchJob(ctx context.Context, localModelNode v1alpha1.LocalModelNode, modelInfo v1alpha1.LocalModelInfo, peer *downloadPeer, retries int32) (*batchv1.Job, error) {
 	jobName := modelInfo.ModelName + "-" + localModelNode.Name
 
 	// Use NodeGroup from modelInfo if set, otherwise fall back to getNodeGroupFromNode
@@ -144,10 +165,23 @@ func (c *LocalModelNodeReconciler) launchJob(ctx context.Context, localModelNode
 	if container == nil {
 		container = c.getContainerSpecFromConfig(storageInitializerConfig)
 	}
+	// Download the resolved revision rather than whatever the mutable source URI points to now, the revisions of
+	// the sources which cannot be pinned in the URI are verified by the storage initializer
+	sourceUri, pinned := revision.PinURI(modelInfo.SourceModelUri, modelInfo.Revision)
+	container.Args = []string{sourceUri, MountPath}
+	if !pinned && modelInfo.Revision != "" {
+		revisions, err := json.Marshal(map[string]string{modelInfo.SourceModelUri: modelInfo.Revision})
+		if err != nil {
+			return nil, err
+		}
+		container.Env = append(container.Env, corev1.EnvVar{Name: constants.StorageRevisionsEnvVarKey, Value: string(revisions)})
+	}
+	if peer != nil {
+		container = getPeerDownloadContainer(modelInfo, peer, peerDownloadConfig)
+	}
 
 	// Use hash-based folder path for storage deduplication
-	storageKey := v1alpha1.GetStorageKey(modelInfo.SourceModelUri)
-	container.Args = []string{modelInfo.SourceModelUri, MountPath}
+	storageKey := getModelFolderName(modelInfo)
 	container.VolumeMounts = []corev1.VolumeMount{
 		{
 			MountPath: MountPath,
@@ -155,6 +189,15 @@ func (c *LocalModelNodeReconciler) launchJob(ctx context.Context, localModelNode
 			ReadOnly:  false,
 			SubPath:   filepath.Join("models", storageKey),
 		},
+		getProgressVolumeMount(storageKey),
+	}
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  DownloadProgressFileEnvVar,
+		Value: filepath.Join(ProgressMountPath, progressFileName),
+	})
+	// Report the end of the logs as the failure reason if the container does not write a termination message
+	if container.TerminationMessagePolicy == "" {
+		container.TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
 	}
 
 	volumes := []corev1.Volume{
@@ -168,6 +211,12 @@ func (c *LocalModelNodeReconciler) launchJob(ctx context.Context, localModelNode
 		},
 	}
 
+	if peer != nil {
+		volume, volumeMount := getPeerCredentialsVolume(peerDownloadConfig)
+		volumes = append(volumes, volume)
+		container.VolumeMounts = append(container.VolumeMounts, volumeMount)
+	}
+
 	jobNs := jobNamespace
 
 	// Only inject if credentials are explicitly configured in LocalModelCache
@@ -187,16 +236,36 @@ func (c *LocalModelNodeReconciler) launchJob(ctx context.Context, localModelNode
 	if modelInfo.Namespace != "" {
 		jobLabels["modelNamespace"] = modelInfo.Namespace
 	}
+	if modelInfo.Revision != "" {
+		jobLabels[ModelFolderLabel] = storageKey
+	}
+	jobAnnotations := map[string]string{}
+	if retries > 0 {
+		jobAnnotations[DownloadRetriesAnnotation] = strconv.Itoa(int(retries))
+	}
+	// The download from a peer is not retried, the model is downloaded from the source instead
+	var backoffLimit *int32
+	// The pods downloading from a peer are labeled for the network policy of the local model agents
+	var podLabels map[string]string
+	if peer != nil {
+		jobLabels[DownloadSourceLabel] = peerDownloadSource
+		podLabels = map[string]string{DownloadSourceLabel: peerDownloadSource}
+		jobAnnotations[PeerNodeAnnotation] = peer.node
+		backoffLimit = ptr.To(int32(0))
+	}
 
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: jobName,
 			Namespace:    jobNs,
 			Labels:       jobLabels,
+			Annotations:  jobAnnotations,
 		},
 		Spec: batchv1.JobSpec{
 			TTLSecondsAfterFinished: &jobTTLSecondsAfterFinished,
+			BackoffLimit:            backoffLimit,
 			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
 				Spec: corev1.PodSpec{
 					NodeSelector:  map[string]string{"kubernetes.io/hostname": nodeName},
 					Containers:    []corev1.Container{*container},
@@ -281,11 +350,28 @@ func (c *LocalModelNodeReconciler) getContainerSpecForStorageUri(ctx context.Con
 		return nil, err
 	}
 
-	for _, sc := range storageContainers.Items {
+	container, err := getStorageContainerForWorkloadType(storageContainers.Items, storageUri, v1alpha1.LocalModelDownloadJob)
+	if err != nil || container != nil {
+		return container, err
+	}
+	// The default storage initializer does not support custom storage URIs, so they are downloaded by the
+	// ClusterStorageContainer running as init container for the inference services
+	if kserveutils.IsPrefixSupported(storageUri, isvcutils.SupportedStorageURIPrefixList) {
+		return nil, nil
+	}
+	return getStorageContainerForWorkloadType(storageContainers.Items, storageUri, v1alpha1.InitContainer)
+}
+
+// getStorageContainerForWorkloadType returns the container of the first enabled ClusterStorageContainer of the
+// workload type supporting the storage URI, or nil if none does
+func getStorageContainerForWorkloadType(storageContainers []v1alpha1.ClusterStorageContainer, storageUri string,
+	workloadType v1alpha1.WorkloadType,
+) (*corev1.Container, error) {
+	for _, sc := range storageContainers {
 		if sc.IsDisabled() {
 			continue
 		}
-		if sc.Spec.WorkloadType != v1alpha1.LocalModelDownloadJob {
+		if sc.Spec.WorkloadType != workloadType {
 			continue
 		}
 		supported, err := sc.Spec.IsStorageUriSupported(storageUri)
@@ -293,10 +379,9 @@ func (c *LocalModelNodeReconciler) getContainerSpecForStorageUri(ctx context.Con
 			return nil, fmt.Errorf("error checking storage container %s: %w", sc.Name, err)
 		}
 		if supported {
-			return &sc.Spec.Container, nil
+			return sc.Spec.Container.DeepCopy(), nil
 		}
 	}
-
 	return nil, nil
 }
 
@@ -310,6 +395,10 @@ func (c *LocalModelNodeReconciler) getLatestJob(ctx context.Context, modelInfo v
 	if modelInfo.Namespace != "" {
 		labelSelector["modelNamespace"] = modelInfo.Namespace
 	}
+	// For model caches with a refresh policy, only get the jobs downloading the revision
+	if modelInfo.Revision != "" {
+		labelSelector[ModelFolderLabel] = getModelFolderName(modelInfo)
+	}
 
 	if err := c.List(ctx, jobList, client.InNamespace(jobNamespace), client.MatchingLabels(labelSelector)); err != nil {
 		if errors.IsNotFound(err) {
@@ -328,6 +417,15 @@ func (c *LocalModelNodeReconciler) getLatestJob(ctx context.Context, modelInfo v
 	return latestJob, len(jobList.Items), nil
 }
 
+// getModelFolderName returns the folder the model is downloaded into: the folder of the URI hash, or the
+// content-addressed folder of the revision for model caches with a refresh policy.
+func getModelFolderName(modelInfo v1alpha1.LocalModelInfo) string {
+	if modelInfo.Revision != "" {
+		return v1alpha1.GetRevisionStorageKey(modelInfo.SourceModelUri, modelInfo.Revision)
+	}
+	return v1alpha1.GetStorageKey(modelInfo.SourceModelUri)
+}
+
 func getModelStatusFromJobStatus(jobStatus batchv1.JobStatus) v1alpha1.ModelStatus {
 	switch {
 	case jobStatus.Succeeded > 0:
@@ -348,19 +446,73 @@ func (c *LocalModelNodeReconciler) downloadModels(ctx context.Context, localMode
 	c.Log.Info("Downloading models to", "node", localModelNode.Name)
 
 	newStatus := map[string]v1alpha1.ModelStatus{}
+	newEvictions := map[string]v1alpha1.ModelEviction{}
+	newRevisions := map[string]string{}
+	newSources := map[string]v1alpha1.ModelSource{}
 	// Track which storage keys (URI hashes) have been processed for download deduplication
 	processedStorageKeys := map[string]v1alpha1.ModelStatus{}
+	servedRevisions := map[string]string{}
+	processedSources := map[string]v1alpha1.ModelSource{}
+	// Evicted models are downloaded again once an inference service uses a model with the same URI
+	inUseStorageKeys := map[string]bool{}
+	// Models with the same URI download the revision of the first model cache with a refresh policy
+	storageKeyRevisions := map[string]string{}
+	for _, modelInfo := range localModelNode.Spec.LocalModels {
+		storageKey := v1alpha1.GetStorageKey(modelInfo.SourceModelUri)
+		if modelInfo.InUse {
+			inUseStorageKeys[storageKey] = true
+		}
+		if _, ok := storageKeyRevisions[storageKey]; !ok && modelInfo.Revision != "" {
+			storageKeyRevisions[storageKey] = modelInfo.Revision
+		}
+	}
 
 	for _, modelInfo := range localModelNode.Spec.LocalModels {
 		statusKey := modelInfo.GetStatusKey()
 		storageKey := v1alpha1.GetStorageKey(modelInfo.SourceModelUri)
 		c.Log.Info("checking model from spec", "model", modelInfo.ModelName, "namespace", modelInfo.Namespace, "statusKey", statusKey, "storageKey", storageKey)
 
+		if localModelNode.Status.ModelStatus[statusKey] == v1alpha1.ModelEvicted && !inUseStorageKeys[storageKey] {
+			newStatus[statusKey] = v1alpha1.ModelEvicted
+			if eviction, ok := localModelNode.Status.ModelEvictions[statusKey]; ok {
+				newEvictions[statusKey] = eviction
+			}
+			continue
+		}
+
 		// Check if another CR with the same URI has already been processed
 		// If so, reuse its status (storage deduplication - same folder on disk)
 		if status, exists := processedStorageKeys[storageKey]; exists {
 			c.Log.Info("Reusing status from another CR with same URI", "statusKey", statusKey, "storageKey", storageKey, "status", status)
 			newStatus[statusKey] = status
+			if revision, ok := servedRevisions[storageKey]; ok {
+				newRevisions[statusKey] = revision
+			}
+			if source, ok := processedSources[storageKey]; ok {
+				newSources[statusKey] = source
+			}
+			continue
+		}
+
+		if revision, ok := storageKeyRevisions[storageKey]; ok {
+			modelInfo.Revision = revision
+			status, servedRevision, source, err := c.downloadRevision(ctx, localModelNode, modelInfo)
+			if err != nil {
+				return err
+			}
+			newStatus[statusKey] = status
+			processedStorageKeys[storageKey] = status
+			if servedRevision != "" {
+				newRevisions[statusKey] = servedRevision
+				servedRevisions[storageKey] = servedRevision
+			}
+			if source == nil {
+				source = getPreviousModelSource(localModelNode, statusKey)
+			}
+			if source != nil {
+				newSources[statusKey] = *source
+				processedSources[storageKey] = *source
+			}
 			continue
 		}
 
@@ -378,6 +530,10 @@ func (c *LocalModelNodeReconciler) downloadModels(ctx context.Context, localMode
 				if status == v1alpha1.ModelDownloaded {
 					newStatus[statusKey] = v1alpha1.ModelDownloaded
 					processedStorageKeys[storageKey] = v1alpha1.ModelDownloaded
+					if source := getPreviousModelSource(localModelNode, statusKey); source != nil {
+						newSources[statusKey] = *source
+						processedSources[storageKey] = *source
+					}
 					continue
 				}
 			}
@@ -389,15 +545,19 @@ func (c *LocalModelNodeReconciler) downloadModels(ctx context.Context, localMode
 			// If job is not found, create a new one. Because download could be incomplete.
 			if job == nil {
 				c.Log.Info("Model folder exists, creating download job", "model", modelInfo.ModelName, "storageKey", storageKey)
-				job, err = c.launchJob(ctx, *localModelNode, modelInfo)
-				if err != nil {
-					c.Log.Error(err, "Failed to create Job", "model", modelInfo.ModelName, "node", nodeName)
-					return err
-				}
+				job, err = c.launchDownloadJob(ctx, localModelNode, modelInfo, 0)
+			} else {
+				job, err = c.relaunchFailedJob(ctx, localModelNode, modelInfo, job)
+			}
+			if err != nil {
+				c.Log.Error(err, "Failed to create Job", "model", modelInfo.ModelName, "node", nodeName)
+				return err
 			}
 			status := getModelStatusFromJobStatus(job.Status)
 			newStatus[statusKey] = status
 			processedStorageKeys[storageKey] = status
+			newSources[statusKey] = getJobModelSource(job)
+			processedSources[storageKey] = newSources[statusKey]
 			c.Log.Info("model downloading status:", "model", modelInfo.ModelName, "statusKey", statusKey,
 				"node", localModelNode.Name, "status", status)
 		} else {
@@ -416,26 +576,52 @@ func (c *LocalModelNodeReconciler) downloadModels(ctx context.Context, localMode
 			// To retry the download, users can manually fix the issue and delete the failed job.
 			// Add the job count check for protection to ensure not creating more than 2 jobs including the previous one.
 			if job == nil || (job.Status.Succeeded > 0 && jobCount < 2) {
-				job, err = c.launchJob(ctx, *localModelNode, modelInfo)
-				if err != nil {
-					c.Log.Error(err, "Failed to create job", "model", modelInfo.ModelName, "node", nodeName)
-					return err
-				}
+				job, err = c.launchDownloadJob(ctx, localModelNode, modelInfo, 0)
+			} else {
+				job, err = c.relaunchFailedJob(ctx, localModelNode, modelInfo, job)
+			}
+			if err != nil {
+				c.Log.Error(err, "Failed to create job", "model", modelInfo.ModelName, "node", nodeName)
+				return err
 			}
 			status := getModelStatusFromJobStatus(job.Status)
 			newStatus[statusKey] = status
 			processedStorageKeys[storageKey] = status
+			newSources[statusKey] = getJobModelSource(job)
+			processedSources[storageKey] = newSources[statusKey]
 			c.Log.Info("model downloading status:", "model", modelInfo.ModelName, "statusKey", statusKey,
 				"node", localModelNode.Name, "status", status)
 		}
 	}
 
+	newDiskUsage, err := c.getModelDiskUsage(localModelNode, newStatus)
+	if err != nil {
+		return err
+	}
+	newDownloads, err := c.getModelDownloads(ctx, localModelNode, newStatus, newRevisions, storageKeyRevisions)
+	if err != nil {
+		return err
+	}
+
 	// Skip update if no changes to status
-	if maps.Equal(localModelNode.Status.ModelStatus, newStatus) {
+	if maps.Equal(localModelNode.Status.ModelStatus, newStatus) &&
+		maps.EqualFunc(localModelNode.Status.ModelDiskUsage, newDiskUsage, resource.Quantity.Equal) &&
+		// Evictions are only recorded by evictModels, they are only removed here
+		len(localModelNode.Status.ModelEvictions) == len(newEvictions) &&
+		maps.Equal(localModelNode.Status.ModelRevisions, newRevisions) &&
+		maps.Equal(localModelNode.Status.ModelSources, newSources) &&
+		reflect.DeepEqual(localModelNode.Status.ModelDownloads, newDownloads) &&
+		localModelNode.Status.PeerAddress == c.PeerAddress {
 		return nil
 	}
 
 	localModelNode.Status.ModelStatus = newStatus
+	localModelNode.Status.ModelDiskUsage = newDiskUsage
+	localModelNode.Status.ModelEvictions = newEvictions
+	localModelNode.Status.ModelRevisions = newRevisions
+	localModelNode.Status.ModelSources = newSources
+	localModelNode.Status.ModelDownloads = newDownloads
+	localModelNode.Status.PeerAddress = c.PeerAddress
 	if err := c.Status().Update(ctx, localModelNode); err != nil {
 		c.Log.Error(err, "Update local model cache status error", "name", localModelNode.Name)
 		return err
@@ -445,9 +631,36 @@ func (c *LocalModelNodeReconciler) downloadModels(ctx context.Context, localMode
 	return nil
 }
 
+// getModelDiskUsage returns the size of the folders of the downloaded models, so the storage used on the node
+// group is accounted from the actual model sizes instead of the sizes declared on the model caches.
+func (c *LocalModelNodeReconciler) getModelDiskUsage(localModelNode *v1alpha1.LocalModelNode, modelStatus map[string]v1alpha1.ModelStatus) (map[string]resource.Quantity, error) {
+	diskUsage := map[string]resource.Quantity{}
+	// Models with the same URI share the same folder on disk
+	storageKeySizes := map[string]resource.Quantity{}
+	for _, modelInfo := range localModelNode.Spec.LocalModels {
+		statusKey := modelInfo.GetStatusKey()
+		if modelStatus[statusKey] != v1alpha1.ModelDownloaded {
+			continue
+		}
+		storageKey := v1alpha1.GetStorageKey(modelInfo.SourceModelUri)
+		size, ok := storageKeySizes[storageKey]
+		if !ok {
+			bytes, err := fsHelper.getModelSize(storageKey)
+			if err != nil {
+				c.Log.Error(err, "Failed to get model folder size", "model", modelInfo.ModelName, "storageKey", storageKey)
+				return nil, err
+			}
+			size = *resource.NewQuantity(bytes, resource.BinarySI)
+			storageKeySizes[storageKey] = size
+		}
+		diskUsage[statusKey] = size
+	}
+	return diskUsage, nil
+}
+
 // Delete models that are not in the spec
 // Uses hash-based folder names (storageKey) for storage deduplication
-func (c *LocalModelNodeReconciler) deleteModels(localModelNode v1alpha1.LocalModelNode) error {
+func (c *LocalModelNodeReconciler) deleteModels(ctx context.Context, localModelNode v1alpha1.LocalModelNode) error {
 	// 1. Scan model dir and get a list of existing folders representing downloaded models
 	foldersToRemove := map[string]struct{}{}
 	entries, err := fsHelper.getModelFolders()
@@ -456,19 +669,56 @@ func (c *LocalModelNodeReconciler) deleteModels(localModelNode v1alpha1.LocalMod
 		return err
 	}
 	for _, entry := range entries {
-		// Models could only exist in sub dir
-		if entry.IsDir() {
+		// Models could only exist in sub dir, or in revision folders linked from the folder of the model
+		if entry.IsDir() || entry.Type()&fs.ModeSymlink != 0 {
 			foldersToRemove[entry.Name()] = struct{}{}
 		}
 	}
 
 	// 2. Compare with list of models from LocalModelNode CR using storage keys (URI hashes)
+	linkTimes := map[string]time.Time{}
 	for _, localModelInfo := range localModelNode.Spec.LocalModels {
+		// Evicted models are deleted unless they share the folder with a model that is not evicted
+		if localModelNode.Status.ModelStatus[localModelInfo.GetStatusKey()] == v1alpha1.ModelEvicted {
+			continue
+		}
 		// Remove expected models from local model set using storage key
 		storageKey := v1alpha1.GetStorageKey(localModelInfo.SourceModelUri)
 		delete(foldersToRemove, storageKey)
+		// Keep the revision being downloaded and the revision served, previous revisions are deleted
+		if localModelInfo.Revision != "" {
+			delete(foldersToRemove, getModelFolderName(localModelInfo))
+			linkedFolder, err := fsHelper.getLinkedModelFolder(storageKey)
+			if err != nil {
+				c.Log.Error(err, "Failed to read model folder link", "storageKey", storageKey)
+				return err
+			}
+			delete(foldersToRemove, linkedFolder)
+			linkTime, err := fsHelper.getModelLinkTime(storageKey)
+			if err != nil {
+				c.Log.Error(err, "Failed to read model folder link", "storageKey", storageKey)
+				return err
+			}
+			if !linkTime.IsZero() {
+				linkTimes[storageKey] = linkTime
+			}
+		}
+	}
+	// Previous revisions are kept until no pod of the node mounts them
+	inUse, err := c.getPreviousRevisionsInUse(ctx, linkTimes)
+	if err != nil {
+		c.Log.Error(err, "Failed to list the pods of the node using the models")
+		return err
 	}
-	// 3. Models not in LocalModelNode CR spec should be deleted
+	for storageKey := range inUse {
+		for folder := range foldersToRemove {
+			if strings.HasPrefix(folder, storageKey+"-") {
+				c.Log.Info("Keeping previous revision mounted by pods of the node", "storageKey", storageKey, "folder", folder)
+				delete(foldersToRemove, folder)
+			}
+		}
+	}
+	// 3. Models not in LocalModelNode CR spec or evicted should be deleted
 	if len(foldersToRemove) != 0 {
 		c.Log.Info("Found model(s) to remove", "num of models", len(foldersToRemove))
 		for storageKey := range foldersToRemove {
@@ -552,6 +802,8 @@ func (c *LocalModelNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	if localModelConfig.JobTTLSecondsAfterFinished != nil {
 		jobTTLSecondsAfterFinished = *localModelConfig.JobTTLSecondsAfterFinished
 	}
+	peerDownloadConfig = localModelConfig.PeerDownload
+	downloadRetryConfig = localModelConfig.DownloadRetry
 
 	storageInitializerConfig, err = v1beta1.GetStorageInitializerConfigs(isvcConfigMap)
 	if err != nil {
@@ -589,14 +841,20 @@ func (c *LocalModelNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return reconcile.Result{}, err
 	}
 
-	// 5. Download models not present locally
+	// 5. Evict models from node groups running out of storage
+	if err := c.evictModels(ctx, &localModelNode); err != nil {
+		c.Log.Error(err, "Model eviction err")
+		return reconcile.Result{}, err
+	}
+
+	// 6. Download models not present locally
 	if err := c.downloadModels(ctx, &localModelNode); err != nil {
 		c.Log.Error(err, "Model download err")
 		return reconcile.Result{}, err
 	}
 
-	// 6. Delete models that are not in the spec
-	if err := c.deleteModels(localModelNode); err != nil {
+	// 7. Delete models that are not in the spec or evicted
+	if err := c.deleteModels(ctx, localModelNode); err != nil {
 		c.Log.Error(err, "Model deletion err")
 		return reconcile.Result{}, err
 	}
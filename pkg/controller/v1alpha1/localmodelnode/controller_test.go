@@ -53,6 +53,8 @@ type mockFileSystem struct {
 	FileSystemInterface
 	// represents the dirs under models root
 	subDirs []os.DirEntry
+	// links from model folders to revision folders
+	links map[string]string
 }
 
 func (f *mockFileSystem) removeModel(model string) error {
@@ -63,6 +65,7 @@ func (f *mockFileSystem) removeModel(model string) error {
 		}
 	}
 	f.subDirs = newEntries
+	delete(f.links, model)
 	return nil
 }
 
@@ -88,17 +91,45 @@ func (f *mockFileSystem) getModelFolders() ([]os.DirEntry, error) {
 	return f.subDirs, nil
 }
 
+func (f *mockFileSystem) getModelSize(modelName string) (int64, error) {
+	return 0, nil
+}
+
+func (f *mockFileSystem) getLinkedModelFolder(modelName string) (string, error) {
+	return f.links[modelName], nil
+}
+
+func (f *mockFileSystem) linkModelFolder(modelName string, target string) error {
+	f.links[modelName] = target
+	f.mockModel(&MockFileInfo{name: modelName})
+	return nil
+}
+
+func (f *mockFileSystem) getModelLinkTime(modelName string) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (f *mockFileSystem) readDownloadProgress(modelName string) (*DownloadProgress, error) {
+	return nil, nil
+}
+
+func (f *mockFileSystem) removeDownloadProgress(modelName string) error {
+	return nil
+}
+
 func (f *mockFileSystem) ensureModelRootFolderExists() error {
 	return nil
 }
 
 func (f *mockFileSystem) clear() {
 	f.subDirs = []os.DirEntry{}
+	f.links = map[string]string{}
 }
 
 func newMockFileSystem() *mockFileSystem {
 	return &mockFileSystem{
 		subDirs: []os.DirEntry{},
+		links:   map[string]string{},
 	}
 }
 
@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelnode
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+)
+
+const (
+	// DownloadProgressFileEnvVar is the environment variable of the download containers with the path of the file
+	// to report the download progress to, as a DownloadProgress JSON document
+	DownloadProgressFileEnvVar = "KSERVE_DOWNLOAD_PROGRESS_FILE"
+	ProgressMountPath          = "/mnt/download-progress"
+	progressFileName           = "progress.json"
+	// Folder of the volume with the progress folders of the download jobs, next to the models folder
+	progressRootFolderName = "progress"
+	// Annotation of the download jobs with the number of times the download was relaunched after a failed job
+	DownloadRetriesAnnotation = "downloadRetries"
+	// Length of the end of the termination message kept in the status
+	maxFailureMessageLength = 1024
+
+	defaultInitialBackoffSeconds = 60
+	defaultMaxBackoffSeconds     = 3600
+)
+
+var downloadRetryConfig *v1beta1.LocalModelDownloadRetryConfig
+
+// DownloadProgress is the progress reported by a download container
+type DownloadProgress struct {
+	DownloadedBytes int64 `json:"downloadedBytes"`
+	// Total bytes to download, if known
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+}
+
+// ProgressReporter reports the progress of a download container to the progress file of the download job. The file
+// is rewritten at most once per interval, and replaced atomically so the agent never reads a partial file.
+type ProgressReporter struct {
+	path      string
+	interval  time.Duration
+	progress  DownloadProgress
+	lastWrite time.Time
+}
+
+// NewProgressReporter creates a reporter writing to the progress file, nothing is reported if the path is empty
+func NewProgressReporter(path string, totalBytes int64) *ProgressReporter {
+	return &ProgressReporter{path: path, interval: 5 * time.Second, progress: DownloadProgress{TotalBytes: totalBytes}}
+}
+
+var _ io.Writer = (*ProgressReporter)(nil)
+
+// Write counts the downloaded bytes. Failing to report the progress does not fail the download.
+func (r *ProgressReporter) Write(p []byte) (int, error) {
+	r.progress.DownloadedBytes += int64(len(p))
+	if time.Since(r.lastWrite) >= r.interval {
+		_ = r.Flush()
+	}
+	return len(p), nil
+}
+
+// Flush writes the progress to the progress file
+func (r *ProgressReporter) Flush() error {
+	if r.path == "" {
+		return nil
+	}
+	r.lastWrite = time.Now()
+	data, err := json.Marshal(r.progress)
+	if err != nil {
+		return err
+	}
+	tmpPath := r.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil { //nolint:gosec // G306: the progress file is read by the agent running as a different UID
+		return err
+	}
+	return os.Rename(tmpPath, r.path)
+}
+
+// getProgressVolumeMount returns the mount of the progress folder of the model folder in the download container.
+// The progress folder is outside the model folder, so the progress file is not served to the inference services.
+func getProgressVolumeMount(folder string) corev1.VolumeMount {
+	return corev1.VolumeMount{
+		MountPath: ProgressMountPath,
+		Name:      PvcSourceMountName,
+		SubPath:   filepath.Join(progressRootFolderName, folder),
+	}
+}
+
+// getJobRetries returns the number of times the download of the job was relaunched after a failed job
+func getJobRetries(job *batchv1.Job) int32 {
+	retries, err := strconv.ParseInt(job.Annotations[DownloadRetriesAnnotation], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(retries)
+}
+
+// getJobFailedCondition returns the Failed condition of a job which will not run any more pods
+func getJobFailedCondition(job *batchv1.Job) *batchv1.JobCondition {
+	for i, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return &job.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// getRetryBackoff returns the backoff before relaunching a download that already has been relaunched retries times
+func getRetryBackoff(config *v1beta1.LocalModelDownloadRetryConfig, retries int32) time.Duration {
+	initialBackoff := time.Duration(config.InitialBackoffSeconds) * time.Second
+	if initialBackoff <= 0 {
+		initialBackoff = defaultInitialBackoffSeconds * time.Second
+	}
+	maxBackoff := time.Duration(config.MaxBackoffSeconds) * time.Second
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoffSeconds * time.Second
+	}
+	backoff := initialBackoff
+	for range retries {
+		backoff *= 2
+		if backoff >= maxBackoff {
+			break
+		}
+	}
+	return min(backoff, maxBackoff)
+}
+
+// relaunchFailedJob downloads the model from the source model URI once the download from a peer node failed,
+// or relaunches a failed download once the backoff of the download retry config elapsed
+func (c *LocalModelNodeReconciler) relaunchFailedJob(ctx context.Context, localModelNode *v1alpha1.LocalModelNode, modelInfo v1alpha1.LocalModelInfo, job *batchv1.Job) (*batchv1.Job, error) {
+	if isPeerJob(job) {
+		return c.fallBackToOrigin(ctx, localModelNode, modelInfo, job)
+	}
+	failed := getJobFailedCondition(job)
+	if downloadRetryConfig == nil || failed == nil {
+		return job, nil
+	}
+	retries := getJobRetries(job)
+	if retries >= downloadRetryConfig.MaxRetries {
+		return job, nil
+	}
+	if backoff := getRetryBackoff(downloadRetryConfig, retries); time.Since(failed.LastTransitionTime.Time) < backoff {
+		c.Log.Info("Waiting before relaunching failed download", "model", modelInfo.ModelName, "job", job.Name,
+			"retries", retries, "backoff", backoff)
+		return job, nil
+	}
+	c.Log.Info("Relaunching failed download", "model", modelInfo.ModelName, "job", job.Name, "retries", retries+1)
+	return c.launchDownloadJob(ctx, localModelNode, modelInfo, retries+1)
+}
+
+// getModelDownloads returns the progress of the downloads of the models which are not downloaded yet, and the
+// reasons of the failed downloads. Models with a refresh policy report the download of the new revision while the
+// previous revision is served.
+func (c *LocalModelNodeReconciler) getModelDownloads(ctx context.Context, localModelNode *v1alpha1.LocalModelNode, modelStatus map[string]v1alpha1.ModelStatus, modelRevisions map[string]string, storageKeyRevisions map[string]string) (map[string]v1alpha1.ModelDownload, error) {
+	downloads := map[string]v1alpha1.ModelDownload{}
+	// Models with the same URI share the download
+	folderDownloads := map[string]*v1alpha1.ModelDownload{}
+	for _, modelInfo := range localModelNode.Spec.LocalModels {
+		statusKey := modelInfo.GetStatusKey()
+		storageKey := v1alpha1.GetStorageKey(modelInfo.SourceModelUri)
+		modelInfo.Revision = storageKeyRevisions[storageKey]
+		folder := getModelFolderName(modelInfo)
+		status := modelStatus[statusKey]
+		if status == v1alpha1.ModelEvicted || status == "" {
+			continue
+		}
+		if status == v1alpha1.ModelDownloaded && modelRevisions[statusKey] == modelInfo.Revision {
+			if err := fsHelper.removeDownloadProgress(folder); err != nil {
+				c.Log.Error(err, "Failed to remove download progress", "model", modelInfo.ModelName, "folder", folder)
+			}
+			continue
+		}
+		if download, ok := folderDownloads[folder]; ok {
+			if download != nil {
+				downloads[statusKey] = *download
+			}
+			continue
+		}
+
+		folderDownloads[folder] = nil
+		job, _, err := c.getLatestJob(ctx, modelInfo, nodeName)
+		if err != nil {
+			return nil, err
+		}
+		if job == nil {
+			continue
+		}
+		previous, hasPrevious := localModelNode.Status.ModelDownloads[statusKey]
+		if !hasPrevious || previous.JobName != job.Name {
+			previous = v1alpha1.ModelDownload{}
+		}
+		download := c.getModelDownload(ctx, folder, job, previous)
+		folderDownloads[folder] = &download
+		downloads[statusKey] = download
+	}
+	return downloads, nil
+}
+
+// getModelDownload returns the progress of the download job from the progress reported by the download container,
+// or from the size of the model folder if the container does not report its progress
+func (c *LocalModelNodeReconciler) getModelDownload(ctx context.Context, folder string, job *batchv1.Job, previous v1alpha1.ModelDownload) v1alpha1.ModelDownload {
+	download := previous
+	download.JobName = job.Name
+	download.Retries = getJobRetries(job)
+
+	progress, err := fsHelper.readDownloadProgress(folder)
+	if err != nil {
+		c.Log.Error(err, "Failed to read download progress", "folder", folder)
+	}
+	if progress == nil {
+		progress = &DownloadProgress{}
+		if exists, _ := fsHelper.hasModelFolder(folder); exists {
+			if progress.DownloadedBytes, err = fsHelper.getModelSize(folder); err != nil {
+				c.Log.Error(err, "Failed to get size of model folder", "folder", folder)
+			}
+		}
+	}
+	download.TotalBytes = progress.TotalBytes
+	if progress.DownloadedBytes != previous.DownloadedBytes || previous.LastProgressTime == nil {
+		now := metav1.Now()
+		download.BytesPerSecond = 0
+		if previous.LastProgressTime != nil {
+			if elapsed := now.Sub(previous.LastProgressTime.Time).Seconds(); elapsed > 0 {
+				download.BytesPerSecond = int64(float64(progress.DownloadedBytes-previous.DownloadedBytes) / elapsed)
+			}
+		}
+		download.DownloadedBytes = progress.DownloadedBytes
+		download.LastProgressTime = &now
+	}
+
+	// The failure message is only looked up once per job, the pods are removed with the job
+	if job.Status.Failed > 0 && previous.FailureMessage == "" {
+		download.FailureMessage = c.getJobFailureMessage(ctx, job)
+	}
+	return download
+}
+
+// getJobFailureMessage returns the termination message of the download container of the last failed pod of the
+// job, which is the end of the logs of containers not writing a termination message
+func (c *LocalModelNodeReconciler) getJobFailureMessage(ctx context.Context, job *batchv1.Job) string {
+	message := ""
+	if failed := getJobFailedCondition(job); failed != nil {
+		message = failed.Message
+	}
+	pods, err := c.Clientset.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: batchv1.JobNameLabel + "=" + job.Name,
+	})
+	if err != nil {
+		c.Log.Error(err, "Failed to list pods of job", "job", job.Name)
+		return message
+	}
+	slices.SortFunc(pods.Items, func(a, b corev1.Pod) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+	for _, pod := range pods.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			terminated := containerStatus.State.Terminated
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+			message = terminated.Message
+			if message == "" {
+				message = terminated.Reason + ": exit code " + strconv.Itoa(int(terminated.ExitCode))
+			}
+			if len(message) > maxFailureMessageLength {
+				message = message[len(message)-maxFailureMessageLength:]
+			}
+			return message
+		}
+	}
+	return message
+}
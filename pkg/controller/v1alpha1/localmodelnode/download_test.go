@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelnode
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+)
+
+func TestGetRetryBackoff(t *testing.T) {
+	tests := map[string]struct {
+		config  v1beta1.LocalModelDownloadRetryConfig
+		retries int32
+		backoff time.Duration
+	}{
+		"default initial backoff": {
+			config:  v1beta1.LocalModelDownloadRetryConfig{MaxRetries: 3},
+			retries: 0,
+			backoff: time.Minute,
+		},
+		"doubled for each retry": {
+			config:  v1beta1.LocalModelDownloadRetryConfig{MaxRetries: 3, InitialBackoffSeconds: 10},
+			retries: 2,
+			backoff: 40 * time.Second,
+		},
+		"capped at max backoff": {
+			config:  v1beta1.LocalModelDownloadRetryConfig{MaxRetries: 100, InitialBackoffSeconds: 10, MaxBackoffSeconds: 30},
+			retries: 90,
+			backoff: 30 * time.Second,
+		},
+		"initial backoff above max backoff": {
+			config:  v1beta1.LocalModelDownloadRetryConfig{MaxRetries: 3, InitialBackoffSeconds: 7200},
+			retries: 0,
+			backoff: time.Hour,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if backoff := getRetryBackoff(&tt.config, tt.retries); backoff != tt.backoff {
+				t.Errorf("backoff is %s, expected %s", backoff, tt.backoff)
+			}
+		})
+	}
+}
+
+func TestGetJobRetries(t *testing.T) {
+	job := &batchv1.Job{}
+	if retries := getJobRetries(job); retries != 0 {
+		t.Errorf("retries of first job are %d, expected 0", retries)
+	}
+	job.Annotations = map[string]string{DownloadRetriesAnnotation: "2"}
+	if retries := getJobRetries(job); retries != 2 {
+		t.Errorf("retries are %d, expected 2", retries)
+	}
+}
+
+func TestProgressReporter(t *testing.T) {
+	root := t.TempDir()
+	helper := NewFileSystemHelper(filepath.Join(root, "models"))
+	folder := "abc123"
+
+	progress, err := helper.readDownloadProgress(folder)
+	if err != nil || progress != nil {
+		t.Fatalf("progress without progress file is %v, %v, expected nil", progress, err)
+	}
+
+	progressFolder := filepath.Join(root, progressRootFolderName, folder)
+	if err := os.MkdirAll(progressFolder, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	reporter := NewProgressReporter(filepath.Join(progressFolder, progressFileName), 100)
+	reporter.interval = time.Hour
+	if _, err := reporter.Write(make([]byte, 30)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reporter.Write(make([]byte, 20)); err != nil {
+		t.Fatal(err)
+	}
+	// The first write is reported immediately, the next ones once per interval
+	progress, err = helper.readDownloadProgress(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *progress != (DownloadProgress{DownloadedBytes: 30, TotalBytes: 100}) {
+		t.Errorf("progress is %+v, expected 30 of 100 bytes", *progress)
+	}
+	if err := reporter.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	progress, err = helper.readDownloadProgress(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *progress != (DownloadProgress{DownloadedBytes: 50, TotalBytes: 100}) {
+		t.Errorf("progress is %+v, expected 50 of 100 bytes", *progress)
+	}
+
+	if err := helper.removeDownloadProgress(folder); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(progressFolder); !os.IsNotExist(err) {
+		t.Errorf("progress folder was not removed: %v", err)
+	}
+}
+
+func TestGetJobFailedCondition(t *testing.T) {
+	job := &batchv1.Job{Status: batchv1.JobStatus{Failed: 1}}
+	if condition := getJobFailedCondition(job); condition != nil {
+		t.Errorf("job retrying failed pods has failed condition %v", condition)
+	}
+	job.Status.Conditions = []batchv1.JobCondition{{
+		Type:               batchv1.JobFailed,
+		Status:             "True",
+		Message:            "Job has reached the specified backoff limit",
+		LastTransitionTime: metav1.Now(),
+	}}
+	if condition := getJobFailedCondition(job); condition == nil || condition.Message != "Job has reached the specified backoff limit" {
+		t.Errorf("failed condition is %v", condition)
+	}
+}
+
+func TestGetStorageContainerForWorkloadType(t *testing.T) {
+	newStorageContainer := func(name string, workloadType v1alpha1.WorkloadType, prefix string) v1alpha1.ClusterStorageContainer {
+		return v1alpha1.ClusterStorageContainer{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.StorageContainerSpec{
+				Container:           corev1.Container{Name: name, Image: name + ":latest"},
+				SupportedUriFormats: []v1alpha1.SupportedUriFormat{{Prefix: prefix}},
+				WorkloadType:        workloadType,
+			},
+		}
+	}
+	disabled := newStorageContainer("disabled", v1alpha1.LocalModelDownloadJob, "custom://")
+	disabled.Disabled = ptr.To(true)
+	storageContainers := []v1alpha1.ClusterStorageContainer{
+		disabled,
+		newStorageContainer("init", v1alpha1.InitContainer, "custom://"),
+		newStorageContainer("job", v1alpha1.LocalModelDownloadJob, "custom://"),
+	}
+
+	tests := map[string]struct {
+		storageUri   string
+		workloadType v1alpha1.WorkloadType
+		expected     string
+	}{
+		"download job":            {storageUri: "custom://model", workloadType: v1alpha1.LocalModelDownloadJob, expected: "job"},
+		"init container":          {storageUri: "custom://model", workloadType: v1alpha1.InitContainer, expected: "init"},
+		"unsupported storage uri": {storageUri: "other://model", workloadType: v1alpha1.InitContainer, expected: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			container, err := getStorageContainerForWorkloadType(storageContainers, tt.storageUri, tt.workloadType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			name := ""
+			if container != nil {
+				name = container.Name
+			}
+			if name != tt.expected {
+				t.Errorf("storage container is %q, expected %q", name, tt.expected)
+			}
+		})
+	}
+}
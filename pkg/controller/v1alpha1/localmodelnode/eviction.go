@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelnode
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+)
+
+const ModelEvictedReason = "ModelEvicted"
+
+// modelFolder is a downloaded model folder, shared by the models with the same URI
+type modelFolder struct {
+	storageKey string
+	models     []v1alpha1.LocalModelInfo
+	size       resource.Quantity
+}
+
+// evictable returns whether none of the models sharing the folder is pinned, used by an inference service or
+// referenced more recently than the minimum idle duration.
+func (f *modelFolder) evictable(policy *v1alpha1.LocalModelEvictionPolicy, now time.Time) bool {
+	for _, model := range f.models {
+		if model.Pinned || model.InUse {
+			return false
+		}
+	}
+	return policy.MinIdleDuration == nil || now.Sub(f.lastReferencedTime()) >= policy.MinIdleDuration.Duration
+}
+
+func (f *modelFolder) priority() int32 {
+	priority := f.models[0].Priority
+	for _, model := range f.models[1:] {
+		priority = max(priority, model.Priority)
+	}
+	return priority
+}
+
+func (f *modelFolder) lastReferencedTime() time.Time {
+	var lastReferenced time.Time
+	for _, model := range f.models {
+		if model.LastReferencedTime != nil && model.LastReferencedTime.After(lastReferenced) {
+			lastReferenced = model.LastReferencedTime.Time
+		}
+	}
+	return lastReferenced
+}
+
+// selectFoldersToEvict returns the folders to evict so the storage used by the folders goes from above the high
+// watermark to below the low watermark. Folders are evicted by priority and then least recently referenced first.
+func selectFoldersToEvict(folders []*modelFolder, policy *v1alpha1.LocalModelEvictionPolicy, storageLimit resource.Quantity, now time.Time) []*modelFolder {
+	used := resource.Quantity{}
+	for _, folder := range folders {
+		used.Add(folder.size)
+	}
+	highWatermark, lowWatermark := policy.GetWatermarks(storageLimit)
+	if used.Cmp(highWatermark) <= 0 {
+		return nil
+	}
+
+	candidates := []*modelFolder{}
+	for _, folder := range folders {
+		if folder.evictable(policy, now) {
+			candidates = append(candidates, folder)
+		}
+	}
+	slices.SortFunc(candidates, func(a, b *modelFolder) int {
+		if c := cmp.Compare(a.priority(), b.priority()); c != 0 {
+			return c
+		}
+		if c := a.lastReferencedTime().Compare(b.lastReferencedTime()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.storageKey, b.storageKey)
+	})
+
+	evicted := []*modelFolder{}
+	for _, folder := range candidates {
+		if used.Cmp(lowWatermark) <= 0 {
+			break
+		}
+		evicted = append(evicted, folder)
+		used.Sub(folder.size)
+	}
+	return evicted
+}
+
+// evictModels evicts the models of the node groups with an eviction policy once the models downloaded on the node
+// use more storage than the high watermark of the node group. Evicted models are marked in the LocalModelNode
+// status, their folders are removed by deleteModels and they are downloaded again once an inference service uses
+// them.
+func (c *LocalModelNodeReconciler) evictModels(ctx context.Context, localModelNode *v1alpha1.LocalModelNode) error {
+	nodeGroupFolders, err := groupModelFolders(localModelNode)
+	if err != nil {
+		c.Log.Error(err, "Failed to get model folder sizes", "node", localModelNode.Name)
+		return err
+	}
+
+	now := time.Now()
+	evicted := sets.New[string]()
+	for _, nodeGroupName := range slices.Sorted(maps.Keys(nodeGroupFolders)) {
+		// A folder shared with a node group evicted before no longer uses storage
+		groupFolders := slices.DeleteFunc(nodeGroupFolders[nodeGroupName], func(folder *modelFolder) bool {
+			return evicted.Has(folder.storageKey)
+		})
+		nodeGroup := &v1alpha1.LocalModelNodeGroup{}
+		if nodeGroupName == "" {
+			var err error
+			if nodeGroup, err = c.getNodeGroupFromNode(ctx, nodeName); err != nil {
+				c.Log.Error(err, "Failed to get node group for current node", "node name", nodeName)
+				return err
+			}
+		} else if err := c.Get(ctx, client.ObjectKey{Name: nodeGroupName}, nodeGroup); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			c.Log.Error(err, "Failed to get node group", "node group", nodeGroupName)
+			return err
+		}
+		policy := nodeGroup.Spec.EvictionPolicy
+		if policy == nil {
+			continue
+		}
+		used := resource.Quantity{}
+		for _, folder := range groupFolders {
+			used.Add(folder.size)
+		}
+		for _, folder := range selectFoldersToEvict(groupFolders, policy, nodeGroup.Spec.StorageLimit, now) {
+			message := fmt.Sprintf("Evicted model folder of %s from node group %s using %s of its %s storage limit, priority %d, last referenced at %s",
+				folder.size.String(), nodeGroup.Name, used.String(), nodeGroup.Spec.StorageLimit.String(), folder.priority(),
+				folder.lastReferencedTime().Format(time.RFC3339))
+			if err := c.evictModelFolder(ctx, localModelNode, folder, message); err != nil {
+				return err
+			}
+			used.Sub(folder.size)
+			evicted.Insert(folder.storageKey)
+		}
+	}
+	if evicted.Len() == 0 {
+		return nil
+	}
+
+	if err := c.Status().Update(ctx, localModelNode); err != nil {
+		c.Log.Error(err, "Update local model node status error", "name", localModelNode.Name)
+		return err
+	}
+	return nil
+}
+
+// groupModelFolders returns the downloaded model folders by node group. A folder shared by models of different node
+// groups uses the storage of each of them, so it is counted against every node group referencing it.
+func groupModelFolders(localModelNode *v1alpha1.LocalModelNode) (map[string][]*modelFolder, error) {
+	folders := map[string]*modelFolder{}
+	nodeGroupFolders := map[string][]*modelFolder{}
+	for _, modelInfo := range localModelNode.Spec.LocalModels {
+		if localModelNode.Status.ModelStatus[modelInfo.GetStatusKey()] != v1alpha1.ModelDownloaded {
+			continue
+		}
+		storageKey := v1alpha1.GetStorageKey(modelInfo.SourceModelUri)
+		folder, ok := folders[storageKey]
+		if ok {
+			folder.models = append(folder.models, modelInfo)
+		} else {
+			size, err := fsHelper.getModelSize(storageKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the size of model folder %s: %w", storageKey, err)
+			}
+			folder = &modelFolder{
+				storageKey: storageKey,
+				models:     []v1alpha1.LocalModelInfo{modelInfo},
+				size:       *resource.NewQuantity(size, resource.BinarySI),
+			}
+			folders[storageKey] = folder
+		}
+		if !slices.Contains(nodeGroupFolders[modelInfo.NodeGroup], folder) {
+			nodeGroupFolders[modelInfo.NodeGroup] = append(nodeGroupFolders[modelInfo.NodeGroup], folder)
+		}
+	}
+	return nodeGroupFolders, nil
+}
+
+// evictModelFolder marks the models sharing the folder as evicted and deletes their download jobs, so a new job
+// downloads the models once an inference service uses them again.
+func (c *LocalModelNodeReconciler) evictModelFolder(ctx context.Context, localModelNode *v1alpha1.LocalModelNode, folder *modelFolder, message string) error {
+	if localModelNode.Status.ModelEvictions == nil {
+		localModelNode.Status.ModelEvictions = map[string]v1alpha1.ModelEviction{}
+	}
+	for _, modelInfo := range folder.models {
+		statusKey := modelInfo.GetStatusKey()
+		c.Log.Info("Evicting model", "model", modelInfo.ModelName, "namespace", modelInfo.Namespace, "storageKey", folder.storageKey, "message", message)
+		localModelNode.Status.ModelStatus[statusKey] = v1alpha1.ModelEvicted
+		localModelNode.Status.ModelEvictions[statusKey] = v1alpha1.ModelEviction{
+			EvictionTime: metav1.Now(),
+			Size:         folder.size,
+			Message:      message,
+		}
+		if c.Recorder != nil {
+			c.Recorder.Eventf(localModelNode, corev1.EventTypeNormal, ModelEvictedReason, "Model %s: %s", statusKey, message)
+		}
+
+		labelSelector := map[string]string{
+			"model": modelInfo.ModelName,
+			"node":  localModelNode.Name,
+		}
+		if modelInfo.Namespace != "" {
+			labelSelector["modelNamespace"] = modelInfo.Namespace
+		}
+		jobs := &batchv1.JobList{}
+		if err := c.List(ctx, jobs, client.InNamespace(jobNamespace), client.MatchingLabels(labelSelector)); err != nil {
+			c.Log.Error(err, "Failed to list jobs", "model", modelInfo.ModelName, "node", localModelNode.Name)
+			return err
+		}
+		propagationPolicy := metav1.DeletePropagationBackground
+		for i := range jobs.Items {
+			if err := c.Delete(ctx, &jobs.Items[i], &client.DeleteOptions{PropagationPolicy: &propagationPolicy}); client.IgnoreNotFound(err) != nil {
+				c.Log.Error(err, "Failed to delete job of evicted model", "job", jobs.Items[i].Name)
+				return err
+			}
+		}
+	}
+	return nil
+}
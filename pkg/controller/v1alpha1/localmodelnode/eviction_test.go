@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelnode
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+)
+
+func TestSelectFoldersToEvict(t *testing.T) {
+	now := time.Now()
+	folder := func(name string, size string, lastReferenced time.Duration, configure func(*v1alpha1.LocalModelInfo)) *modelFolder {
+		model := v1alpha1.LocalModelInfo{
+			ModelName:          name,
+			LastReferencedTime: &metav1.Time{Time: now.Add(-lastReferenced)},
+		}
+		if configure != nil {
+			configure(&model)
+		}
+		return &modelFolder{storageKey: name, models: []v1alpha1.LocalModelInfo{model}, size: resource.MustParse(size)}
+	}
+	storageLimit := resource.MustParse("100Gi")
+
+	tests := map[string]struct {
+		folders []*modelFolder
+		policy  v1alpha1.LocalModelEvictionPolicy
+		evicted []string
+	}{
+		"below high watermark": {
+			folders: []*modelFolder{
+				folder("a", "50Gi", time.Hour, nil),
+				folder("b", "40Gi", 2*time.Hour, nil),
+			},
+			evicted: []string{},
+		},
+		"least recently referenced first until below low watermark": {
+			folders: []*modelFolder{
+				folder("a", "40Gi", time.Hour, nil),
+				folder("b", "30Gi", 3*time.Hour, nil),
+				folder("c", "25Gi", 2*time.Hour, nil),
+			},
+			evicted: []string{"b"},
+		},
+		"lower priority first": {
+			folders: []*modelFolder{
+				folder("a", "40Gi", time.Hour, func(m *v1alpha1.LocalModelInfo) { m.Priority = -1 }),
+				folder("b", "30Gi", 3*time.Hour, func(m *v1alpha1.LocalModelInfo) { m.Priority = 10 }),
+				folder("c", "25Gi", 2*time.Hour, nil),
+			},
+			evicted: []string{"a"},
+		},
+		"extreme priorities": {
+			folders: []*modelFolder{
+				folder("a", "60Gi", 3*time.Hour, func(m *v1alpha1.LocalModelInfo) { m.Priority = math.MaxInt32 }),
+				folder("b", "35Gi", time.Hour, func(m *v1alpha1.LocalModelInfo) { m.Priority = math.MinInt32 }),
+			},
+			policy:  v1alpha1.LocalModelEvictionPolicy{HighWatermarkPercent: 90, LowWatermarkPercent: 70},
+			evicted: []string{"b"},
+		},
+		"pinned and in use models are not evicted": {
+			folders: []*modelFolder{
+				folder("a", "40Gi", time.Hour, nil),
+				folder("b", "30Gi", 3*time.Hour, func(m *v1alpha1.LocalModelInfo) { m.Pinned = true }),
+				folder("c", "25Gi", 2*time.Hour, func(m *v1alpha1.LocalModelInfo) { m.InUse = true }),
+			},
+			evicted: []string{"a"},
+		},
+		"recently referenced models are not evicted": {
+			folders: []*modelFolder{
+				folder("a", "40Gi", time.Hour, nil),
+				folder("b", "30Gi", 3*time.Hour, nil),
+				folder("c", "25Gi", 2*time.Hour, nil),
+			},
+			policy:  v1alpha1.LocalModelEvictionPolicy{MinIdleDuration: &metav1.Duration{Duration: 4 * time.Hour}},
+			evicted: []string{},
+		},
+		"custom watermarks": {
+			folders: []*modelFolder{
+				folder("a", "40Gi", time.Hour, nil),
+				folder("b", "30Gi", 3*time.Hour, nil),
+				folder("c", "25Gi", 2*time.Hour, nil),
+			},
+			policy:  v1alpha1.LocalModelEvictionPolicy{HighWatermarkPercent: 80, LowWatermarkPercent: 50},
+			evicted: []string{"b", "c"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			evicted := []string{}
+			for _, folder := range selectFoldersToEvict(tt.folders, &tt.policy, storageLimit, now) {
+				evicted = append(evicted, folder.storageKey)
+			}
+			if len(evicted) != len(tt.evicted) {
+				t.Fatalf("expected evicted folders %v, got %v", tt.evicted, evicted)
+			}
+			for i := range evicted {
+				if evicted[i] != tt.evicted[i] {
+					t.Errorf("expected evicted folders %v, got %v", tt.evicted, evicted)
+				}
+			}
+		})
+	}
+}
+
+func TestGroupModelFolders(t *testing.T) {
+	previousFsHelper := fsHelper
+	fsHelper = newMockFileSystem()
+	t.Cleanup(func() { fsHelper = previousFsHelper })
+
+	model := func(name string, uri string, nodeGroup string) v1alpha1.LocalModelInfo {
+		return v1alpha1.LocalModelInfo{ModelName: name, SourceModelUri: uri, NodeGroup: nodeGroup}
+	}
+	localModelNode := &v1alpha1.LocalModelNode{
+		Spec: v1alpha1.LocalModelNodeSpec{
+			LocalModels: []v1alpha1.LocalModelInfo{
+				model("shared-a", "s3://models/shared", "group-a"),
+				model("shared-b", "s3://models/shared", "group-b"),
+				model("shared-b2", "s3://models/shared", "group-b"),
+				model("other", "s3://models/other", "group-b"),
+				model("pending", "s3://models/pending", "group-a"),
+			},
+		},
+		Status: v1alpha1.LocalModelNodeStatus{ModelStatus: map[string]v1alpha1.ModelStatus{}},
+	}
+	for _, modelInfo := range localModelNode.Spec.LocalModels {
+		if modelInfo.ModelName != "pending" {
+			localModelNode.Status.ModelStatus[modelInfo.GetStatusKey()] = v1alpha1.ModelDownloaded
+		}
+	}
+
+	nodeGroupFolders, err := groupModelFolders(localModelNode)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	storageKeys := func(folders []*modelFolder) []string {
+		keys := []string{}
+		for _, folder := range folders {
+			keys = append(keys, folder.storageKey)
+		}
+		return keys
+	}
+	shared := v1alpha1.GetStorageKey("s3://models/shared")
+	if got, want := storageKeys(nodeGroupFolders["group-a"]), []string{shared}; !slices.Equal(got, want) {
+		t.Errorf("group-a folders = %v, want %v", got, want)
+	}
+	if got, want := storageKeys(nodeGroupFolders["group-b"]), []string{shared, v1alpha1.GetStorageKey("s3://models/other")}; !slices.Equal(got, want) {
+		t.Errorf("group-b folders = %v, want %v", got, want)
+	}
+	if nodeGroupFolders["group-a"][0] != nodeGroupFolders["group-b"][0] {
+		t.Errorf("the shared folder should be the same for both node groups")
+	}
+	if got := len(nodeGroupFolders["group-a"][0].models); got != 3 {
+		t.Errorf("shared folder models = %d, want 3", got)
+	}
+}
@@ -17,26 +17,37 @@ limitations under the License.
 package localmodelnode
 
 import (
+	"encoding/json"
 	"errors"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"time"
 )
 
 type FileSystemInterface interface {
 	removeModel(modelName string) error
 	hasModelFolder(modelName string) (bool, error)
 	getModelFolders() ([]os.DirEntry, error)
+	getModelSize(modelName string) (int64, error)
+	getLinkedModelFolder(modelName string) (string, error)
+	linkModelFolder(modelName string, target string) error
+	getModelLinkTime(modelName string) (time.Time, error)
+	readDownloadProgress(modelName string) (*DownloadProgress, error)
+	removeDownloadProgress(modelName string) error
 	ensureModelRootFolderExists() error
 }
 
 type FileSystemHelper struct {
 	modelsRootFolder string
+	// Folder with the download progress of the model folders, next to the models root folder
+	progressRootFolder string
 }
 
 func NewFileSystemHelper(modelsRootFolder string) *FileSystemHelper {
 	return &FileSystemHelper{
-		modelsRootFolder: modelsRootFolder,
+		modelsRootFolder:   modelsRootFolder,
+		progressRootFolder: filepath.Join(filepath.Dir(modelsRootFolder), progressRootFolderName),
 	}
 }
 
@@ -47,7 +58,10 @@ func getModelFolder(rootFolderName string, modelName string) string {
 
 func (f *FileSystemHelper) removeModel(modelName string) error {
 	path := getModelFolder(f.modelsRootFolder, modelName)
-	return os.RemoveAll(path)
+	if err := os.RemoveAll(path); err != nil {
+		return err
+	}
+	return f.removeDownloadProgress(modelName)
 }
 
 func (f *FileSystemHelper) getModelFolders() ([]os.DirEntry, error) {
@@ -73,6 +87,94 @@ func (f *FileSystemHelper) hasModelFolder(modelName string) (bool, error) {
 	return false, err
 }
 
+// getModelSize returns the bytes used by the files in the model folder
+func (f *FileSystemHelper) getModelSize(modelName string) (int64, error) {
+	var size int64
+	// The folder of a model cache with a refresh policy links to the folder of the served revision
+	folder, err := filepath.EvalSymlinks(getModelFolder(f.modelsRootFolder, modelName))
+	if err != nil {
+		return 0, err
+	}
+	err = filepath.WalkDir(folder, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
+// getLinkedModelFolder returns the name of the folder the model folder links to,
+// or an empty string if the model folder does not exist or is not a link
+func (f *FileSystemHelper) getLinkedModelFolder(modelName string) (string, error) {
+	path := getModelFolder(f.modelsRootFolder, modelName)
+	info, err := os.Lstat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	if info.Mode()&fs.ModeSymlink == 0 {
+		return "", nil
+	}
+	target, err := os.Readlink(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(target), nil
+}
+
+// linkModelFolder atomically replaces the model folder with a link to the target folder in the models root folder.
+// The link is relative so it resolves both on the node and in the pods mounting the volume.
+func (f *FileSystemHelper) linkModelFolder(modelName string, target string) error {
+	path := getModelFolder(f.modelsRootFolder, modelName)
+	info, err := os.Lstat(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	// A folder downloaded before the model cache had a refresh policy cannot be replaced atomically. It is moved to
+	// a revision folder of the model so the pods mounting it keep serving it until it is deleted like the other
+	// previous revisions.
+	if err == nil && info.IsDir() {
+		if err := os.Rename(path, getModelFolder(f.modelsRootFolder, modelName+legacyRevisionSuffix)); err != nil {
+			return err
+		}
+	}
+	tmpPath := getModelFolder(f.modelsRootFolder, "."+modelName+".tmp")
+	if err := os.Remove(tmpPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err := os.Symlink(target, tmpPath); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// getModelLinkTime returns when the model folder was last linked to a revision folder,
+// or the zero time if the model folder does not exist or is not a link
+func (f *FileSystemHelper) getModelLinkTime(modelName string) (time.Time, error) {
+	info, err := os.Lstat(getModelFolder(f.modelsRootFolder, modelName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	if info.Mode()&fs.ModeSymlink == 0 {
+		return time.Time{}, nil
+	}
+	return info.ModTime(), nil
+}
+
 func (f *FileSystemHelper) ensureModelRootFolderExists() error {
 	// If the folder already exists, this will do nothing
 	if err := os.MkdirAll(f.modelsRootFolder, os.ModePerm); err != nil { //nolint:gosec // G301: local model cache must be readable by model server running as a different UID
@@ -80,3 +182,25 @@ func (f *FileSystemHelper) ensureModelRootFolderExists() error {
 	}
 	return nil
 }
+
+// readDownloadProgress returns the progress reported by the download container of the model folder,
+// or nil if the download container does not report its progress
+func (f *FileSystemHelper) readDownloadProgress(modelName string) (*DownloadProgress, error) {
+	data, err := os.ReadFile(filepath.Join(f.progressRootFolder, modelName, progressFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	progress := &DownloadProgress{}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+// removeDownloadProgress removes the progress folder of the model folder once the model is downloaded
+func (f *FileSystemHelper) removeDownloadProgress(modelName string) error {
+	return os.RemoveAll(filepath.Join(f.progressRootFolder, modelName))
+}
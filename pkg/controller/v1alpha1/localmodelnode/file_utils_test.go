@@ -112,6 +112,120 @@ func TestFileSystemHelper_removeModel(t *testing.T) {
 	}
 }
 
+func TestFileSystemHelper_getModelSize(t *testing.T) {
+	tempDir := t.TempDir()
+	helper := NewFileSystemHelper(tempDir)
+
+	// Case 1: Model folder does not exist
+	if _, err := helper.getModelSize("nonexistent-model"); err == nil {
+		t.Errorf("expected error for non-existent model folder, got nil")
+	}
+
+	// Case 2: Sizes of the files in nested folders are summed up
+	modelName := "test-model"
+	subPath := filepath.Join(tempDir, modelName, "sub")
+	if err := os.MkdirAll(subPath, 0o755); err != nil { //nolint:gosec // test directory permissions are not security-sensitive
+		t.Fatalf("failed to create model folder: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, modelName, "model.bin"), make([]byte, 100), 0o644); err != nil { //nolint
+		t.Fatalf("failed to create file in model folder: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(subPath, "config.json"), make([]byte, 20), 0o644); err != nil { //nolint
+		t.Fatalf("failed to create file in model folder: %v", err)
+	}
+	size, err := helper.getModelSize(modelName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if size != 120 {
+		t.Errorf("expected model size 120, got %d", size)
+	}
+}
+
+func TestFileSystemHelper_linkModelFolder(t *testing.T) {
+	tempDir := t.TempDir()
+	helper := NewFileSystemHelper(tempDir)
+	modelName := "test-model"
+
+	// Case 1: Model folder does not exist
+	linked, err := helper.getLinkedModelFolder(modelName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if linked != "" {
+		t.Errorf("expected no linked folder, got %q", linked)
+	}
+
+	// Case 2: Model folder downloaded before the refresh policy is replaced by a link
+	if err := os.Mkdir(filepath.Join(tempDir, modelName), 0o755); err != nil { //nolint:gosec // test directory permissions are not security-sensitive
+		t.Fatalf("failed to create model folder: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, modelName, "model.bin"), []byte("legacy"), 0o644); err != nil { //nolint
+		t.Fatalf("failed to create file in model folder: %v", err)
+	}
+	if linkTime, err := helper.getModelLinkTime(modelName); err != nil || !linkTime.IsZero() {
+		t.Errorf("expected no link time for a directory, got %v, err: %v", linkTime, err)
+	}
+	if linked, err = helper.getLinkedModelFolder(modelName); err != nil || linked != "" {
+		t.Errorf("expected no linked folder for a directory, got %q, err: %v", linked, err)
+	}
+	for _, revision := range []string{"test-model-rev1", "test-model-rev2"} {
+		if err := os.Mkdir(filepath.Join(tempDir, revision), 0o755); err != nil { //nolint:gosec // test directory permissions are not security-sensitive
+			t.Fatalf("failed to create revision folder: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(tempDir, revision, "model.bin"), []byte(revision), 0o644); err != nil { //nolint
+			t.Fatalf("failed to create file in revision folder: %v", err)
+		}
+	}
+	if err := helper.linkModelFolder(modelName, "test-model-rev1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if linked, err = helper.getLinkedModelFolder(modelName); err != nil || linked != "test-model-rev1" {
+		t.Errorf("expected linked folder test-model-rev1, got %q, err: %v", linked, err)
+	}
+	// The folder is moved to a revision folder rather than removed, pods may still mount it
+	if data, err := os.ReadFile(filepath.Join(tempDir, modelName+legacyRevisionSuffix, "model.bin")); err != nil || string(data) != "legacy" {
+		t.Errorf("expected model folder to be moved to the legacy revision folder, got %q, err: %v", data, err)
+	}
+	if linkTime, err := helper.getModelLinkTime(modelName); err != nil || linkTime.IsZero() {
+		t.Errorf("expected link time of the model folder, got %v, err: %v", linkTime, err)
+	}
+
+	// Case 3: Link is switched to the new revision
+	if err := helper.linkModelFolder(modelName, "test-model-rev2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if linked, err = helper.getLinkedModelFolder(modelName); err != nil || linked != "test-model-rev2" {
+		t.Errorf("expected linked folder test-model-rev2, got %q, err: %v", linked, err)
+	}
+	data, err := os.ReadFile(filepath.Join(tempDir, modelName, "model.bin"))
+	if err != nil {
+		t.Fatalf("failed to read file through the link: %v", err)
+	}
+	if string(data) != "test-model-rev2" {
+		t.Errorf("expected file of the new revision, got %q", data)
+	}
+	exists, err := helper.hasModelFolder(modelName)
+	if err != nil || !exists {
+		t.Errorf("expected linked model folder to exist, got exists=%v, err: %v", exists, err)
+	}
+	size, err := helper.getModelSize(modelName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if size != int64(len("test-model-rev2")) {
+		t.Errorf("expected size of the linked revision, got %d", size)
+	}
+
+	// Case 4: Removing the model folder only removes the link
+	if err := helper.removeModel(modelName); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tempDir, "test-model-rev2", "model.bin")); err != nil {
+		t.Errorf("expected revision folder to be kept, statErr: %v", err)
+	}
+}
+
 func TestFileSystemHelper_ensureModelRootFolderExists(t *testing.T) {
 	// Case 1: Folder does not exist, should be created
 	tempDir := t.TempDir()
@@ -0,0 +1,361 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/controller/v1alpha1/localmodel/revision"
+)
+
+const (
+	// PeerDownloadCommand is the argument of the local model agent binary to download a model from a peer node
+	PeerDownloadCommand       = "peer-download"
+	PeerDownloadContainerName = "kserve-localmodel-peer-download"
+	DefaultPeerDownloadPort   = 8082
+	// Label of the jobs downloading a model from a peer node, and the annotation with the peer node name
+	DownloadSourceLabel = "downloadSource"
+	PeerNodeAnnotation  = "peerNode"
+	peerDownloadSource  = "peer"
+)
+
+var defaultPeerDownloadImage = "kserve/kserve-localmodelnode-agent:latest"
+
+// downloadPeer is a node of the node group serving a downloaded model
+type downloadPeer struct {
+	node string
+	url  string
+}
+
+// isPeerJob returns whether the job downloads the model from a peer node
+func isPeerJob(job *batchv1.Job) bool {
+	return job.Labels[DownloadSourceLabel] == peerDownloadSource
+}
+
+// getJobModelSource returns where the job downloaded the model from
+func getJobModelSource(job *batchv1.Job) v1alpha1.ModelSource {
+	if isPeerJob(job) {
+		return v1alpha1.ModelSource{Type: v1alpha1.PeerModelSource, Node: job.Annotations[PeerNodeAnnotation]}
+	}
+	return v1alpha1.ModelSource{Type: v1alpha1.OriginModelSource}
+}
+
+// getPreviousModelSource returns the source recorded in the status for a model whose download job may have been
+// cleaned up, or nil if it is unknown
+func getPreviousModelSource(localModelNode *v1alpha1.LocalModelNode, statusKey string) *v1alpha1.ModelSource {
+	if source, ok := localModelNode.Status.ModelSources[statusKey]; ok {
+		return &source
+	}
+	return nil
+}
+
+// getServedFolders returns the model folders of a LocalModelNode which are fully downloaded,
+// including the content-addressed folders of the revisions served for model caches with a refresh policy
+func getServedFolders(localModelNode *v1alpha1.LocalModelNode) map[string]struct{} {
+	folders := map[string]struct{}{}
+	for _, modelInfo := range localModelNode.Spec.LocalModels {
+		statusKey := modelInfo.GetStatusKey()
+		if localModelNode.Status.ModelStatus[statusKey] != v1alpha1.ModelDownloaded {
+			continue
+		}
+		folders[v1alpha1.GetStorageKey(modelInfo.SourceModelUri)] = struct{}{}
+		if servedRevision := localModelNode.Status.ModelRevisions[statusKey]; servedRevision != "" {
+			folders[v1alpha1.GetRevisionStorageKey(modelInfo.SourceModelUri, servedRevision)] = struct{}{}
+		}
+	}
+	return folders
+}
+
+// findPeer returns a ready peer node of the same node group serving the folder of the model, or nil if peer
+// download is disabled or no peer is ready. Peers are only used for sources publishing the checksums of their
+// files, so the model downloaded from a peer is verified against the source.
+func (c *LocalModelNodeReconciler) findPeer(ctx context.Context, localModelNode *v1alpha1.LocalModelNode, modelInfo v1alpha1.LocalModelInfo, config *v1beta1.LocalModelPeerDownloadConfig) (*downloadPeer, error) {
+	if config == nil || !config.Enabled || !revision.SupportsFileListing(modelInfo.SourceModelUri) {
+		return nil, nil
+	}
+	folder := getModelFolderName(modelInfo)
+	localModelNodes := &v1alpha1.LocalModelNodeList{}
+	if err := c.List(ctx, localModelNodes); err != nil {
+		return nil, err
+	}
+	peers := []downloadPeer{}
+	for _, peer := range localModelNodes.Items {
+		if peer.Name == localModelNode.Name || peer.Status.PeerAddress == "" {
+			continue
+		}
+		sameNodeGroup := slices.ContainsFunc(peer.Spec.LocalModels, func(peerModel v1alpha1.LocalModelInfo) bool {
+			return peerModel.NodeGroup == modelInfo.NodeGroup
+		})
+		if _, ok := getServedFolders(&peer)[folder]; !ok || !sameNodeGroup {
+			continue
+		}
+		peers = append(peers, downloadPeer{
+			node: peer.Name,
+			url:  fmt.Sprintf("https://%s/models/%s/", peer.Status.PeerAddress, folder),
+		})
+	}
+	if len(peers) == 0 {
+		return nil, nil
+	}
+	// Spread the nodes downloading the model over the peers
+	slices.SortFunc(peers, func(a, b downloadPeer) int { return strings.Compare(a.node, b.node) })
+	h := fnv.New32a()
+	h.Write([]byte(localModelNode.Name))
+	return &peers[h.Sum32()%uint32(len(peers))], nil //nolint:gosec // G115: the number of peers fits in uint32
+}
+
+// getPeerDownloadContainer returns the container of a job downloading the model from the peer node with the local
+// model agent image. Storage credentials are injected to the container to list the checksums of the source files.
+func getPeerDownloadContainer(modelInfo v1alpha1.LocalModelInfo, peer *downloadPeer, config *v1beta1.LocalModelPeerDownloadConfig) *corev1.Container {
+	image := defaultPeerDownloadImage
+	if config.Image != "" {
+		image = config.Image
+	}
+	args := []string{
+		PeerDownloadCommand, "--peer", peer.url, "--source", modelInfo.SourceModelUri, "--dest", MountPath,
+		"--credentials", PeerCredentialsMountPath,
+	}
+	if modelInfo.Revision != "" {
+		args = append(args, "--revision", modelInfo.Revision)
+	}
+	return &corev1.Container{
+		Name:                     PeerDownloadContainerName,
+		Image:                    image,
+		Command:                  []string{"/manager"},
+		Args:                     args,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+}
+
+// launchDownloadJob launches a job downloading the model from a ready peer node of the node group,
+// or from the source model URI if peer download is disabled or no peer is ready
+func (c *LocalModelNodeReconciler) launchDownloadJob(ctx context.Context, localModelNode *v1alpha1.LocalModelNode, modelInfo v1alpha1.LocalModelInfo, retries int32) (*batchv1.Job, error) {
+	peer, err := c.findPeer(ctx, localModelNode, modelInfo, peerDownloadConfig)
+	if err != nil {
+		c.Log.Error(err, "Failed to find peer node, downloading from the source", "model", modelInfo.ModelName)
+		peer = nil
+	}
+	return c.launchJob(ctx, *localModelNode, modelInfo, peer, retries)
+}
+
+// fallBackToOrigin launches a job downloading the model from the source model URI once the job downloading the
+// model from a peer node failed
+func (c *LocalModelNodeReconciler) fallBackToOrigin(ctx context.Context, localModelNode *v1alpha1.LocalModelNode, modelInfo v1alpha1.LocalModelInfo, job *batchv1.Job) (*batchv1.Job, error) {
+	if !isPeerJob(job) || job.Status.Failed == 0 {
+		return job, nil
+	}
+	c.Log.Info("Download from peer failed, downloading from the source", "model", modelInfo.ModelName,
+		"peer", job.Annotations[PeerNodeAnnotation], "job", job.Name)
+	return c.launchJob(ctx, *localModelNode, modelInfo, nil, getJobRetries(job))
+}
+
+// PeerServer serves the downloaded models of the node over TLS to the download jobs of the peer nodes
+// authenticated by the token of the peer secret
+type PeerServer struct {
+	Client      client.Client
+	Log         logr.Logger
+	Port        int32
+	Credentials *PeerCredentials
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, every agent serves its models
+func (s *PeerServer) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the files of the downloaded model folders at /models/<folder>/<path> until the context is done
+func (s *PeerServer) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /models/{folder}/{path...}", s.serveModelFile)
+	tlsConfig, err := s.Credentials.TLSConfig()
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Addr:              ":" + strconv.Itoa(int(s.Port)),
+		Handler:           s.Credentials.authenticate(mux),
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	s.Log.Info("Serving downloaded models to peer nodes", "port", s.Port)
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *PeerServer) serveModelFile(w http.ResponseWriter, r *http.Request) {
+	folder := r.PathValue("folder")
+	localModelNode := &v1alpha1.LocalModelNode{}
+	if err := s.Client.Get(r.Context(), types.NamespacedName{Name: nodeName}, localModelNode); err != nil {
+		http.Error(w, "local model node not found", http.StatusServiceUnavailable)
+		return
+	}
+	// Only fully downloaded models are served
+	if _, ok := getServedFolders(localModelNode)[folder]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	// The root confines the requested path to the model folder
+	root, err := os.OpenRoot(filepath.Join(modelsRootFolder, folder))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer root.Close()
+	file, err := root.Open(r.PathValue("path"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		http.NotFound(w, r)
+		return
+	}
+	http.ServeContent(w, r, info.Name(), info.ModTime(), file)
+}
+
+// PeerDownloader downloads the files of a model from a peer node and verifies them against the checksums
+// published by the source of the model
+type PeerDownloader struct {
+	// HTTPClient trusts the certificate of the peer servers
+	HTTPClient *http.Client
+	// Token authenticates the download to the peer servers
+	Token    string
+	Resolver *revision.SourceResolver
+	Log      logr.Logger
+	// File to report the download progress to, from the environment of the download job
+	ProgressFile string
+}
+
+// Download downloads the files of the source model from the peer URL into the destination folder
+func (d *PeerDownloader) Download(ctx context.Context, peerURL string, sourceModelUri string, sourceRevision string, dest string) error {
+	files, err := d.Resolver.ListFiles(ctx, sourceModelUri, sourceRevision)
+	if err != nil {
+		return fmt.Errorf("failed to list the files of %s: %w", sourceModelUri, err)
+	}
+	// Files that cannot be verified are not trusted from a peer, the model is downloaded from the source instead
+	for _, file := range files {
+		if !file.HasChecksum() {
+			return fmt.Errorf("file %s of %s has no checksum to verify a peer download against", file.Path, sourceModelUri)
+		}
+	}
+	d.Log.Info("Downloading model from peer", "peer", peerURL, "sourceModelUri", sourceModelUri, "files", len(files))
+	root, err := os.OpenRoot(dest)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+	var totalBytes int64
+	for _, file := range files {
+		totalBytes += file.Size
+	}
+	progress := NewProgressReporter(d.ProgressFile, totalBytes)
+	for _, file := range files {
+		if err := d.downloadFile(ctx, root, peerURL, file, progress); err != nil {
+			return err
+		}
+	}
+	if err := progress.Flush(); err != nil {
+		d.Log.Error(err, "Failed to report download progress")
+	}
+	d.Log.Info("Downloaded model from peer", "peer", peerURL, "sourceModelUri", sourceModelUri)
+	return nil
+}
+
+func (d *PeerDownloader) downloadFile(ctx context.Context, root *os.Root, peerURL string, file revision.SourceFile, progress *ProgressReporter) error {
+	segments := strings.Split(file.Path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, peerURL+strings.Join(segments, "/"), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+d.Token)
+	resp, err := d.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download %s from peer: %w", file.Path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s from peer: %s", file.Path, resp.Status)
+	}
+
+	if dir := filepath.Dir(file.Path); dir != "." {
+		if err := root.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: local model cache must be readable by model server running as a different UID
+			return err
+		}
+	}
+	out, err := root.OpenFile(file.Path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644) //nolint:gosec // G302: local model cache must be readable by model server running as a different UID
+	if err != nil {
+		return err
+	}
+	verifier := revision.NewFileVerifier(file)
+	if _, err := io.Copy(io.MultiWriter(out, verifier, progress), resp.Body); err != nil {
+		_ = out.Close()
+		return fmt.Errorf("failed to download %s from peer: %w", file.Path, err)
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	if err := verifier.Verify(); err != nil {
+		// Do not leave a corrupted file for the download from the source
+		_ = root.Remove(file.Path)
+		return fmt.Errorf("file downloaded from peer does not match the source: %w", err)
+	}
+	return nil
+}
+
+// GetPeerAddress returns the address of the agent serving the downloaded models, from the pod IP passed as an
+// environment variable via the downward API
+func GetPeerAddress(port int32) string {
+	podIP := os.Getenv("POD_IP")
+	if podIP == "" {
+		return ""
+	}
+	return net.JoinHostPort(podIP, strconv.Itoa(int(port)))
+}
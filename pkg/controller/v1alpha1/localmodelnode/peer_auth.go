@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelnode
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+)
+
+const (
+	// DefaultPeerSecretName is the secret of the job namespace with the token and the TLS certificate shared by the
+	// local model agents serving their models and the jobs downloading from them
+	DefaultPeerSecretName = "kserve-localmodel-peer"
+	// PeerTokenKey is the key of the token authenticating the download jobs in the peer secret
+	PeerTokenKey = "token"
+	// PeerServerName is the name the TLS certificate of the local model agents is issued for, the peers are
+	// reached by their pod IPs
+	PeerServerName = "kserve-localmodelnode-agent"
+	// PeerCredentialsMountPath is where the token and the certificate are mounted in the peer download jobs
+	PeerCredentialsMountPath  = "/var/run/secrets/kserve-localmodel-peer"
+	peerCredentialsVolumeName = "peer-credentials"
+	peerCertificateValidity   = 10 * 365 * 24 * time.Hour
+	peerSecretPollInterval    = 5 * time.Second
+	// PeerSecretTimeout is how long the agents wait for the local model controller to create the peer secret
+	PeerSecretTimeout = 5 * time.Minute
+)
+
+// PeerCredentials are the token and the TLS certificate of the peer server
+type PeerCredentials struct {
+	Token       string
+	Certificate []byte
+	Key         []byte
+}
+
+// GetPeerSecretName returns the name of the peer secret of the peer download config
+func GetPeerSecretName(config *v1beta1.LocalModelPeerDownloadConfig) string {
+	if config != nil && config.SecretName != "" {
+		return config.SecretName
+	}
+	return DefaultPeerSecretName
+}
+
+// EnsurePeerSecret creates the peer secret with a random token and a self-signed certificate if it does not exist.
+// It is created by the local model controller, so the agents of the nodes only read it.
+func EnsurePeerSecret(ctx context.Context, clientset kubernetes.Interface, namespace string, name string) error {
+	secrets := clientset.CoreV1().Secrets(namespace)
+	_, err := secrets.Get(ctx, name, metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+	secret, err := newPeerSecret(namespace, name)
+	if err != nil {
+		return err
+	}
+	_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+	return client.IgnoreAlreadyExists(err)
+}
+
+// GetPeerCredentials returns the credentials of the peer secret, waiting for the local model controller to create
+// it until the timeout.
+func GetPeerCredentials(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, timeout time.Duration) (*PeerCredentials, error) {
+	var secret *corev1.Secret
+	err := wait.PollUntilContextTimeout(ctx, peerSecretPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		var err error
+		secret, err = clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get peer secret %s/%s: %w", namespace, name, err)
+	}
+	credentials := &PeerCredentials{
+		Token:       string(secret.Data[PeerTokenKey]),
+		Certificate: secret.Data[corev1.TLSCertKey],
+		Key:         secret.Data[corev1.TLSPrivateKeyKey],
+	}
+	if credentials.Token == "" || credentials.Certificate == nil || credentials.Key == nil {
+		return nil, errors.New("peer secret " + namespace + "/" + name + " must have a token, a tls.crt and a tls.key")
+	}
+	return credentials, nil
+}
+
+func newPeerSecret(namespace string, name string) (*corev1.Secret, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: PeerServerName},
+		DNSNames:              []string{PeerServerName},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(peerCertificateValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			PeerTokenKey:            []byte(hex.EncodeToString(token)),
+			corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}),
+			corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}),
+		},
+	}, nil
+}
+
+// TLSConfig returns the TLS config of the peer server
+func (p *PeerCredentials) TLSConfig() (*tls.Config, error) {
+	certificate, err := tls.X509KeyPair(p.Certificate, p.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// authenticate rejects the requests without the token of the peer secret
+func (p *PeerCredentials) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(p.Token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// LoadPeerClientCredentials reads the token and the certificate mounted in the peer download jobs
+func LoadPeerClientCredentials(dir string) (*PeerCredentials, error) {
+	token, err := os.ReadFile(filepath.Join(dir, PeerTokenKey))
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := os.ReadFile(filepath.Join(dir, corev1.TLSCertKey))
+	if err != nil {
+		return nil, err
+	}
+	return &PeerCredentials{Token: strings.TrimSpace(string(token)), Certificate: certificate}, nil
+}
+
+// HTTPClient returns a client trusting only the certificate of the peer servers
+func (p *PeerCredentials) HTTPClient() (*http.Client, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(p.Certificate) {
+		return nil, errors.New("invalid peer certificate")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    pool,
+		ServerName: PeerServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+// getPeerCredentialsVolume returns the volume of the token and the certificate of the peer download jobs, the
+// private key of the peer servers is not mounted
+func getPeerCredentialsVolume(config *v1beta1.LocalModelPeerDownloadConfig) (corev1.Volume, corev1.VolumeMount) {
+	volume := corev1.Volume{
+		Name: peerCredentialsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: GetPeerSecretName(config),
+				Items: []corev1.KeyToPath{
+					{Key: PeerTokenKey, Path: PeerTokenKey},
+					{Key: corev1.TLSCertKey, Path: corev1.TLSCertKey},
+				},
+			},
+		},
+	}
+	return volume, corev1.VolumeMount{Name: peerCredentialsVolumeName, MountPath: PeerCredentialsMountPath, ReadOnly: true}
+}
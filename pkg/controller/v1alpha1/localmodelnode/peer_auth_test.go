@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelnode
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPeerCredentials(t *testing.T) {
+	ctx := context.Background()
+	clientset := fake.NewSimpleClientset()
+	// The agents wait for the local model controller to create the secret
+	if _, err := GetPeerCredentials(ctx, clientset, "kserve-localmodel-jobs", DefaultPeerSecretName, time.Millisecond); err == nil {
+		t.Fatal("expected an error without the peer secret")
+	}
+	if err := EnsurePeerSecret(ctx, clientset, "kserve-localmodel-jobs", DefaultPeerSecretName); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	credentials, err := GetPeerCredentials(ctx, clientset, "kserve-localmodel-jobs", DefaultPeerSecretName, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The existing secret is kept
+	if err := EnsurePeerSecret(ctx, clientset, "kserve-localmodel-jobs", DefaultPeerSecretName); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The agents of the other nodes share the credentials of the secret
+	shared, err := GetPeerCredentials(ctx, clientset, "kserve-localmodel-jobs", DefaultPeerSecretName, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if shared.Token != credentials.Token || string(shared.Certificate) != string(credentials.Certificate) {
+		t.Fatal("expected the agents to share the peer credentials")
+	}
+
+	tlsConfig, err := credentials.TLSConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := httptest.NewUnstartedServer(credentials.authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+	server.TLS = tlsConfig
+	server.StartTLS()
+	defer server.Close()
+
+	// The download jobs only get the token and the certificate
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, PeerTokenKey), []byte(credentials.Token), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, corev1.TLSCertKey), credentials.Certificate, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	clientCredentials, err := LoadPeerClientCredentials(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client, err := clientCredentials.HTTPClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		token      string
+		wantStatus int
+	}{
+		"valid token":   {token: clientCredentials.Token, wantStatus: http.StatusOK},
+		"invalid token": {token: "invalid", wantStatus: http.StatusUnauthorized},
+		"no token":      {wantStatus: http.StatusUnauthorized},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/models/folder/config.json", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+
+	// Plain HTTP clients and clients not trusting the peer certificate cannot reach the server
+	if resp, err := http.DefaultClient.Get(server.URL); err == nil {
+		resp.Body.Close()
+		t.Error("expected the certificate of the peer server to be untrusted by the default client")
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelnode
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/controller/v1alpha1/localmodel/revision"
+)
+
+func TestPeerDownloader(t *testing.T) {
+	files := map[string]string{
+		"config.json":          `{"model_type": "llama"}`,
+		"weights/model.bin":    "weights",
+		"tokenizer/vocab.json": `{"a": 1}`,
+	}
+	sha256Hex := func(content string) string {
+		sum := sha256.Sum256([]byte(content))
+		return hex.EncodeToString(sum[:])
+	}
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/models/org/llama/tree/abc123" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `[{"type": "directory", "path": "weights"},
+			{"type": "file", "path": "config.json", "size": %d, "lfs": {"oid": %q}},
+			{"type": "file", "path": "weights/model.bin", "size": %d, "lfs": {"oid": %q}},
+			{"type": "file", "path": "tokenizer/vocab.json", "size": %d, "lfs": {"oid": %q}}]`,
+			len(files["config.json"]), sha256Hex(files["config.json"]),
+			len(files["weights/model.bin"]), sha256Hex(files["weights/model.bin"]),
+			len(files["tokenizer/vocab.json"]), sha256Hex(files["tokenizer/vocab.json"]))
+	}))
+	defer source.Close()
+
+	tests := map[string]struct {
+		peerFiles map[string]string
+		wantErr   bool
+	}{
+		"files match the source": {
+			peerFiles: files,
+		},
+		"file missing on peer": {
+			peerFiles: map[string]string{"config.json": files["config.json"]},
+			wantErr:   true,
+		},
+		"corrupted file on peer": {
+			peerFiles: map[string]string{
+				"config.json":          files["config.json"],
+				"weights/model.bin":    "corrupt",
+				"tokenizer/vocab.json": files["tokenizer/vocab.json"],
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			peer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer peer-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				content, ok := tt.peerFiles[r.URL.Path[len("/models/folder/"):]]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprint(w, content)
+			}))
+			defer peer.Close()
+			dest := t.TempDir()
+			downloader := &PeerDownloader{
+				HTTPClient: http.DefaultClient,
+				Token:      "peer-token",
+				Resolver:   &revision.SourceResolver{HTTPClient: http.DefaultClient, HuggingFaceEndpoint: source.URL},
+				Log:        logr.Discard(),
+			}
+
+			err := downloader.Download(context.Background(), peer.URL+"/models/folder/", "hf://org/llama", "abc123", dest)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if _, statErr := os.Stat(filepath.Join(dest, "weights", "model.bin")); statErr == nil && tt.peerFiles["weights/model.bin"] != files["weights/model.bin"] {
+					t.Error("corrupted file was not removed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for path, content := range files {
+				data, err := os.ReadFile(filepath.Join(dest, path))
+				if err != nil {
+					t.Fatalf("failed to read %s: %v", path, err)
+				}
+				if string(data) != content {
+					t.Errorf("content of %s is %q, expected %q", path, data, content)
+				}
+			}
+		})
+	}
+}
+
+func TestGetServedFolders(t *testing.T) {
+	downloaded := v1alpha1.LocalModelInfo{ModelName: "downloaded", SourceModelUri: "hf://org/downloaded"}
+	refreshed := v1alpha1.LocalModelInfo{ModelName: "refreshed", SourceModelUri: "hf://org/refreshed", Revision: "def456"}
+	downloading := v1alpha1.LocalModelInfo{ModelName: "downloading", SourceModelUri: "hf://org/downloading"}
+	localModelNode := &v1alpha1.LocalModelNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "node"},
+		Spec: v1alpha1.LocalModelNodeSpec{
+			LocalModels: []v1alpha1.LocalModelInfo{downloaded, refreshed, downloading},
+		},
+		Status: v1alpha1.LocalModelNodeStatus{
+			ModelStatus: map[string]v1alpha1.ModelStatus{
+				"downloaded":  v1alpha1.ModelDownloaded,
+				"refreshed":   v1alpha1.ModelDownloaded,
+				"downloading": v1alpha1.ModelDownloading,
+			},
+			// The previous revision is served until the new revision is downloaded
+			ModelRevisions: map[string]string{"refreshed": "abc123"},
+		},
+	}
+
+	folders := getServedFolders(localModelNode)
+	expected := []string{
+		v1alpha1.GetStorageKey(downloaded.SourceModelUri),
+		v1alpha1.GetStorageKey(refreshed.SourceModelUri),
+		v1alpha1.GetRevisionStorageKey(refreshed.SourceModelUri, "abc123"),
+	}
+	if len(folders) != len(expected) {
+		t.Fatalf("served folders are %v, expected %v", folders, expected)
+	}
+	for _, folder := range expected {
+		if _, ok := folders[folder]; !ok {
+			t.Errorf("folder %s is not served", folder)
+		}
+	}
+}
+
+func TestGetJobModelSource(t *testing.T) {
+	originJob := &batchv1.Job{}
+	if source := getJobModelSource(originJob); source.Type != v1alpha1.OriginModelSource || source.Node != "" {
+		t.Errorf("source of origin job is %v", source)
+	}
+	peerJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+		Labels:      map[string]string{DownloadSourceLabel: peerDownloadSource},
+		Annotations: map[string]string{PeerNodeAnnotation: "node-2"},
+	}}
+	if source := getJobModelSource(peerJob); source.Type != v1alpha1.PeerModelSource || source.Node != "node-2" {
+		t.Errorf("source of peer job is %v", source)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelnode
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+// legacyRevisionSuffix is the suffix of the revision folder a model folder downloaded before the model cache had a
+// refresh policy is moved to when it is replaced by a link to a revision folder
+const legacyRevisionSuffix = "-unversioned"
+
+// downloadRevision downloads the revision of a model cache with a refresh policy into the content-addressed folder
+// of the revision. The folder of the storage key, which is mounted by the inference services, is switched to link
+// to the new revision only once its download job succeeded, so the previous revision is served until then.
+// Returns the status of the model, the revision served on the node and where it was downloaded from if it is known
+// from the download job.
+func (c *LocalModelNodeReconciler) downloadRevision(ctx context.Context, localModelNode *v1alpha1.LocalModelNode, modelInfo v1alpha1.LocalModelInfo) (v1alpha1.ModelStatus, string, *v1alpha1.ModelSource, error) {
+	statusKey := modelInfo.GetStatusKey()
+	storageKey := v1alpha1.GetStorageKey(modelInfo.SourceModelUri)
+	revisionKey := getModelFolderName(modelInfo)
+
+	linkedFolder, err := fsHelper.getLinkedModelFolder(storageKey)
+	if err != nil {
+		c.Log.Error(err, "Failed to read model folder link", "model", modelInfo.ModelName, "storageKey", storageKey)
+		return "", "", nil, err
+	}
+	serving, err := fsHelper.hasModelFolder(storageKey)
+	if err != nil {
+		c.Log.Error(err, "Failed to check model folder", "model", modelInfo.ModelName, "storageKey", storageKey)
+		return "", "", nil, err
+	}
+	if serving && linkedFolder == revisionKey {
+		return v1alpha1.ModelDownloaded, modelInfo.Revision, nil, nil
+	}
+	// Revision served before the switch, unknown for folders downloaded before the model cache had a refresh policy
+	servedRevision := ""
+	if serving && linkedFolder != "" {
+		servedRevision = localModelNode.Status.ModelRevisions[statusKey]
+	}
+
+	job, jobCount, err := c.getLatestJob(ctx, modelInfo, nodeName)
+	if err != nil {
+		c.Log.Error(err, "Failed to getLatestJob", "model", modelInfo.ModelName, "node", nodeName)
+		return "", "", nil, err
+	}
+	revisionExists, err := fsHelper.hasModelFolder(revisionKey)
+	if err != nil {
+		c.Log.Error(err, "Failed to check revision folder", "model", modelInfo.ModelName, "folder", revisionKey)
+		return "", "", nil, err
+	}
+	// Recreate the job if the revision folder was removed after the download, with the same protection as for
+	// models without a refresh policy to not create more than 2 jobs.
+	if job == nil || (job.Status.Succeeded > 0 && !revisionExists && jobCount < 2) {
+		c.Log.Info("Downloading model revision", "model", modelInfo.ModelName, "revision", modelInfo.Revision, "folder", revisionKey)
+		job, err = c.launchDownloadJob(ctx, localModelNode, modelInfo, 0)
+	} else {
+		job, err = c.relaunchFailedJob(ctx, localModelNode, modelInfo, job)
+	}
+	if err != nil {
+		c.Log.Error(err, "Failed to create job", "model", modelInfo.ModelName, "node", nodeName)
+		return "", "", nil, err
+	}
+
+	status := getModelStatusFromJobStatus(job.Status)
+	if status == v1alpha1.ModelDownloaded && !revisionExists {
+		c.Log.Info("Revision folder not found after download", "model", modelInfo.ModelName, "folder", revisionKey)
+		status = v1alpha1.ModelDownloadError
+	}
+	if status == v1alpha1.ModelDownloaded {
+		if err := fsHelper.linkModelFolder(storageKey, revisionKey); err != nil {
+			c.Log.Error(err, "Failed to switch model folder to revision", "model", modelInfo.ModelName, "storageKey", storageKey, "folder", revisionKey)
+			return "", "", nil, err
+		}
+		c.Log.Info("Switched model to revision", "model", modelInfo.ModelName, "storageKey", storageKey,
+			"previous", servedRevision, "revision", modelInfo.Revision)
+		source := getJobModelSource(job)
+		return v1alpha1.ModelDownloaded, modelInfo.Revision, &source, nil
+	}
+	if serving {
+		c.Log.Info("Serving previous revision until the new revision is downloaded", "model", modelInfo.ModelName,
+			"revision", modelInfo.Revision, "download status", status)
+		return v1alpha1.ModelDownloaded, servedRevision, nil, nil
+	}
+	source := getJobModelSource(job)
+	return status, "", &source, nil
+}
+
+// getPreviousRevisionsInUse returns the storage keys of the models of which previous revisions may still be mounted
+// by the pods of the node. The kubelet resolves the link of the model folder when a pod starts, so the pods created
+// before the model folder was linked to the served revision keep mounting a previous revision.
+func (c *LocalModelNodeReconciler) getPreviousRevisionsInUse(ctx context.Context, linkTimes map[string]time.Time) (map[string]struct{}, error) {
+	if len(linkTimes) == 0 {
+		return nil, nil
+	}
+	pods, err := c.Clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: "spec.nodeName=" + nodeName,
+		LabelSelector: constants.LocalModelLabel,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return getStorageKeysMountedBefore(pods.Items, linkTimes), nil
+}
+
+// getStorageKeysMountedBefore returns the storage keys of the models mounted by running pods created before the
+// model folder was linked
+func getStorageKeysMountedBefore(pods []corev1.Pod, linkTimes map[string]time.Time) map[string]struct{} {
+	storageKeys := map[string]struct{}{}
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		sourceUri, ok := pod.Annotations[constants.LocalModelSourceUriAnnotationKey]
+		if !ok {
+			continue
+		}
+		storageKey := v1alpha1.GetStorageKey(sourceUri)
+		if linkTime, ok := linkTimes[storageKey]; ok && pod.CreationTimestamp.Time.Before(linkTime) {
+			storageKeys[storageKey] = struct{}{}
+		}
+	}
+	return storageKeys
+}
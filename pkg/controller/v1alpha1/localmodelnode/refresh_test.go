@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelnode
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+func TestGetStorageKeysMountedBefore(t *testing.T) {
+	linkTime := time.Now()
+	pod := func(name string, sourceUri string, created time.Time, phase corev1.PodPhase) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Annotations:       map[string]string{constants.LocalModelSourceUriAnnotationKey: sourceUri},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	linkTimes := map[string]time.Time{
+		v1alpha1.GetStorageKey("hf://org/old-pod"):      linkTime,
+		v1alpha1.GetStorageKey("hf://org/new-pod"):      linkTime,
+		v1alpha1.GetStorageKey("hf://org/finished-pod"): linkTime,
+	}
+	pods := []corev1.Pod{
+		pod("old", "hf://org/old-pod", linkTime.Add(-time.Hour), corev1.PodRunning),
+		pod("new", "hf://org/new-pod", linkTime.Add(time.Hour), corev1.PodRunning),
+		pod("finished", "hf://org/finished-pod", linkTime.Add(-time.Hour), corev1.PodSucceeded),
+		pod("not-refreshed", "hf://org/not-refreshed", linkTime.Add(-time.Hour), corev1.PodRunning),
+	}
+
+	storageKeys := getStorageKeysMountedBefore(pods, linkTimes)
+	if len(storageKeys) != 1 {
+		t.Fatalf("expected only the model of the pod created before the link, got %v", storageKeys)
+	}
+	if _, ok := storageKeys[v1alpha1.GetStorageKey("hf://org/old-pod")]; !ok {
+		t.Errorf("expected previous revisions of hf://org/old-pod to be in use, got %v", storageKeys)
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=servingruntimes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=servingruntimes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=clusterservingruntimes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=clusterservingruntimes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices,verbs=get;list;watch
+package servingruntime
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+)
+
+// ServingRuntimeReconciler reports the inference services using a ServingRuntime, the validity of its spec and its
+// compatibility with the inference services in its status
+type ServingRuntimeReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+func (r *ServingRuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	servingRuntime := &v1alpha1.ServingRuntime{}
+	if err := r.Get(ctx, req.NamespacedName, servingRuntime); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Runtimes of the same namespace are selected among each other
+	runtimes := &v1alpha1.ServingRuntimeList{}
+	if err := r.List(ctx, runtimes, client.InNamespace(servingRuntime.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+	otherRuntimes := []v1alpha1.SupportedRuntime{}
+	for _, other := range runtimes.Items {
+		if other.Name != servingRuntime.Name {
+			otherRuntimes = append(otherRuntimes, v1alpha1.SupportedRuntime{Name: other.Name, Spec: other.Spec})
+		}
+	}
+	isvcs := &v1beta1.InferenceServiceList{}
+	if err := r.List(ctx, isvcs, client.InNamespace(servingRuntime.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+	usingIsvcs := []v1beta1.InferenceService{}
+	for _, isvc := range isvcs.Items {
+		if usesServingRuntime(&isvc, servingRuntime.Name) {
+			usingIsvcs = append(usingIsvcs, isvc)
+		}
+	}
+
+	status := computeStatus(servingRuntime.Name, &servingRuntime.Spec, &servingRuntime.Status, otherRuntimes, usingIsvcs)
+	status.ObservedGeneration = servingRuntime.Generation
+	if equality.Semantic.DeepEqual(servingRuntime.Status, *status) {
+		return reconcile.Result{}, nil
+	}
+	servingRuntime.Status = *status
+	if err := r.Status().Update(ctx, servingRuntime); err != nil {
+		r.Log.Error(err, "Failed to update ServingRuntime status", "name", servingRuntime.Name, "namespace", servingRuntime.Namespace)
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
+// usesServingRuntime returns whether the inference service uses the ServingRuntime of its namespace, selected
+// automatically or set in its spec
+func usesServingRuntime(isvc *v1beta1.InferenceService, name string) bool {
+	if isvc.Status.ServingRuntimeName != "" || isvc.Status.ClusterServingRuntimeName != "" {
+		return isvc.Status.ServingRuntimeName == name
+	}
+	// The runtime was not resolved yet or the inference service failed to use it
+	model := isvc.Spec.Predictor.Model
+	return model != nil && model.Runtime != nil && *model.Runtime == name
+}
+
+func (r *ServingRuntimeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ServingRuntime{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("servingruntime").
+		// The priority conflicts of a runtime change with the other runtimes of the namespace
+		Watches(&v1alpha1.ServingRuntime{}, handler.EnqueueRequestsFromMapFunc(r.servingRuntimesOfNamespace),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&v1beta1.InferenceService{}, handler.EnqueueRequestsFromMapFunc(servingRuntimesOfInferenceService)).
+		Complete(r)
+}
+
+func (r *ServingRuntimeReconciler) servingRuntimesOfNamespace(ctx context.Context, obj client.Object) []reconcile.Request {
+	runtimes := &v1alpha1.ServingRuntimeList{}
+	if err := r.List(ctx, runtimes, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "Failed to list ServingRuntimes", "namespace", obj.GetNamespace())
+		return nil
+	}
+	requests := []reconcile.Request{}
+	for _, other := range runtimes.Items {
+		if other.Name != obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: other.Name, Namespace: other.Namespace}})
+		}
+	}
+	return requests
+}
+
+// servingRuntimesOfInferenceService returns the runtimes the inference service may use. Both the previous and the
+// new version of an updated inference service are mapped, so a runtime is reconciled when it is no longer used.
+func servingRuntimesOfInferenceService(_ context.Context, obj client.Object) []reconcile.Request {
+	isvc, ok := obj.(*v1beta1.InferenceService)
+	if !ok {
+		return nil
+	}
+	names := []string{isvc.Status.ServingRuntimeName}
+	if model := isvc.Spec.Predictor.Model; model != nil && model.Runtime != nil {
+		names = append(names, *model.Runtime)
+	}
+	requests := []reconcile.Request{}
+	for _, name := range names {
+		if name != "" {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: name, Namespace: isvc.Namespace}})
+		}
+	}
+	return requests
+}
+
+// ClusterServingRuntimeReconciler reports the inference services using a ClusterServingRuntime, the validity of
+// its spec and its compatibility with the inference services in its status
+type ClusterServingRuntimeReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+func (r *ClusterServingRuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	clusterServingRuntime := &v1alpha1.ClusterServingRuntime{}
+	if err := r.Get(ctx, req.NamespacedName, clusterServingRuntime); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	runtimes := &v1alpha1.ClusterServingRuntimeList{}
+	if err := r.List(ctx, runtimes); err != nil {
+		return reconcile.Result{}, err
+	}
+	otherRuntimes := []v1alpha1.SupportedRuntime{}
+	for _, other := range runtimes.Items {
+		if other.Name != clusterServingRuntime.Name {
+			otherRuntimes = append(otherRuntimes, v1alpha1.SupportedRuntime{Name: other.Name, Spec: other.Spec})
+		}
+	}
+	isvcs := &v1beta1.InferenceServiceList{}
+	if err := r.List(ctx, isvcs); err != nil {
+		return reconcile.Result{}, err
+	}
+	usingIsvcs := []v1beta1.InferenceService{}
+	for _, isvc := range isvcs.Items {
+		uses, err := r.usesClusterServingRuntime(ctx, &isvc, clusterServingRuntime.Name)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if uses {
+			usingIsvcs = append(usingIsvcs, isvc)
+		}
+	}
+
+	status := computeStatus(clusterServingRuntime.Name, &clusterServingRuntime.Spec, &clusterServingRuntime.Status, otherRuntimes, usingIsvcs)
+	status.ObservedGeneration = clusterServingRuntime.Generation
+	if equality.Semantic.DeepEqual(clusterServingRuntime.Status, *status) {
+		return reconcile.Result{}, nil
+	}
+	clusterServingRuntime.Status = *status
+	if err := r.Status().Update(ctx, clusterServingRuntime); err != nil {
+		r.Log.Error(err, "Failed to update ClusterServingRuntime status", "name", clusterServingRuntime.Name)
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
+// usesClusterServingRuntime returns whether the inference service uses the ClusterServingRuntime, selected
+// automatically or set in its spec and not shadowed by a ServingRuntime of the same name in its namespace
+func (r *ClusterServingRuntimeReconciler) usesClusterServingRuntime(ctx context.Context, isvc *v1beta1.InferenceService, name string) (bool, error) {
+	if isvc.Status.ServingRuntimeName != "" || isvc.Status.ClusterServingRuntimeName != "" {
+		return isvc.Status.ClusterServingRuntimeName == name, nil
+	}
+	model := isvc.Spec.Predictor.Model
+	if model == nil || model.Runtime == nil || *model.Runtime != name {
+		return false, nil
+	}
+	servingRuntime := &v1alpha1.ServingRuntime{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: isvc.Namespace}, servingRuntime)
+	if err == nil {
+		return false, nil
+	}
+	return true, client.IgnoreNotFound(err)
+}
+
+func (r *ClusterServingRuntimeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ClusterServingRuntime{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("clusterservingruntime").
+		// The priority conflicts of a runtime change with the other cluster runtimes
+		Watches(&v1alpha1.ClusterServingRuntime{}, handler.EnqueueRequestsFromMapFunc(r.otherClusterServingRuntimes),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&v1beta1.InferenceService{}, handler.EnqueueRequestsFromMapFunc(clusterServingRuntimesOfInferenceService)).
+		Complete(r)
+}
+
+func (r *ClusterServingRuntimeReconciler) otherClusterServingRuntimes(ctx context.Context, obj client.Object) []reconcile.Request {
+	runtimes := &v1alpha1.ClusterServingRuntimeList{}
+	if err := r.List(ctx, runtimes); err != nil {
+		r.Log.Error(err, "Failed to list ClusterServingRuntimes")
+		return nil
+	}
+	requests := []reconcile.Request{}
+	for _, other := range runtimes.Items {
+		if other.Name != obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: other.Name}})
+		}
+	}
+	return requests
+}
+
+// clusterServingRuntimesOfInferenceService returns the cluster runtimes the inference service may use
+func clusterServingRuntimesOfInferenceService(_ context.Context, obj client.Object) []reconcile.Request {
+	isvc, ok := obj.(*v1beta1.InferenceService)
+	if !ok {
+		return nil
+	}
+	names := []string{isvc.Status.ClusterServingRuntimeName}
+	if model := isvc.Spec.Predictor.Model; model != nil && model.Runtime != nil {
+		names = append(names, *model.Runtime)
+	}
+	requests := []reconcile.Request{}
+	for _, name := range names {
+		if name != "" {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servingruntime
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+// computeStatus returns the status of the runtime from its spec, the other runtimes it is selected among and the
+// inference services using it
+func computeStatus(name string, spec *v1alpha1.ServingRuntimeSpec, previous *v1alpha1.ServingRuntimeStatus,
+	otherRuntimes []v1alpha1.SupportedRuntime, isvcs []v1beta1.InferenceService,
+) *v1alpha1.ServingRuntimeStatus {
+	status := previous.DeepCopy()
+	status.InitializeConditions()
+
+	if err := spec.ValidateCreate(); err != nil {
+		status.MarkFalse(v1alpha1.RuntimeSpecValid, v1alpha1.InvalidRuntimeSpec, "%s", err.Error())
+	} else if modelFormat := spec.GetInconsistentPriorityModelFormat(); modelFormat != "" {
+		status.MarkFalse(v1alpha1.RuntimeSpecValid, v1alpha1.InvalidRuntimeSpec,
+			"different priorities assigned for the model format %s", modelFormat)
+	} else {
+		status.MarkTrue(v1alpha1.RuntimeSpecValid)
+	}
+
+	conflicts := getPriorityConflicts(spec, otherRuntimes)
+	if len(conflicts) > 0 {
+		status.MarkFalse(v1alpha1.ModelFormatPrioritiesUnique, v1alpha1.ConflictingPriority, "%s", strings.Join(conflicts, "; "))
+	} else {
+		status.MarkTrue(v1alpha1.ModelFormatPrioritiesUnique)
+	}
+
+	inferenceServices := make([]v1alpha1.NamespacedName, 0, len(isvcs))
+	incompatibilities := []string{}
+	reason := ""
+	for i := range isvcs {
+		isvc := &isvcs[i]
+		inferenceServices = append(inferenceServices, v1alpha1.NamespacedName{Namespace: isvc.Namespace, Name: isvc.Name})
+		if isvcReason, message := getIncompatibility(name, spec, isvc); isvcReason != "" {
+			// The reason of the condition is the reason of the first incompatible inference service
+			if reason == "" {
+				reason = isvcReason
+			}
+			incompatibilities = append(incompatibilities, isvc.Namespace+"/"+isvc.Name+": "+message)
+		}
+	}
+	slices.Sort(incompatibilities)
+	if len(incompatibilities) > 0 {
+		status.MarkFalse(v1alpha1.InferenceServicesCompatible, reason, "%s", strings.Join(incompatibilities, "; "))
+	} else {
+		status.MarkTrue(v1alpha1.InferenceServicesCompatible)
+	}
+
+	slices.SortFunc(inferenceServices, func(a, b v1alpha1.NamespacedName) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+	// The runtime was selected by a new inference service since the last reconcile
+	for _, isvc := range inferenceServices {
+		if !slices.Contains(previous.InferenceServices, isvc) {
+			status.LastSelectedTime = ptr.To(metav1.Now())
+			break
+		}
+	}
+	status.InferenceServices = nil
+	if len(inferenceServices) > 0 {
+		status.InferenceServices = inferenceServices
+	}
+	return status
+}
+
+// getPriorityConflicts returns the model formats auto-selected with the same priority by the runtime and another
+// runtime. A disabled runtime is never selected, so it does not conflict.
+func getPriorityConflicts(spec *v1alpha1.ServingRuntimeSpec, otherRuntimes []v1alpha1.SupportedRuntime) []string {
+	conflicts := []string{}
+	if spec.IsDisabled() {
+		return conflicts
+	}
+	for _, other := range otherRuntimes {
+		if modelFormat := spec.GetConflictingPriorityModelFormat(&other.Spec); modelFormat != "" {
+			conflicts = append(conflicts, fmt.Sprintf("model format %s has the same priority in runtime %s", modelFormat, other.Name))
+		}
+	}
+	slices.Sort(conflicts)
+	return conflicts
+}
+
+// getIncompatibility returns the reason and message why the runtime cannot serve the model of the inference service,
+// or an empty reason if it is compatible
+func getIncompatibility(name string, spec *v1alpha1.ServingRuntimeSpec, isvc *v1beta1.InferenceService) (string, string) {
+	model := isvc.Spec.Predictor.Model
+	if model == nil {
+		return "", ""
+	}
+	if spec.IsDisabled() {
+		return v1alpha1.RuntimeDisabled, "runtime is disabled"
+	}
+	// Model formats which are not auto-selected are supported when the runtime is set explicitly
+	explicitModel := model.DeepCopy()
+	explicitModel.Runtime = ptr.To(name)
+	if !explicitModel.RuntimeSupportsModel(spec) {
+		modelFormat := model.ModelFormat.Name
+		if model.ModelFormat.Version != nil {
+			modelFormat += ":" + *model.ModelFormat.Version
+		}
+		return v1alpha1.UnsupportedModelFormat, "model format " + modelFormat + " is not supported"
+	}
+	// Inference services without protocol version use the default protocol of the runtime
+	if model.ProtocolVersion != nil && !spec.IsProtocolVersionSupported(*model.ProtocolVersion) {
+		return v1alpha1.UnsupportedProtocolVersion, "protocol version " + string(*model.ProtocolVersion) + " is not supported"
+	}
+	if model.Image == "" && !hasServingImage(spec) {
+		return v1alpha1.MissingImage, "no image is set for container " + constants.InferenceServiceContainerName
+	}
+	return "", ""
+}
+
+// hasServingImage returns whether the runtime sets the image of the container serving the model
+func hasServingImage(spec *v1alpha1.ServingRuntimeSpec) bool {
+	for _, container := range spec.Containers {
+		if container.Name == constants.InferenceServiceContainerName {
+			return container.Image != ""
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servingruntime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+func newRuntimeSpec(priority int32) v1alpha1.ServingRuntimeSpec {
+	return v1alpha1.ServingRuntimeSpec{
+		ProtocolVersions: []constants.InferenceServiceProtocol{constants.ProtocolV1},
+		SupportedModelFormats: []v1alpha1.SupportedModelFormat{
+			{Name: "sklearn", AutoSelect: ptr.To(true), Priority: ptr.To(priority)},
+		},
+		ServingRuntimePodSpec: v1alpha1.ServingRuntimePodSpec{
+			Containers: []corev1.Container{{Name: constants.InferenceServiceContainerName, Image: "kserve/sklearnserver"}},
+		},
+	}
+}
+
+func newInferenceService(name string, modelFormat string) v1beta1.InferenceService {
+	return v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{
+				Model: &v1beta1.ModelSpec{ModelFormat: v1beta1.ModelFormat{Name: modelFormat}},
+			},
+		},
+	}
+}
+
+func TestComputeStatus(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	spec := newRuntimeSpec(1)
+
+	status := computeStatus("sklearn-runtime", &spec, &v1alpha1.ServingRuntimeStatus{}, nil,
+		[]v1beta1.InferenceService{newInferenceService("b", "sklearn"), newInferenceService("a", "sklearn")})
+	g.Expect(status.IsReady()).To(gomega.BeTrue())
+	g.Expect(status.InferenceServices).To(gomega.Equal([]v1alpha1.NamespacedName{
+		{Namespace: "default", Name: "a"},
+		{Namespace: "default", Name: "b"},
+	}))
+	g.Expect(status.LastSelectedTime).NotTo(gomega.BeNil())
+
+	// The last selected time is kept while no new inference service selects the runtime
+	lastSelectedTime := metav1.NewTime(status.LastSelectedTime.Add(-time.Hour))
+	status.LastSelectedTime = &lastSelectedTime
+	status = computeStatus("sklearn-runtime", &spec, status, nil,
+		[]v1beta1.InferenceService{newInferenceService("a", "sklearn")})
+	g.Expect(status.InferenceServices).To(gomega.Equal([]v1alpha1.NamespacedName{{Namespace: "default", Name: "a"}}))
+	g.Expect(*status.LastSelectedTime).To(gomega.Equal(lastSelectedTime))
+
+	status = computeStatus("sklearn-runtime", &spec, status, nil, nil)
+	g.Expect(status.InferenceServices).To(gomega.BeNil())
+	g.Expect(status.IsReady()).To(gomega.BeTrue())
+}
+
+func TestComputeStatusConditions(t *testing.T) {
+	scenarios := map[string]struct {
+		spec          func() v1alpha1.ServingRuntimeSpec
+		otherRuntimes []v1alpha1.SupportedRuntime
+		isvcs         []v1beta1.InferenceService
+		condition     apis.ConditionType
+		reason        string
+	}{
+		"invalid spec": {
+			spec: func() v1alpha1.ServingRuntimeSpec {
+				spec := newRuntimeSpec(1)
+				spec.WorkerSpec = &v1alpha1.WorkerSpec{}
+				return spec
+			},
+			condition: v1alpha1.RuntimeSpecValid,
+			reason:    v1alpha1.InvalidRuntimeSpec,
+		},
+		"inconsistent priorities": {
+			spec: func() v1alpha1.ServingRuntimeSpec {
+				spec := newRuntimeSpec(1)
+				spec.SupportedModelFormats = append(spec.SupportedModelFormats,
+					v1alpha1.SupportedModelFormat{Name: "sklearn", AutoSelect: ptr.To(true), Priority: ptr.To(int32(2))})
+				return spec
+			},
+			condition: v1alpha1.RuntimeSpecValid,
+			reason:    v1alpha1.InvalidRuntimeSpec,
+		},
+		"conflicting priority": {
+			spec:          func() v1alpha1.ServingRuntimeSpec { return newRuntimeSpec(1) },
+			otherRuntimes: []v1alpha1.SupportedRuntime{{Name: "other", Spec: newRuntimeSpec(1)}},
+			condition:     v1alpha1.ModelFormatPrioritiesUnique,
+			reason:        v1alpha1.ConflictingPriority,
+		},
+		"disabled runtime": {
+			spec: func() v1alpha1.ServingRuntimeSpec {
+				spec := newRuntimeSpec(1)
+				spec.Disabled = ptr.To(true)
+				return spec
+			},
+			isvcs:     []v1beta1.InferenceService{newInferenceService("a", "sklearn")},
+			condition: v1alpha1.InferenceServicesCompatible,
+			reason:    v1alpha1.RuntimeDisabled,
+		},
+		"unsupported model format": {
+			spec:      func() v1alpha1.ServingRuntimeSpec { return newRuntimeSpec(1) },
+			isvcs:     []v1beta1.InferenceService{newInferenceService("a", "xgboost")},
+			condition: v1alpha1.InferenceServicesCompatible,
+			reason:    v1alpha1.UnsupportedModelFormat,
+		},
+		"unsupported protocol version": {
+			spec: func() v1alpha1.ServingRuntimeSpec { return newRuntimeSpec(1) },
+			isvcs: func() []v1beta1.InferenceService {
+				isvc := newInferenceService("a", "sklearn")
+				isvc.Spec.Predictor.Model.ProtocolVersion = ptr.To(constants.ProtocolV2)
+				return []v1beta1.InferenceService{isvc}
+			}(),
+			condition: v1alpha1.InferenceServicesCompatible,
+			reason:    v1alpha1.UnsupportedProtocolVersion,
+		},
+		"missing image": {
+			spec: func() v1alpha1.ServingRuntimeSpec {
+				spec := newRuntimeSpec(1)
+				spec.Containers[0].Image = ""
+				return spec
+			},
+			isvcs:     []v1beta1.InferenceService{newInferenceService("a", "sklearn")},
+			condition: v1alpha1.InferenceServicesCompatible,
+			reason:    v1alpha1.MissingImage,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			spec := scenario.spec()
+			status := computeStatus("sklearn-runtime", &spec, &v1alpha1.ServingRuntimeStatus{}, scenario.otherRuntimes, scenario.isvcs)
+			g.Expect(status.IsReady()).To(gomega.BeFalse())
+			condition := status.GetCondition(scenario.condition)
+			g.Expect(condition).NotTo(gomega.BeNil())
+			g.Expect(condition.IsFalse()).To(gomega.BeTrue())
+			g.Expect(condition.Reason).To(gomega.Equal(scenario.reason))
+		})
+	}
+}
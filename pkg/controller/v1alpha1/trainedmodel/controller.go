@@ -39,6 +39,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/network"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -48,6 +49,7 @@ import (
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/controller/v1alpha1/trainedmodel/reconcilers/modelconfig"
+	"github.com/kserve/kserve/pkg/controller/v1alpha1/trainedmodel/sharding/memory"
 	v1beta1utils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
 	"github.com/kserve/kserve/pkg/utils"
 )
@@ -189,6 +191,20 @@ func (r *TrainedModelReconciler) updateStatus(ctx context.Context, req ctrl.Requ
 		}
 	}
 
+	// The TrainedModels of the other shards than shard 0 are served by the predictor of their shard
+	if shardId, _ := memory.GetAssignedShard(desiredModel); shardId > 0 {
+		shardName := constants.PredictorShardServiceName(constants.PredictorServiceName(isvc.Name, isvc.Spec.Predictor.Name), shardId)
+		shardURL := &apis.URL{
+			Scheme: "http",
+			Host:   network.GetServiceHostname(shardName, isvc.Namespace),
+			Path:   constants.PredictPath(desiredModel.Name, isvc.Spec.Predictor.GetImplementation().GetProtocol()),
+		}
+		desiredModel.Status.URL = shardURL
+		desiredModel.Status.Address = &duckv1.Addressable{
+			URL: shardURL,
+		}
+	}
+
 	// Get the current model
 	existingModel := &v1alpha1.TrainedModel{}
 	if err := r.Get(ctx, req.NamespacedName, existingModel); err != nil {
@@ -256,18 +272,14 @@ func (r *TrainedModelReconciler) updateConditions(ctx context.Context, req ctrl.
 
 	// Get trained models with same inference service
 	var trainedModels v1alpha1.TrainedModelList
-	if err := r.List(ctx, &trainedModels, client.InNamespace(tm.Namespace), client.MatchingLabels{constants.ParentInferenceServiceLabel: isvc.Name, constants.TrainedModelAllocated: isvc.Name}); err != nil {
+	if err := r.List(ctx, &trainedModels, client.InNamespace(tm.Namespace), client.MatchingLabels{constants.ParentInferenceServiceLabel: isvc.Name}); err != nil {
 		return err
 	}
 
-	if _, ok := tm.Labels[constants.TrainedModelAllocated]; !ok {
-		trainedModels.Items = append(trainedModels.Items, *tm)
-	}
-
-	totalReqMemory := trainedModels.TotalRequestedMemory()
 	// Update Inference Service Resource Available condition
-	if v1beta1utils.IsMemoryResourceAvailable(isvc, totalReqMemory) {
-		log.Info("Parent InferenceService memory resources are available", "TrainedModel", tm.Name, "InferenceService", isvc.Name)
+	shardStrategy := memory.NewMemoryStrategy(isvc, trainedModels.Items)
+	if shardId, err := shardStrategy.GetOrAssignShard(tm); err == nil {
+		log.Info("Parent InferenceService memory resources are available", "TrainedModel", tm.Name, "InferenceService", isvc.Name, "shard", shardId)
 		if _, ok := tm.Labels[constants.TrainedModelAllocated]; !ok {
 			tm.Labels[constants.TrainedModelAllocated] = isvc.Name
 			if updateErr := r.Update(ctx, tm); updateErr != nil {
@@ -280,7 +292,7 @@ func (r *TrainedModelReconciler) updateConditions(ctx context.Context, req ctrl.
 			Status: corev1.ConditionTrue,
 		})
 	} else {
-		log.Info("Parent InferenceService memory resources are not available", "TrainedModel", tm.Name, "InferenceService", isvc.Name)
+		log.Info("Parent InferenceService memory resources are not available", "TrainedModel", tm.Name, "InferenceService", isvc.Name, "reason", err.Error())
 		tm.Status.SetCondition(v1alpha1.MemoryResourceAvailable, &apis.Condition{
 			Type:    v1alpha1.MemoryResourceAvailable,
 			Status:  corev1.ConditionFalse,
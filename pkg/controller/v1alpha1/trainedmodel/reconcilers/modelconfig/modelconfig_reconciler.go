@@ -51,8 +51,8 @@ func NewModelConfigReconciler(client client.Client, clientset kubernetes.Interfa
 
 func (c *ModelConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request, tm *v1alpha1.TrainedModel) error {
 	log.Info("Reconciling TrainedModel", "apiVersion", tm.APIVersion, "trainedmodel", tm.Spec)
-	shardStrategy := memory.MemoryStrategy{}
-	shardId := shardStrategy.GetOrAssignShard(tm)
+	// The shard is assigned by the TrainedModel controller, the InferenceService controller creates its modelConfig
+	shardId, _ := memory.GetAssignedShard(tm)
 	// Use tm's parent InferenceService field to get the model modelConfig
 	modelConfigName := constants.ModelConfigName(tm.Spec.InferenceService, shardId)
 	log.Info("Reconciling modelConfig", "modelConfigName", modelConfigName, "namespace", req.Namespace)
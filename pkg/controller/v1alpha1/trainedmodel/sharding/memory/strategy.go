@@ -17,22 +17,105 @@ limitations under the License.
 package memory
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
 )
 
-// TODO MemoryStrategy will be implemented in another PR
-type MemoryStrategy struct{}
+// MemoryStrategy bin-packs the TrainedModels of a multi-model InferenceService into shards on their memory.
+// Every shard is served by a predictor with the memory limit of the InferenceService predictor. A TrainedModel
+// is assigned to the lowest shard with enough memory left, so a new shard is only added once the memory of the
+// existing shards runs out. The assignment is recorded on the TrainedModel and does not change afterwards.
+type MemoryStrategy struct {
+	capacity resource.Quantity
+	// used is the memory requested by the TrainedModels assigned to every shard
+	used map[int]resource.Quantity
+}
+
+// NewMemoryStrategy returns the MemoryStrategy of the InferenceService with the shards used by its TrainedModels.
+func NewMemoryStrategy(isvc *v1beta1.InferenceService, trainedModels []v1alpha1.TrainedModel) *MemoryStrategy {
+	v := &MemoryStrategy{
+		capacity: getShardCapacity(isvc),
+		used:     map[int]resource.Quantity{0: {}},
+	}
+	for i := range trainedModels {
+		if shardId, ok := GetAssignedShard(&trainedModels[i]); ok {
+			v.add(shardId, trainedModels[i].Spec.Model.Memory)
+		}
+	}
+	return v
+}
+
+// getShardCapacity returns the memory limit of the predictor container.
+func getShardCapacity(isvc *v1beta1.InferenceService) resource.Quantity {
+	if isvc.Spec.Predictor.GetExtensions() == nil || len(isvc.Spec.Predictor.GetImplementations()) == 0 {
+		return resource.Quantity{}
+	}
+	container := isvc.Spec.Predictor.GetImplementation().GetContainer(isvc.ObjectMeta, isvc.Spec.Predictor.GetExtensions(), nil)
+	return *container.Resources.Limits.Memory()
+}
+
+// GetAssignedShard returns the shard a TrainedModel is assigned to. TrainedModels allocated before they were
+// assigned to shards are served by shard 0.
+func GetAssignedShard(tm *v1alpha1.TrainedModel) (int, bool) {
+	if value, ok := tm.Labels[constants.TrainedModelShard]; ok {
+		if shardId, err := strconv.Atoi(value); err == nil && shardId >= 0 {
+			return shardId, true
+		}
+	}
+	if _, ok := tm.Labels[constants.TrainedModelAllocated]; ok {
+		return 0, true
+	}
+	return 0, false
+}
+
+func (v *MemoryStrategy) add(shardId int, memory resource.Quantity) {
+	used := v.used[shardId]
+	used.Add(memory)
+	v.used[shardId] = used
+}
+
+func (v *MemoryStrategy) fits(shardId int, memory resource.Quantity) bool {
+	remaining := v.capacity.DeepCopy()
+	remaining.Sub(v.used[shardId])
+	return remaining.Cmp(memory) >= 0
+}
 
-// Return a TrainedModel's shardId
-func (v *MemoryStrategy) GetOrAssignShard(tm *v1alpha1.TrainedModel) int {
-	// TODO to be implemented in another PR
-	// Currently each InferenceService only has one shard with id=0
-	return 0
+// GetOrAssignShard returns a TrainedModel's shardId. A TrainedModel not assigned yet is assigned to the lowest
+// shard with enough memory left and the assignment is recorded on its shard label.
+func (v *MemoryStrategy) GetOrAssignShard(tm *v1alpha1.TrainedModel) (int, error) {
+	if shardId, ok := GetAssignedShard(tm); ok {
+		return shardId, nil
+	}
+	memory := tm.Spec.Model.Memory
+	if v.capacity.Cmp(memory) < 0 {
+		return 0, fmt.Errorf("TrainedModel %s memory %s exceeds the predictor memory limit %s", tm.Name, memory.String(), v.capacity.String())
+	}
+	shardId := 0
+	for !v.fits(shardId, memory) {
+		shardId++
+	}
+	v.add(shardId, memory)
+	if tm.Labels == nil {
+		tm.Labels = make(map[string]string)
+	}
+	tm.Labels[constants.TrainedModelShard] = strconv.Itoa(shardId)
+	return shardId, nil
 }
 
-func (v *MemoryStrategy) GetShard(isvc *v1beta1.InferenceService) []int {
-	// TODO to be implemented in another PR
-	// Currently each InferenceService only has one shard with id=0
-	return []int{0}
+// GetShard returns the sorted ids of the InferenceService shards. Shard 0 is always present as it is served by
+// the predictor itself.
+func (v *MemoryStrategy) GetShard() []int {
+	shards := make([]int, 0, len(v.used))
+	for shardId := range v.used {
+		shards = append(shards, shardId)
+	}
+	slices.Sort(shards)
+	return shards
 }
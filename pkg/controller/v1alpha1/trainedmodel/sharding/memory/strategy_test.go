@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+func makeInferenceService(memoryLimit string) *v1beta1.InferenceService {
+	return &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "mms", Namespace: "default"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{
+				Triton: &v1beta1.TritonSpec{
+					PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{
+						Container: corev1.Container{
+							Resources: corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{
+									corev1.ResourceMemory: resource.MustParse(memoryLimit),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func makeTrainedModel(name, memory string, labels map[string]string) v1alpha1.TrainedModel {
+	return v1alpha1.TrainedModel{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Spec: v1alpha1.TrainedModelSpec{
+			InferenceService: "mms",
+			Model:            v1alpha1.ModelSpec{Memory: resource.MustParse(memory)},
+		},
+	}
+}
+
+func TestGetAssignedShard(t *testing.T) {
+	tests := map[string]struct {
+		labels     map[string]string
+		wantShard  int
+		wantAssign bool
+	}{
+		"not assigned": {
+			labels: nil,
+		},
+		"assigned to a shard": {
+			labels:     map[string]string{constants.TrainedModelShard: "2"},
+			wantShard:  2,
+			wantAssign: true,
+		},
+		"allocated before sharding": {
+			labels:     map[string]string{constants.TrainedModelAllocated: "mms"},
+			wantShard:  0,
+			wantAssign: true,
+		},
+		"invalid shard": {
+			labels: map[string]string{constants.TrainedModelShard: "first"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tm := makeTrainedModel("model", "1Gi", tt.labels)
+			shardId, ok := GetAssignedShard(&tm)
+			assert.Equal(t, tt.wantAssign, ok)
+			assert.Equal(t, tt.wantShard, shardId)
+		})
+	}
+}
+
+func TestGetOrAssignShard(t *testing.T) {
+	tests := map[string]struct {
+		memoryLimit   string
+		trainedModels []v1alpha1.TrainedModel
+		memory        string
+		wantShard     int
+		wantShards    []int
+		wantErr       bool
+	}{
+		"first model is assigned to shard 0": {
+			memoryLimit: "2Gi",
+			memory:      "1Gi",
+			wantShard:   0,
+			wantShards:  []int{0},
+		},
+		"model fits in shard 0": {
+			memoryLimit: "2Gi",
+			trainedModels: []v1alpha1.TrainedModel{
+				makeTrainedModel("model-1", "1Gi", map[string]string{constants.TrainedModelShard: "0"}),
+			},
+			memory:     "1Gi",
+			wantShard:  0,
+			wantShards: []int{0},
+		},
+		"new shard is added when the memory runs out": {
+			memoryLimit: "2Gi",
+			trainedModels: []v1alpha1.TrainedModel{
+				makeTrainedModel("model-1", "1536Mi", map[string]string{constants.TrainedModelShard: "0"}),
+			},
+			memory:     "1Gi",
+			wantShard:  1,
+			wantShards: []int{0, 1},
+		},
+		"model fills the lowest shard with memory left": {
+			memoryLimit: "2Gi",
+			trainedModels: []v1alpha1.TrainedModel{
+				makeTrainedModel("model-1", "1536Mi", map[string]string{constants.TrainedModelShard: "0"}),
+				makeTrainedModel("model-2", "1Gi", map[string]string{constants.TrainedModelShard: "1"}),
+			},
+			memory:     "512Mi",
+			wantShard:  0,
+			wantShards: []int{0, 1},
+		},
+		"unassigned models are not counted": {
+			memoryLimit: "2Gi",
+			trainedModels: []v1alpha1.TrainedModel{
+				makeTrainedModel("model-1", "2Gi", nil),
+			},
+			memory:     "2Gi",
+			wantShard:  0,
+			wantShards: []int{0},
+		},
+		"model larger than the predictor memory limit": {
+			memoryLimit: "2Gi",
+			memory:      "3Gi",
+			wantErr:     true,
+			wantShards:  []int{0},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			strategy := NewMemoryStrategy(makeInferenceService(tt.memoryLimit), tt.trainedModels)
+			tm := makeTrainedModel("model", tt.memory, nil)
+			shardId, err := strategy.GetOrAssignShard(&tm)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.NotContains(t, tm.Labels, constants.TrainedModelShard)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantShard, shardId)
+				// The assignment is recorded on the TrainedModel and returned from then on
+				assignedShard, ok := GetAssignedShard(&tm)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantShard, assignedShard)
+			}
+			assert.Equal(t, tt.wantShards, strategy.GetShard())
+		})
+	}
+}
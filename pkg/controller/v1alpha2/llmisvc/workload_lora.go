@@ -51,7 +51,7 @@ var loraPathInvalidCharsRe = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
 // (which must be DNS labels: lowercase alphanumeric and hyphens only).
 var loraVolumeNameInvalidCharsRe = regexp.MustCompile(`[^a-z0-9-]`)
 
-// resolvedLoRAAdapter is one adapter after URI validation (hf/s3 downloads are handled in attachModelArtifacts).
+// resolvedLoRAAdapter is one adapter after URI validation (storage-initializer downloads are handled in attachModelArtifacts).
 type resolvedLoRAAdapter struct {
 	name      string
 	mountPath string
@@ -94,22 +94,22 @@ func enumerateLoRAAdapters(spec v1alpha2.LLMInferenceServiceSpec) ([]resolvedLoR
 		scheme := schema + "://"
 		mountPath := filepath.Join(loraAdaptersMountRoot, sanitizeLoRAPathSegment(adapterName))
 
-		switch scheme {
-		case constants.HfURIPrefix, constants.S3URIPrefix:
+		switch {
+		case slices.Contains(storageInitializerURIPrefixes, scheme):
 			if storageInitializerDisabled {
-				return nil, fmt.Errorf("LoRA adapter %q: hf:// and s3:// require the storage initializer — set storageInitializer.enabled to true (see %s)", adapterName, loraAdapterDocsURL)
+				return nil, fmt.Errorf("LoRA adapter %q: %s requires the storage initializer — set storageInitializer.enabled to true (see %s)", adapterName, scheme, loraAdapterDocsURL)
 			}
-		case constants.PvcURIPrefix:
+		case scheme == constants.PvcURIPrefix:
 			if storageInitializerDisabled {
 				return nil, fmt.Errorf("LoRA adapter %q: pvc:// requires a mounted volume — do not set storageInitializer.enabled to false (see %s)", adapterName, loraAdapterDocsURL)
 			}
-		case constants.OciURIPrefix:
+		case scheme == constants.OciURIPrefix:
 			// oci:// is intentionally not supported for LoRA adapters. OCI models run as sidecar
 			// containers ("modelcars") with shared process namespaces, but only one modelcar per pod
 			// is currently supported. Workaround: package the adapter in a PVC and use pvc://.
-			return nil, fmt.Errorf("LoRA adapter %q: oci:// is not supported for LoRA adapters; use hf://, s3://, or pvc:// instead (see %s)", adapterName, loraAdapterDocsURL)
+			return nil, fmt.Errorf("LoRA adapter %q: oci:// is not supported for LoRA adapters; use %s instead (see %s)", adapterName, supportedLoRAURIPrefixes(), loraAdapterDocsURL)
 		default:
-			return nil, fmt.Errorf("LoRA adapter %q: unsupported URI scheme %q; supported schemes are hf://, s3://, pvc:// (see %s)", adapterName, scheme, loraAdapterDocsURL)
+			return nil, fmt.Errorf("LoRA adapter %q: unsupported URI scheme %q; supported schemes are %s (see %s)", adapterName, scheme, supportedLoRAURIPrefixes(), loraAdapterDocsURL)
 		}
 
 		out = append(out, resolvedLoRAAdapter{
@@ -122,12 +122,17 @@ func enumerateLoRAAdapters(spec v1alpha2.LLMInferenceServiceSpec) ([]resolvedLoR
 	return out, nil
 }
 
-// collectLoRADownloadPairs filters pre-resolved adapters to hf:// and s3:// uri/path pairs
-// for a single storage-initializer run.
+// supportedLoRAURIPrefixes lists the supported LoRA adapter URI schemes for the error messages.
+func supportedLoRAURIPrefixes() string {
+	return strings.Join(append(slices.Clone(storageInitializerURIPrefixes), constants.PvcURIPrefix), ", ")
+}
+
+// collectLoRADownloadPairs filters pre-resolved adapters to the uri/path pairs downloaded by the
+// storage-initializer.
 func collectLoRADownloadPairs(adapters []resolvedLoRAAdapter) []storageDownloadPair {
 	var pairs []storageDownloadPair
 	for _, a := range adapters {
-		if a.scheme == constants.HfURIPrefix || a.scheme == constants.S3URIPrefix {
+		if slices.Contains(storageInitializerURIPrefixes, a.scheme) {
 			pairs = append(pairs, storageDownloadPair{uri: a.uri, path: a.mountPath})
 		}
 	}
@@ -135,8 +140,8 @@ func collectLoRADownloadPairs(adapters []resolvedLoRAAdapter) []storageDownloadP
 }
 
 // attachLoRAAdapters reconciles spec.model.lora.adapters into vLLM CLI flags appended to the main
-// container's Args. hf:// and s3:// adapters are downloaded in attachModelArtifacts via one
-// storage-initializer; pvc:// adapters are mounted here.
+// container's Args. The adapters of the storage-initializer schemes (hf://, s3://, modelscope://, hdfs://, ...)
+// are downloaded in attachModelArtifacts; pvc:// adapters are mounted here.
 func (r *LLMISVCReconciler) attachLoRAAdapters(
 	ctx context.Context,
 	llmSvc *v1alpha2.LLMInferenceService,
@@ -151,13 +156,13 @@ func (r *LLMISVCReconciler) attachLoRAAdapters(
 
 	var loraModules []string
 	for _, a := range adapters {
-		switch a.scheme {
-		case constants.PvcURIPrefix:
+		switch {
+		case a.scheme == constants.PvcURIPrefix:
 			volName := kmeta.ChildName("lora-pvc-", a.name)
 			if err := attachLoraPVCAdapter(a.uri, podSpec, containerName, a.mountPath, volName); err != nil {
 				return fmt.Errorf("LoRA adapter %q: %w", a.name, err)
 			}
-		case constants.HfURIPrefix, constants.S3URIPrefix:
+		case slices.Contains(storageInitializerURIPrefixes, a.scheme):
 			// Downloaded alongside the base model in attachModelArtifacts.
 		default:
 			return fmt.Errorf("LoRA adapter %q: internal error, unhandled scheme %q", a.name, a.scheme)
@@ -40,6 +40,20 @@ import (
 
 const CaBundleVolumeName = "cabundle-cert"
 
+// storageInitializerURIPrefixes are the schemes of the model and LoRA adapter URIs downloaded by the
+// storage-initializer. They match the storage URIs of the InferenceService predictor, with the
+// credentials of the service account injected the same way.
+var storageInitializerURIPrefixes = []string{
+	constants.S3URIPrefix,
+	constants.GsURIPrefix,
+	constants.HfURIPrefix,
+	constants.MsURIPrefix,
+	constants.HdfsURIPrefix,
+	constants.WebHdfsURIPrefix,
+	constants.HttpsURIPrefix,
+	constants.HttpURIPrefix,
+}
+
 // storageDownloadPair is one uri→path pair for the storage-initializer (multi-arg entrypoint).
 type storageDownloadPair struct {
 	uri  string
@@ -72,7 +86,8 @@ func extractAndStripStorageInitializer(podSpec *corev1.PodSpec) *corev1.Containe
 }
 
 // attachModelArtifacts configures a PodSpec to fetch and use a model from a provided URI in the LLMInferenceService.
-// The storage backend (PVC, OCI, Hugging Face, or S3) is determined from the URI schema and the appropriate helper function
+// The storage backend (PVC, OCI, or any storage downloaded by the storage-initializer, e.g. Hugging Face, ModelScope,
+// S3, GCS, HDFS or HTTP(S)) is determined from the URI schema and the appropriate helper function
 // is called to configure the PodSpec. This function will adjust volumes, container arguments, container volume mounts,
 // add containers, and do other changes to the PodSpec to ensure the model is fetched properly from storage.
 //
@@ -187,9 +202,10 @@ func (r *LLMISVCReconciler) attachModelArtifacts(ctx context.Context, serviceAcc
 			}
 		}
 
-	case constants.S3URIPrefix:
+	case constants.S3URIPrefix, constants.GsURIPrefix, constants.MsURIPrefix, constants.HdfsURIPrefix,
+		constants.WebHdfsURIPrefix, constants.HttpsURIPrefix, constants.HttpURIPrefix:
 		if len(loraPairs) == 0 {
-			if err := r.attachS3ModelArtifact(ctx, serviceAccount, llmSvc, modelUri, curr, podSpec, config.StorageConfig, config.CredentialConfig, containerName, modelPath); err != nil {
+			if err := r.attachStorageModelArtifact(ctx, serviceAccount, llmSvc, modelUri, curr, podSpec, config.StorageConfig, config.CredentialConfig, containerName, modelPath); err != nil {
 				return err
 			}
 		} else {
@@ -271,22 +287,23 @@ func (r *LLMISVCReconciler) attachPVCModelArtifact(modelUri string, podSpec *cor
 	return nil
 }
 
-// attachS3ModelArtifact configures a PodSpec to use a model stored in an S3-compatible object store.
-// Model downloading is delegated to vLLM by passing the S3 URI and other required arguments.
+// attachStorageModelArtifact configures a PodSpec to use a model downloaded by the storage-initializer from
+// an S3-compatible or GCS object store, ModelScope, HDFS (or WebHDFS), or an HTTP(S) server, including Azure Blob
+// storage. The credentials of the service account are injected into the storage-initializer.
 //
 // Parameters:
 //   - ctx: The context for API calls and logging.
 //   - serviceAccount: service account associated with the LLMInferenceService.
 //   - llmSvc: The LLMInferenceService resource containing the model specification.
-//   - modelUri: The URI of the model in the S3-compatible object store.
-//   - podSpec: The PodSpec to which the S3 model should be attached.
+//   - modelUri: The URI of the model in the storage.
+//   - podSpec: The PodSpec to which the model should be attached.
 //   - storageConfig: The storage initializer configuration.
 //   - credentialConfig: The credential configuration used for model downloads.
 //
 // Returns:
 //
 //	An error if the configuration fails, otherwise nil.
-func (r *LLMISVCReconciler) attachS3ModelArtifact(ctx context.Context, serviceAccount *corev1.ServiceAccount, llmSvc *v1alpha2.LLMInferenceService, modelUri string, curr corev1.PodSpec, podSpec *corev1.PodSpec, storageConfig *kserveTypes.StorageInitializerConfig, credentialConfig *credentials.CredentialConfig, containerName string, modelPath string) error {
+func (r *LLMISVCReconciler) attachStorageModelArtifact(ctx context.Context, serviceAccount *corev1.ServiceAccount, llmSvc *v1alpha2.LLMInferenceService, modelUri string, curr corev1.PodSpec, podSpec *corev1.PodSpec, storageConfig *kserveTypes.StorageInitializerConfig, credentialConfig *credentials.CredentialConfig, containerName string, modelPath string) error {
 	if err := r.attachStorageInitializer(llmSvc, modelUri, curr, podSpec, storageConfig, containerName, modelPath); err != nil {
 		return err
 	}
@@ -301,7 +318,8 @@ func (r *LLMISVCReconciler) attachS3ModelArtifact(ctx context.Context, serviceAc
 				return nil
 			}
 		}
-		// Check for AWS IAM Role for Service Account or AWS IAM User Credentials
+		// Check for AWS IAM Role for Service Account, or the storage secrets (S3, GCS, Azure, HDFS, ModelScope)
+		// of the service account
 		credentialBuilder := credentials.NewCredentialBuilderFromConfig(r.Client, r.Clientset, *credentialConfig)
 		if err := credentialBuilder.CreateSecretVolumeAndEnvFromServiceAccount(
 			ctx,
@@ -460,8 +478,8 @@ func (r *LLMISVCReconciler) attachStorageInitializer(llmSvc *v1alpha2.LLMInferen
 }
 
 // attachMultiStorageDownloads adds one storage-initializer init container with multiple
-// src_uri dest_path pairs (see storage-initializer entrypoint) and mounts the shared
-// emptyDir at the common parent of all destination paths.
+// src_uri dest_path pairs (see storage-initializer entrypoint), or one storage-initializer per pair
+// in the per URI mode, and mounts the shared emptyDir at the common parent of all destination paths.
 func (r *LLMISVCReconciler) attachMultiStorageDownloads(
 	ctx context.Context,
 	serviceAccount *corev1.ServiceAccount,
@@ -487,11 +505,6 @@ func (r *LLMISVCReconciler) attachMultiStorageDownloads(
 		parent = "/"
 	}
 
-	args := make([]string, 0, len(pairs)*2)
-	for _, p := range pairs {
-		args = append(args, p.uri, p.path)
-	}
-
 	copied := *storageConfig
 	for _, ic := range curr.InitContainers {
 		if ic.Name == constants.StorageInitializerContainerName {
@@ -500,28 +513,46 @@ func (r *LLMISVCReconciler) attachMultiStorageDownloads(
 		}
 	}
 
-	initC := utils.CreateInitContainerWithConfig(&copied, args)
-
-	if userOverride != nil {
-		merged, err := utils.MergeContainerWithPatch(*initC, *userOverride)
-		if err != nil {
-			return fmt.Errorf("failed to merge user storage-initializer customizations: %w", err)
+	downloads := [][]storageDownloadPair{pairs}
+	if len(pairs) > 1 && storageConfig.MultiStorageUriMode == kserveTypes.MultiStorageUriModePerUri {
+		downloads = make([][]storageDownloadPair, 0, len(pairs))
+		for _, p := range pairs {
+			downloads = append(downloads, []storageDownloadPair{p})
 		}
-		merged.Name = initC.Name
-		merged.Args = initC.Args
-		merged.Command = initC.Command
-		initC = &merged
 	}
 
-	podSpec.InitContainers = append(podSpec.InitContainers, *initC)
-	iname := initC.Name
+	initNames := make([]string, 0, len(downloads))
+	for i, download := range downloads {
+		args := make([]string, 0, len(download)*2)
+		for _, p := range download {
+			args = append(args, p.uri, p.path)
+		}
 
-	if err := utils.AddModelMount(utils.StorageMountParams{
-		MountPath:  parent,
-		VolumeName: constants.StorageInitializerVolumeName,
-		ReadOnly:   false,
-	}, iname, podSpec); err != nil {
-		return err
+		initC := utils.CreateInitContainerWithConfig(&copied, args)
+		initC.Name = utils.StorageInitializerContainerNameForIndex(i)
+		utils.AddParallelDownloadsEnvVar(initC, storageConfig, len(download))
+
+		if userOverride != nil {
+			merged, err := utils.MergeContainerWithPatch(*initC, *userOverride)
+			if err != nil {
+				return fmt.Errorf("failed to merge user storage-initializer customizations: %w", err)
+			}
+			merged.Name = initC.Name
+			merged.Args = initC.Args
+			merged.Command = initC.Command
+			initC = &merged
+		}
+
+		podSpec.InitContainers = append(podSpec.InitContainers, *initC)
+		initNames = append(initNames, initC.Name)
+
+		if err := utils.AddModelMount(utils.StorageMountParams{
+			MountPath:  parent,
+			VolumeName: constants.StorageInitializerVolumeName,
+			ReadOnly:   false,
+		}, initC.Name, podSpec); err != nil {
+			return err
+		}
 	}
 	if err := utils.AddModelMount(utils.StorageMountParams{
 		MountPath:  parent,
@@ -531,47 +562,53 @@ func (r *LLMISVCReconciler) attachMultiStorageDownloads(
 		return err
 	}
 
-	initPtr := utils.GetInitContainerWithName(podSpec, constants.StorageInitializerContainerName)
-	if initPtr == nil {
-		return errors.New("storage-initializer init container not found after attachMultiStorageDownloads")
-	}
-
 	if serviceAccount == nil {
 		serviceAccount = &corev1.ServiceAccount{}
 		err := r.Get(ctx, types.NamespacedName{Name: constants.LLMISVCDefaultServiceAccountName, Namespace: llmSvc.Namespace}, serviceAccount)
 		if err != nil {
 			log.FromContext(ctx).Error(err, "Failed to find default service account", "namespace", llmSvc.Namespace)
-			injectCaBundle(llmSvc.Namespace, podSpec, initPtr, storageConfig)
-			return nil
+			serviceAccount = nil
 		}
 	}
 
 	credentialBuilder := credentials.NewCredentialBuilderFromConfig(r.Client, r.Clientset, *credentialConfig)
-	if err := credentialBuilder.CreateSecretVolumeAndEnvFromServiceAccount(
-		ctx,
-		serviceAccount,
-		llmSvc.Annotations,
-		initPtr,
-		&podSpec.Volumes,
-	); err != nil {
-		return err
-	}
+	for i, iname := range initNames {
+		initPtr := utils.GetInitContainerWithName(podSpec, iname)
+		if initPtr == nil {
+			return fmt.Errorf("%s init container not found after attachMultiStorageDownloads", iname)
+		}
 
-	needHF := slices.ContainsFunc(pairs, func(p storageDownloadPair) bool {
-		return strings.HasPrefix(p.uri, constants.HfURIPrefix)
-	})
-	currentInit := utils.GetInitContainerWithName(&curr, constants.StorageInitializerContainerName)
-	if needHF && (currentInit == nil || slices.ContainsFunc(currentInit.Env, func(e corev1.EnvVar) bool {
-		return strings.HasPrefix(e.Name, "HF_")
-	})) {
-		utils.AddDefaultHuggingFaceEnvVars(initPtr)
-	}
+		if serviceAccount == nil {
+			injectCaBundle(llmSvc.Namespace, podSpec, initPtr, storageConfig)
+			continue
+		}
 
-	if containerName == tokenizerContainerName {
-		utils.AddEnvVars(initPtr, []corev1.EnvVar{*tokenizerOnlyDownload.DeepCopy()})
-	}
+		if err := credentialBuilder.CreateSecretVolumeAndEnvFromServiceAccount(
+			ctx,
+			serviceAccount,
+			llmSvc.Annotations,
+			initPtr,
+			&podSpec.Volumes,
+		); err != nil {
+			return err
+		}
 
-	injectCaBundle(llmSvc.Namespace, podSpec, initPtr, storageConfig)
+		needHF := slices.ContainsFunc(downloads[i], func(p storageDownloadPair) bool {
+			return strings.HasPrefix(p.uri, constants.HfURIPrefix)
+		})
+		currentInit := utils.GetInitContainerWithName(&curr, iname)
+		if needHF && (currentInit == nil || slices.ContainsFunc(currentInit.Env, func(e corev1.EnvVar) bool {
+			return strings.HasPrefix(e.Name, "HF_")
+		})) {
+			utils.AddDefaultHuggingFaceEnvVars(initPtr)
+		}
+
+		if containerName == tokenizerContainerName {
+			utils.AddEnvVars(initPtr, []corev1.EnvVar{*tokenizerOnlyDownload.DeepCopy()})
+		}
+
+		injectCaBundle(llmSvc.Namespace, podSpec, initPtr, storageConfig)
+	}
 	return nil
 }
 
@@ -655,7 +692,7 @@ func injectCaBundle(namespace string, podSpec *corev1.PodSpec, initContainer *co
 		ReadOnly:  true,
 	}
 
-	podSpec.Volumes = append(podSpec.Volumes, caBundleVolume)
+	podSpec.Volumes = utils.AppendVolumeIfNotExists(podSpec.Volumes, caBundleVolume)
 	initContainer.VolumeMounts = append(initContainer.VolumeMounts, caBundleVolumeMount)
 
 	return true
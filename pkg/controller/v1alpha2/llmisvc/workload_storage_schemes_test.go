@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package llmisvc
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha2"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/credentials"
+	"github.com/kserve/kserve/pkg/credentials/hdfs"
+	"github.com/kserve/kserve/pkg/credentials/ms"
+	kserveTypes "github.com/kserve/kserve/pkg/types"
+	"github.com/kserve/kserve/pkg/utils"
+)
+
+func mustParseURL(t *testing.T, uri string) apis.URL {
+	t.Helper()
+	u, err := apis.ParseURL(uri)
+	require.NoError(t, err)
+	return *u
+}
+
+func TestAttachModelArtifactsStorageSchemes(t *testing.T) {
+	const namespace = "models"
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm-sa", Namespace: namespace},
+		Secrets:    []corev1.ObjectReference{{Name: "hdfs-creds"}, {Name: "ms-creds"}},
+	}
+	clientset := fakeclientset.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "hdfs-creds", Namespace: namespace},
+			Data:       map[string][]byte{hdfs.HdfsNamenode: []byte("https://namenode:9870")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms-creds", Namespace: namespace},
+			Data:       map[string][]byte{ms.MSTokenKey: []byte("token")},
+		},
+	)
+	r := &LLMISVCReconciler{Clientset: clientset}
+	config := &Config{
+		StorageConfig: &kserveTypes.StorageInitializerConfig{
+			Image:         "kserve/storage-initializer:latest",
+			CpuRequest:    "100m",
+			CpuLimit:      "1",
+			MemoryRequest: "256Mi",
+			MemoryLimit:   "1Gi",
+		},
+		CredentialConfig: &credentials.CredentialConfig{},
+	}
+
+	for _, modelUri := range []string{
+		"hdfs://models/llama",
+		"webhdfs://models/llama",
+		"modelscope://qwen/Qwen2.5-7B-Instruct",
+		"gs://bucket/models/llama",
+		"https://account.blob.core.windows.net/models/llama",
+	} {
+		t.Run(modelUri, func(t *testing.T) {
+			llmSvc := &v1alpha2.LLMInferenceService{
+				ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: namespace},
+				Spec: v1alpha2.LLMInferenceServiceSpec{
+					Model: v1alpha2.LLMModelSpec{URI: mustParseURL(t, modelUri)},
+				},
+			}
+			podSpec := &corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}}}
+
+			err := r.attachModelArtifacts(context.Background(), serviceAccount, llmSvc, corev1.PodSpec{}, podSpec, config,
+				"main", constants.DefaultModelLocalMountPath, false)
+			require.NoError(t, err)
+
+			initContainer := utils.GetInitContainerWithName(podSpec, constants.StorageInitializerContainerName)
+			require.NotNil(t, initContainer)
+			assert.Equal(t, []string{modelUri, constants.DefaultModelLocalMountPath}, initContainer.Args)
+			assert.Contains(t, initContainer.VolumeMounts, corev1.VolumeMount{
+				Name:      hdfs.HdfsVolumeName,
+				ReadOnly:  true,
+				MountPath: hdfs.MountPath,
+			})
+			assert.True(t, slices.ContainsFunc(initContainer.Env, func(e corev1.EnvVar) bool {
+				return e.Name == ms.MSTokenKey
+			}), "the ModelScope token is injected")
+			assert.True(t, slices.ContainsFunc(podSpec.Volumes, func(v corev1.Volume) bool {
+				return v.Name == hdfs.HdfsVolumeName
+			}), "the HDFS secret volume is added")
+		})
+	}
+}
+
+func TestEnumerateLoRAAdaptersStorageSchemes(t *testing.T) {
+	newSpec := func(t *testing.T, uris ...string) v1alpha2.LLMInferenceServiceSpec {
+		spec := v1alpha2.LLMInferenceServiceSpec{Model: v1alpha2.LLMModelSpec{LoRA: &v1alpha2.LoRASpec{}}}
+		for i, uri := range uris {
+			spec.Model.LoRA.Adapters = append(spec.Model.LoRA.Adapters, v1alpha2.LLMModelSpec{
+				Name: ptr.To(string(rune('a' + i))),
+				URI:  mustParseURL(t, uri),
+			})
+		}
+		return spec
+	}
+
+	adapters, err := enumerateLoRAAdapters(newSpec(t,
+		"modelscope://org/adapter", "hdfs://adapters/a", "webhdfs://adapters/b", "gs://bucket/adapter", "pvc://claim/adapter"))
+	require.NoError(t, err)
+	pairs := collectLoRADownloadPairs(adapters)
+	assert.Equal(t, []storageDownloadPair{
+		{uri: "modelscope://org/adapter", path: "/mnt/lora/a"},
+		{uri: "hdfs://adapters/a", path: "/mnt/lora/b"},
+		{uri: "webhdfs://adapters/b", path: "/mnt/lora/c"},
+		{uri: "gs://bucket/adapter", path: "/mnt/lora/d"},
+	}, pairs, "pvc:// adapters are mounted rather than downloaded")
+
+	disabled := newSpec(t, "hdfs://adapters/a")
+	disabled.StorageInitializer = &v1alpha2.StorageInitializerSpec{Enabled: ptr.To(false)}
+	_, err = enumerateLoRAAdapters(disabled)
+	require.ErrorContains(t, err, "hdfs:// requires the storage initializer")
+
+	_, err = enumerateLoRAAdapters(newSpec(t, "ftp://adapters/a"))
+	require.ErrorContains(t, err, `unsupported URI scheme "ftp://"; supported schemes are s3://, gs://, hf://, modelscope://, hdfs://, webhdfs://, https://, http://, pvc://`)
+}
@@ -18,6 +18,7 @@ package inferenceservice
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -443,6 +444,75 @@ var _ = Describe("Canary deployment controller", func() {
 		})
 	})
 
+	Context("When the canary has a progressive rollout", func() {
+		It("Should persist the rollout status and move through the steps", func() {
+			configMap := createInferenceServiceConfigMap(configs)
+			Expect(k8sClient.Create(context.TODO(), configMap)).NotTo(HaveOccurred())
+			defer k8sClient.Delete(context.TODO(), configMap)
+
+			servingRuntime := getServingRuntime("tf-canary-rollout", "default")
+			Expect(k8sClient.Create(context.TODO(), &servingRuntime)).NotTo(HaveOccurred())
+			defer k8sClient.Delete(context.TODO(), &servingRuntime)
+
+			serviceName := "canary-rollout-test"
+			serviceKey := types.NamespacedName{Name: serviceName, Namespace: "default"}
+			ctx := context.Background()
+
+			canary := makeCanary("v2", 0, "s3://test/model-v2")
+			canary.Rollout = &v1beta1.ProgressiveRolloutSpec{
+				Steps: []v1beta1.RolloutStep{
+					{TrafficPercent: 25, Hold: &metav1.Duration{Duration: 2 * time.Second}},
+					{TrafficPercent: 50, Hold: &metav1.Duration{Duration: 2 * time.Second}},
+				},
+			}
+			isvc := makeCanaryISVC(serviceName, "default", "s3://test/model-v1", 4, []v1beta1.CanarySpec{canary})
+			isvc.DefaultInferenceService(nil, nil, &v1beta1.SecurityConfig{AutoMountServiceAccountToken: false}, nil, nil)
+			Expect(k8sClient.Create(ctx, isvc)).Should(Succeed())
+			defer k8sClient.Delete(ctx, isvc)
+
+			canaryKey := types.NamespacedName{Name: constants.PredictorServiceName(serviceName, "v2"), Namespace: "default"}
+			markCanaryAvailable(ctx, canaryKey)
+
+			rolloutStatus := func() *v1beta1.RolloutStatus {
+				actual := &v1beta1.InferenceService{}
+				if err := k8sClient.Get(ctx, serviceKey, actual); err != nil || len(actual.Status.CanaryStatuses) == 0 {
+					return nil
+				}
+				return actual.Status.CanaryStatuses[0].Rollout
+			}
+
+			// The first step hold is persisted, so it elapses rather than restarting on every reconcile.
+			Eventually(func() int32 {
+				if status := rolloutStatus(); status != nil && status.StepStartTime != nil {
+					return status.CurrentStep
+				}
+				return -1
+			}, timeout, interval).Should(Equal(int32(0)))
+
+			// The requeue after the hold moves the rollout to the next step without any other event.
+			Eventually(func() int32 {
+				if status := rolloutStatus(); status != nil {
+					return status.CurrentStep
+				}
+				return -1
+			}, timeout, interval).Should(Equal(int32(1)))
+			status := rolloutStatus()
+			Expect(status.History).To(HaveLen(1))
+			Expect(status.History[0].Result).To(Equal(v1beta1.RolloutStepPassed))
+
+			Eventually(func() v1beta1.RolloutPhase {
+				if status := rolloutStatus(); status != nil {
+					return status.Phase
+				}
+				return ""
+			}, timeout, interval).Should(Equal(v1beta1.RolloutSucceeded))
+
+			actual := &v1beta1.InferenceService{}
+			Expect(k8sClient.Get(ctx, serviceKey, actual)).Should(Succeed())
+			Expect(actual.Status.CanaryStatuses[0].TrafficPercent).To(Equal(int32(50)))
+		})
+	})
+
 	Context("When force stopping an InferenceService with canary", func() {
 		It("Should delete both stable and canary Deployments", func() {
 			configMap := createInferenceServiceConfigMap(configs)
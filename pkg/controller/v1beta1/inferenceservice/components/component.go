@@ -22,12 +22,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
-	"github.com/kserve/kserve/pkg/controller/v1alpha1/trainedmodel/sharding/memory"
 	v1beta1utils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
 	"github.com/kserve/kserve/pkg/credentials"
 )
@@ -37,6 +37,14 @@ type Component interface {
 	Reconcile(ctx context.Context, isvc *v1beta1.InferenceService) (ctrl.Result, error)
 }
 
+// StatusRequeuer is implemented by components progressing over time, e.g. holding a canary rollout step.
+// Unlike a requeue returned by Reconcile, the InferenceService is requeued once its status and the other
+// components are reconciled, so the progress is persisted and applied to the ingress.
+type StatusRequeuer interface {
+	// StatusRequeueAfter returns how long to wait before the component must be reconciled again, zero if not needed.
+	StatusRequeueAfter() time.Duration
+}
+
 func addStorageSpecAnnotations(storageSpec *v1beta1.ModelStorageSpec, annotations map[string]string) bool {
 	if storageSpec == nil {
 		return false
@@ -92,13 +100,10 @@ func addBatcherAnnotations(batcher *v1beta1.Batcher, annotations map[string]stri
 func addAgentAnnotations(isvc *v1beta1.InferenceService, annotations map[string]string) bool {
 	if v1beta1utils.IsMMSPredictor(&isvc.Spec.Predictor) {
 		annotations[constants.AgentShouldInjectAnnotationKey] = "true"
-		shardStrategy := memory.MemoryStrategy{}
-		for _, id := range shardStrategy.GetShard(isvc) {
-			multiModelConfigMapName := constants.ModelConfigName(isvc.Name, id)
-			annotations[constants.AgentModelConfigVolumeNameAnnotationKey] = multiModelConfigMapName
-			annotations[constants.AgentModelConfigMountPathAnnotationKey] = constants.ModelConfigDir
-			annotations[constants.AgentModelDirAnnotationKey] = constants.ModelDir
-		}
+		// The predictor serves the TrainedModels of shard 0, the other shards are served by the shard predictors
+		annotations[constants.AgentModelConfigVolumeNameAnnotationKey] = constants.ModelConfigName(isvc.Name, 0)
+		annotations[constants.AgentModelConfigMountPathAnnotationKey] = constants.ModelConfigDir
+		annotations[constants.AgentModelDirAnnotationKey] = constants.ModelDir
 		return true
 	}
 	return false
@@ -18,8 +18,10 @@ package components
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -33,6 +35,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
@@ -45,16 +48,21 @@ import (
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1alpha1/localmodel/revision"
 	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/knative"
 	modelconfig "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/modelconfig"
 	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/raw"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/rollout"
 	isvcutils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
 	"github.com/kserve/kserve/pkg/credentials"
 	"github.com/kserve/kserve/pkg/utils"
 	"github.com/kserve/kserve/pkg/webhook/admission/pod"
 )
 
-var _ Component = &Predictor{}
+var (
+	_ Component      = &Predictor{}
+	_ StatusRequeuer = &Predictor{}
+)
 
 const (
 	ErrInvalidPlaceholder         = "failed to replace placeholders in serving runtime %s Container %s"
@@ -69,20 +77,50 @@ type Predictor struct {
 	scheme                 *runtime.Scheme
 	inferenceServiceConfig *v1beta1.InferenceServicesConfig
 	deploymentMode         constants.DeploymentModeType
-	Log                    logr.Logger
+	rolloutReconciler      *rollout.RolloutReconciler
+	// revisionResolver overrides the resolver of the model revisions, which uses the storage credentials of the
+	// predictor otherwise
+	revisionResolver   revision.Resolver
+	statusRequeueAfter time.Duration
+	Log                logr.Logger
+}
+
+// PredictorOption customizes how the Predictor reaches outside the cluster.
+type PredictorOption func(*Predictor)
+
+// WithRevisionResolver resolves the model revisions with resolver instead of reaching the model sources with the
+// storage credentials of the predictor.
+func WithRevisionResolver(resolver revision.Resolver) PredictorOption {
+	return func(p *Predictor) {
+		p.revisionResolver = resolver
+	}
+}
+
+// WithRolloutAnalyzer analyzes the canary rollout steps with analyzer instead of querying Prometheus and the
+// analysis webhooks.
+func WithRolloutAnalyzer(analyzer rollout.Analyzer) PredictorOption {
+	return func(p *Predictor) {
+		p.rolloutReconciler = rollout.NewRolloutReconciler(analyzer)
+	}
 }
 
 func NewPredictor(client client.Client, clientset kubernetes.Interface, scheme *runtime.Scheme,
 	inferenceServiceConfig *v1beta1.InferenceServicesConfig, deploymentMode constants.DeploymentModeType,
+	opts ...PredictorOption,
 ) Component {
-	return &Predictor{
+	p := &Predictor{
 		client:                 client,
 		clientset:              clientset,
 		scheme:                 scheme,
 		inferenceServiceConfig: inferenceServiceConfig,
 		deploymentMode:         deploymentMode,
+		rolloutReconciler:      rollout.NewRolloutReconciler(rollout.NewAnalyzer(&http.Client{})),
 		Log:                    ctrl.Log.WithName("PredictorReconciler"),
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 type predictorResources struct {
@@ -118,6 +156,10 @@ func (p *Predictor) buildPredictorResources(ctx context.Context, isvc *v1beta1.I
 			return nil, err
 		}
 	}
+	// Pin the model sources to their revisions so that all the replicas download the same model
+	if err := p.resolveModelRevisions(ctx, isvc, annotations); err != nil {
+		return nil, err
+	}
 	// Add confidential annotations if enabled on the predictor
 	addConfidentialAnnotations(&isvc.Spec.Predictor, annotations)
 
@@ -195,6 +237,8 @@ func (p *Predictor) Reconcile(ctx context.Context, isvc *v1beta1.InferenceServic
 		return ctrl.Result{}, err
 	}
 
+	pruneResolvedSources(isvc)
+
 	res, err := p.buildPredictorResources(ctx, isvc, multiNodeEnabled)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -214,6 +258,21 @@ func (p *Predictor) Reconcile(ctx context.Context, isvc *v1beta1.InferenceServic
 		objectMeta.Labels[constants.InferenceServiceGenerationPodLabelKey] = isvcGeneration
 	}
 
+	// Hold the rollout of new models until the nodes running the predictor pre-pulled them. The target model
+	// state stays pending, it moves to loading once the new pods initialize the pre-pulled models. The warmup
+	// jobs are checked again once the pending state is persisted.
+	if isModelPrepullEnabled(isvc) && !utils.GetForceStopRuntime(isvc) {
+		prepulled, err := p.reconcileModelPrepull(ctx, isvc, &podSpec, objectMeta.Annotations)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to pre-pull the predictor models")
+		}
+		if !prepulled {
+			isvc.Status.UpdateModelRevisionStates(v1beta1.Pending, nil)
+			p.statusRequeueAfter = modelPrepullPollInterval
+			return ctrl.Result{}, nil
+		}
+	}
+
 	p.Log.Info("Resolved main predictor container", "podSpec", podSpec)
 	var rawDeployment bool
 	var podLabelKey string
@@ -221,15 +280,24 @@ func (p *Predictor) Reconcile(ctx context.Context, isvc *v1beta1.InferenceServic
 
 	// Here we allow switch between knative and vanilla deployment
 	kstatus := &knservingv1.ServiceStatus{}
+	var rolloutRequeue time.Duration
 	if p.deploymentMode == constants.Standard {
 		rawDeployment = true
 		podLabelKey = constants.RawDeploymentAppLabel
 		// Reconcile canary first so CanaryStatuses is fresh when the stable
 		// minReplicas reduction decision is made below. This ensures the
 		// stable Deployment is not scaled down until canary pods are Ready.
-		if err := p.reconcileCanaryDeployments(ctx, isvc); err != nil {
+		if rolloutRequeue, err = p.reconcileCanaryDeployments(ctx, isvc); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to reconcile canary deployments")
 		}
+		// Predictors scaled to zero are autoscaled on the requests buffered by the activator
+		activatorEnabled, err := p.isActivatorEnabled(ctx, isvc)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if activatorEnabled {
+			objectMeta.Annotations[constants.ActivatorInternalAnnotationKey] = "true"
+		}
 		// This is main RawKubeReconciler to create objects (deployment, svc, scaler)
 		if err := p.reconcileRawDeployment(ctx, isvc, objectMeta, workerObjectMeta, &podSpec, workerPodSpec); err != nil {
 			isvc.Status.PropagateRawStatusWithMessages(v1beta1.PredictorComponent, "ReconcileFailed", err.Error(), corev1.ConditionFalse)
@@ -245,6 +313,14 @@ func (p *Predictor) Reconcile(ctx context.Context, isvc *v1beta1.InferenceServic
 		}
 	}
 
+	if err := p.reconcileShadow(ctx, isvc); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "fails to reconcile shadow predictor")
+	}
+
+	if err := p.reconcileShards(ctx, isvc); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "fails to reconcile predictor shards")
+	}
+
 	// Handle InferenceService status updates based on the force stop annotation.
 	// If true, transition the service to a stopped and unready state; otherwise, ensure it's not marked as stopped.
 	if utils.GetForceStopRuntime(isvc) {
@@ -283,12 +359,20 @@ func (p *Predictor) Reconcile(ctx context.Context, isvc *v1beta1.InferenceServic
 	}
 
 	if isvc.Status.PropagateModelStatus(statusSpec, predictorPods, rawDeployment, kstatus) {
+		// Progressive canary rollouts are evaluated again once the current step hold has elapsed.
+		p.statusRequeueAfter = rolloutRequeue
 		return ctrl.Result{}, nil
 	} else {
 		return ctrl.Result{RequeueAfter: time.Second}, nil
 	}
 }
 
+// StatusRequeueAfter returns how long to wait before the progressive rollout of the canaries, or the pre-pull of
+// the models, must be evaluated again.
+func (p *Predictor) StatusRequeueAfter() time.Duration {
+	return p.statusRequeueAfter
+}
+
 func (p *Predictor) reconcileModelConfig(ctx context.Context, isvc *v1beta1.InferenceService) error {
 	configMapReconciler := modelconfig.NewModelConfigReconciler(p.client, p.clientset, p.scheme)
 	return configMapReconciler.Reconcile(ctx, isvc)
@@ -311,6 +395,181 @@ func (p *Predictor) addStorageInitializerAnnotations(ctx context.Context, predic
 	return nil
 }
 
+// resolveModelRevisions resolves the revisions of the storage URIs of the predictor when the pinModelRevisions
+// storage initializer config is enabled, and annotates them so that the storage initializer downloads them. The
+// revisions are recorded in the model status and reused as long as the storage URI is deployed, so the replicas
+// created later download the same model even when the source changed. The storage URIs whose revision cannot be
+// resolved are downloaded unpinned, which the ModelRevisionsResolved condition reports, and resolved again by the
+// next reconciles.
+func (p *Predictor) resolveModelRevisions(ctx context.Context, isvc *v1beta1.InferenceService, annotations map[string]string) error {
+	storageUris := predictorStorageUris(&isvc.Spec.Predictor)
+	if len(storageUris) == 0 {
+		isvc.Status.ClearCondition(v1beta1.ModelRevisionsResolved)
+		return nil
+	}
+	isvcConfigMap, err := v1beta1.GetInferenceServiceConfigMap(ctx, p.clientset)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get InferenceService ConfigMap")
+	}
+	storageInitializerConfig, err := v1beta1.GetStorageInitializerConfigs(isvcConfigMap)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get StorageInitializer config")
+	}
+	if !storageInitializerConfig.PinModelRevisions {
+		isvc.Status.ClearCondition(v1beta1.ModelRevisionsResolved)
+		return nil
+	}
+
+	// The sources are only reached within the reconcile for the storage URIs not resolved yet
+	resolveCtx, cancel := context.WithTimeout(ctx, revision.DefaultResolveTimeout)
+	defer cancel()
+	var resolver revision.Resolver
+	revisions := map[string]string{}
+	var failures []string
+	for _, storageUri := range storageUris {
+		if resolved := isvc.Status.GetResolvedModelSource(storageUri); resolved != nil {
+			revisions[storageUri] = resolved.Revision
+			continue
+		}
+		if resolver == nil {
+			if resolver, err = p.newRevisionResolver(resolveCtx, isvc, isvcConfigMap, annotations, storageUri); err != nil {
+				p.Log.Error(err, "Failed to get the storage credentials to resolve the model revisions", "inferenceservice", isvc.Name)
+				failures = append(failures, fmt.Sprintf("failed to get the storage credentials: %v", err))
+				break
+			}
+		}
+		// The OCI materialization mode does not change the image
+		_, sourceUri, _ := utils.ParseOciScheme(storageUri)
+		resolved, err := resolver.Resolve(resolveCtx, sourceUri)
+		if errors.Is(err, revision.ErrUnsupportedScheme) {
+			continue
+		} else if err != nil {
+			p.Log.Error(err, "Failed to resolve the model source revision, the model is downloaded unpinned",
+				"inferenceservice", isvc.Name, "storageUri", storageUri)
+			failures = append(failures, fmt.Sprintf("failed to resolve the revision of %s: %v", storageUri, err))
+			continue
+		}
+		p.Log.Info("Resolved model source revision", "inferenceservice", isvc.Name, "storageUri", storageUri, "revision", resolved)
+		now := metav1.Now()
+		isvc.Status.ModelStatus.ResolvedSources = append(isvc.Status.ModelStatus.ResolvedSources, v1beta1.ResolvedModelSource{
+			StorageUri:   storageUri,
+			Revision:     resolved,
+			ResolvedTime: &now,
+		})
+		revisions[storageUri] = resolved
+	}
+	setModelRevisionsResolvedCondition(isvc, failures)
+	if len(revisions) == 0 {
+		return nil
+	}
+	revisionsJSON, err := json.Marshal(revisions)
+	if err != nil {
+		return err
+	}
+	annotations[constants.StorageRevisionsInternalAnnotationKey] = string(revisionsJSON)
+	return nil
+}
+
+// isActivatorEnabled returns whether the predictor is scaled to zero behind the activator, which is only routed to
+// by the Gateway API HTTPRoute of the InferenceService.
+func (p *Predictor) isActivatorEnabled(ctx context.Context, isvc *v1beta1.InferenceService) (bool, error) {
+	if isvc.Annotations[constants.EnableActivatorAnnotationKey] != "true" {
+		return false, nil
+	}
+	isvcConfigMap, err := v1beta1.GetInferenceServiceConfigMap(ctx, p.clientset)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get InferenceService ConfigMap")
+	}
+	ingressConfig, err := v1beta1.NewIngressConfig(isvcConfigMap)
+	if err != nil {
+		return false, errors.Wrapf(err, "fails to create IngressConfig")
+	}
+	return isvcutils.IsActivatorEnabled(isvc, ingressConfig), nil
+}
+
+// newRevisionResolver returns the resolver of the model revisions, reaching the model sources with the storage
+// credentials the storage initializer of the predictor is given
+func (p *Predictor) newRevisionResolver(ctx context.Context, isvc *v1beta1.InferenceService, isvcConfigMap *corev1.ConfigMap,
+	annotations map[string]string, storageUri string,
+) (revision.Resolver, error) {
+	if p.revisionResolver != nil {
+		return p.revisionResolver, nil
+	}
+	credentialBuilder := credentials.NewCredentialBuilder(p.client, p.clientset, isvcConfigMap)
+	container := &corev1.Container{Args: []string{storageUri}}
+	volumes := []corev1.Volume{}
+	var err error
+	if storageSpec := isvc.Spec.Predictor.GetImplementation().GetStorageSpec(); storageSpec != nil &&
+		storageSpec.StorageKey != nil && storageSpec.Parameters != nil {
+		err = credentialBuilder.CreateStorageSpecSecretEnvs(ctx, isvc.Namespace, annotations, *storageSpec.StorageKey,
+			*storageSpec.Parameters, container)
+	} else {
+		err = credentialBuilder.CreateSecretVolumeAndEnv(ctx, isvc.Namespace, annotations,
+			isvc.Spec.Predictor.ServiceAccountName, container, &volumes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	creds, err := revision.CredentialsFromContainer(ctx, p.clientset, isvc.Namespace, container, volumes)
+	if err != nil {
+		return nil, err
+	}
+	return revision.NewSourceResolverWithCredentials(creds, revision.DefaultResolveTimeout), nil
+}
+
+// setModelRevisionsResolvedCondition reports whether the revisions of all the model sources are pinned
+func setModelRevisionsResolvedCondition(isvc *v1beta1.InferenceService, failures []string) {
+	condition := &apis.Condition{
+		Type:   v1beta1.ModelRevisionsResolved,
+		Status: corev1.ConditionTrue,
+	}
+	if len(failures) > 0 {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = v1beta1.ModelRevisionResolutionFailedReason
+		condition.Message = "The models are downloaded unpinned: " + strings.Join(failures, "; ")
+	}
+	isvc.Status.SetCondition(v1beta1.ModelRevisionsResolved, condition)
+}
+
+// predictorStorageUris returns the storage URIs of the models of the predictor
+func predictorStorageUris(predictor *v1beta1.PredictorSpec) []string {
+	storageUris := []string{}
+	if implementations := predictor.GetImplementations(); len(implementations) > 0 {
+		if storageUri := implementations[0].GetStorageUri(); storageUri != nil && *storageUri != "" {
+			storageUris = append(storageUris, *storageUri)
+		}
+	}
+	for _, storageUri := range predictor.StorageUris {
+		storageUris = append(storageUris, storageUri.Uri)
+	}
+	return storageUris
+}
+
+// pruneResolvedSources removes the resolved revisions of the storage URIs no longer deployed by the predictor, its
+// canaries or its shadow, so deploying a storage URI again resolves its current revision
+func pruneResolvedSources(isvc *v1beta1.InferenceService) {
+	if len(isvc.Status.ModelStatus.ResolvedSources) == 0 {
+		return
+	}
+	deployed := sets.New(predictorStorageUris(&isvc.Spec.Predictor)...)
+	for i := range isvc.Spec.Canary {
+		deployed.Insert(predictorStorageUris(&isvc.Spec.Canary[i].Predictor)...)
+	}
+	if isvc.Spec.Shadow != nil {
+		deployed.Insert(predictorStorageUris(&isvc.Spec.Shadow.Predictor)...)
+	}
+	resolvedSources := []v1beta1.ResolvedModelSource{}
+	for _, resolved := range isvc.Status.ModelStatus.ResolvedSources {
+		if deployed.Has(resolved.StorageUri) {
+			resolvedSources = append(resolvedSources, resolved)
+		}
+	}
+	if len(resolvedSources) == 0 {
+		resolvedSources = nil
+	}
+	isvc.Status.ModelStatus.ResolvedSources = resolvedSources
+}
+
 // addConfidentialAnnotations sets confidential annotations on the service/deployment if
 // the predictor's ConfidentialSpec is enabled. These annotations are read by the webhook
 // mutator to inject environment variables for confidential model serving.
@@ -337,9 +596,14 @@ func (p *Predictor) reconcileModel(ctx context.Context, isvc *v1beta1.InferenceS
 				Reason:  v1beta1.RuntimeNotRecognized,
 				Message: "Waiting for runtime to become available",
 			})
+			isvc.Status.RuntimeSelection = nil
 			return sRuntime, nil, err
 		}
 		runtimeAnnotations = annotations
+		isvc.Status.RuntimeSelection = &v1beta1.RuntimeSelection{Name: *isvc.Spec.Predictor.Model.Runtime, Kind: v1beta1.ServingRuntimeKind}
+		if isClusterServingRuntime {
+			isvc.Status.RuntimeSelection.Kind = v1beta1.ClusterServingRuntimeKind
+		}
 
 		if r.IsDisabled() {
 			isvc.Status.UpdateModelTransitionStatus(v1beta1.InvalidSpec, &v1beta1.FailureInfo{
@@ -379,17 +643,21 @@ func (p *Predictor) reconcileModel(ctx context.Context, isvc *v1beta1.InferenceS
 			isvc.Status.ClusterServingRuntimeName = ""
 		}
 	} else {
-		runtimes, err := isvc.Spec.Predictor.Model.GetSupportingRuntimes(ctx, p.client, isvc.Namespace, false, multiNodeEnabled)
+		runtimes, candidates, err := isvc.Spec.Predictor.Model.GetRuntimeCandidates(ctx, p.client, isvc.Namespace, false, multiNodeEnabled)
 		if err != nil {
 			return sRuntime, nil, err
 		}
+		isvc.Status.RuntimeSelection = &v1beta1.RuntimeSelection{AutoSelected: true, Candidates: candidates}
 		if len(runtimes) == 0 {
 			isvc.Status.UpdateModelTransitionStatus(v1beta1.InvalidSpec, &v1beta1.FailureInfo{
 				Reason:  v1beta1.NoSupportingRuntime,
-				Message: "No runtime found to support specified framework/version",
+				Message: "No runtime found to support specified framework/version, see status.runtimeSelection for the rejected runtimes",
 			})
 			return sRuntime, nil, fmt.Errorf("no runtime found to support predictor with model type: %v", isvc.Spec.Predictor.Model.ModelFormat)
 		}
+		isvc.Status.RuntimeSelection.Name = candidates[0].Name
+		isvc.Status.RuntimeSelection.Kind = candidates[0].Kind
+
 		// Get first supporting runtime.
 		sRuntime = runtimes[0].Spec
 		isvc.Spec.Predictor.Model.Runtime = &runtimes[0].Name
@@ -858,7 +1126,8 @@ func (p *Predictor) reconcileKnativeDeployment(ctx context.Context, isvc *v1beta
 	return kstatus, nil
 }
 
-func canaryReplicaCount(isvc *v1beta1.InferenceService, canary *v1beta1.CanarySpec) int32 {
+// canaryReplicaCount returns the replicas needed by the canary to serve the given traffic percentage.
+func canaryReplicaCount(isvc *v1beta1.InferenceService, canary *v1beta1.CanarySpec, trafficPercent int32) int32 {
 	if canary.Predictor.MinReplicas != nil {
 		return *canary.Predictor.MinReplicas
 	}
@@ -866,7 +1135,7 @@ func canaryReplicaCount(isvc *v1beta1.InferenceService, canary *v1beta1.CanarySp
 	if isvc.Spec.Predictor.MinReplicas != nil {
 		stableReplicas = *isvc.Spec.Predictor.MinReplicas
 	}
-	return int32(math.Ceil(float64(stableReplicas) * float64(canary.TrafficPercent) / 100))
+	return int32(math.Ceil(float64(stableReplicas) * float64(trafficPercent) / 100))
 }
 
 // adjustStableMinReplicasForCanaries reduces the stable predictor's minReplicas
@@ -890,7 +1159,7 @@ func adjustStableMinReplicasForCanaries(isvc *v1beta1.InferenceService, componen
 		if !readyMap[canary.Predictor.Name] {
 			continue
 		}
-		readyCanaryReplicas += canaryReplicaCount(isvc, canary)
+		readyCanaryReplicas += canaryReplicaCount(isvc, canary, isvc.Status.GetCanaryTrafficPercent(canary))
 	}
 
 	if readyCanaryReplicas > 0 {
@@ -915,7 +1184,9 @@ func buildCanaryPredictor(stable v1beta1.PredictorSpec, canary v1beta1.CanarySpe
 	return canaryPredictor, nil
 }
 
-func (p *Predictor) reconcileCanaryDeployments(ctx context.Context, isvc *v1beta1.InferenceService) error {
+// reconcileCanaryDeployments reconciles the canary deployments and their status. It returns how long to wait
+// before the progressive rollout of a canary must be evaluated again, zero when no rollout is progressing.
+func (p *Predictor) reconcileCanaryDeployments(ctx context.Context, isvc *v1beta1.InferenceService) (time.Duration, error) {
 	stableName := constants.PredictorServiceName(isvc.Name, isvc.Spec.Predictor.Name)
 	expectedNames := map[string]bool{stableName: true}
 
@@ -926,18 +1197,22 @@ func (p *Predictor) reconcileCanaryDeployments(ctx context.Context, isvc *v1beta
 		canaryName := constants.PredictorServiceName(isvc.Name, canary.Predictor.Name)
 		expectedNames[canaryName] = true
 
-		replicas := canaryReplicaCount(isvc, canary)
+		// Canaries with a progressive rollout are sized for their final step so promoting a step does not
+		// have to wait for new pods.
+		replicas := canaryReplicaCount(isvc, canary, canary.GetMaxTrafficPercent())
 		canaryPredictor, err := buildCanaryPredictor(stablePredictor, *canary, replicas)
 		if err != nil {
-			return err
+			return 0, err
 		}
 
 		canaryISVC := isvc.DeepCopy()
 		canaryISVC.Spec.Predictor = canaryPredictor
 		res, err := p.buildPredictorResources(ctx, canaryISVC, false)
 		if err != nil {
-			return errors.Wrapf(err, "fails to build resources for canary %s", canary.Predictor.Name)
+			return 0, errors.Wrapf(err, "fails to build resources for canary %s", canary.Predictor.Name)
 		}
+		// Keep the revisions resolved for the storage URIs of the canary
+		isvc.Status.ModelStatus.ResolvedSources = canaryISVC.Status.ModelStatus.ResolvedSources
 
 		componentExt := v1beta1.ComponentExtensionSpec{}
 		componentExt.MinReplicas = &replicas
@@ -945,17 +1220,23 @@ func (p *Predictor) reconcileCanaryDeployments(ctx context.Context, isvc *v1beta
 		r, err := raw.NewRawKubeReconciler(ctx, p.client, p.clientset, p.scheme, res.objectMeta, metav1.ObjectMeta{},
 			&componentExt, &res.podSpec, nil, nil, nil, nil, nil, nil)
 		if err != nil {
-			return errors.Wrapf(err, "fails to create canary reconciler for %s", canary.Predictor.Name)
+			return 0, errors.Wrapf(err, "fails to create canary reconciler for %s", canary.Predictor.Name)
 		}
 
 		if _, err := r.Reconcile(ctx, isvc); err != nil {
-			return errors.Wrapf(err, "fails to reconcile canary %s", canary.Predictor.Name)
+			return 0, errors.Wrapf(err, "fails to reconcile canary %s", canary.Predictor.Name)
 		}
-		p.Log.Info("Reconciled canary deployment", "canary", canary.Predictor.Name, "trafficPercent", canary.TrafficPercent)
+		p.Log.Info("Reconciled canary deployment", "canary", canary.Predictor.Name, "replicas", replicas)
+	}
+
+	previousRollouts := make(map[string]*v1beta1.RolloutStatus, len(isvc.Status.CanaryStatuses))
+	for _, cs := range isvc.Status.CanaryStatuses {
+		previousRollouts[cs.Name] = cs.Rollout
 	}
 
 	// Update canary status
 	var canaryStatuses []v1beta1.CanaryStatus
+	var requeueAfter time.Duration
 	allReady := true
 	for i := range isvc.Spec.Canary {
 		canary := &isvc.Spec.Canary[i]
@@ -970,13 +1251,29 @@ func (p *Predictor) reconcileCanaryDeployments(ctx context.Context, isvc *v1beta
 			allReady = false
 		}
 
-		canaryStatuses = append(canaryStatuses, v1beta1.CanaryStatus{
-			Name:           canary.Predictor.Name,
-			Ready:          ready,
-			TrafficPercent: canary.TrafficPercent,
-		})
+		canaryStatus := v1beta1.CanaryStatus{
+			Name:  canary.Predictor.Name,
+			Ready: ready,
+		}
+		if canary.Rollout != nil {
+			canaryStatus.Rollout = previousRollouts[canary.Predictor.Name]
+			if !utils.GetForceStopRuntime(isvc) {
+				rolloutStatus, after, err := p.rolloutReconciler.Reconcile(ctx, isvc, canary, canaryStatus.Rollout, ready)
+				if err != nil {
+					return 0, errors.Wrapf(err, "fails to reconcile rollout of canary %s", canary.Predictor.Name)
+				}
+				canaryStatus.Rollout = rolloutStatus
+				if after > 0 && (requeueAfter == 0 || after < requeueAfter) {
+					requeueAfter = after
+				}
+			}
+		}
+		canaryStatuses = append(canaryStatuses, canaryStatus)
 	}
 	isvc.Status.CanaryStatuses = canaryStatuses
+	for i := range isvc.Spec.Canary {
+		isvc.Status.CanaryStatuses[i].TrafficPercent = isvc.Status.GetCanaryTrafficPercent(&isvc.Spec.Canary[i])
+	}
 
 	if len(isvc.Spec.Canary) > 0 {
 		status := corev1.ConditionTrue
@@ -1003,16 +1300,17 @@ func (p *Predictor) reconcileCanaryDeployments(ctx context.Context, isvc *v1beta
 		constants.InferenceServicePodLabelKey: isvc.Name,
 		constants.KServiceComponentLabel:      string(v1beta1.PredictorComponent),
 	}); err != nil {
-		return errors.Wrapf(err, "fails to list predictor deployments for cleanup")
+		return 0, errors.Wrapf(err, "fails to list predictor deployments for cleanup")
 	}
 	for i := range deployList.Items {
 		deploy := &deployList.Items[i]
-		if expectedNames[deploy.Name] {
+		// The predictor shards of a multi-model InferenceService are reconciled separately
+		if _, ok := deploy.Labels[constants.TrainedModelShard]; expectedNames[deploy.Name] || ok {
 			continue
 		}
 		p.Log.Info("Deleting orphaned predictor deployment", "name", deploy.Name)
 		if err := p.client.Delete(ctx, deploy); err != nil && !apierrors.IsNotFound(err) {
-			return errors.Wrapf(err, "fails to delete orphaned deployment %s", deploy.Name)
+			return 0, errors.Wrapf(err, "fails to delete orphaned deployment %s", deploy.Name)
 		}
 	}
 
@@ -1021,18 +1319,18 @@ func (p *Predictor) reconcileCanaryDeployments(ctx context.Context, isvc *v1beta
 		constants.InferenceServicePodLabelKey: isvc.Name,
 		constants.KServiceComponentLabel:      string(v1beta1.PredictorComponent),
 	}); err != nil {
-		return errors.Wrapf(err, "fails to list predictor services for cleanup")
+		return 0, errors.Wrapf(err, "fails to list predictor services for cleanup")
 	}
 	for i := range svcList.Items {
 		svc := &svcList.Items[i]
-		if expectedNames[svc.Name] {
+		if _, ok := svc.Labels[constants.TrainedModelShard]; expectedNames[svc.Name] || ok {
 			continue
 		}
 		p.Log.Info("Deleting orphaned predictor service", "name", svc.Name)
 		if err := p.client.Delete(ctx, svc); err != nil && !apierrors.IsNotFound(err) {
-			return errors.Wrapf(err, "fails to delete orphaned service %s", svc.Name)
+			return 0, errors.Wrapf(err, "fails to delete orphaned service %s", svc.Name)
 		}
 	}
 
-	return nil
+	return requeueAfter, nil
 }
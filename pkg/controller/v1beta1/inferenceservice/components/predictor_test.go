@@ -17,15 +17,22 @@ package components
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1alpha1/localmodel/revision"
 )
 
 func TestComputeMpNodeAndGPUs(t *testing.T) {
@@ -236,3 +243,148 @@ func TestAdjustStableMinReplicasForCanaries(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneResolvedSources(t *testing.T) {
+	modelURI := "hf://org/model"
+	canaryURI := "hf://org/model-v2"
+	shadowURI := "oci://registry.io/model:v3"
+	isvc := &v1beta1.InferenceService{
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{
+				Model: &v1beta1.ModelSpec{
+					PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{StorageURI: &modelURI},
+				},
+				StorageUris: []v1beta1.StorageUri{{Uri: "s3://bucket/adapter", MountPath: "/mnt/models/adapter"}},
+			},
+			Canary: []v1beta1.CanarySpec{{
+				TrafficPercent: 10,
+				Predictor: v1beta1.PredictorSpec{
+					Name: "v2",
+					Model: &v1beta1.ModelSpec{
+						PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{StorageURI: &canaryURI},
+					},
+				},
+			}},
+			Shadow: &v1beta1.ShadowSpec{
+				Predictor: v1beta1.PredictorSpec{
+					Model: &v1beta1.ModelSpec{
+						PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{StorageURI: &shadowURI},
+					},
+				},
+			},
+		},
+		Status: v1beta1.InferenceServiceStatus{
+			ModelStatus: v1beta1.ModelStatus{
+				ResolvedSources: []v1beta1.ResolvedModelSource{
+					{StorageUri: modelURI, Revision: "abc123"},
+					{StorageUri: "s3://bucket/adapter", Revision: "sha256:1234"},
+					{StorageUri: canaryURI, Revision: "def456"},
+					{StorageUri: shadowURI, Revision: "sha256:5678"},
+					{StorageUri: "hf://org/removed", Revision: "0123ab"},
+				},
+			},
+		},
+	}
+
+	pruneResolvedSources(isvc)
+	assert.Equal(t, []v1beta1.ResolvedModelSource{
+		{StorageUri: modelURI, Revision: "abc123"},
+		{StorageUri: "s3://bucket/adapter", Revision: "sha256:1234"},
+		{StorageUri: canaryURI, Revision: "def456"},
+		{StorageUri: shadowURI, Revision: "sha256:5678"},
+	}, isvc.Status.ModelStatus.ResolvedSources)
+
+	isvc.Spec.Canary = nil
+	isvc.Spec.Shadow = nil
+	isvc.Spec.Predictor.StorageUris = nil
+	pruneResolvedSources(isvc)
+	assert.Equal(t, []v1beta1.ResolvedModelSource{{StorageUri: modelURI, Revision: "abc123"}},
+		isvc.Status.ModelStatus.ResolvedSources)
+}
+
+type stubRevisionResolver map[string]string
+
+func (r stubRevisionResolver) Resolve(_ context.Context, sourceModelUri string) (string, error) {
+	if resolved, ok := r[sourceModelUri]; ok {
+		return resolved, nil
+	}
+	return "", errors.New("source unreachable")
+}
+
+func newPinningConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace},
+		Data:       map[string]string{v1beta1.StorageInitializerConfigMapKeyName: `{"memoryRequest": "100Mi", "memoryLimit": "1Gi", "cpuRequest": "100m", "cpuLimit": "1", "pinModelRevisions": true}`},
+	}
+}
+
+func TestResolveModelRevisions(t *testing.T) {
+	modelURI := "hf://org/model"
+	isvc := &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "isvc", Namespace: "default"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{
+				Model: &v1beta1.ModelSpec{
+					PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{StorageURI: &modelURI},
+				},
+				StorageUris: []v1beta1.StorageUri{{Uri: "s3://bucket/adapter", MountPath: "/mnt/models/adapter"}},
+			},
+		},
+	}
+	resolver := stubRevisionResolver{modelURI: "abc123"}
+	p := &Predictor{
+		clientset:        fakeclientset.NewSimpleClientset(newPinningConfigMap()),
+		revisionResolver: resolver,
+		Log:              logr.Discard(),
+	}
+
+	// The source which cannot be resolved is downloaded unpinned instead of failing the reconcile
+	annotations := map[string]string{}
+	require.NoError(t, p.resolveModelRevisions(context.Background(), isvc, annotations))
+	assert.JSONEq(t, `{"hf://org/model":"abc123"}`, annotations[constants.StorageRevisionsInternalAnnotationKey])
+	condition := isvc.Status.GetCondition(v1beta1.ModelRevisionsResolved)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1beta1.ModelRevisionResolutionFailedReason, condition.Reason)
+	assert.Contains(t, condition.Message, "s3://bucket/adapter")
+
+	// The next reconcile resolves it again
+	resolver["s3://bucket/adapter"] = "etag"
+	annotations = map[string]string{}
+	require.NoError(t, p.resolveModelRevisions(context.Background(), isvc, annotations))
+	assert.JSONEq(t, `{"hf://org/model":"abc123","s3://bucket/adapter":"etag"}`,
+		annotations[constants.StorageRevisionsInternalAnnotationKey])
+	assert.True(t, isvc.Status.IsConditionReady(v1beta1.ModelRevisionsResolved))
+	assert.Len(t, isvc.Status.ModelStatus.ResolvedSources, 2)
+}
+
+func TestNewRevisionResolverUsesStorageCredentials(t *testing.T) {
+	clientset := fakeclientset.NewSimpleClientset(
+		newPinningConfigMap(),
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "models", Namespace: "default"},
+			Secrets:    []corev1.ObjectReference{{Name: "hf-secret"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "hf-secret", Namespace: "default"},
+			Data:       map[string][]byte{"HF_TOKEN": []byte("hf-token")},
+		},
+	)
+	isvc := &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "isvc", Namespace: "default"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{
+				Model:   &v1beta1.ModelSpec{},
+				PodSpec: v1beta1.PodSpec{ServiceAccountName: "models"},
+			},
+		},
+	}
+	p := &Predictor{client: fake.NewClientBuilder().Build(), clientset: clientset, Log: logr.Discard()}
+
+	resolver, err := p.newRevisionResolver(context.Background(), isvc, newPinningConfigMap(), map[string]string{}, "hf://org/model")
+	require.NoError(t, err)
+	sourceResolver, ok := resolver.(*revision.SourceResolver)
+	require.True(t, ok)
+	assert.Equal(t, "hf-token", sourceResolver.HuggingFaceToken)
+	assert.Equal(t, revision.DefaultResolveTimeout, sourceResolver.HTTPClient.Timeout)
+}
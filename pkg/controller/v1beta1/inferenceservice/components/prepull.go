@@ -0,0 +1,352 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/kmeta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	isvcutils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
+	"github.com/kserve/kserve/pkg/credentials"
+	"github.com/kserve/kserve/pkg/types"
+	"github.com/kserve/kserve/pkg/utils"
+	"github.com/kserve/kserve/pkg/webhook/admission/pod"
+)
+
+const (
+	// modelPrepullPollInterval is the interval the warmup jobs are checked at while the rollout is held
+	modelPrepullPollInterval = 10 * time.Second
+	// modelPrepullContainerName is the name of the containers of the warmup jobs
+	modelPrepullContainerName = "model-prepull"
+)
+
+// modelPrepullJobDeadlineSeconds bounds the warmup of a node, the rollout proceeds once it is exceeded
+var modelPrepullJobDeadlineSeconds = int64(3600)
+
+// modelPrepullSources are the model sources of the predictor warmed up on the nodes
+type modelPrepullSources struct {
+	// images are the OCI model images pulled by the nodes, mounted as image volume when native
+	images []modelPrepullImage
+	// downloads are the storage URIs downloaded by the storage initializer into the node cache
+	downloads []string
+	// revisions are the revisions the downloaded objects of the storage URIs not pinned by their URI must match
+	revisions map[string]string
+}
+
+type modelPrepullImage struct {
+	reference string
+	native    bool
+}
+
+func (s *modelPrepullSources) empty() bool {
+	return len(s.images) == 0 && len(s.downloads) == 0
+}
+
+// key identifies the models of a rollout, it changes with the storage URIs and their pinned revisions
+func (s *modelPrepullSources) key() string {
+	hash := sha256.New()
+	for _, image := range s.images {
+		fmt.Fprintf(hash, "image\t%s\t%t\n", image.reference, image.native)
+	}
+	for _, download := range s.downloads {
+		fmt.Fprintf(hash, "download\t%s\t%s\n", download, s.revisions[download])
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// isModelPrepullEnabled returns whether the models of the InferenceService are pre-pulled onto the nodes before
+// they are rolled out
+func isModelPrepullEnabled(isvc *v1beta1.InferenceService) bool {
+	return strings.EqualFold(isvc.Annotations[constants.ModelPrepullAnnotationKey], "true")
+}
+
+// getModelPrepullSources returns the model sources of the predictor which are warmed up on the nodes, pinned to
+// their resolved revisions like the storage initializer does. The OCI model images are pulled unless they are
+// fetched by the storage initializer, and the other sources are downloaded when the node cache is configured.
+func getModelPrepullSources(storageUris []string, annotations map[string]string, config *types.StorageInitializerConfig) (*modelPrepullSources, error) {
+	uris := make([]v1beta1.StorageUri, 0, len(storageUris))
+	for _, storageUri := range storageUris {
+		uris = append(uris, v1beta1.StorageUri{Uri: storageUri})
+	}
+	pinnedUris, revisions, err := pod.PinStorageURIs(uris, annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := &modelPrepullSources{revisions: map[string]string{}}
+	for i, pinnedUri := range pinnedUris {
+		mode, normalizedUri, isOci := utils.ParseOciScheme(pinnedUri.Uri)
+		if isOci {
+			if mode == "" {
+				mode = types.ResolveOciModelMode(config)
+			}
+			switch mode {
+			case types.OciModelModeModelcar, types.OciModelModeNative:
+				sources.images = append(sources.images, modelPrepullImage{
+					reference: strings.TrimPrefix(normalizedUri, constants.OciURIPrefix),
+					native:    mode == types.OciModelModeNative,
+				})
+				continue
+			case types.OciModelModeFetch:
+				continue
+			}
+		}
+		if config.NodeCacheDir == "" || strings.HasPrefix(pinnedUri.Uri, constants.PvcURIPrefix) ||
+			strings.HasPrefix(pinnedUri.Uri, "file://") ||
+			!utils.IsPrefixSupported(pinnedUri.Uri, isvcutils.SupportedStorageURIPrefixList) {
+			continue
+		}
+		sources.downloads = append(sources.downloads, pinnedUri.Uri)
+		if rev, ok := revisions[storageUris[i]]; ok {
+			sources.revisions[pinnedUri.Uri] = rev
+		}
+	}
+	return sources, nil
+}
+
+// reconcileModelPrepull pre-pulls the models of the predictor onto the nodes running its pods before it is rolled
+// out to new models, so that the new pods do not wait for the download of the models. The rollout is held while
+// the warmup jobs of the nodes run, and proceeds once they finished, successfully or not. The pods are annotated
+// with the node cache directory of their models, which their storage initializer downloads the models through.
+// Returns whether the rollout can proceed.
+func (p *Predictor) reconcileModelPrepull(ctx context.Context, isvc *v1beta1.InferenceService, podSpec *corev1.PodSpec, annotations map[string]string) (bool, error) {
+	// The models of the local model caches are already on the nodes
+	if _, ok := annotations[constants.LocalModelSourceUriAnnotationKey]; ok {
+		return true, nil
+	}
+	isvcConfigMap, err := v1beta1.GetInferenceServiceConfigMap(ctx, p.clientset)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get InferenceService ConfigMap")
+	}
+	storageInitializerConfig, err := v1beta1.GetStorageInitializerConfigs(isvcConfigMap)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get StorageInitializer config")
+	}
+	sources, err := getModelPrepullSources(predictorStorageUris(&isvc.Spec.Predictor), annotations, storageInitializerConfig)
+	if err != nil {
+		return false, err
+	}
+	if sources.empty() {
+		return true, nil
+	}
+	key := sources.key()
+	cacheSubDir := path.Join(isvc.Namespace, isvc.Name, key)
+	annotations[constants.ModelPrepullInternalAnnotationKey] = cacheSubDir
+
+	// The nodes running the pods of the previous models are the nodes likely to receive the new pods
+	nodes, err := p.getModelPrepullNodes(ctx, isvc, cacheSubDir)
+	if err != nil {
+		return false, err
+	}
+	if nodes.Len() == 0 {
+		return true, nil
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := p.client.List(ctx, jobs, client.InNamespace(isvc.Namespace),
+		client.MatchingLabels{constants.InferenceServicePodLabelKey: isvc.Name}, client.HasLabels{constants.ModelPrepullLabelKey}); err != nil {
+		return false, errors.Wrapf(err, "fails to list the model pre-pull jobs")
+	}
+	nodeJobs := map[string]*batchv1.Job{}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		// Remove the warmup jobs of the previous rollouts
+		if job.Labels[constants.ModelPrepullLabelKey] != key {
+			if err := p.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+				return false, errors.Wrapf(err, "fails to delete model pre-pull job %s", job.Name)
+			}
+			continue
+		}
+		nodeJobs[job.Spec.Template.Spec.NodeSelector[corev1.LabelHostname]] = job
+	}
+
+	pending := 0
+	for _, node := range sets.List(nodes) {
+		job, ok := nodeJobs[node]
+		if !ok {
+			job, err = p.buildModelPrepullJob(ctx, isvc, podSpec, annotations, sources, storageInitializerConfig, isvcConfigMap, key, node)
+			if err != nil {
+				return false, err
+			}
+			// The job of the node may be created but not in the cache yet
+			if err := p.client.Create(ctx, job); client.IgnoreAlreadyExists(err) != nil {
+				return false, errors.Wrapf(err, "fails to create the model pre-pull job of node %s", node)
+			}
+			p.Log.Info("Pre-pulling the predictor models", "inferenceservice", isvc.Name, "namespace", isvc.Namespace, "node", node)
+			pending++
+			continue
+		}
+		switch {
+		case isJobConditionTrue(job, batchv1.JobFailed):
+			p.Log.Info("Failed to pre-pull the predictor models, the pods download them", "inferenceservice", isvc.Name,
+				"namespace", isvc.Namespace, "node", node, "job", job.Name)
+		case !isJobConditionTrue(job, batchv1.JobComplete):
+			pending++
+		}
+	}
+	if pending > 0 {
+		p.Log.Info("Holding the predictor rollout until the models are pre-pulled", "inferenceservice", isvc.Name,
+			"namespace", isvc.Namespace, "pendingNodes", pending)
+		return false, nil
+	}
+	return true, nil
+}
+
+// getModelPrepullNodes returns the nodes of the predictor pods which do not run the models of cacheSubDir yet
+func (p *Predictor) getModelPrepullNodes(ctx context.Context, isvc *v1beta1.InferenceService, cacheSubDir string) (sets.Set[string], error) {
+	pods, err := isvcutils.ListPodsByLabel(ctx, p.client, isvc.Namespace, constants.InferenceServicePodLabelKey, isvc.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fails to list inferenceservice pods")
+	}
+	nodes := sets.New[string]()
+	for _, pod := range pods.Items {
+		if pod.Labels[constants.KServiceComponentLabel] != string(v1beta1.PredictorComponent) ||
+			pod.DeletionTimestamp != nil || pod.Spec.NodeName == "" {
+			continue
+		}
+		if pod.Annotations[constants.ModelPrepullInternalAnnotationKey] != cacheSubDir {
+			nodes.Insert(pod.Spec.NodeName)
+		}
+	}
+	return nodes, nil
+}
+
+// buildModelPrepullJob builds the job warming up the models on the node: the containers of the model images make
+// the node pull the images, and the storage initializer downloads the other models into the node cache.
+func (p *Predictor) buildModelPrepullJob(ctx context.Context, isvc *v1beta1.InferenceService, podSpec *corev1.PodSpec,
+	annotations map[string]string, sources *modelPrepullSources, config *types.StorageInitializerConfig,
+	isvcConfigMap *corev1.ConfigMap, key string, node string,
+) (*batchv1.Job, error) {
+	jobPodSpec := corev1.PodSpec{
+		NodeSelector:       map[string]string{corev1.LabelHostname: node},
+		Tolerations:        podSpec.Tolerations,
+		ServiceAccountName: podSpec.ServiceAccountName,
+		ImagePullSecrets:   podSpec.ImagePullSecrets,
+		RestartPolicy:      corev1.RestartPolicyNever,
+	}
+
+	for i, image := range sources.images {
+		name := fmt.Sprintf("%s-%d", modelPrepullContainerName, i)
+		if !image.native {
+			jobPodSpec.Containers = append(jobPodSpec.Containers, *utils.CreateModelcarInitContainer(name, image.reference, config))
+			continue
+		}
+		// The native model images are only mounted, they may not have a shell
+		container := utils.CreateInitContainerWithConfig(config, nil)
+		container.Name = name
+		container.Command = []string{"sh", "-c", "exit 0"}
+		container.VolumeMounts = []corev1.VolumeMount{{Name: name, MountPath: constants.DefaultModelLocalMountPath, ReadOnly: true}}
+		jobPodSpec.Containers = append(jobPodSpec.Containers, *container)
+		jobPodSpec.Volumes = append(jobPodSpec.Volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				Image: &corev1.ImageVolumeSource{Reference: image.reference, PullPolicy: corev1.PullIfNotPresent},
+			},
+		})
+	}
+
+	if len(sources.downloads) > 0 {
+		// The models are only downloaded into the node cache
+		args := make([]string, 0, len(sources.downloads)*2)
+		for _, download := range sources.downloads {
+			args = append(args, download, "")
+		}
+		container := utils.CreateInitContainerWithConfig(config, args)
+		container.Name = modelPrepullContainerName
+		credentialBuilder := credentials.NewCredentialBuilder(p.client, p.clientset, isvcConfigMap)
+		if err := credentialBuilder.CreateSecretVolumeAndEnv(ctx, isvc.Namespace, annotations, podSpec.ServiceAccountName,
+			container, &jobPodSpec.Volumes); err != nil {
+			return nil, err
+		}
+		if len(sources.revisions) > 0 {
+			revisionsJSON, err := json.Marshal(sources.revisions)
+			if err != nil {
+				return nil, err
+			}
+			container.Env = utils.MergeEnvs(container.Env, []corev1.EnvVar{
+				{Name: constants.StorageRevisionsEnvVarKey, Value: string(revisionsJSON)},
+			})
+		}
+		if err := utils.AddModelCacheToContainer(container, &jobPodSpec, config.NodeCacheDir, annotations[constants.ModelPrepullInternalAnnotationKey], false); err != nil {
+			return nil, err
+		}
+		// The cache of the previous models of the InferenceService is removed once the new models are downloaded
+		container.Env = utils.MergeEnvs(container.Env, []corev1.EnvVar{
+			{Name: constants.StorageCachePruneEnvVarKey, Value: "true"},
+		})
+		jobPodSpec.Containers = append(jobPodSpec.Containers, *container)
+	}
+	slices.SortStableFunc(jobPodSpec.Containers, func(a, b corev1.Container) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getModelPrepullJobName(isvc.Name, key, node),
+			Namespace: isvc.Namespace,
+			Labels: map[string]string{
+				constants.InferenceServicePodLabelKey: isvc.Name,
+				constants.ModelPrepullLabelKey:        key,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          ptr.To(int32(2)),
+			ActiveDeadlineSeconds: &modelPrepullJobDeadlineSeconds,
+			Template: corev1.PodTemplateSpec{
+				Spec: jobPodSpec,
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(isvc, job, p.scheme); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// getModelPrepullJobName returns the name of the warmup job of the node, which is unique for the models of a rollout
+func getModelPrepullJobName(isvcName string, key string, node string) string {
+	nodeHash := sha256.Sum256([]byte(node))
+	return kmeta.ChildName(isvcName, "-prepull-"+key+"-"+hex.EncodeToString(nodeHash[:])[:8])
+}
+
+func isJobConditionTrue(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/types"
+)
+
+func TestGetModelPrepullSources(t *testing.T) {
+	tests := []struct {
+		name              string
+		storageUris       []string
+		annotations       map[string]string
+		config            types.StorageInitializerConfig
+		expectedImages    []modelPrepullImage
+		expectedDownloads []string
+		expectedRevisions map[string]string
+	}{
+		{
+			name:           "OCI images are pulled in the configured mode",
+			storageUris:    []string{"oci://registry.io/model:v1", "oci+native://registry.io/adapter:v1", "oci+fetch://registry.io/other:v1"},
+			config:         types.StorageInitializerConfig{OciModelMode: types.OciModelModeModelcar},
+			expectedImages: []modelPrepullImage{{reference: "registry.io/model:v1"}, {reference: "registry.io/adapter:v1", native: true}},
+		},
+		{
+			name:        "other sources are not downloaded without node cache",
+			storageUris: []string{"s3://bucket/model", "hf://org/model"},
+		},
+		{
+			name:              "other sources are downloaded into the node cache",
+			storageUris:       []string{"s3://bucket/model", "hf://org/model", "pvc://claim/model", "custom://model"},
+			config:            types.StorageInitializerConfig{NodeCacheDir: "/var/cache/models"},
+			expectedDownloads: []string{"s3://bucket/model", "hf://org/model"},
+		},
+		{
+			name:              "OCI images are downloaded when the OCI support is disabled",
+			storageUris:       []string{"oci://registry.io/model:v1"},
+			config:            types.StorageInitializerConfig{NodeCacheDir: "/var/cache/models"},
+			expectedDownloads: []string{"oci://registry.io/model:v1"},
+		},
+		{
+			name:        "sources are pinned to their revisions",
+			storageUris: []string{"hf://org/model", "s3://bucket/model", "oci://registry.io/model:v1"},
+			annotations: map[string]string{
+				constants.StorageRevisionsInternalAnnotationKey: `{"hf://org/model":"abc123","s3://bucket/model":"sha256:1234","oci://registry.io/model:v1":"sha256:5678"}`,
+			},
+			config:            types.StorageInitializerConfig{NodeCacheDir: "/var/cache/models", OciModelMode: types.OciModelModeModelcar},
+			expectedImages:    []modelPrepullImage{{reference: "registry.io/model@sha256:5678"}},
+			expectedDownloads: []string{"hf://org/model:abc123", "s3://bucket/model"},
+			expectedRevisions: map[string]string{"s3://bucket/model": "sha256:1234"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources, err := getModelPrepullSources(tt.storageUris, tt.annotations, &tt.config)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedImages, sources.images)
+			assert.Equal(t, tt.expectedDownloads, sources.downloads)
+			if tt.expectedRevisions == nil {
+				tt.expectedRevisions = map[string]string{}
+			}
+			assert.Equal(t, tt.expectedRevisions, sources.revisions)
+		})
+	}
+}
+
+func TestModelPrepullSourcesKey(t *testing.T) {
+	config := &types.StorageInitializerConfig{NodeCacheDir: "/var/cache/models"}
+	revisions := func(rev string) map[string]string {
+		return map[string]string{constants.StorageRevisionsInternalAnnotationKey: `{"s3://bucket/model":"` + rev + `"}`}
+	}
+	sources, err := getModelPrepullSources([]string{"s3://bucket/model"}, revisions("sha256:1234"), config)
+	require.NoError(t, err)
+	sameSources, err := getModelPrepullSources([]string{"s3://bucket/model"}, revisions("sha256:1234"), config)
+	require.NoError(t, err)
+	changedSources, err := getModelPrepullSources([]string{"s3://bucket/model"}, revisions("sha256:5678"), config)
+	require.NoError(t, err)
+
+	assert.Len(t, sources.key(), 16)
+	assert.Equal(t, sources.key(), sameSources.key())
+	assert.NotEqual(t, sources.key(), changedSources.key())
+}
+
+func newModelPrepullPredictor(t *testing.T, objects ...client.Object) (*Predictor, client.Client) {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, v1beta1.AddToScheme(s))
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(objects...).Build()
+	clientset := k8sfake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace},
+		Data: map[string]string{
+			v1beta1.StorageInitializerConfigMapKeyName: `{
+				"image": "kserve/storage-initializer:latest",
+				"memoryRequest": "100Mi", "memoryLimit": "1Gi", "cpuRequest": "100m", "cpuLimit": "1",
+				"ociModelMode": "modelcar",
+				"nodeCacheDir": "/var/cache/kserve-models"
+			}`,
+		},
+	})
+	return &Predictor{client: c, clientset: clientset, scheme: s, Log: ctrl.Log.WithName("test")}, c
+}
+
+func newModelPrepullPod(name string, node string, cacheSubDir string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				constants.InferenceServicePodLabelKey: "my-model",
+				constants.KServiceComponentLabel:      string(v1beta1.PredictorComponent),
+			},
+		},
+		Spec: corev1.PodSpec{NodeName: node},
+	}
+	if cacheSubDir != "" {
+		pod.Annotations = map[string]string{constants.ModelPrepullInternalAnnotationKey: cacheSubDir}
+	}
+	return pod
+}
+
+func TestReconcileModelPrepull(t *testing.T) {
+	storageUri := "s3://bucket/model-v2"
+	modelcarUri := "oci://registry.io/adapter:v2"
+	isvc := &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-model",
+			Namespace:   "default",
+			UID:         "isvc-uid",
+			Annotations: map[string]string{constants.ModelPrepullAnnotationKey: "true"},
+		},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{
+				Model: &v1beta1.ModelSpec{
+					PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{StorageURI: &storageUri},
+				},
+				StorageUris: []v1beta1.StorageUri{{Uri: modelcarUri, MountPath: "/mnt/models/adapter"}},
+			},
+		},
+	}
+	podSpec := &corev1.PodSpec{
+		ServiceAccountName: "model-sa",
+		Tolerations:        []corev1.Toleration{{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}},
+	}
+	staleJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-model-prepull-stale",
+			Namespace: "default",
+			Labels: map[string]string{
+				constants.InferenceServicePodLabelKey: "my-model",
+				constants.ModelPrepullLabelKey:        "previous",
+			},
+		},
+	}
+
+	p, c := newModelPrepullPredictor(t, isvc,
+		newModelPrepullPod("old-a", "node-a", "default/my-model/previous"),
+		newModelPrepullPod("old-b", "node-b", ""),
+		newModelPrepullPod("pending", "", ""),
+		staleJob,
+	)
+	ctx := context.Background()
+
+	annotations := map[string]string{}
+	prepulled, err := p.reconcileModelPrepull(ctx, isvc, podSpec, annotations)
+	require.NoError(t, err)
+	assert.False(t, prepulled, "the rollout must be held while the models are pre-pulled")
+	cacheSubDir := annotations[constants.ModelPrepullInternalAnnotationKey]
+	require.Regexp(t, `^default/my-model/[0-9a-f]{16}$`, cacheSubDir)
+
+	jobs := &batchv1.JobList{}
+	require.NoError(t, c.List(ctx, jobs, client.InNamespace("default")))
+	require.Len(t, jobs.Items, 2, "the stale job is deleted and a job is created per node")
+	nodes := []string{}
+	for _, job := range jobs.Items {
+		node := job.Spec.Template.Spec.NodeSelector[corev1.LabelHostname]
+		nodes = append(nodes, node)
+		assert.Equal(t, getModelPrepullJobName("my-model", path.Base(cacheSubDir), node), job.Name)
+		assert.Equal(t, "my-model", job.Labels[constants.InferenceServicePodLabelKey])
+		assert.Equal(t, "isvc-uid", string(job.OwnerReferences[0].UID))
+
+		jobPodSpec := job.Spec.Template.Spec
+		assert.Equal(t, "model-sa", jobPodSpec.ServiceAccountName)
+		assert.Equal(t, podSpec.Tolerations, jobPodSpec.Tolerations)
+		require.Len(t, jobPodSpec.Containers, 2)
+		assert.Equal(t, modelPrepullContainerName, jobPodSpec.Containers[0].Name)
+		assert.Equal(t, []string{storageUri, ""}, jobPodSpec.Containers[0].Args)
+		assert.Contains(t, jobPodSpec.Containers[0].Env, corev1.EnvVar{
+			Name: constants.StorageCacheDirEnvVarKey, Value: constants.ModelCacheMountPath + "/" + path.Base(cacheSubDir),
+		})
+		assert.Contains(t, jobPodSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name: constants.ModelCacheVolumeName, MountPath: constants.ModelCacheMountPath, SubPath: "default/my-model",
+		})
+		assert.Contains(t, jobPodSpec.Containers[0].Env, corev1.EnvVar{Name: constants.StorageCachePruneEnvVarKey, Value: "true"})
+		assert.Equal(t, "registry.io/adapter:v2", jobPodSpec.Containers[1].Image)
+		require.Len(t, jobPodSpec.Volumes, 1)
+		assert.Equal(t, "/var/cache/kserve-models", jobPodSpec.Volumes[0].HostPath.Path)
+	}
+	assert.ElementsMatch(t, []string{"node-a", "node-b"}, nodes)
+
+	// The rollout is held until all the jobs finished, successfully or not
+	setJobCondition(t, c, &jobs.Items[0], batchv1.JobComplete)
+	prepulled, err = p.reconcileModelPrepull(ctx, isvc, podSpec, map[string]string{})
+	require.NoError(t, err)
+	assert.False(t, prepulled)
+
+	setJobCondition(t, c, &jobs.Items[1], batchv1.JobFailed)
+	prepulled, err = p.reconcileModelPrepull(ctx, isvc, podSpec, map[string]string{})
+	require.NoError(t, err)
+	assert.True(t, prepulled)
+}
+
+func TestReconcileModelPrepullWithoutPreviousPods(t *testing.T) {
+	storageUri := "oci://registry.io/model:v1"
+	isvc := &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-model", Namespace: "default"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{
+				Model: &v1beta1.ModelSpec{
+					PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{StorageURI: &storageUri},
+				},
+			},
+		},
+	}
+	annotations := map[string]string{}
+	p, _ := newModelPrepullPredictor(t, isvc)
+	_, err := p.reconcileModelPrepull(context.Background(), isvc, &corev1.PodSpec{}, annotations)
+	require.NoError(t, err)
+	cacheSubDir := annotations[constants.ModelPrepullInternalAnnotationKey]
+	require.NotEmpty(t, cacheSubDir)
+
+	// The pods already running the models are not warmed up again
+	p, c := newModelPrepullPredictor(t, isvc, newModelPrepullPod("current", "node-a", cacheSubDir))
+	prepulled, err := p.reconcileModelPrepull(context.Background(), isvc, &corev1.PodSpec{}, map[string]string{})
+	require.NoError(t, err)
+	assert.True(t, prepulled)
+	jobs := &batchv1.JobList{}
+	require.NoError(t, c.List(context.Background(), jobs))
+	assert.Empty(t, jobs.Items)
+}
+
+func setJobCondition(t *testing.T, c client.Client, job *batchv1.Job, conditionType batchv1.JobConditionType) {
+	t.Helper()
+	job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{Type: conditionType, Status: corev1.ConditionTrue})
+	require.NoError(t, c.Status().Update(context.Background(), job))
+}
+
+func TestGetModelPrepullJobName(t *testing.T) {
+	name := getModelPrepullJobName("my-model", "0123456789abcdef", "node-a")
+	assert.Regexp(t, `^my-model-prepull-0123456789abcdef-[0-9a-f]{8}$`, name)
+	assert.Equal(t, name, getModelPrepullJobName("my-model", "0123456789abcdef", "node-a"))
+	assert.NotEqual(t, name, getModelPrepullJobName("my-model", "0123456789abcdef", "node-b"))
+
+	// The job names fit in the job-name label of their pods
+	longName := getModelPrepullJobName(strings.Repeat("a", 60), "0123456789abcdef", "node-a")
+	assert.LessOrEqual(t, len(longName), 63)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"dario.cat/mergo"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/network"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/knative"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/raw"
+	"github.com/kserve/kserve/pkg/utils"
+)
+
+// shadowReplicaCount returns the replicas needed by the shadow to serve the mirrored traffic.
+func shadowReplicaCount(isvc *v1beta1.InferenceService, shadow *v1beta1.ShadowSpec) int32 {
+	if shadow.Predictor.MinReplicas != nil {
+		return *shadow.Predictor.MinReplicas
+	}
+	stableReplicas := int32(1)
+	if isvc.Spec.Predictor.MinReplicas != nil {
+		stableReplicas = *isvc.Spec.Predictor.MinReplicas
+	}
+	return max(int32(math.Ceil(float64(stableReplicas)*float64(shadow.GetMirrorPercent())/100)), 1)
+}
+
+func buildShadowPredictor(stable v1beta1.PredictorSpec, shadow v1beta1.ShadowSpec, replicas int32) (v1beta1.PredictorSpec, error) {
+	shadowPredictor := *stable.DeepCopy()
+	if err := mergo.Merge(&shadowPredictor, shadow.Predictor, mergo.WithOverride); err != nil {
+		return shadowPredictor, fmt.Errorf("building shadow predictor: %w", err)
+	}
+
+	// Zero out autoscaling fields (validator rejects these on shadow)
+	shadowPredictor.MaxReplicas = 0
+	shadowPredictor.ScaleTarget = nil
+	shadowPredictor.ScaleMetric = nil
+	shadowPredictor.AutoScaling = nil
+	shadowPredictor.MinReplicas = &replicas
+
+	return shadowPredictor, nil
+}
+
+// reconcileShadow deploys the shadow predictor receiving the mirrored traffic and reports its status under
+// the shadow component. The shadow resources are deleted once the shadow is removed or the service is stopped.
+func (p *Predictor) reconcileShadow(ctx context.Context, isvc *v1beta1.InferenceService) error {
+	shadow := isvc.Spec.Shadow
+	if shadow == nil || utils.GetForceStopRuntime(isvc) {
+		return p.deleteShadow(ctx, isvc)
+	}
+
+	replicas := shadowReplicaCount(isvc, shadow)
+	shadowPredictor, err := buildShadowPredictor(isvc.Spec.Predictor, *shadow, replicas)
+	if err != nil {
+		return err
+	}
+
+	shadowISVC := isvc.DeepCopy()
+	shadowISVC.Spec.Predictor = shadowPredictor
+	res, err := p.buildPredictorResources(ctx, shadowISVC, false)
+	if err != nil {
+		return errors.Wrapf(err, "fails to build resources for shadow predictor")
+	}
+	// Keep the revisions resolved for the storage URIs of the shadow
+	isvc.Status.ModelStatus.ResolvedSources = shadowISVC.Status.ModelStatus.ResolvedSources
+	shadowName := constants.ShadowPredictorServiceName(isvc.Name)
+	res.objectMeta.Name = shadowName
+	// The shadow component label keeps the shadow out of the predictor pods and the canary orphan cleanup.
+	res.objectMeta.Labels[constants.KServiceComponentLabel] = string(v1beta1.ShadowComponent)
+
+	componentExt := v1beta1.ComponentExtensionSpec{MinReplicas: &replicas}
+	if p.deploymentMode == constants.Standard {
+		r, err := raw.NewRawKubeReconciler(ctx, p.client, p.clientset, p.scheme, res.objectMeta, metav1.ObjectMeta{},
+			&componentExt, &res.podSpec, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return errors.Wrapf(err, "fails to create shadow reconciler")
+		}
+		deploymentList, err := r.Reconcile(ctx, isvc)
+		if err != nil {
+			return errors.Wrapf(err, "fails to reconcile shadow predictor")
+		}
+		isvc.Status.PropagateRawStatus(v1beta1.ShadowComponent, deploymentList, &apis.URL{
+			Scheme: "http",
+			Host:   network.GetServiceHostname(shadowName, isvc.Namespace),
+		})
+	} else {
+		// Requests are mirrored to the revision service directly, keep the activator out of the request path.
+		res.objectMeta.Annotations[autoscaling.TargetBurstCapacityKey] = "0"
+		componentExt.MaxReplicas = replicas
+		r := knative.NewKsvcReconciler(ctx, p.client, p.scheme, res.objectMeta, &componentExt, &res.podSpec,
+			isvc.Status.Components[v1beta1.ShadowComponent], p.inferenceServiceConfig.ServiceLabelDisallowedList, nil, nil, nil, nil, nil)
+		if err := controllerutil.SetControllerReference(isvc, r.Service, p.scheme); err != nil {
+			return errors.Wrapf(err, "fails to set owner reference for shadow predictor")
+		}
+		kstatus, err := r.Reconcile(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "fails to reconcile shadow predictor")
+		}
+		isvc.Status.PropagateStatus(v1beta1.ShadowComponent, kstatus)
+	}
+	p.Log.Info("Reconciled shadow predictor", "name", shadowName, "mirrorPercent", shadow.GetMirrorPercent(), "replicas", replicas)
+	return nil
+}
+
+// deleteShadow deletes the resources of a previously deployed shadow predictor and clears its status.
+func (p *Predictor) deleteShadow(ctx context.Context, isvc *v1beta1.InferenceService) error {
+	if _, ok := isvc.Status.Components[v1beta1.ShadowComponent]; !ok {
+		return nil
+	}
+
+	meta := metav1.ObjectMeta{Name: constants.ShadowPredictorServiceName(isvc.Name), Namespace: isvc.Namespace}
+	var objects []client.Object
+	if p.deploymentMode == constants.Standard {
+		objects = []client.Object{
+			&appsv1.Deployment{ObjectMeta: meta},
+			&corev1.Service{ObjectMeta: meta},
+			&autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: meta},
+		}
+	} else {
+		objects = []client.Object{&knservingv1.Service{ObjectMeta: meta}}
+	}
+	for _, obj := range objects {
+		if err := p.client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "fails to delete shadow predictor %T %s", obj, meta.Name)
+		}
+	}
+	p.Log.Info("Deleted shadow predictor", "name", meta.Name)
+
+	delete(isvc.Status.Components, v1beta1.ShadowComponent)
+	isvc.Status.ClearCondition(v1beta1.ShadowReady)
+	return nil
+}
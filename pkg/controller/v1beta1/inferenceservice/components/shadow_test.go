@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+func TestShadowReplicaCount(t *testing.T) {
+	tests := []struct {
+		name           string
+		stableReplicas *int32
+		shadow         v1beta1.ShadowSpec
+		expected       int32
+	}{
+		{
+			name:     "full mirror defaults to the stable replicas",
+			shadow:   v1beta1.ShadowSpec{},
+			expected: 1,
+		},
+		{
+			name:           "partial mirror scales down the replicas",
+			stableReplicas: ptr.To(int32(4)),
+			shadow:         v1beta1.ShadowSpec{MirrorPercent: ptr.To(int32(50))},
+			expected:       2,
+		},
+		{
+			name:           "at least one replica",
+			stableReplicas: ptr.To(int32(4)),
+			shadow:         v1beta1.ShadowSpec{MirrorPercent: ptr.To(int32(0))},
+			expected:       1,
+		},
+		{
+			name:           "explicit replicas win",
+			stableReplicas: ptr.To(int32(4)),
+			shadow: v1beta1.ShadowSpec{
+				Predictor: v1beta1.PredictorSpec{
+					ComponentExtensionSpec: v1beta1.ComponentExtensionSpec{MinReplicas: ptr.To(int32(3))},
+				},
+			},
+			expected: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isvc := &v1beta1.InferenceService{
+				Spec: v1beta1.InferenceServiceSpec{
+					Predictor: v1beta1.PredictorSpec{
+						ComponentExtensionSpec: v1beta1.ComponentExtensionSpec{MinReplicas: tt.stableReplicas},
+					},
+				},
+			}
+			assert.Equal(t, tt.expected, shadowReplicaCount(isvc, &tt.shadow))
+		})
+	}
+}
+
+func TestBuildShadowPredictor(t *testing.T) {
+	stable := v1beta1.PredictorSpec{
+		ComponentExtensionSpec: v1beta1.ComponentExtensionSpec{MaxReplicas: 5},
+		Model: &v1beta1.ModelSpec{
+			ModelFormat:            v1beta1.ModelFormat{Name: "sklearn"},
+			PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{StorageURI: ptr.To("gs://bucket/v1")},
+		},
+	}
+	shadow := v1beta1.ShadowSpec{
+		Predictor: v1beta1.PredictorSpec{
+			Model: &v1beta1.ModelSpec{
+				PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{StorageURI: ptr.To("gs://bucket/v2")},
+			},
+		},
+	}
+
+	predictor, err := buildShadowPredictor(stable, shadow, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "gs://bucket/v2", *predictor.Model.StorageURI)
+	assert.Equal(t, "sklearn", predictor.Model.ModelFormat.Name)
+	assert.Equal(t, int32(2), *predictor.MinReplicas)
+	assert.Zero(t, predictor.MaxReplicas)
+	assert.Equal(t, "gs://bucket/v1", *stable.Model.StorageURI)
+}
+
+func TestDeleteShadow(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, autoscalingv2.AddToScheme(s))
+	require.NoError(t, v1beta1.AddToScheme(s))
+
+	shadowMeta := metav1.ObjectMeta{Name: constants.ShadowPredictorServiceName("my-model"), Namespace: "default"}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&appsv1.Deployment{ObjectMeta: shadowMeta},
+		&corev1.Service{ObjectMeta: shadowMeta},
+	).Build()
+	p := &Predictor{client: c, deploymentMode: constants.Standard, Log: ctrl.Log.WithName("test")}
+
+	isvc := &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-model", Namespace: "default"},
+		Status: v1beta1.InferenceServiceStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{{Type: v1beta1.ShadowReady, Status: corev1.ConditionTrue}},
+			},
+			Components: map[v1beta1.ComponentType]v1beta1.ComponentStatusSpec{
+				v1beta1.PredictorComponent: {},
+				v1beta1.ShadowComponent:    {URL: &apis.URL{Scheme: "http", Host: "my-model-predictor-shadow.default.svc.cluster.local"}},
+			},
+		},
+	}
+
+	require.NoError(t, p.reconcileShadow(context.Background(), isvc))
+
+	for _, obj := range []client.Object{&appsv1.Deployment{}, &corev1.Service{}} {
+		err := c.Get(context.Background(), client.ObjectKey{Name: shadowMeta.Name, Namespace: shadowMeta.Namespace}, obj)
+		assert.True(t, apierrors.IsNotFound(err), "expected %T to be deleted", obj)
+	}
+	assert.NotContains(t, isvc.Status.Components, v1beta1.ShadowComponent)
+	assert.Contains(t, isvc.Status.Components, v1beta1.PredictorComponent)
+	assert.Nil(t, isvc.Status.GetCondition(v1beta1.ShadowReady))
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1alpha1/trainedmodel/sharding/memory"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/knative"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/raw"
+	v1beta1utils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
+)
+
+// reconcileShards deploys a predictor for every shard of a multi-model InferenceService besides shard 0, which
+// is served by the predictor itself. Each shard predictor loads the TrainedModels of its shard modelConfig.
+// The predictors of the shards without TrainedModels left are deleted.
+func (p *Predictor) reconcileShards(ctx context.Context, isvc *v1beta1.InferenceService) error {
+	shards := map[string]bool{}
+	if v1beta1utils.IsMMSPredictor(&isvc.Spec.Predictor) {
+		trainedModels := &v1alpha1.TrainedModelList{}
+		if err := p.client.List(ctx, trainedModels, client.InNamespace(isvc.Namespace), client.MatchingLabels{constants.ParentInferenceServiceLabel: isvc.Name}); err != nil {
+			return errors.Wrapf(err, "fails to list trained models")
+		}
+		shardStrategy := memory.NewMemoryStrategy(isvc, trainedModels.Items)
+		for _, id := range shardStrategy.GetShard() {
+			if id == 0 {
+				continue
+			}
+			if err := p.reconcileShard(ctx, isvc, id); err != nil {
+				return err
+			}
+			shards[strconv.Itoa(id)] = true
+		}
+	}
+	return p.deleteShards(ctx, isvc, shards)
+}
+
+func (p *Predictor) reconcileShard(ctx context.Context, isvc *v1beta1.InferenceService, shardId int) error {
+	res, err := p.buildPredictorResources(ctx, isvc, false)
+	if err != nil {
+		return errors.Wrapf(err, "fails to build resources for predictor shard %d", shardId)
+	}
+	shardName := constants.PredictorShardServiceName(res.objectMeta.Name, shardId)
+	res.objectMeta.Name = shardName
+	res.objectMeta.Labels[constants.TrainedModelShard] = strconv.Itoa(shardId)
+	res.objectMeta.Annotations[constants.AgentModelConfigVolumeNameAnnotationKey] = constants.ModelConfigName(isvc.Name, shardId)
+
+	componentExt := isvc.Spec.Predictor.ComponentExtensionSpec
+	if p.deploymentMode == constants.Standard {
+		r, err := raw.NewRawKubeReconciler(ctx, p.client, p.clientset, p.scheme, res.objectMeta, metav1.ObjectMeta{},
+			&componentExt, &res.podSpec, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return errors.Wrapf(err, "fails to create reconciler for predictor shard %d", shardId)
+		}
+		if _, err := r.Reconcile(ctx, isvc); err != nil {
+			return errors.Wrapf(err, "fails to reconcile predictor shard %d", shardId)
+		}
+	} else {
+		r := knative.NewKsvcReconciler(ctx, p.client, p.scheme, res.objectMeta, &componentExt, &res.podSpec,
+			v1beta1.ComponentStatusSpec{}, p.inferenceServiceConfig.ServiceLabelDisallowedList, nil, nil, nil, nil, nil)
+		if err := controllerutil.SetControllerReference(isvc, r.Service, p.scheme); err != nil {
+			return errors.Wrapf(err, "fails to set owner reference for predictor shard %d", shardId)
+		}
+		if _, err := r.Reconcile(ctx); err != nil {
+			return errors.Wrapf(err, "fails to reconcile predictor shard %d", shardId)
+		}
+	}
+	p.Log.Info("Reconciled predictor shard", "name", shardName, "shard", shardId)
+	return nil
+}
+
+// deleteShards deletes the resources of the predictor shards not in the given shards.
+func (p *Predictor) deleteShards(ctx context.Context, isvc *v1beta1.InferenceService, shards map[string]bool) error {
+	selector := []client.ListOption{
+		client.InNamespace(isvc.Namespace),
+		client.MatchingLabels{constants.InferenceServicePodLabelKey: isvc.Name},
+		client.HasLabels{constants.TrainedModelShard},
+	}
+	var names []string
+	if p.deploymentMode == constants.Standard {
+		deployList := &appsv1.DeploymentList{}
+		if err := p.client.List(ctx, deployList, selector...); err != nil {
+			return errors.Wrapf(err, "fails to list predictor shards")
+		}
+		for _, deploy := range deployList.Items {
+			if !shards[deploy.Labels[constants.TrainedModelShard]] {
+				names = append(names, deploy.Name)
+			}
+		}
+	} else {
+		ksvcList := &knservingv1.ServiceList{}
+		if err := p.client.List(ctx, ksvcList, selector...); err != nil {
+			return errors.Wrapf(err, "fails to list predictor shards")
+		}
+		for _, ksvc := range ksvcList.Items {
+			if !shards[ksvc.Labels[constants.TrainedModelShard]] {
+				names = append(names, ksvc.Name)
+			}
+		}
+	}
+
+	for _, name := range names {
+		meta := metav1.ObjectMeta{Name: name, Namespace: isvc.Namespace}
+		var objects []client.Object
+		if p.deploymentMode == constants.Standard {
+			objects = []client.Object{
+				&appsv1.Deployment{ObjectMeta: meta},
+				&corev1.Service{ObjectMeta: meta},
+				&autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: meta},
+			}
+		} else {
+			objects = []client.Object{&knservingv1.Service{ObjectMeta: meta}}
+		}
+		for _, obj := range objects {
+			if err := p.client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "fails to delete predictor shard %T %s", obj, name)
+			}
+		}
+		p.Log.Info("Deleted predictor shard", "name", name)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+func TestDeleteShards(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, autoscalingv2.AddToScheme(s))
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	require.NoError(t, v1beta1.AddToScheme(s))
+
+	predictorMeta := metav1.ObjectMeta{
+		Name:      constants.PredictorServiceName("mms"),
+		Namespace: "default",
+		Labels:    map[string]string{constants.InferenceServicePodLabelKey: "mms"},
+	}
+	shardMeta := metav1.ObjectMeta{
+		Name:      constants.PredictorShardServiceName(constants.PredictorServiceName("mms"), 1),
+		Namespace: "default",
+		Labels: map[string]string{
+			constants.InferenceServicePodLabelKey: "mms",
+			constants.TrainedModelShard:           "1",
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&appsv1.Deployment{ObjectMeta: predictorMeta},
+		&corev1.Service{ObjectMeta: predictorMeta},
+		&appsv1.Deployment{ObjectMeta: shardMeta},
+		&corev1.Service{ObjectMeta: shardMeta},
+	).Build()
+	p := &Predictor{client: c, deploymentMode: constants.Standard, Log: ctrl.Log.WithName("test")}
+
+	// The predictor is no longer configured for multi-model serving so its shards are deleted
+	isvc := &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "mms", Namespace: "default"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{
+				SKLearn: &v1beta1.SKLearnSpec{
+					PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{StorageURI: ptr.To("gs://bucket/model")},
+				},
+			},
+		},
+	}
+	require.NoError(t, p.reconcileShards(context.Background(), isvc))
+
+	for _, obj := range []client.Object{&appsv1.Deployment{}, &corev1.Service{}} {
+		err := c.Get(context.Background(), client.ObjectKey{Name: shardMeta.Name, Namespace: shardMeta.Namespace}, obj)
+		assert.True(t, apierrors.IsNotFound(err), "expected %T to be deleted", obj)
+		require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: predictorMeta.Name, Namespace: predictorMeta.Namespace}, obj))
+	}
+}
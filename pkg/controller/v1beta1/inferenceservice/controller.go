@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
@@ -28,6 +29,7 @@ import (
 	"github.com/pkg/errors"
 	istioclientv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -59,6 +61,7 @@ import (
 	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/components"
 	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers"
 	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/cabundleconfigmap"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/ingress"
 	modelconfig "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/modelconfig"
 	isvcutils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
 	kservetypes "github.com/kserve/kserve/pkg/types"
@@ -83,6 +86,7 @@ import (
 // +kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices/finalizers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;create
@@ -91,6 +95,7 @@ import (
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects/finalizers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects/status,verbs=get;update;patch
@@ -152,6 +157,11 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return reconcile.Result{}, errors.Wrapf(err, "fails to create DeployConfig")
 	}
 
+	ingressConfig, err := v1beta1.NewIngressConfig(isvcConfigMap)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "fails to create IngressConfig")
+	}
+
 	deploymentMode := isvcutils.GetDeploymentMode(isvc.Status.DeploymentMode, annotations, deployConfig)
 	r.Log.Info("Inference service deployment mode ", "deployment mode ", deploymentMode)
 
@@ -193,7 +203,7 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		// The object is being deleted
 		if controllerutil.ContainsFinalizer(isvc, finalizerName) {
 			// our finalizer is present, so lets handle any external dependency
-			if err := r.deleteExternalResources(ctx, isvc); err != nil {
+			if err := r.deleteExternalResources(ctx, isvc, ingressConfig); err != nil {
 				// if fail to delete the external dependency here, return with error
 				// so that it can be retried
 				return ctrl.Result{}, err
@@ -266,6 +276,26 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		knutils.ValidateInitialScaleAnnotation(isvc.Annotations, allowZeroInitialScale, r.Log)
 	}
 
+	// Abort early if the predictor is scaled from zero by the activator, but KEDA ScaledObjects are not available
+	if deploymentMode == constants.Standard && isvcutils.IsActivatorEnabled(isvc, ingressConfig) {
+		kedaAvailable, checkKedaErr := utils.IsCrdAvailable(r.ClientConfig, kedav1alpha1.SchemeGroupVersion.String(), constants.KedaScaledObjectKind)
+		if checkKedaErr != nil {
+			if updateErr := r.updateStatus(ctx, isvc, deploymentMode); updateErr != nil {
+				r.Log.Error(updateErr, "Error updating status after KEDA CRD availability check failure")
+			}
+			return reconcile.Result{}, checkKedaErr
+		}
+
+		if !kedaAvailable {
+			r.Recorder.Event(isvc, corev1.EventTypeWarning, "ActivatorRejected",
+				"It is not possible to scale the predictor from zero when KEDA ScaledObjects are not available")
+			if err := r.updateStatus(ctx, isvc, deploymentMode); err != nil {
+				r.Log.Error(err, "Error updating status when the activator was rejected")
+			}
+			return reconcile.Result{Requeue: false}, reconcile.TerminalError(fmt.Errorf("the predictor of InferenceService '%s' is scaled from zero by the activator, but KEDA is not available", isvc.Name))
+		}
+	}
+
 	// Setup reconcilers
 	r.Log.Info("Reconciling inference service", "apiVersion", isvc.APIVersion, "isvc", isvc.Name, "namespace", isvc.Namespace)
 
@@ -288,6 +318,8 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	if isvc.Spec.Explainer != nil {
 		componentReconcilers = append(componentReconcilers, components.NewExplainer(r.Client, r.Clientset, r.Scheme, isvcConfig, deploymentMode))
 	}
+	// Components progressing over time are requeued once the status is persisted and the ingress reconciled
+	var statusRequeueAfter time.Duration
 	for _, reconciler := range componentReconcilers {
 		result, err := reconciler.Reconcile(ctx, isvc)
 		if err != nil {
@@ -302,6 +334,9 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		if result.RequeueAfter > 0 {
 			return result, nil
 		}
+		if requeuer, ok := reconciler.(components.StatusRequeuer); ok {
+			statusRequeueAfter = minRequeueAfter(statusRequeueAfter, requeuer.StatusRequeueAfter())
+		}
 	}
 	// Handle InferenceService status updates based on the force stop annotation.
 	// If true, transition the service to a stopped and unready state; otherwise, ensure it's not marked as stopped.
@@ -350,12 +385,6 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 			isvc.Status.PropagateCrossComponentStatus(componentList, v1beta1.LatestDeploymentReady)
 		}
 	}
-	// Reconcile ingress
-	ingressConfig, err := v1beta1.NewIngressConfig(isvcConfigMap)
-	if err != nil {
-		return reconcile.Result{}, errors.Wrapf(err, "fails to create IngressConfig")
-	}
-
 	// Reconcile ingress using factory
 	factory := reconcilers.NewReconcilerFactory()
 
@@ -397,6 +426,7 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		if err := r.updateStatus(ctx, isvc, deploymentMode); err != nil {
 			r.Log.Error(err, "Error updating status before requeue")
 		}
+		result.RequeueAfter = minRequeueAfter(result.RequeueAfter, statusRequeueAfter)
 		return result, nil
 	}
 
@@ -414,7 +444,15 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return reconcile.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: statusRequeueAfter}, nil
+}
+
+// minRequeueAfter returns the shortest of two requeue delays, zero meaning no requeue.
+func minRequeueAfter(a, b time.Duration) time.Duration {
+	if a == 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
 }
 
 func (r *InferenceServiceReconciler) updateStatus(ctx context.Context, desiredService *v1beta1.InferenceService,
@@ -582,6 +620,33 @@ func servingRuntimesPredicate() predicate.Funcs {
 	}
 }
 
+// trainedModelFunc enqueues the parent InferenceService of a TrainedModel to reconcile the shard the model is assigned to.
+func (r *InferenceServiceReconciler) trainedModelFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	tm, ok := obj.(*v1alpha1.TrainedModel)
+	if !ok || tm == nil {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Namespace: tm.Namespace,
+			Name:      tm.Spec.InferenceService,
+		},
+	}}
+}
+
+// trainedModelsPredicate returns a predicate that filters TrainedModel updates
+// to only include those where the assigned shard has changed.
+func trainedModelsPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.GetLabels()[constants.TrainedModelShard] != e.ObjectNew.GetLabels()[constants.TrainedModelShard]
+		},
+		CreateFunc:  func(e event.CreateEvent) bool { return false },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return false },
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+	}
+}
+
 // clusterServingRuntimesPredicate returns a predicate that filters ClusterServingRuntime updates
 // to only include those where the Spec has changed.
 func clusterServingRuntimesPredicate() predicate.Funcs {
@@ -666,7 +731,12 @@ func (r *InferenceServiceReconciler) SetupWithManager(mgr ctrl.Manager, deployCo
 	ctrlBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&v1beta1.InferenceService{}).
 		Owns(&appsv1.Deployment{}).
-		Owns(&corev1.Service{})
+		Owns(&corev1.Service{}).
+		// The rollout held by the model pre-pull proceeds once the warmup jobs finish
+		Owns(&batchv1.Job{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			_, ok := obj.GetLabels()[constants.ModelPrepullLabelKey]
+			return ok
+		})))
 
 	if ksvcFound {
 		ctrlBuilder = ctrlBuilder.Owns(&knservingv1.Service{})
@@ -720,7 +790,8 @@ func (r *InferenceServiceReconciler) SetupWithManager(mgr ctrl.Manager, deployCo
 	}
 
 	ctrlBuilder = ctrlBuilder.Watches(&v1alpha1.ServingRuntime{}, handler.EnqueueRequestsFromMapFunc(r.servingRuntimeFunc), builder.WithPredicates(servingRuntimesPredicate())).
-		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.podInitContainersFunc), builder.WithPredicates(podInitContainersPredicate()))
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.podInitContainersFunc), builder.WithPredicates(podInitContainersPredicate())).
+		Watches(&v1alpha1.TrainedModel{}, handler.EnqueueRequestsFromMapFunc(r.trainedModelFunc), builder.WithPredicates(trainedModelsPredicate()))
 
 	csrFound, err := utils.IsCrdAvailable(r.ClientConfig, v1alpha1.SchemeGroupVersion.String(), "ClusterServingRuntime")
 	if err != nil {
@@ -735,7 +806,7 @@ func (r *InferenceServiceReconciler) SetupWithManager(mgr ctrl.Manager, deployCo
 	return ctrlBuilder.Complete(r)
 }
 
-func (r *InferenceServiceReconciler) deleteExternalResources(ctx context.Context, isvc *v1beta1.InferenceService) error {
+func (r *InferenceServiceReconciler) deleteExternalResources(ctx context.Context, isvc *v1beta1.InferenceService, ingressConfig *v1beta1.IngressConfig) error {
 	// Delete all the TrainedModel that uses this InferenceService as parent
 	r.Log.Info("Deleting external resources", "InferenceService", isvc.Name)
 	var trainedModels v1alpha1.TrainedModelList
@@ -754,6 +825,14 @@ func (r *InferenceServiceReconciler) deleteExternalResources(ctx context.Context
 			r.Log.Error(err, "unable to delete trainedmodel", "trainedmodel", v)
 		}
 	}
+
+	// The activator ReferenceGrant is shared by the InferenceServices of the namespace and not owned by any of them
+	if isvcutils.IsActivatorEnabled(isvc, ingressConfig) {
+		if err := ingress.ReleaseActivatorReferenceGrant(ctx, r.Client, isvc, ingressConfig); err != nil {
+			r.Log.Error(err, "unable to release the activator reference grant", "inferenceservice", isvc.Name)
+			return err
+		}
+	}
 	return nil
 }
 
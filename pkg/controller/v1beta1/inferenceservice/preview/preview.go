@@ -0,0 +1,455 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package preview renders the workloads and the pods of an InferenceService offline, the way the controller and the
+// pod mutating webhook would render them in the cluster.
+package preview
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/pmezard/go-difflib/difflib"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/components"
+	isvcutils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
+	kservescheme "github.com/kserve/kserve/pkg/scheme"
+	"github.com/kserve/kserve/pkg/utils"
+	"github.com/kserve/kserve/pkg/webhook/admission/pod"
+)
+
+// Input is the InferenceService to render along with the cluster objects the rendering reads.
+type Input struct {
+	InferenceService *v1beta1.InferenceService
+	// ConfigMap is the inferenceservice-config of the cluster
+	ConfigMap *corev1.ConfigMap
+	// Objects are the other cluster objects read while rendering, e.g. ServingRuntimes, ServiceAccounts or Secrets.
+	// Namespaced objects without namespace are read in the namespace of the InferenceService.
+	Objects []client.Object
+}
+
+const (
+	// ModelRevisionResolutionStep is the reconcile step pinning the model sources to their current revision
+	ModelRevisionResolutionStep = "ResolveModelRevisions"
+	// RolloutAnalysisStep is the reconcile step analyzing the canary rollout steps
+	RolloutAnalysisStep = "AnalyzeRollout"
+)
+
+// errStepSkipped is returned by the reconcile steps which need to reach outside the cluster
+var errStepSkipped = errors.New("skipped in the preview")
+
+// Result is the offline rendering of an InferenceService.
+type Result struct {
+	DeploymentMode constants.DeploymentModeType
+	Workloads      []Workload
+	// SkippedSteps are the reconcile steps which need to reach outside the cluster and were not run, the models
+	// are rendered unpinned and the canary rollouts do not progress
+	SkippedSteps []string
+}
+
+// Workload is a Deployment or Knative Service rendered for an InferenceService component.
+type Workload struct {
+	Object client.Object
+	// Pod is the pod of the workload once mutated by the pod mutating webhook
+	Pod *corev1.Pod
+	// Mutations are the steps of the webhook mutation chain, in the order they are applied to the pod
+	Mutations []Mutation
+}
+
+// Mutation is the change a step of the webhook mutation chain made to the pod.
+type Mutation struct {
+	Name string
+	// Diff is the unified diff of the pod YAML, it is empty when the step did not change the pod
+	Diff string
+	// Skipped is set when the step needs to reach outside the cluster and was not run
+	Skipped bool
+}
+
+// NewScheme returns the scheme of the objects read and rendered by the preview.
+func NewScheme() (*runtime.Scheme, error) {
+	s := runtime.NewScheme()
+	if err := kservescheme.AddAll(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Decode reads the InferenceService, the inferenceservice-config ConfigMap and the other cluster objects from
+// multi-document YAML or JSON manifests.
+func Decode(s *runtime.Scheme, manifests ...[]byte) (*Input, error) {
+	input := &Input{}
+	decoder := serializer.NewCodecFactory(s).UniversalDeserializer()
+	for _, manifest := range manifests {
+		reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(manifest)))
+		for {
+			doc, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			// Skip the empty documents and the documents holding only comments
+			if jsonDoc, err := yaml.YAMLToJSON(doc); err != nil {
+				return nil, err
+			} else if string(bytes.TrimSpace(jsonDoc)) == "null" {
+				continue
+			}
+			obj, _, err := decoder.Decode(doc, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			switch o := obj.(type) {
+			case *v1beta1.InferenceService:
+				if input.InferenceService != nil {
+					return nil, fmt.Errorf("only one InferenceService can be previewed, found %s and %s",
+						input.InferenceService.Name, o.Name)
+				}
+				input.InferenceService = o
+			case *corev1.ConfigMap:
+				if o.Name == constants.InferenceServiceConfigMapName {
+					input.ConfigMap = o
+				} else {
+					input.Objects = append(input.Objects, o)
+				}
+			case client.Object:
+				input.Objects = append(input.Objects, o)
+			default:
+				return nil, fmt.Errorf("unsupported object %s", obj.GetObjectKind().GroupVersionKind())
+			}
+		}
+	}
+	if input.InferenceService == nil {
+		return nil, errors.New("no InferenceService found in the manifests")
+	}
+	if input.ConfigMap == nil {
+		return nil, fmt.Errorf("no %s ConfigMap found in the manifests", constants.InferenceServiceConfigMapName)
+	}
+	return input, nil
+}
+
+// Render renders the workloads of the InferenceService components with the controller reconcilers, then runs the
+// mutation chain of the pod mutating webhook on their pods. The cluster is faked with the input objects, the
+// reconcile and mutation steps reaching outside the cluster are skipped and reported as such.
+func Render(ctx context.Context, s *runtime.Scheme, input *Input) (*Result, error) {
+	isvc := input.InferenceService.DeepCopy()
+	if isvc.Namespace == "" {
+		isvc.Namespace = metav1.NamespaceDefault
+	}
+	configMap := input.ConfigMap.DeepCopy()
+	configMap.Name = constants.InferenceServiceConfigMapName
+	configMap.Namespace = constants.KServeNamespace
+
+	objects := []client.Object{isvc}
+	coreObjects := []runtime.Object{configMap}
+	for _, obj := range input.Objects {
+		obj = obj.DeepCopyObject().(client.Object)
+		if obj.GetNamespace() == "" && isNamespaced(obj) {
+			obj.SetNamespace(isvc.Namespace)
+		}
+		objects = append(objects, obj)
+		switch obj.(type) {
+		case *corev1.ServiceAccount, *corev1.Secret, *corev1.ConfigMap:
+			coreObjects = append(coreObjects, obj)
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(objects...).WithStatusSubresource(isvc).Build()
+	clientset := k8sfake.NewSimpleClientset(coreObjects...)
+
+	isvcConfig, err := v1beta1.NewInferenceServicesConfig(configMap)
+	if err != nil {
+		return nil, fmt.Errorf("fails to create InferenceServicesConfig: %w", err)
+	}
+	deployConfig, err := v1beta1.NewDeployConfig(configMap)
+	if err != nil {
+		return nil, fmt.Errorf("fails to create DeployConfig: %w", err)
+	}
+	securityConfig, err := v1beta1.NewSecurityConfig(configMap)
+	if err != nil {
+		return nil, fmt.Errorf("fails to create SecurityConfig: %w", err)
+	}
+	localModelConfig, err := v1beta1.NewLocalModelConfig(configMap)
+	if err != nil {
+		return nil, fmt.Errorf("fails to create LocalModelConfig: %w", err)
+	}
+
+	// Default the InferenceService the way the InferenceService webhook does at admission
+	var models *v1alpha1.LocalModelCacheList
+	var nsModels *v1alpha1.LocalModelNamespaceCacheList
+	if _, disabled := isvc.Annotations[constants.DisableLocalModelKey]; !disabled && localModelConfig.Enabled {
+		models = &v1alpha1.LocalModelCacheList{}
+		if err := c.List(ctx, models); err != nil {
+			return nil, err
+		}
+		nsModels = &v1alpha1.LocalModelNamespaceCacheList{}
+		if err := c.List(ctx, nsModels, client.InNamespace(isvc.Namespace)); err != nil {
+			return nil, err
+		}
+	}
+	isvc.DefaultInferenceService(isvcConfig, deployConfig, securityConfig, models, nsModels)
+
+	annotations := utils.Filter(isvc.Annotations, func(key string) bool {
+		return !utils.Includes(isvcConfig.ServiceAnnotationDisallowedList, key)
+	})
+	deploymentMode := isvcutils.GetDeploymentMode(isvc.Status.DeploymentMode, annotations, deployConfig)
+	if deploymentMode == constants.ModelMeshDeployment {
+		return nil, fmt.Errorf("the %s deployment mode cannot be previewed", deploymentMode)
+	}
+
+	skipped := &skippedSteps{}
+	componentReconcilers := []components.Component{
+		components.NewPredictor(c, clientset, s, isvcConfig, deploymentMode,
+			components.WithRevisionResolver(skippedRevisionResolver{skipped}),
+			components.WithRolloutAnalyzer(skippedRolloutAnalyzer{skipped})),
+	}
+	if isvc.Spec.Transformer != nil {
+		componentReconcilers = append(componentReconcilers, components.NewTransformer(c, clientset, s, isvcConfig, deploymentMode))
+	}
+	if isvc.Spec.Explainer != nil {
+		componentReconcilers = append(componentReconcilers, components.NewExplainer(c, clientset, s, isvcConfig, deploymentMode))
+	}
+	for _, reconciler := range componentReconcilers {
+		if _, err := reconciler.Reconcile(ctx, isvc); err != nil {
+			return nil, err
+		}
+	}
+
+	workloads, err := listWorkloads(ctx, c, s, isvc.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	mutator := &pod.Mutator{Client: c, Clientset: clientset}
+	result := &Result{DeploymentMode: deploymentMode, SkippedSteps: skipped.list()}
+	for _, workload := range workloads {
+		rendered, err := mutatePod(ctx, mutator, workload, configMap, isvc)
+		if err != nil {
+			return nil, fmt.Errorf("fails to mutate the pod of %s %s: %w",
+				workload.GetObjectKind().GroupVersionKind().Kind, workload.GetName(), err)
+		}
+		result.Workloads = append(result.Workloads, *rendered)
+	}
+	return result, nil
+}
+
+// skippedSteps records the reconcile steps which were not run.
+type skippedSteps struct {
+	mu    sync.Mutex
+	steps sets.Set[string]
+}
+
+func (s *skippedSteps) add(step string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.steps == nil {
+		s.steps = sets.New[string]()
+	}
+	s.steps.Insert(step)
+}
+
+func (s *skippedSteps) list() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sets.List(s.steps)
+}
+
+// skippedRevisionResolver does not resolve the model revisions, the model sources are left unpinned.
+type skippedRevisionResolver struct {
+	skipped *skippedSteps
+}
+
+func (r skippedRevisionResolver) Resolve(_ context.Context, _ string) (string, error) {
+	r.skipped.add(ModelRevisionResolutionStep)
+	return "", errStepSkipped
+}
+
+// skippedRolloutAnalyzer does not analyze the canary rollout steps, the rollouts hold their current step.
+type skippedRolloutAnalyzer struct {
+	skipped *skippedSteps
+}
+
+func (a skippedRolloutAnalyzer) Analyze(_ context.Context, _ *v1beta1.InferenceService, _ *v1beta1.CanarySpec,
+	_ v1beta1.RolloutStep,
+) (bool, string, error) {
+	a.skipped.add(RolloutAnalysisStep)
+	return false, "", errStepSkipped
+}
+
+// listWorkloads lists the Deployments and Knative Services the component reconcilers created.
+func listWorkloads(ctx context.Context, c client.Client, s *runtime.Scheme, namespace string) ([]client.Object, error) {
+	workloads := []client.Object{}
+	deployments := &appsv1.DeploymentList{}
+	if err := c.List(ctx, deployments, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for i := range deployments.Items {
+		workloads = append(workloads, &deployments.Items[i])
+	}
+	services := &knservingv1.ServiceList{}
+	if err := c.List(ctx, services, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for i := range services.Items {
+		workloads = append(workloads, &services.Items[i])
+	}
+	for _, workload := range workloads {
+		gvk, err := apiutil.GVKForObject(workload, s)
+		if err != nil {
+			return nil, err
+		}
+		workload.GetObjectKind().SetGroupVersionKind(gvk)
+		workload.SetResourceVersion("")
+	}
+	return workloads, nil
+}
+
+// mutatePod runs the webhook mutation chain on the pod of the workload, diffing the pod after each step.
+func mutatePod(ctx context.Context, mutator *pod.Mutator, workload client.Object, configMap *corev1.ConfigMap,
+	isvc *v1beta1.InferenceService,
+) (*Workload, error) {
+	var template corev1.PodTemplateSpec
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		template = *w.Spec.Template.DeepCopy()
+	case *knservingv1.Service:
+		template = corev1.PodTemplateSpec{
+			ObjectMeta: *w.Spec.Template.ObjectMeta.DeepCopy(),
+			Spec:       *w.Spec.Template.Spec.PodSpec.DeepCopy(),
+		}
+	}
+	p := &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: template.ObjectMeta,
+		Spec:       template.Spec,
+	}
+	p.Name = ""
+	p.GenerateName = workload.GetName() + "-"
+	p.Namespace = workload.GetNamespace()
+
+	rendered := &Workload{Object: workload, Pod: p}
+	// The webhook ignores the pods not managed by KServe
+	if _, ok := p.Labels[constants.InferenceServicePodLabelKey]; !ok {
+		return rendered, nil
+	}
+	mutations, err := mutator.Mutations(ctx, p, configMap, isvc)
+	if err != nil {
+		return nil, err
+	}
+	for _, mutation := range mutations {
+		if mutation.Name == pod.OciVerificationMutationName {
+			rendered.Mutations = append(rendered.Mutations, Mutation{Name: mutation.Name, Skipped: true})
+			continue
+		}
+		before, err := yaml.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+		if err := mutation.Mutate(p); err != nil {
+			return nil, fmt.Errorf("%s: %w", mutation.Name, err)
+		}
+		after, err := yaml.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(before)),
+			B:        difflib.SplitLines(string(after)),
+			FromFile: "before",
+			ToFile:   mutation.Name,
+			Context:  3,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rendered.Mutations = append(rendered.Mutations, Mutation{Name: mutation.Name, Diff: diff})
+	}
+	return rendered, nil
+}
+
+// Write prints the rendered workloads and their mutated pods as YAML, followed by the diff of each mutation when
+// diffs is set.
+func (r *Result) Write(w io.Writer, diffs bool) error {
+	var out strings.Builder
+	fmt.Fprintf(&out, "# Deployment mode: %s\n", r.DeploymentMode)
+	for _, step := range r.SkippedSteps {
+		fmt.Fprintf(&out, "# %s: skipped\n", step)
+	}
+	for _, workload := range r.Workloads {
+		kind := workload.Object.GetObjectKind().GroupVersionKind().Kind
+		for _, section := range []struct {
+			title string
+			obj   runtime.Object
+		}{
+			{fmt.Sprintf("%s %s", kind, workload.Object.GetName()), workload.Object},
+			{fmt.Sprintf("Pod of %s %s", kind, workload.Object.GetName()), workload.Pod},
+		} {
+			data, err := yaml.Marshal(section.obj)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(&out, "---\n# %s\n%s", section.title, data)
+		}
+		if !diffs {
+			continue
+		}
+		for _, mutation := range workload.Mutations {
+			switch {
+			case mutation.Skipped:
+				fmt.Fprintf(&out, "# %s: skipped\n", mutation.Name)
+			case mutation.Diff == "":
+				fmt.Fprintf(&out, "# %s: no changes\n", mutation.Name)
+			default:
+				fmt.Fprintf(&out, "# %s:\n", mutation.Name)
+				for _, line := range difflib.SplitLines(strings.TrimRight(mutation.Diff, " \n")) {
+					fmt.Fprintf(&out, "#   %s", line)
+				}
+			}
+		}
+	}
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+// isNamespaced reports whether the object is read in a namespace.
+func isNamespaced(obj client.Object) bool {
+	switch obj.(type) {
+	case *v1alpha1.ClusterServingRuntime, *v1alpha1.ClusterStorageContainer, *v1alpha1.LocalModelCache,
+		*v1alpha1.LocalModelNode, *v1alpha1.LocalModelNodeGroup, *corev1.Namespace, *corev1.Node:
+		return false
+	}
+	return true
+}
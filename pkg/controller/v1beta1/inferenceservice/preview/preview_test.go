@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+const configMapManifest = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: inferenceservice-config
+  namespace: kserve
+data:
+  deploy: |
+    {"defaultDeploymentMode": "Standard"}
+  ingress: |
+    {"ingressGateway": "knative-serving/knative-ingress-gateway", "ingressDomain": "example.com"}
+  storageInitializer: |
+    {
+      "image": "kserve/storage-initializer:latest",
+      "memoryRequest": "100Mi", "memoryLimit": "1Gi", "cpuRequest": "100m", "cpuLimit": "1"
+    }
+  agent: |
+    {"image": "kserve/agent:latest", "memoryRequest": "100Mi", "memoryLimit": "1Gi", "cpuRequest": "100m", "cpuLimit": "1"}
+  logger: |
+    {"image": "kserve/agent:latest", "memoryRequest": "100Mi", "memoryLimit": "1Gi", "cpuRequest": "100m", "cpuLimit": "1"}
+  batcher: |
+    {"image": "kserve/agent:latest", "memoryRequest": "100Mi", "memoryLimit": "1Gi", "cpuRequest": "100m", "cpuLimit": "1"}
+  credentials: |
+    {}
+`
+
+const runtimeManifest = `
+# The runtime of the sklearn models
+---
+apiVersion: serving.kserve.io/v1alpha1
+kind: ClusterServingRuntime
+metadata:
+  name: kserve-sklearnserver
+spec:
+  supportedModelFormats:
+    - name: sklearn
+      version: "1"
+      autoSelect: true
+  protocolVersions:
+    - v1
+  containers:
+    - name: kserve-container
+      image: kserve/sklearnserver:latest
+      args:
+        - --model_name={{.Name}}
+        - --model_dir=/mnt/models
+`
+
+const isvcManifest = `
+apiVersion: serving.kserve.io/v1beta1
+kind: InferenceService
+metadata:
+  name: sklearn-iris
+  namespace: models
+spec:
+  predictor:
+    logger:
+      mode: all
+      url: http://broker
+    model:
+      modelFormat:
+        name: sklearn
+      storageUri: gs://kfserving-examples/models/sklearn/1.0/model
+`
+
+func TestDecode(t *testing.T) {
+	s, err := NewScheme()
+	require.NoError(t, err)
+
+	input, err := Decode(s, []byte(isvcManifest), []byte(configMapManifest+"---\n"+runtimeManifest))
+	require.NoError(t, err)
+	assert.Equal(t, "sklearn-iris", input.InferenceService.Name)
+	assert.Equal(t, constants.InferenceServiceConfigMapName, input.ConfigMap.Name)
+	require.Len(t, input.Objects, 1)
+	assert.IsType(t, &v1alpha1.ClusterServingRuntime{}, input.Objects[0])
+
+	_, err = Decode(s, []byte(configMapManifest))
+	require.ErrorContains(t, err, "no InferenceService found")
+	_, err = Decode(s, []byte(isvcManifest))
+	require.ErrorContains(t, err, "no inferenceservice-config ConfigMap found")
+	_, err = Decode(s, []byte(isvcManifest), []byte(isvcManifest), []byte(configMapManifest))
+	require.ErrorContains(t, err, "only one InferenceService can be previewed")
+}
+
+func TestRender(t *testing.T) {
+	s, err := NewScheme()
+	require.NoError(t, err)
+	input, err := Decode(s, []byte(isvcManifest), []byte(configMapManifest), []byte(runtimeManifest))
+	require.NoError(t, err)
+
+	result, err := Render(context.Background(), s, input)
+	require.NoError(t, err)
+	assert.Equal(t, constants.Standard, result.DeploymentMode)
+	assert.Empty(t, result.SkippedSteps)
+	require.Len(t, result.Workloads, 1)
+
+	workload := result.Workloads[0]
+	require.IsType(t, &appsv1.Deployment{}, workload.Object)
+	assert.Equal(t, "sklearn-iris-predictor", workload.Object.GetName())
+	assert.Equal(t, "models", workload.Object.GetNamespace())
+	assert.Equal(t, "Deployment", workload.Object.GetObjectKind().GroupVersionKind().Kind)
+	// The workload is rendered before the mutations
+	assert.Empty(t, workload.Object.(*appsv1.Deployment).Spec.Template.Spec.InitContainers)
+
+	pod := workload.Pod
+	assert.Equal(t, "sklearn-iris-predictor-", pod.GenerateName)
+	require.Len(t, pod.Spec.InitContainers, 1)
+	assert.Equal(t, constants.StorageInitializerContainerName, pod.Spec.InitContainers[0].Name)
+	assert.Equal(t, "gs://kfserving-examples/models/sklearn/1.0/model", pod.Spec.InitContainers[0].Args[0])
+	containerNames := []string{}
+	for _, container := range pod.Spec.Containers {
+		containerNames = append(containerNames, container.Name)
+	}
+	assert.Equal(t, []string{constants.InferenceServiceContainerName, constants.AgentContainerName}, containerNames)
+
+	diffs := map[string]string{}
+	for _, mutation := range workload.Mutations {
+		assert.False(t, mutation.Skipped)
+		diffs[mutation.Name] = mutation.Diff
+	}
+	assert.Contains(t, diffs, "InjectGKEAcceleratorSelector")
+	assert.Empty(t, diffs["InjectGKEAcceleratorSelector"])
+	assert.Contains(t, diffs["InjectStorageInitializer"], "+    name: storage-initializer\n")
+	assert.NotContains(t, diffs["InjectStorageInitializer"], "+    name: agent\n")
+	assert.Contains(t, diffs["InjectAgent"], "+    name: agent\n")
+
+	var out bytes.Buffer
+	require.NoError(t, result.Write(&out, true))
+	assert.Contains(t, out.String(), "# Deployment sklearn-iris-predictor\n")
+	assert.Contains(t, out.String(), "# Pod of Deployment sklearn-iris-predictor\n")
+	assert.Contains(t, out.String(), "# InjectGKEAcceleratorSelector: no changes\n")
+	assert.Contains(t, out.String(), "#   +++ InjectStorageInitializer\n")
+
+	out.Reset()
+	require.NoError(t, result.Write(&out, false))
+	assert.NotContains(t, out.String(), "InjectStorageInitializer")
+}
+
+func TestRenderKnative(t *testing.T) {
+	s, err := NewScheme()
+	require.NoError(t, err)
+	input, err := Decode(s, []byte(isvcManifest), []byte(configMapManifest), []byte(runtimeManifest))
+	require.NoError(t, err)
+	input.InferenceService.Annotations = map[string]string{constants.DeploymentMode: string(constants.Knative)}
+
+	result, err := Render(context.Background(), s, input)
+	require.NoError(t, err)
+	assert.Equal(t, constants.Knative, result.DeploymentMode)
+	require.Len(t, result.Workloads, 1)
+	require.IsType(t, &knservingv1.Service{}, result.Workloads[0].Object)
+	require.Len(t, result.Workloads[0].Pod.Spec.InitContainers, 1)
+	assert.Equal(t, constants.StorageInitializerContainerName, result.Workloads[0].Pod.Spec.InitContainers[0].Name)
+}
+
+func TestRenderSkipsOciVerification(t *testing.T) {
+	s, err := NewScheme()
+	require.NoError(t, err)
+	input, err := Decode(s, []byte(isvcManifest), []byte(configMapManifest), []byte(runtimeManifest))
+	require.NoError(t, err)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	storageInitializerConfig, err := json.Marshal(map[string]interface{}{
+		"image":         "kserve/storage-initializer:latest",
+		"memoryRequest": "100Mi", "memoryLimit": "1Gi", "cpuRequest": "100m", "cpuLimit": "1",
+		"ociModelMode": "modelcar",
+		"ociVerification": map[string]interface{}{
+			"publicKeys": []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))},
+		},
+	})
+	require.NoError(t, err)
+	input.ConfigMap.Data["storageInitializer"] = string(storageInitializerConfig)
+	storageUri := "oci://registry.io/models/sklearn:v1"
+	input.InferenceService.Spec.Predictor.Model.StorageURI = &storageUri
+
+	result, err := Render(context.Background(), s, input)
+	require.NoError(t, err)
+	mutations := result.Workloads[0].Mutations
+	require.NotEmpty(t, mutations)
+	last := mutations[len(mutations)-1]
+	assert.Equal(t, "VerifyOciModels", last.Name)
+	assert.True(t, last.Skipped)
+	images := []string{}
+	for _, container := range result.Workloads[0].Pod.Spec.Containers {
+		images = append(images, container.Image)
+	}
+	assert.Contains(t, images, "registry.io/models/sklearn:v1", "the modelcar is injected without verifying its image")
+}
+
+func TestRenderSkipsModelRevisionResolution(t *testing.T) {
+	s, err := NewScheme()
+	require.NoError(t, err)
+	input, err := Decode(s, []byte(isvcManifest), []byte(configMapManifest), []byte(runtimeManifest))
+	require.NoError(t, err)
+	input.ConfigMap.Data["storageInitializer"] = `{
+		"image": "kserve/storage-initializer:latest",
+		"memoryRequest": "100Mi", "memoryLimit": "1Gi", "cpuRequest": "100m", "cpuLimit": "1",
+		"pinModelRevisions": true
+	}`
+
+	result, err := Render(context.Background(), s, input)
+	require.NoError(t, err)
+	assert.Equal(t, []string{ModelRevisionResolutionStep}, result.SkippedSteps)
+	initContainers := result.Workloads[0].Pod.Spec.InitContainers
+	require.Len(t, initContainers, 1)
+	assert.Equal(t, "gs://kfserving-examples/models/sklearn/1.0/model", initContainers[0].Args[0],
+		"the model is rendered unpinned")
+
+	var out bytes.Buffer
+	require.NoError(t, result.Write(&out, false))
+	assert.Contains(t, out.String(), "# ResolveModelRevisions: skipped\n")
+}
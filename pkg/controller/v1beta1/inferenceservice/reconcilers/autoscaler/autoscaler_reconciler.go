@@ -89,6 +89,10 @@ func createAutoscaler(client client.Client,
 	configMap *corev1.ConfigMap,
 ) (Autoscaler, error) {
 	ac := getAutoscalerClass(componentMeta)
+	if ac == constants.AutoscalerClassHPA && componentMeta.Annotations[constants.ActivatorInternalAnnotationKey] == "true" {
+		// HPA cannot scale to zero, KEDA scales the component on the requests buffered by the activator.
+		ac = constants.AutoscalerClassKeda
+	}
 	switch ac {
 	case constants.AutoscalerClassHPA, constants.AutoscalerClassExternal, constants.AutoscalerClassNone:
 		return hpa.NewHPAReconciler(client, scheme, componentMeta, componentExt)
@@ -140,6 +140,18 @@ func TestCreateAutoscaler(t *testing.T) {
 			wantType:    "*keda.KedaReconciler",
 			wantErr:     false,
 		},
+		{
+			name:        "Return KedaReconciler for activator annotation",
+			annotations: map[string]string{constants.ActivatorInternalAnnotationKey: "true"},
+			wantType:    "*keda.KedaReconciler",
+			wantErr:     false,
+		},
+		{
+			name:        "Return HPAReconciler for activator annotation with none annotation",
+			annotations: map[string]string{constants.ActivatorInternalAnnotationKey: "true", "serving.kserve.io/autoscalerClass": "none"},
+			wantType:    "*hpa.HPAReconciler",
+			wantErr:     false,
+		},
 		{
 			name:        "Return error for unknown annotation",
 			annotations: map[string]string{"serving.kserve.io/autoscalerClass": "unknown"},
@@ -155,7 +167,7 @@ func TestCreateAutoscaler(t *testing.T) {
 
 			// Provide a dummy configMap for keda autoscalerClass to avoid nil pointer panic
 			var configMap *corev1.ConfigMap
-			if tt.annotations["serving.kserve.io/autoscalerClass"] == "keda" {
+			if tt.wantType == "*keda.KedaReconciler" {
 				configMap = &corev1.ConfigMap{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "dummy-config",
@@ -22,9 +22,11 @@ import (
 	"strings"
 	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -38,6 +40,7 @@ import (
 
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
+	isvcutils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
 	"github.com/kserve/kserve/pkg/utils"
 )
 
@@ -332,6 +335,16 @@ func createRawPredictorHTTPRoute(ctx context.Context, client client.Client, isvc
 	if len(isvc.Spec.Canary) > 0 {
 		applyCanaryWeights(isvc, &httpRoute)
 	}
+	if isvc.Spec.Shadow != nil {
+		applyShadowMirror(isvc, &httpRoute)
+	}
+	if isvcutils.IsActivatorEnabled(isvc, ingressConfig) {
+		inDataPath, err := isActivatorInDataPath(ctx, client, isvc)
+		if err != nil {
+			return nil, err
+		}
+		applyActivatorBackend(isvc, &httpRoute, inDataPath)
+	}
 	return &httpRoute, nil
 }
 
@@ -678,6 +691,16 @@ func createRawTopLevelHTTPRoute(ctx context.Context, client client.Client, isvc
 	if len(isvc.Spec.Canary) > 0 {
 		applyCanaryWeights(isvc, &httpRoute)
 	}
+	if isvc.Spec.Shadow != nil {
+		applyShadowMirror(isvc, &httpRoute)
+	}
+	if isvcutils.IsActivatorEnabled(isvc, ingressConfig) {
+		inDataPath, err := isActivatorInDataPath(ctx, client, isvc)
+		if err != nil {
+			return nil, err
+		}
+		applyActivatorBackend(isvc, &httpRoute, inDataPath)
+	}
 	return &httpRoute, nil
 }
 
@@ -685,7 +708,8 @@ func createRawTopLevelHTTPRoute(ctx context.Context, client client.Client, isvc
 // backends for canary traffic splitting. Only canaries whose deployments are
 // Ready (as reported in isvc.Status.CanaryStatuses) receive traffic; not-ready
 // canaries are omitted so no traffic is routed to a backend without ready
-// endpoints.
+// endpoints. Canaries with a progressive rollout receive the traffic of their
+// current rollout step.
 func applyCanaryWeights(isvc *v1beta1.InferenceService, httpRoute *gwapiv1.HTTPRoute) {
 	readyMap := make(map[string]bool, len(isvc.Status.CanaryStatuses))
 	for _, cs := range isvc.Status.CanaryStatuses {
@@ -695,7 +719,7 @@ func applyCanaryWeights(isvc *v1beta1.InferenceService, httpRoute *gwapiv1.HTTPR
 	var totalReadyCanaryPercent int32
 	for _, canary := range isvc.Spec.Canary {
 		if readyMap[canary.Predictor.Name] {
-			totalReadyCanaryPercent += canary.TrafficPercent
+			totalReadyCanaryPercent += isvc.Status.GetCanaryTrafficPercent(&canary)
 		}
 	}
 	stableWeight := int32(100) - totalReadyCanaryPercent
@@ -723,7 +747,7 @@ func applyCanaryWeights(isvc *v1beta1.InferenceService, httpRoute *gwapiv1.HTTPR
 				continue
 			}
 			canaryServiceName := constants.PredictorServiceName(isvc.Name, canary.Predictor.Name)
-			cw := canary.TrafficPercent
+			cw := isvc.Status.GetCanaryTrafficPercent(&canary)
 			backend := gwapiv1.HTTPBackendRef{
 				BackendRef: gwapiv1.BackendRef{
 					BackendObjectReference: gwapiv1.BackendObjectReference{
@@ -742,6 +766,189 @@ func applyCanaryWeights(isvc *v1beta1.InferenceService, httpRoute *gwapiv1.HTTPR
 	}
 }
 
+// applyShadowMirror adds a RequestMirror filter to the rules routing to the
+// stable predictor so a copy of their requests is sent to the shadow predictor.
+// The filter is only added once the shadow is Ready so no requests are mirrored
+// to a backend without ready endpoints.
+func applyShadowMirror(isvc *v1beta1.InferenceService, httpRoute *gwapiv1.HTTPRoute) {
+	if !isvc.Status.IsConditionReady(v1beta1.ShadowReady) {
+		return
+	}
+	predictorName := gwapiv1.ObjectName(constants.PredictorServiceName(isvc.Name, isvc.Spec.Predictor.Name))
+	mirrorPercent := isvc.Spec.Shadow.GetMirrorPercent()
+
+	for i := range httpRoute.Spec.Rules {
+		rule := &httpRoute.Spec.Rules[i]
+		if len(rule.BackendRefs) == 0 || rule.BackendRefs[0].Name != predictorName {
+			continue
+		}
+		backendRef := rule.BackendRefs[0].BackendObjectReference
+		backendRef.Name = gwapiv1.ObjectName(constants.ShadowPredictorServiceName(isvc.Name))
+		// Copy the filters, they are shared between the rules of the route.
+		filters := make([]gwapiv1.HTTPRouteFilter, 0, len(rule.Filters)+1)
+		filters = append(filters, rule.Filters...)
+		rule.Filters = append(filters, gwapiv1.HTTPRouteFilter{
+			Type: gwapiv1.HTTPRouteFilterRequestMirror,
+			RequestMirror: &gwapiv1.HTTPRequestMirrorFilter{
+				BackendRef: backendRef,
+				Percent:    ptr.To(mirrorPercent),
+			},
+		})
+	}
+}
+
+// isActivatorInDataPath returns whether the requests of the predictor must be buffered by the activator, i.e.
+// the predictor is scaled to zero or has no available replica yet.
+func isActivatorInDataPath(ctx context.Context, client client.Client, isvc *v1beta1.InferenceService) (bool, error) {
+	deployment := &appsv1.Deployment{}
+	err := client.Get(ctx, types.NamespacedName{
+		Name:      constants.PredictorServiceName(isvc.Name, isvc.Spec.Predictor.Name),
+		Namespace: isvc.Namespace,
+	}, deployment)
+	if apierr.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 {
+		return true, nil
+	}
+	return deployment.Status.AvailableReplicas == 0, nil
+}
+
+// applyActivatorBackend routes the rules of the predictor through the activator
+// when the predictor is scaled to zero. The activator buffers the requests while
+// the predictor scales from zero and proxies them to the predictor service and
+// port set in the activator target header. Once the predictor is warm it is
+// served directly and its requests are mirrored to the activator, keeping the
+// predictor active until they stop. The predictor is then scaled out on its
+// other metrics, the activator only reports the concurrency of the requests it
+// proxies.
+func applyActivatorBackend(isvc *v1beta1.InferenceService, httpRoute *gwapiv1.HTTPRoute, inDataPath bool) {
+	predictorName := gwapiv1.ObjectName(constants.PredictorServiceName(isvc.Name, isvc.Spec.Predictor.Name))
+
+	for i := range httpRoute.Spec.Rules {
+		rule := &httpRoute.Spec.Rules[i]
+		if len(rule.BackendRefs) != 1 || rule.BackendRefs[0].Name != predictorName {
+			continue
+		}
+		backendRef := &rule.BackendRefs[0].BackendObjectReference
+		port := int32(constants.CommonDefaultHttpPort)
+		if backendRef.Port != nil {
+			port = *backendRef.Port
+		}
+		targetHeader := gwapiv1.HTTPHeader{
+			Name:  constants.ActivatorTargetHeader,
+			Value: constants.ActivatorTarget(string(predictorName), isvc.Namespace, port),
+		}
+
+		// Copy the filters, they are shared between the rules of the route. A rule
+		// accepts a single request header modifier, the target header is added to it.
+		filters := make([]gwapiv1.HTTPRouteFilter, 0, len(rule.Filters)+2)
+		hasHeaderModifier := false
+		for _, filter := range rule.Filters {
+			if filter.Type == gwapiv1.HTTPRouteFilterRequestHeaderModifier && filter.RequestHeaderModifier != nil {
+				filter.RequestHeaderModifier = filter.RequestHeaderModifier.DeepCopy()
+				filter.RequestHeaderModifier.Set = append(filter.RequestHeaderModifier.Set, targetHeader)
+				hasHeaderModifier = true
+			}
+			filters = append(filters, filter)
+		}
+		if !hasHeaderModifier {
+			filters = append(filters, gwapiv1.HTTPRouteFilter{
+				Type:                  gwapiv1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &gwapiv1.HTTPHeaderFilter{Set: []gwapiv1.HTTPHeader{targetHeader}},
+			})
+		}
+
+		if !inDataPath {
+			rule.Filters = append(filters, gwapiv1.HTTPRouteFilter{
+				Type: gwapiv1.HTTPRouteFilterRequestMirror,
+				RequestMirror: &gwapiv1.HTTPRequestMirrorFilter{
+					BackendRef: gwapiv1.BackendObjectReference{
+						Name:      constants.ActivatorName,
+						Namespace: ptr.To(gwapiv1.Namespace(constants.KServeNamespace)),
+						Port:      ptr.To(gwapiv1.PortNumber(constants.ActivatorMirrorServicePort)),
+					},
+				},
+			})
+			continue
+		}
+		rule.Filters = filters
+
+		backendRef.Name = constants.ActivatorName
+		backendRef.Namespace = ptr.To(gwapiv1.Namespace(constants.KServeNamespace))
+		backendRef.Port = ptr.To(gwapiv1.PortNumber(constants.ActivatorServicePort))
+	}
+}
+
+// reconcileActivatorReferenceGrant allows the HTTPRoutes of the namespace to reference the activator
+// service in the KServe namespace. The grant is shared by the InferenceServices of the namespace and
+// is therefore not owned by any of them.
+func (r *RawHTTPRouteReconciler) reconcileActivatorReferenceGrant(ctx context.Context, namespace string) error {
+	if namespace == constants.KServeNamespace {
+		return nil
+	}
+	name := constants.ActivatorName + "-" + namespace
+	existing := &gwapiv1.ReferenceGrant{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: name, Namespace: constants.KServeNamespace}, existing)
+	if err == nil || !apierr.IsNotFound(err) {
+		return err
+	}
+
+	grant := &gwapiv1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.KServeNamespace,
+		},
+		Spec: gwapiv1.ReferenceGrantSpec{
+			From: []gwapiv1.ReferenceGrantFrom{{
+				Group:     gwapiv1.GroupName,
+				Kind:      "HTTPRoute",
+				Namespace: gwapiv1.Namespace(namespace),
+			}},
+			To: []gwapiv1.ReferenceGrantTo{{
+				Group: "",
+				Kind:  constants.ServiceKind,
+				Name:  ptr.To(gwapiv1.ObjectName(constants.ActivatorName)),
+			}},
+		},
+	}
+	log.Info("Creating activator ReferenceGrant", "name", name, "namespace", constants.KServeNamespace)
+	return client.IgnoreAlreadyExists(r.client.Create(ctx, grant))
+}
+
+// ReleaseActivatorReferenceGrant deletes the activator ReferenceGrant of the namespace of the InferenceService
+// once no other InferenceService of the namespace routes its requests through the activator.
+func ReleaseActivatorReferenceGrant(ctx context.Context, c client.Client, isvc *v1beta1.InferenceService, ingressConfig *v1beta1.IngressConfig) error {
+	if isvc.Namespace == constants.KServeNamespace {
+		return nil
+	}
+	grant := &gwapiv1.ReferenceGrant{}
+	err := c.Get(ctx, types.NamespacedName{Name: constants.ActivatorName + "-" + isvc.Namespace, Namespace: constants.KServeNamespace}, grant)
+	// The Gateway API CRDs are not installed when the InferenceServices are served by another ingress.
+	if apierr.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	isvcs := &v1beta1.InferenceServiceList{}
+	if err := c.List(ctx, isvcs, client.InNamespace(isvc.Namespace)); err != nil {
+		return err
+	}
+	for i := range isvcs.Items {
+		other := &isvcs.Items[i]
+		if other.Name != isvc.Name && other.DeletionTimestamp == nil && isvcutils.IsActivatorEnabled(other, ingressConfig) {
+			return nil
+		}
+	}
+	log.Info("Deleting activator ReferenceGrant", "name", grant.Name, "namespace", grant.Namespace)
+	return client.IgnoreNotFound(c.Delete(ctx, grant))
+}
+
 func semanticHttpRouteEquals(desired, existing *gwapiv1.HTTPRoute) bool {
 	if !equality.Semantic.DeepDerivative(desired.Labels, existing.Labels) ||
 		!equality.Semantic.DeepDerivative(desired.Annotations, existing.Annotations) {
@@ -749,12 +956,14 @@ func semanticHttpRouteEquals(desired, existing *gwapiv1.HTTPRoute) bool {
 	}
 	// DeepDerivative treats missing fields as matching, so a single unweighted
 	// backend is seen as a subset of two weighted backends. Compare backend ref
-	// counts explicitly to detect canary addition/removal.
+	// counts explicitly to detect canary addition/removal. The same applies to
+	// the filters when a shadow mirror is added or removed.
 	if len(desired.Spec.Rules) != len(existing.Spec.Rules) {
 		return false
 	}
 	for i := range desired.Spec.Rules {
-		if len(desired.Spec.Rules[i].BackendRefs) != len(existing.Spec.Rules[i].BackendRefs) {
+		if len(desired.Spec.Rules[i].BackendRefs) != len(existing.Spec.Rules[i].BackendRefs) ||
+			len(desired.Spec.Rules[i].Filters) != len(existing.Spec.Rules[i].Filters) {
 			return false
 		}
 	}
@@ -1114,9 +1323,17 @@ func (r *RawHTTPRouteReconciler) Reconcile(ctx context.Context, isvc *v1beta1.In
 		isInternal = true
 	}
 	if !isInternal && !r.ingressConfig.DisableIngressCreation {
+		if isvcutils.IsActivatorEnabled(isvc, r.ingressConfig) {
+			if err := r.reconcileActivatorReferenceGrant(ctx, isvc.Namespace); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to reconcile activator reference grant: %w", err)
+			}
+		} else if err := ReleaseActivatorReferenceGrant(ctx, r.client, isvc, r.ingressConfig); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to release activator reference grant: %w", err)
+		}
 		if err := r.reconcilePredictorHTTPRoute(ctx, isvc); err != nil {
 			return ctrl.Result{}, err
 		}
+		setShadowMirroredCondition(isvc, "")
 		if isvc.Spec.Transformer != nil {
 			if err := r.reconcileTransformerHTTPRoute(ctx, isvc); err != nil {
 				return ctrl.Result{}, err
@@ -1146,6 +1363,7 @@ func (r *RawHTTPRouteReconciler) Reconcile(ctx context.Context, isvc *v1beta1.In
 			return result, err
 		}
 	} else {
+		setShadowMirroredCondition(isvc, "ingress creation is disabled, requests are mirrored by the HTTPRoute")
 		// Ingress creation is disabled. We set it to true as the isvc condition depends on it.
 		isvc.Status.SetCondition(v1beta1.IngressReady, &knapis.Condition{
 			Type:   v1beta1.IngressReady,
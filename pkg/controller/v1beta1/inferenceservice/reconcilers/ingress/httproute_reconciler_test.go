@@ -23,9 +23,11 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/format"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -3770,3 +3772,262 @@ func TestSemanticHttpRouteEquals_BackendRefCount(t *testing.T) {
 		g.Expect(semanticHttpRouteEquals(makeRoute(2), makeRoute(2))).To(BeTrue())
 	})
 }
+
+func TestApplyShadowMirror(t *testing.T) {
+	makeISVC := func(shadowReady bool) *v1beta1.InferenceService {
+		readyStatus := corev1.ConditionFalse
+		if shadowReady {
+			readyStatus = corev1.ConditionTrue
+		}
+		return &v1beta1.InferenceService{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-model", Namespace: "default"},
+			Spec: v1beta1.InferenceServiceSpec{
+				Shadow: &v1beta1.ShadowSpec{MirrorPercent: ptr.To(int32(25))},
+			},
+			Status: v1beta1.InferenceServiceStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{Type: v1beta1.ShadowReady, Status: readyStatus},
+					},
+				},
+			},
+		}
+	}
+	makeRoute := func() *gwapiv1.HTTPRoute {
+		sharedFilters := []gwapiv1.HTTPRouteFilter{addIsvcHeaders("my-model", "default")}
+		return &gwapiv1.HTTPRoute{
+			Spec: gwapiv1.HTTPRouteSpec{
+				Rules: []gwapiv1.HTTPRouteRule{
+					{
+						Filters: sharedFilters,
+						BackendRefs: []gwapiv1.HTTPBackendRef{{BackendRef: gwapiv1.BackendRef{
+							BackendObjectReference: gwapiv1.BackendObjectReference{
+								Kind: ptr.To(gwapiv1.Kind(constants.ServiceKind)),
+								Name: "my-model-predictor",
+								Port: ptr.To(int32(8080)),
+							},
+						}}},
+					},
+					{
+						Filters: sharedFilters,
+						BackendRefs: []gwapiv1.HTTPBackendRef{{BackendRef: gwapiv1.BackendRef{
+							BackendObjectReference: gwapiv1.BackendObjectReference{
+								Kind: ptr.To(gwapiv1.Kind(constants.ServiceKind)),
+								Name: "my-model-explainer",
+								Port: ptr.To(int32(80)),
+							},
+						}}},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("shadow ready mirrors predictor rules", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		httpRoute := makeRoute()
+
+		applyShadowMirror(makeISVC(true), httpRoute)
+
+		predictorRule := httpRoute.Spec.Rules[0]
+		g.Expect(predictorRule.Filters).To(HaveLen(2))
+		mirror := predictorRule.Filters[1]
+		g.Expect(mirror.Type).To(Equal(gwapiv1.HTTPRouteFilterRequestMirror))
+		g.Expect(string(mirror.RequestMirror.BackendRef.Name)).To(Equal("my-model-predictor-shadow"))
+		g.Expect(*mirror.RequestMirror.BackendRef.Port).To(Equal(int32(8080)))
+		g.Expect(*mirror.RequestMirror.Percent).To(Equal(int32(25)))
+		g.Expect(httpRoute.Spec.Rules[1].Filters).To(HaveLen(1))
+	})
+
+	t.Run("shadow not ready is not mirrored", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		httpRoute := makeRoute()
+
+		applyShadowMirror(makeISVC(false), httpRoute)
+
+		g.Expect(httpRoute.Spec.Rules[0].Filters).To(HaveLen(1))
+	})
+
+	t.Run("mirror removal is detected", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mirrored := makeRoute()
+		applyShadowMirror(makeISVC(true), mirrored)
+
+		g.Expect(semanticHttpRouteEquals(makeRoute(), mirrored)).To(BeFalse())
+	})
+}
+
+func TestApplyActivatorBackend(t *testing.T) {
+	isvc := &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-model", Namespace: "default"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{
+				ComponentExtensionSpec: v1beta1.ComponentExtensionSpec{MinReplicas: ptr.To(int32(0))},
+			},
+		},
+	}
+	sharedFilters := []gwapiv1.HTTPRouteFilter{addIsvcHeaders("my-model", "default")}
+	makeRoute := func() *gwapiv1.HTTPRoute {
+		return &gwapiv1.HTTPRoute{
+			Spec: gwapiv1.HTTPRouteSpec{
+				Rules: []gwapiv1.HTTPRouteRule{
+					createHTTPRouteRule(createGRPCRouteMatches(), sharedFilters, "my-model-predictor", "default", 9000, nil),
+					createHTTPRouteRule(createHTTPRouteMatches(constants.FallbackPrefix()), sharedFilters, "my-model-predictor", "default", 8080, nil),
+				},
+			},
+		}
+	}
+	expectedTargets := []string{"my-model-predictor.default:9000", "my-model-predictor.default:8080"}
+
+	t.Run("predictor scaled to zero is served by the activator", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		httpRoute := makeRoute()
+		applyActivatorBackend(isvc, httpRoute, true)
+
+		for i, expectedTarget := range expectedTargets {
+			rule := httpRoute.Spec.Rules[i]
+			g.Expect(rule.BackendRefs).To(HaveLen(1))
+			backendRef := rule.BackendRefs[0]
+			g.Expect(string(backendRef.Name)).To(Equal(constants.ActivatorName))
+			g.Expect(string(*backendRef.Namespace)).To(Equal(constants.KServeNamespace))
+			g.Expect(*backendRef.Port).To(Equal(int32(constants.ActivatorServicePort)))
+			g.Expect(rule.Filters).To(HaveLen(1))
+			g.Expect(rule.Filters[0].RequestHeaderModifier.Set).To(ContainElement(gwapiv1.HTTPHeader{
+				Name:  constants.ActivatorTargetHeader,
+				Value: expectedTarget,
+			}))
+		}
+	})
+
+	t.Run("warm predictor is served directly and mirrored to the activator", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		httpRoute := makeRoute()
+		applyActivatorBackend(isvc, httpRoute, false)
+
+		for i, expectedTarget := range expectedTargets {
+			rule := httpRoute.Spec.Rules[i]
+			g.Expect(string(rule.BackendRefs[0].Name)).To(Equal("my-model-predictor"))
+			g.Expect(rule.Filters).To(HaveLen(2))
+			g.Expect(rule.Filters[0].RequestHeaderModifier.Set).To(ContainElement(gwapiv1.HTTPHeader{
+				Name:  constants.ActivatorTargetHeader,
+				Value: expectedTarget,
+			}))
+			mirror := rule.Filters[1]
+			g.Expect(mirror.Type).To(Equal(gwapiv1.HTTPRouteFilterRequestMirror))
+			g.Expect(string(mirror.RequestMirror.BackendRef.Name)).To(Equal(constants.ActivatorName))
+			g.Expect(string(*mirror.RequestMirror.BackendRef.Namespace)).To(Equal(constants.KServeNamespace))
+			g.Expect(*mirror.RequestMirror.BackendRef.Port).To(Equal(int32(constants.ActivatorMirrorServicePort)))
+		}
+		// Switching the activator out of the data path updates the route.
+		inDataPath := makeRoute()
+		applyActivatorBackend(isvc, inDataPath, true)
+		g.Expect(semanticHttpRouteEquals(httpRoute, inDataPath)).To(BeFalse())
+	})
+
+	// The shared filters are left untouched.
+	g := NewGomegaWithT(t)
+	g.Expect(sharedFilters[0].RequestHeaderModifier.Set).To(HaveLen(2))
+}
+
+func TestIsActivatorInDataPath(t *testing.T) {
+	isvc := &v1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{Name: "my-model", Namespace: "default"}}
+	makeDeployment := func(replicas, available int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-model-predictor", Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+			Status:     appsv1.DeploymentStatus{AvailableReplicas: available},
+		}
+	}
+	tests := map[string]struct {
+		deployment *appsv1.Deployment
+		expected   bool
+	}{
+		"deployment not created": {expected: true},
+		"scaled to zero":         {deployment: makeDeployment(0, 1), expected: true},
+		"scaling from zero":      {deployment: makeDeployment(1, 0), expected: true},
+		"warm":                   {deployment: makeDeployment(2, 1), expected: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			builder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
+			if tt.deployment != nil {
+				builder = builder.WithObjects(tt.deployment)
+			}
+			inDataPath, err := isActivatorInDataPath(t.Context(), builder.Build(), isvc)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(inDataPath).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestReconcileActivatorReferenceGrant(t *testing.T) {
+	g := NewGomegaWithT(t)
+	s := scheme.Scheme
+	s.AddKnownTypes(schema.GroupVersion{Group: gwapiv1.GroupVersion.Group, Version: gwapiv1.GroupVersion.Version},
+		&gwapiv1.ReferenceGrant{}, &gwapiv1.ReferenceGrantList{})
+	fakeClient := fake.NewClientBuilder().WithScheme(s).Build()
+	r := &RawHTTPRouteReconciler{client: fakeClient, scheme: s}
+
+	g.Expect(r.reconcileActivatorReferenceGrant(t.Context(), "default")).To(Succeed())
+	// A second reconcile keeps the existing grant.
+	g.Expect(r.reconcileActivatorReferenceGrant(t.Context(), "default")).To(Succeed())
+
+	grant := &gwapiv1.ReferenceGrant{}
+	g.Expect(fakeClient.Get(t.Context(), types.NamespacedName{
+		Name:      constants.ActivatorName + "-default",
+		Namespace: constants.KServeNamespace,
+	}, grant)).To(Succeed())
+	g.Expect(grant.Spec.From).To(ConsistOf(gwapiv1.ReferenceGrantFrom{
+		Group:     gwapiv1.GroupName,
+		Kind:      "HTTPRoute",
+		Namespace: "default",
+	}))
+	g.Expect(*grant.Spec.To[0].Name).To(Equal(gwapiv1.ObjectName(constants.ActivatorName)))
+
+	// HTTPRoutes of the KServe namespace do not need a grant.
+	g.Expect(r.reconcileActivatorReferenceGrant(t.Context(), constants.KServeNamespace)).To(Succeed())
+	grants := &gwapiv1.ReferenceGrantList{}
+	g.Expect(fakeClient.List(t.Context(), grants)).To(Succeed())
+	g.Expect(grants.Items).To(HaveLen(1))
+}
+
+func TestReleaseActivatorReferenceGrant(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = v1beta1.AddToScheme(s)
+	_ = gwapiv1.Install(s)
+	makeISVC := func(name string, minReplicas int32) *v1beta1.InferenceService {
+		return &v1beta1.InferenceService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				Annotations: map[string]string{constants.EnableActivatorAnnotationKey: "true"},
+			},
+			Spec: v1beta1.InferenceServiceSpec{
+				Predictor: v1beta1.PredictorSpec{
+					ComponentExtensionSpec: v1beta1.ComponentExtensionSpec{MinReplicas: ptr.To(minReplicas)},
+				},
+			},
+		}
+	}
+	ingressConfig := &v1beta1.IngressConfig{EnableGatewayAPI: true}
+	grantKey := types.NamespacedName{Name: constants.ActivatorName + "-default", Namespace: constants.KServeNamespace}
+
+	g := NewGomegaWithT(t)
+	first, second := makeISVC("first", 0), makeISVC("second", 0)
+	fakeClient := fake.NewClientBuilder().WithScheme(s).WithObjects(first, second).Build()
+	r := &RawHTTPRouteReconciler{client: fakeClient, scheme: s}
+	g.Expect(r.reconcileActivatorReferenceGrant(t.Context(), "default")).To(Succeed())
+
+	// The grant is kept while another InferenceService of the namespace uses the activator.
+	g.Expect(ReleaseActivatorReferenceGrant(t.Context(), fakeClient, first, ingressConfig)).To(Succeed())
+	g.Expect(fakeClient.Get(t.Context(), grantKey, &gwapiv1.ReferenceGrant{})).To(Succeed())
+
+	second.Spec.Predictor.MinReplicas = ptr.To(int32(1))
+	g.Expect(fakeClient.Update(t.Context(), second)).To(Succeed())
+	g.Expect(ReleaseActivatorReferenceGrant(t.Context(), fakeClient, first, ingressConfig)).To(Succeed())
+	g.Expect(apierr.IsNotFound(fakeClient.Get(t.Context(), grantKey, &gwapiv1.ReferenceGrant{}))).To(BeTrue())
+
+	// Releasing a deleted grant succeeds.
+	g.Expect(ReleaseActivatorReferenceGrant(t.Context(), fakeClient, first, ingressConfig)).To(Succeed())
+}
@@ -85,6 +85,14 @@ func (ir *IngressReconciler) Reconcile(ctx context.Context, isvc *v1beta1.Infere
 	if err := ir.reconcileVirtualService(ctx, isvc); err != nil {
 		return ctrl.Result{}, errors.Wrapf(err, "fails to reconcile virtual service")
 	}
+	switch {
+	case !ir.isVirtualServiceAvailable:
+		setShadowMirroredCondition(isvc, "the Istio VirtualService CRD is not present, requests are mirrored by the VirtualService")
+	case disableIstioVirtualHost:
+		setShadowMirroredCondition(isvc, "the Istio virtual host is disabled, requests are mirrored by the VirtualService")
+	default:
+		setShadowMirroredCondition(isvc, "")
+	}
 	// Create external service which points to local gateway
 	if err := ir.reconcileExternalService(ctx, isvc, ir.ingressConfig); err != nil {
 		return ctrl.Result{}, errors.Wrapf(err, "fails to reconcile external name service")
@@ -567,7 +575,7 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 		httpRoutes = append(httpRoutes, &explainerRouter)
 	}
 	// Add predict route
-	httpRoutes = append(httpRoutes, &istiov1beta1.HTTPRoute{
+	predictRoute := &istiov1beta1.HTTPRoute{
 		Match: createHTTPMatchRequest("", serviceHost,
 			network.GetServiceHostname(isvc.Name, isvc.Namespace), additionalHosts, isInternal, config),
 		Route: []*istiov1beta1.HTTPRouteDestination{
@@ -582,7 +590,9 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 				},
 			},
 		},
-	})
+	}
+	applyShadowVirtualServiceMirror(isvc, predictRoute)
+	httpRoutes = append(httpRoutes, predictRoute)
 
 	gateways := []string{
 		config.LocalGateway,
@@ -640,7 +650,7 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 				},
 			})
 		}
-		httpRoutes = append(httpRoutes, &istiov1beta1.HTTPRoute{
+		pathPredictRoute := &istiov1beta1.HTTPRoute{
 			Match: []*istiov1beta1.HTTPMatchRequest{
 				{
 					Uri: &istiov1beta1.StringMatch{
@@ -684,7 +694,9 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 					},
 				},
 			},
-		})
+		}
+		applyShadowVirtualServiceMirror(isvc, pathPredictRoute)
+		httpRoutes = append(httpRoutes, pathPredictRoute)
 		// Include ingressDomain to the domains (both internal and external) derived by Knative
 		hosts = append(hosts, url.Host)
 	}
@@ -722,6 +734,52 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 	return desiredIngress
 }
 
+// applyShadowVirtualServiceMirror mirrors the requests of a route to the latest ready revision of the shadow predictor.
+// The revision service is used as the mirrored requests do not carry the Knative routing headers, and
+// only routes sending the requests to the predictor are mirrored.
+func applyShadowVirtualServiceMirror(isvc *v1beta1.InferenceService, route *istiov1beta1.HTTPRoute) {
+	if isvc.Spec.Shadow == nil || isvc.Spec.Transformer != nil || !isvc.Status.IsConditionReady(v1beta1.ShadowReady) {
+		return
+	}
+	revision := isvc.Status.Components[v1beta1.ShadowComponent].LatestReadyRevision
+	if revision == "" {
+		return
+	}
+	route.Mirror = &istiov1beta1.Destination{
+		Host: network.GetServiceHostname(revision, isvc.Namespace),
+		Port: &istiov1beta1.PortSelector{
+			Number: constants.CommonDefaultHttpPort,
+		},
+	}
+	route.MirrorPercentage = &istiov1beta1.Percent{
+		Value: float64(isvc.Spec.Shadow.GetMirrorPercent()),
+	}
+}
+
+// setShadowMirroredCondition reports whether the ingress mirrors the predictor requests to the shadow predictor.
+// unsupported is set to the reason why the ingress configuration cannot mirror them.
+func setShadowMirroredCondition(isvc *v1beta1.InferenceService, unsupported string) {
+	if isvc.Spec.Shadow == nil {
+		isvc.Status.ClearCondition(v1beta1.ShadowMirrored)
+		return
+	}
+	condition := &apis.Condition{
+		Type:   v1beta1.ShadowMirrored,
+		Status: corev1.ConditionTrue,
+	}
+	switch {
+	case unsupported != "":
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = v1beta1.ShadowMirroringUnsupportedReason
+		condition.Message = "Shadow is inactive: " + unsupported
+	case !isvc.Status.IsConditionReady(v1beta1.ShadowReady):
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = "ShadowNotReady"
+		condition.Message = "Requests are mirrored once the shadow predictor is ready"
+	}
+	isvc.Status.SetCondition(v1beta1.ShadowMirrored, condition)
+}
+
 // getDomainList gets all the available domain names available with Knative Serving.
 func getDomainList(ctx context.Context, clientset kubernetes.Interface) *[]string {
 	res := new([]string)
@@ -2308,3 +2308,136 @@ func TestReconcileExternalService_NoUpdateWhenUnchanged(t *testing.T) {
 	g.Expect(svc.Labels).To(gomega.HaveKeyWithValue("some-other-label", "value"), "labels from other controllers should be preserved")
 	g.Expect(svc.Annotations).To(gomega.HaveKeyWithValue("serving.cert-manager.io/certificate", "some-cert"), "annotations from other controllers should be preserved")
 }
+
+func TestApplyShadowVirtualServiceMirror(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	makeISVC := func(shadowReady bool, revision string) *v1beta1.InferenceService {
+		readyStatus := corev1.ConditionFalse
+		if shadowReady {
+			readyStatus = corev1.ConditionTrue
+		}
+		return &v1beta1.InferenceService{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-model", Namespace: "test"},
+			Spec: v1beta1.InferenceServiceSpec{
+				Shadow: &v1beta1.ShadowSpec{},
+			},
+			Status: v1beta1.InferenceServiceStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: v1beta1.ShadowReady, Status: readyStatus}},
+				},
+				Components: map[v1beta1.ComponentType]v1beta1.ComponentStatusSpec{
+					v1beta1.ShadowComponent: {LatestReadyRevision: revision},
+				},
+			},
+		}
+	}
+
+	scenarios := map[string]struct {
+		isvc         *v1beta1.InferenceService
+		expectMirror *istiov1beta1.Destination
+	}{
+		"ready shadow is mirrored to its latest ready revision": {
+			isvc: makeISVC(true, "my-model-predictor-shadow-00001"),
+			expectMirror: &istiov1beta1.Destination{
+				Host: network.GetServiceHostname("my-model-predictor-shadow-00001", "test"),
+				Port: &istiov1beta1.PortSelector{Number: constants.CommonDefaultHttpPort},
+			},
+		},
+		"shadow not ready is not mirrored": {
+			isvc: makeISVC(false, "my-model-predictor-shadow-00001"),
+		},
+		"shadow without ready revision is not mirrored": {
+			isvc: makeISVC(true, ""),
+		},
+		"shadow behind a transformer is not mirrored": {
+			isvc: func() *v1beta1.InferenceService {
+				isvc := makeISVC(true, "my-model-predictor-shadow-00001")
+				isvc.Spec.Transformer = &v1beta1.TransformerSpec{}
+				return isvc
+			}(),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			route := &istiov1beta1.HTTPRoute{}
+			applyShadowVirtualServiceMirror(scenario.isvc, route)
+			if scenario.expectMirror == nil {
+				g.Expect(route.Mirror).To(gomega.BeNil())
+				g.Expect(route.MirrorPercentage).To(gomega.BeNil())
+				return
+			}
+			g.Expect(cmp.Diff(scenario.expectMirror, route.Mirror, protocmp.Transform())).To(gomega.BeEmpty())
+			g.Expect(route.MirrorPercentage.GetValue()).To(gomega.Equal(float64(100)))
+		})
+	}
+}
+
+func TestSetShadowMirroredCondition(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	makeISVC := func(shadow bool, shadowReady corev1.ConditionStatus) *v1beta1.InferenceService {
+		isvc := &v1beta1.InferenceService{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-model", Namespace: "test"},
+			Status: v1beta1.InferenceServiceStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: v1beta1.ShadowReady, Status: shadowReady}},
+				},
+			},
+		}
+		if shadow {
+			isvc.Spec.Shadow = &v1beta1.ShadowSpec{}
+		}
+		return isvc
+	}
+
+	scenarios := map[string]struct {
+		isvc          *v1beta1.InferenceService
+		unsupported   string
+		expectStatus  corev1.ConditionStatus
+		expectReason  string
+		expectMissing bool
+	}{
+		"ready shadow is mirrored": {
+			isvc:         makeISVC(true, corev1.ConditionTrue),
+			expectStatus: corev1.ConditionTrue,
+		},
+		"shadow not ready is not mirrored yet": {
+			isvc:         makeISVC(true, corev1.ConditionFalse),
+			expectStatus: corev1.ConditionFalse,
+			expectReason: "ShadowNotReady",
+		},
+		"unsupported ingress reports the shadow inactive": {
+			isvc:         makeISVC(true, corev1.ConditionTrue),
+			unsupported:  "the Istio virtual host is disabled",
+			expectStatus: corev1.ConditionFalse,
+			expectReason: v1beta1.ShadowMirroringUnsupportedReason,
+		},
+		"no shadow clears the condition": {
+			isvc: func() *v1beta1.InferenceService {
+				isvc := makeISVC(false, corev1.ConditionTrue)
+				isvc.Status.Conditions = append(isvc.Status.Conditions, apis.Condition{Type: v1beta1.ShadowMirrored, Status: corev1.ConditionTrue})
+				return isvc
+			}(),
+			expectMissing: true,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			setShadowMirroredCondition(scenario.isvc, scenario.unsupported)
+			condition := scenario.isvc.Status.GetCondition(v1beta1.ShadowMirrored)
+			if scenario.expectMissing {
+				g.Expect(condition).To(gomega.BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(gomega.BeNil())
+			g.Expect(condition.Status).To(gomega.Equal(scenario.expectStatus))
+			g.Expect(condition.Reason).To(gomega.Equal(scenario.expectReason))
+			if scenario.unsupported != "" {
+				g.Expect(condition.Message).To(gomega.ContainSubstring(scenario.unsupported))
+			}
+		})
+	}
+}
@@ -103,6 +103,8 @@ func (r *RawIngressReconciler) Reconcile(ctx context.Context, isvc *v1beta1.Infe
 		return ctrl.Result{}, nil
 	}
 
+	setShadowMirroredCondition(isvc, "Kubernetes Ingress cannot mirror requests, enable the Gateway API to mirror them")
+
 	// Create or update ingress to match the desired state
 	if !isInternal && !r.ingressConfig.DisableIngressCreation {
 		ingress, err := createRawIngress(r.scheme, isvc, r.ingressConfig, r.isvcConfig)
@@ -184,6 +184,25 @@ func getKedaMetrics(componentMeta metav1.ObjectMeta, componentExt *v1beta1.Compo
 	return triggers, nil
 }
 
+// getActivatorTrigger returns the external push trigger scaling the component on the requests the activator
+// buffers and proxies to it. The activator pushes the activation as soon as a request arrives for a component
+// scaled to zero.
+func getActivatorTrigger(componentMeta metav1.ObjectMeta, componentExt *v1beta1.ComponentExtensionSpec) kedav1alpha1.ScaleTriggers {
+	targetConcurrency := int32(constants.DefaultActivatorTargetConcurrency)
+	if componentExt != nil && componentExt.ScaleMetric != nil && *componentExt.ScaleMetric == v1beta1.MetricConcurrency &&
+		componentExt.ScaleTarget != nil {
+		targetConcurrency = *componentExt.ScaleTarget
+	}
+	return kedav1alpha1.ScaleTriggers{
+		Type: "external-push",
+		Metadata: map[string]string{
+			"scalerAddress":                     constants.ActivatorScalerAddress(),
+			constants.ActivatorScalerServiceKey: componentMeta.Name,
+			constants.ActivatorScalerTargetKey:  strconv.Itoa(int(targetConcurrency)),
+		},
+	}
+}
+
 func createKedaScaledObject(componentMeta metav1.ObjectMeta,
 	componentExtension *v1beta1.ComponentExtensionSpec,
 	configMap *corev1.ConfigMap,
@@ -230,13 +249,17 @@ func createKedaScaledObject(componentMeta metav1.ObjectMeta,
 		MinReplicas = &constants.DefaultMinReplicas
 	}
 
-	if MaxReplicas < *MinReplicas {
-		MaxReplicas = *MinReplicas
+	// A scaled object needs at least one replica to scale from zero to
+	if MaxReplicas < max(*MinReplicas, 1) {
+		MaxReplicas = max(*MinReplicas, 1)
 	}
 	triggers, err := getKedaMetrics(componentMeta, componentExtension, configMap)
 	if err != nil {
 		return nil, err
 	}
+	if componentMeta.Annotations[constants.ActivatorInternalAnnotationKey] == "true" {
+		triggers = append(triggers, getActivatorTrigger(componentMeta, componentExtension))
+	}
 
 	scaledobject := &kedav1alpha1.ScaledObject{
 		ObjectMeta: metav1.ObjectMeta{
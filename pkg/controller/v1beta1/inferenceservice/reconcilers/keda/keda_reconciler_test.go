@@ -406,6 +406,32 @@ func TestCreateKedaScaledObject_MaxReplicasLessThanMinReplicas(t *testing.T) {
 	assert.Equal(t, int32(5), *scaledObject.Spec.MaxReplicaCount)
 }
 
+func TestCreateKedaScaledObject_Activator(t *testing.T) {
+	componentMeta := metav1.ObjectMeta{
+		Name:        "test-component",
+		Namespace:   "test-namespace",
+		Annotations: map[string]string{constants.ActivatorInternalAnnotationKey: "true"},
+	}
+	concurrency := v1beta1.MetricConcurrency
+	componentExt := &v1beta1.ComponentExtensionSpec{
+		MinReplicas: ptr.To(int32(0)),
+		ScaleMetric: &concurrency,
+		ScaleTarget: ptr.To(int32(5)),
+	}
+	configMap := &corev1.ConfigMap{}
+
+	scaledObject, err := createKedaScaledObject(componentMeta, componentExt, configMap)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), *scaledObject.Spec.MinReplicaCount)
+	assert.Equal(t, int32(1), *scaledObject.Spec.MaxReplicaCount)
+	require.Len(t, scaledObject.Spec.Triggers, 1)
+	trigger := scaledObject.Spec.Triggers[0]
+	assert.Equal(t, "external-push", trigger.Type)
+	assert.Equal(t, constants.ActivatorScalerAddress(), trigger.Metadata["scalerAddress"])
+	assert.Equal(t, "test-component", trigger.Metadata[constants.ActivatorScalerServiceKey])
+	assert.Equal(t, "5", trigger.Metadata[constants.ActivatorScalerTargetKey])
+}
+
 func TestGetKedaMetrics_NilAutoScaling(t *testing.T) {
 	componentMeta := metav1.ObjectMeta{
 		Name:      "test-component",
@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/controller/v1alpha1/trainedmodel/sharding/memory"
@@ -55,8 +56,12 @@ func (c *ModelConfigReconciler) Reconcile(ctx context.Context, isvc *v1beta1.Inf
 		// Create an empty modelConfig for every InferenceService shard
 		// An InferenceService without storageUri is an empty model server with for multi-model serving so a modelConfig configmap should be created
 		// An InferenceService with storageUri is considered as multi-model InferenceService with only one model, a modelConfig configmap should be created as well
-		shardStrategy := memory.MemoryStrategy{}
-		for _, id := range shardStrategy.GetShard(isvc) {
+		trainedModels := &v1alpha1.TrainedModelList{}
+		if err := c.client.List(ctx, trainedModels, client.InNamespace(isvc.Namespace), client.MatchingLabels{constants.ParentInferenceServiceLabel: isvc.Name}); err != nil {
+			return err
+		}
+		shardStrategy := memory.NewMemoryStrategy(isvc, trainedModels.Items)
+		for _, id := range shardStrategy.GetShard() {
 			modelConfigName := constants.ModelConfigName(isvc.Name, id)
 			_, err := c.clientset.CoreV1().ConfigMaps(isvc.Namespace).Get(ctx, modelConfigName, metav1.GetOptions{})
 			if err != nil {
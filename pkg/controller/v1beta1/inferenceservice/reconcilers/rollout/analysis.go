@@ -0,0 +1,260 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+const (
+	defaultWebhookTimeout  = 10 * time.Second
+	prometheusQueryTimeout = 10 * time.Second
+	prometheusQueryPath    = "/api/v1/query"
+	maxMessageBodyLength   = 256
+)
+
+// Analyzer evaluates the success criteria of a canary rollout step.
+type Analyzer interface {
+	// Analyze returns whether the step passed and a message detailing the outcome. An error is returned
+	// when the analysis could not be run, in which case it is retried until the AnalysisDeadline.
+	Analyze(ctx context.Context, isvc *v1beta1.InferenceService, canary *v1beta1.CanarySpec, step v1beta1.RolloutStep) (bool, string, error)
+}
+
+// QueryData holds the fields available to the analysis metric query templates.
+type QueryData struct {
+	Name          string
+	Namespace     string
+	Canary        string
+	CanaryService string
+}
+
+// WebhookRequest is the payload sent to the analysis webhook.
+type WebhookRequest struct {
+	Name           string `json:"name"`
+	Namespace      string `json:"namespace"`
+	Canary         string `json:"canary"`
+	CanaryService  string `json:"canaryService"`
+	TrafficPercent int32  `json:"trafficPercent"`
+}
+
+type httpAnalyzer struct {
+	client *http.Client
+}
+
+// NewAnalyzer returns an Analyzer evaluating Prometheus and webhook success criteria with the given http client.
+// Each request is bounded by its own deadline, the client should not set a timeout capping the webhook timeouts.
+func NewAnalyzer(client *http.Client) Analyzer {
+	return &httpAnalyzer{client: client}
+}
+
+// AnalysisTimeout bounds the analysis of a step, it runs within the InferenceService reconcile and the metric
+// queries and the webhook call are made one after the other, each with its own timeout.
+func AnalysisTimeout(analysis *v1beta1.RolloutAnalysisSpec) time.Duration {
+	var timeout time.Duration
+	if analysis.Prometheus != nil {
+		timeout += time.Duration(len(analysis.Prometheus.Metrics)) * prometheusQueryTimeout
+	}
+	if analysis.Webhook != nil {
+		timeout += webhookTimeout(analysis.Webhook)
+	}
+	return timeout
+}
+
+func webhookTimeout(webhook *v1beta1.WebhookAnalysisSpec) time.Duration {
+	if webhook.TimeoutSeconds != nil {
+		return time.Duration(*webhook.TimeoutSeconds) * time.Second
+	}
+	return defaultWebhookTimeout
+}
+
+func (a *httpAnalyzer) Analyze(ctx context.Context, isvc *v1beta1.InferenceService, canary *v1beta1.CanarySpec, step v1beta1.RolloutStep) (bool, string, error) {
+	analysis := canary.Rollout.Analysis
+	data := QueryData{
+		Name:          isvc.Name,
+		Namespace:     isvc.Namespace,
+		Canary:        canary.Predictor.Name,
+		CanaryService: constants.PredictorServiceName(isvc.Name, canary.Predictor.Name),
+	}
+
+	var messages []string
+	if analysis.Prometheus != nil {
+		for _, metric := range analysis.Prometheus.Metrics {
+			value, err := a.queryPrometheus(ctx, analysis.Prometheus.Address, metric, data)
+			if err != nil {
+				return false, "", fmt.Errorf("metric %s: %w", metric.Name, err)
+			}
+			if metric.Max != nil && value > metric.Max.AsApproximateFloat64() {
+				return false, fmt.Sprintf("metric %s value %g is above the maximum %s", metric.Name, value, metric.Max.String()), nil
+			}
+			if metric.Min != nil && value < metric.Min.AsApproximateFloat64() {
+				return false, fmt.Sprintf("metric %s value %g is below the minimum %s", metric.Name, value, metric.Min.String()), nil
+			}
+			messages = append(messages, fmt.Sprintf("metric %s value %g", metric.Name, value))
+		}
+	}
+	if analysis.Webhook != nil {
+		passed, message, err := a.callWebhook(ctx, analysis.Webhook, data, step)
+		if err != nil {
+			return false, "", fmt.Errorf("webhook: %w", err)
+		}
+		if !passed {
+			return false, message, nil
+		}
+		messages = append(messages, message)
+	}
+	return true, strings.Join(messages, ", "), nil
+}
+
+// prometheusResponse is the subset of the Prometheus HTTP API instant query response used by the analysis.
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// queryPrometheus runs the metric query and returns its single result value.
+func (a *httpAnalyzer) queryPrometheus(ctx context.Context, address string, metric v1beta1.AnalysisMetric, data QueryData) (float64, error) {
+	query, err := renderQuery(metric.Query, data)
+	if err != nil {
+		return 0, err
+	}
+	queryURL := strings.TrimSuffix(address, "/") + prometheusQueryPath + "?" + url.Values{"query": []string{query}}.Encode()
+	ctx, cancel := context.WithTimeout(ctx, prometheusQueryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, queryURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	result := &prometheusResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return 0, fmt.Errorf("failed to decode prometheus response: %w", err)
+	}
+	if result.Status != "success" {
+		return 0, fmt.Errorf("prometheus query failed: %s", result.Error)
+	}
+
+	var sample []interface{}
+	switch result.Data.ResultType {
+	case "scalar":
+		if err := json.Unmarshal(result.Data.Result, &sample); err != nil {
+			return 0, err
+		}
+	case "vector":
+		var vector []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(result.Data.Result, &vector); err != nil {
+			return 0, err
+		}
+		if len(vector) != 1 {
+			return 0, fmt.Errorf("query must return a single value, got %d", len(vector))
+		}
+		sample = vector[0].Value
+	default:
+		return 0, fmt.Errorf("unsupported prometheus result type %q", result.Data.ResultType)
+	}
+	if len(sample) != 2 {
+		return 0, errors.New("malformed prometheus sample")
+	}
+	raw, ok := sample[1].(string)
+	if !ok {
+		return 0, errors.New("malformed prometheus sample value")
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(value) {
+		// NaN usually means no request reached the canary yet.
+		return 0, errors.New("query returned NaN")
+	}
+	return value, nil
+}
+
+// callWebhook posts the step to the webhook, a 2xx response passes the step and other client responses fail it.
+func (a *httpAnalyzer) callWebhook(ctx context.Context, webhook *v1beta1.WebhookAnalysisSpec, data QueryData, step v1beta1.RolloutStep) (bool, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout(webhook))
+	defer cancel()
+
+	payload, err := json.Marshal(WebhookRequest{
+		Name:           data.Name,
+		Namespace:      data.Namespace,
+		Canary:         data.Canary,
+		CanaryService:  data.CanaryService,
+		TrafficPercent: step.TrafficPercent,
+	})
+	if err != nil {
+		return false, "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return false, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return false, "", err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxMessageBodyLength))
+	message := fmt.Sprintf("webhook returned %d", resp.StatusCode)
+	if len(body) > 0 {
+		message = fmt.Sprintf("%s: %s", message, strings.TrimSpace(string(body)))
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		// Server errors do not judge the step, the analysis is retried.
+		return false, "", errors.New(message)
+	}
+	return resp.StatusCode >= 200 && resp.StatusCode < 300, message, nil
+}
+
+func renderQuery(query string, data QueryData) (string, error) {
+	tmpl, err := template.New("query").Parse(query)
+	if err != nil {
+		return "", fmt.Errorf("invalid query template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render query: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+)
+
+func TestPrometheusAnalysis(t *testing.T) {
+	isvc := &v1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{Name: "model", Namespace: "default"}}
+
+	scenarios := map[string]struct {
+		response     string
+		expectPassed bool
+		expectError  bool
+	}{
+		"value below max passes": {
+			response:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0.001"]}]}}`,
+			expectPassed: true,
+		},
+		"value above max fails": {
+			response:     `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"0.5"]}}`,
+			expectPassed: false,
+		},
+		"empty vector is an error": {
+			response:    `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			expectError: true,
+		},
+		"NaN is an error": {
+			response:    `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"NaN"]}}`,
+			expectError: true,
+		},
+		"query error is an error": {
+			response:    `{"status":"error","error":"parse error"}`,
+			expectError: true,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			var query string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, prometheusQueryPath, r.URL.Path)
+				query = r.URL.Query().Get("query")
+				fmt.Fprint(w, scenario.response)
+			}))
+			defer server.Close()
+
+			maxErrorRate := resource.MustParse("0.01")
+			canary := &v1beta1.CanarySpec{
+				Predictor: v1beta1.PredictorSpec{Name: "v2"},
+				Rollout: &v1beta1.ProgressiveRolloutSpec{
+					Analysis: &v1beta1.RolloutAnalysisSpec{
+						Prometheus: &v1beta1.PrometheusAnalysisSpec{
+							Address: server.URL,
+							Metrics: []v1beta1.AnalysisMetric{{
+								Name:  "error-rate",
+								Query: `rate(errors{namespace="{{ .Namespace }}",service="{{ .CanaryService }}"}[5m])`,
+								Max:   &maxErrorRate,
+							}},
+						},
+					},
+				},
+			}
+
+			passed, _, err := NewAnalyzer(server.Client()).Analyze(context.Background(), isvc, canary, v1beta1.RolloutStep{TrafficPercent: 10})
+			if scenario.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, scenario.expectPassed, passed)
+			assert.Equal(t, `rate(errors{namespace="default",service="model-v2-predictor"}[5m])`, query)
+		})
+	}
+}
+
+func TestWebhookAnalysis(t *testing.T) {
+	isvc := &v1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{Name: "model", Namespace: "default"}}
+
+	scenarios := map[string]struct {
+		statusCode   int
+		expectPassed bool
+		expectError  bool
+	}{
+		"ok passes":               {statusCode: http.StatusOK, expectPassed: true},
+		"client error fails":      {statusCode: http.StatusPreconditionFailed, expectPassed: false},
+		"server error is retried": {statusCode: http.StatusServiceUnavailable, expectError: true},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			var request WebhookRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+				w.WriteHeader(scenario.statusCode)
+			}))
+			defer server.Close()
+
+			canary := &v1beta1.CanarySpec{
+				Predictor: v1beta1.PredictorSpec{Name: "v2"},
+				Rollout: &v1beta1.ProgressiveRolloutSpec{
+					Analysis: &v1beta1.RolloutAnalysisSpec{
+						Webhook: &v1beta1.WebhookAnalysisSpec{URL: server.URL},
+					},
+				},
+			}
+
+			passed, _, err := NewAnalyzer(server.Client()).Analyze(context.Background(), isvc, canary, v1beta1.RolloutStep{TrafficPercent: 25})
+			if scenario.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, scenario.expectPassed, passed)
+			}
+			assert.Equal(t, WebhookRequest{
+				Name:           "model",
+				Namespace:      "default",
+				Canary:         "v2",
+				CanaryService:  "model-v2-predictor",
+				TrafficPercent: 25,
+			}, request)
+		})
+	}
+}
+
+func TestAnalysisTimeout(t *testing.T) {
+	analysis := &v1beta1.RolloutAnalysisSpec{
+		Prometheus: &v1beta1.PrometheusAnalysisSpec{
+			Metrics: []v1beta1.AnalysisMetric{{Name: "error-rate"}, {Name: "latency"}},
+		},
+		Webhook: &v1beta1.WebhookAnalysisSpec{URL: "http://analysis.default.svc/check", TimeoutSeconds: ptr.To(int64(30))},
+	}
+	assert.Equal(t, 50*time.Second, AnalysisTimeout(analysis))
+
+	analysis.Webhook.TimeoutSeconds = nil
+	assert.Equal(t, 30*time.Second, AnalysisTimeout(analysis))
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+)
+
+var log = logf.Log.WithName("RolloutReconciler")
+
+// AnalysisRetryInterval is how long to wait before analyzing a step again when the analysis could not be run.
+const AnalysisRetryInterval = 30 * time.Second
+
+// AnalysisDeadline is how long after the end of its hold a step may fail to be analyzed, e.g. because the
+// canary metrics are still NaN without traffic, before the step fails and the canary traffic is rolled back.
+const AnalysisDeadline = 10 * time.Minute
+
+// RolloutReconciler drives the progressive rollout of a canary through its rollout steps.
+type RolloutReconciler struct {
+	analyzer Analyzer
+	now      func() metav1.Time
+}
+
+func NewRolloutReconciler(analyzer Analyzer) *RolloutReconciler {
+	return &RolloutReconciler{
+		analyzer: analyzer,
+		now:      metav1.Now,
+	}
+}
+
+// Reconcile computes the next rollout status of the canary from the previously observed one. It returns the
+// new status and, while the rollout is progressing, how long to wait before the rollout must be evaluated again.
+func (r *RolloutReconciler) Reconcile(ctx context.Context, isvc *v1beta1.InferenceService, canary *v1beta1.CanarySpec,
+	previous *v1beta1.RolloutStatus, canaryReady bool,
+) (*v1beta1.RolloutStatus, time.Duration, error) {
+	specHash, err := SpecHash(canary)
+	if err != nil {
+		return previous, 0, err
+	}
+
+	var status *v1beta1.RolloutStatus
+	if previous == nil || previous.SpecHash != specHash {
+		log.Info("Starting canary rollout", "isvc", isvc.Name, "canary", canary.Predictor.Name)
+		status = &v1beta1.RolloutStatus{
+			Phase:    v1beta1.RolloutProgressing,
+			SpecHash: specHash,
+			Message:  "Rollout started",
+		}
+	} else {
+		status = previous.DeepCopy()
+	}
+	if status.Phase != v1beta1.RolloutProgressing {
+		return status, 0, nil
+	}
+
+	steps := canary.Rollout.Steps
+	if int(status.CurrentStep) >= len(steps) {
+		status.CurrentStep = int32(len(steps) - 1) //nolint:gosec // bounded by the CRD
+	}
+	step := steps[status.CurrentStep]
+	if status.StepStartTime == nil {
+		if !canaryReady {
+			// The hold starts once the canary serves traffic, the deployment watch triggers the next evaluation.
+			status.Message = fmt.Sprintf("Waiting for the canary to be ready to start step %d", status.CurrentStep)
+			return status, 0, nil
+		}
+		now := r.now()
+		status.StepStartTime = &now
+		status.Message = fmt.Sprintf("Holding step %d at %d%% traffic", status.CurrentStep, step.TrafficPercent)
+	}
+
+	now := r.now()
+	if remaining := holdDuration(step) - now.Sub(status.StepStartTime.Time); remaining > 0 {
+		return status, remaining, nil
+	}
+
+	passed, message := true, "No analysis configured"
+	if canary.Rollout.Analysis != nil {
+		analysisCtx, cancel := context.WithTimeout(ctx, AnalysisTimeout(canary.Rollout.Analysis))
+		passed, message, err = r.analyzer.Analyze(analysisCtx, isvc, canary, step)
+		cancel()
+		if err != nil {
+			log.Error(err, "Failed to analyze canary rollout step", "isvc", isvc.Name, "canary", canary.Predictor.Name, "step", status.CurrentStep)
+			overdue := now.Sub(status.StepStartTime.Time) - holdDuration(step)
+			if overdue < AnalysisDeadline {
+				status.Message = fmt.Sprintf("Failed to analyze step %d: %v", status.CurrentStep, err)
+				return status, min(AnalysisRetryInterval, AnalysisDeadline-overdue), nil
+			}
+			passed, message = false, fmt.Sprintf("analysis did not complete within %s: %v", AnalysisDeadline, err)
+		}
+	}
+
+	result := v1beta1.RolloutStepPassed
+	if !passed {
+		result = v1beta1.RolloutStepFailed
+	}
+	status.History = append(status.History, v1beta1.RolloutStepStatus{
+		Step:           status.CurrentStep,
+		TrafficPercent: step.TrafficPercent,
+		Result:         result,
+		StartTime:      status.StepStartTime,
+		CompletionTime: &now,
+		Message:        message,
+	})
+
+	switch {
+	case !passed:
+		log.Info("Canary rollout step failed, rolling back", "isvc", isvc.Name, "canary", canary.Predictor.Name, "step", status.CurrentStep)
+		status.Phase = v1beta1.RolloutRolledBack
+		status.StepStartTime = nil
+		status.Message = fmt.Sprintf("Step %d failed the analysis, canary traffic rolled back: %s", status.CurrentStep, message)
+		return status, 0, nil
+	case int(status.CurrentStep) == len(steps)-1:
+		log.Info("Canary rollout succeeded", "isvc", isvc.Name, "canary", canary.Predictor.Name)
+		status.Phase = v1beta1.RolloutSucceeded
+		status.StepStartTime = nil
+		status.Message = "All rollout steps passed the analysis"
+		return status, 0, nil
+	default:
+		status.CurrentStep++
+		status.StepStartTime = &now
+		next := steps[status.CurrentStep]
+		log.Info("Canary rollout step passed", "isvc", isvc.Name, "canary", canary.Predictor.Name, "nextStep", status.CurrentStep, "trafficPercent", next.TrafficPercent)
+		status.Message = fmt.Sprintf("Holding step %d at %d%% traffic", status.CurrentStep, next.TrafficPercent)
+		return status, max(holdDuration(next), time.Second), nil
+	}
+}
+
+// SpecHash identifies the canary predictor and rollout policy, a new rollout starts whenever it changes.
+func SpecHash(canary *v1beta1.CanarySpec) (string, error) {
+	data, err := json.Marshal(struct {
+		Predictor v1beta1.PredictorSpec
+		Rollout   *v1beta1.ProgressiveRolloutSpec
+	}{canary.Predictor, canary.Rollout})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
+
+func holdDuration(step v1beta1.RolloutStep) time.Duration {
+	if step.Hold == nil {
+		return 0
+	}
+	return step.Hold.Duration
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+)
+
+type fakeAnalyzer struct {
+	passed bool
+	err    error
+	calls  int
+}
+
+func (f *fakeAnalyzer) Analyze(_ context.Context, _ *v1beta1.InferenceService, _ *v1beta1.CanarySpec, _ v1beta1.RolloutStep) (bool, string, error) {
+	f.calls++
+	return f.passed, "analyzed", f.err
+}
+
+func newRolloutTestCanary() *v1beta1.CanarySpec {
+	maxErrorRate := resource.MustParse("0.01")
+	return &v1beta1.CanarySpec{
+		Predictor: v1beta1.PredictorSpec{Name: "v2"},
+		Rollout: &v1beta1.ProgressiveRolloutSpec{
+			Steps: []v1beta1.RolloutStep{
+				{TrafficPercent: 10, Hold: &metav1.Duration{Duration: 5 * time.Minute}},
+				{TrafficPercent: 50, Hold: &metav1.Duration{Duration: 10 * time.Minute}},
+			},
+			Analysis: &v1beta1.RolloutAnalysisSpec{
+				Prometheus: &v1beta1.PrometheusAnalysisSpec{
+					Address: "http://prometheus:9090",
+					Metrics: []v1beta1.AnalysisMetric{{Name: "error-rate", Query: "vector(0)", Max: &maxErrorRate}},
+				},
+			},
+		},
+	}
+}
+
+func TestRolloutReconcile(t *testing.T) {
+	isvc := &v1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{Name: "model", Namespace: "default"}}
+	start := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	canary := newRolloutTestCanary()
+	specHash, err := SpecHash(canary)
+	require.NoError(t, err)
+
+	scenarios := map[string]struct {
+		previous       *v1beta1.RolloutStatus
+		canaryReady    bool
+		elapsed        time.Duration
+		analyzer       *fakeAnalyzer
+		expectPhase    v1beta1.RolloutPhase
+		expectStep     int32
+		expectRequeue  time.Duration
+		expectHistory  int
+		expectAnalyzed bool
+	}{
+		"new rollout waits for the canary to be ready": {
+			canaryReady: false,
+			analyzer:    &fakeAnalyzer{passed: true},
+			expectPhase: v1beta1.RolloutProgressing,
+		},
+		"new rollout starts holding the first step": {
+			canaryReady:   true,
+			analyzer:      &fakeAnalyzer{passed: true},
+			expectPhase:   v1beta1.RolloutProgressing,
+			expectRequeue: 5 * time.Minute,
+		},
+		"step still holding": {
+			previous:      &v1beta1.RolloutStatus{Phase: v1beta1.RolloutProgressing, SpecHash: specHash, StepStartTime: &start},
+			canaryReady:   true,
+			elapsed:       2 * time.Minute,
+			analyzer:      &fakeAnalyzer{passed: true},
+			expectPhase:   v1beta1.RolloutProgressing,
+			expectRequeue: 3 * time.Minute,
+		},
+		"passing step advances to the next step": {
+			previous:       &v1beta1.RolloutStatus{Phase: v1beta1.RolloutProgressing, SpecHash: specHash, StepStartTime: &start},
+			canaryReady:    true,
+			elapsed:        6 * time.Minute,
+			analyzer:       &fakeAnalyzer{passed: true},
+			expectPhase:    v1beta1.RolloutProgressing,
+			expectStep:     1,
+			expectRequeue:  10 * time.Minute,
+			expectHistory:  1,
+			expectAnalyzed: true,
+		},
+		"passing last step succeeds": {
+			previous:       &v1beta1.RolloutStatus{Phase: v1beta1.RolloutProgressing, SpecHash: specHash, CurrentStep: 1, StepStartTime: &start},
+			canaryReady:    true,
+			elapsed:        11 * time.Minute,
+			analyzer:       &fakeAnalyzer{passed: true},
+			expectPhase:    v1beta1.RolloutSucceeded,
+			expectStep:     1,
+			expectHistory:  1,
+			expectAnalyzed: true,
+		},
+		"failing step rolls back": {
+			previous:       &v1beta1.RolloutStatus{Phase: v1beta1.RolloutProgressing, SpecHash: specHash, StepStartTime: &start},
+			canaryReady:    true,
+			elapsed:        6 * time.Minute,
+			analyzer:       &fakeAnalyzer{passed: false},
+			expectPhase:    v1beta1.RolloutRolledBack,
+			expectHistory:  1,
+			expectAnalyzed: true,
+		},
+		"analysis error is retried": {
+			previous:       &v1beta1.RolloutStatus{Phase: v1beta1.RolloutProgressing, SpecHash: specHash, StepStartTime: &start},
+			canaryReady:    true,
+			elapsed:        6 * time.Minute,
+			analyzer:       &fakeAnalyzer{err: errors.New("connection refused")},
+			expectPhase:    v1beta1.RolloutProgressing,
+			expectRequeue:  AnalysisRetryInterval,
+			expectAnalyzed: true,
+		},
+		"analysis error is retried until the deadline": {
+			previous:       &v1beta1.RolloutStatus{Phase: v1beta1.RolloutProgressing, SpecHash: specHash, StepStartTime: &start},
+			canaryReady:    true,
+			elapsed:        5*time.Minute + AnalysisDeadline - 10*time.Second,
+			analyzer:       &fakeAnalyzer{err: errors.New("query returned NaN")},
+			expectPhase:    v1beta1.RolloutProgressing,
+			expectRequeue:  10 * time.Second,
+			expectAnalyzed: true,
+		},
+		"analysis error past the deadline rolls back": {
+			previous:       &v1beta1.RolloutStatus{Phase: v1beta1.RolloutProgressing, SpecHash: specHash, StepStartTime: &start},
+			canaryReady:    true,
+			elapsed:        5*time.Minute + AnalysisDeadline,
+			analyzer:       &fakeAnalyzer{err: errors.New("query returned NaN")},
+			expectPhase:    v1beta1.RolloutRolledBack,
+			expectHistory:  1,
+			expectAnalyzed: true,
+		},
+		"rolled back rollout is not evaluated again": {
+			previous:    &v1beta1.RolloutStatus{Phase: v1beta1.RolloutRolledBack, SpecHash: specHash},
+			canaryReady: true,
+			analyzer:    &fakeAnalyzer{passed: true},
+			expectPhase: v1beta1.RolloutRolledBack,
+		},
+		"spec change restarts a rolled back rollout": {
+			previous: &v1beta1.RolloutStatus{
+				Phase: v1beta1.RolloutRolledBack, SpecHash: "outdated", CurrentStep: 1,
+				History: []v1beta1.RolloutStepStatus{{Step: 1, Result: v1beta1.RolloutStepFailed}},
+			},
+			canaryReady:   true,
+			analyzer:      &fakeAnalyzer{passed: true},
+			expectPhase:   v1beta1.RolloutProgressing,
+			expectRequeue: 5 * time.Minute,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			r := NewRolloutReconciler(scenario.analyzer)
+			r.now = func() metav1.Time { return metav1.NewTime(start.Add(scenario.elapsed)) }
+
+			status, requeue, err := r.Reconcile(context.Background(), isvc, canary, scenario.previous, scenario.canaryReady)
+			require.NoError(t, err)
+			assert.Equal(t, scenario.expectPhase, status.Phase)
+			assert.Equal(t, scenario.expectStep, status.CurrentStep)
+			assert.Equal(t, scenario.expectRequeue, requeue)
+			assert.Len(t, status.History, scenario.expectHistory)
+			assert.Equal(t, scenario.expectAnalyzed, scenario.analyzer.calls > 0)
+			assert.Equal(t, specHash, status.SpecHash)
+		})
+	}
+}
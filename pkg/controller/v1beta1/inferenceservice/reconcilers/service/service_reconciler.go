@@ -274,8 +274,10 @@ func (r *ServiceReconciler) checkServiceExist(ctx context.Context, client client
 }
 
 func semanticServiceEquals(desired, existing *corev1.Service) bool {
+	// The activator only proxies the requests to the services annotated for it.
 	return equality.Semantic.DeepEqual(desired.Spec.Ports, existing.Spec.Ports) &&
-		equality.Semantic.DeepEqual(desired.Spec.Selector, existing.Spec.Selector)
+		equality.Semantic.DeepEqual(desired.Spec.Selector, existing.Spec.Selector) &&
+		desired.Annotations[constants.ActivatorInternalAnnotationKey] == existing.Annotations[constants.ActivatorInternalAnnotationKey]
 }
 
 // Reconcile ...
@@ -80,6 +80,31 @@ func IsMMSPredictor(predictor *v1beta1.PredictorSpec) bool {
 	}
 }
 
+// IsActivatorEnabled returns whether the predictor is scaled to zero behind the activator in Standard deployment mode.
+// The activator is enabled with the enable-activator annotation and only sits in front of the Gateway API HTTPRoute
+// of the predictor, so it is not used with the Kubernetes Ingress or when the predictor receives requests from other
+// components, canaries or a shadow. Otherwise MinReplicas 0 keeps its autoscaler class behavior. Requests sent to the
+// predictor Service from within the cluster bypass the activator and are not served while the predictor is scaled to
+// zero.
+func IsActivatorEnabled(isvc *v1beta1.InferenceService, ingressConfig *v1beta1.IngressConfig) bool {
+	if isvc.Annotations[constants.EnableActivatorAnnotationKey] != "true" || ingressConfig == nil || !ingressConfig.EnableGatewayAPI {
+		return false
+	}
+	predictor := isvc.Spec.Predictor
+	if predictor.MinReplicas == nil || *predictor.MinReplicas != 0 || predictor.WorkerSpec != nil {
+		return false
+	}
+	if isvc.Spec.Transformer != nil || isvc.Spec.Explainer != nil || len(isvc.Spec.Canary) > 0 || isvc.Spec.Shadow != nil {
+		return false
+	}
+	switch constants.AutoscalerClassType(isvc.Annotations[constants.AutoscalerClass]) {
+	case "", constants.AutoscalerClassHPA, constants.AutoscalerClassKeda:
+		return true
+	default:
+		return false
+	}
+}
+
 func IsMemoryResourceAvailable(isvc *v1beta1.InferenceService, totalReqMemory resource.Quantity) bool {
 	if isvc.Spec.Predictor.GetExtensions() == nil || len(isvc.Spec.Predictor.GetImplementations()) == 0 {
 		return false
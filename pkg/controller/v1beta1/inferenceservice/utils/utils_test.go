@@ -18,6 +18,7 @@ package utils
 
 import (
 	"errors"
+	"maps"
 	"slices"
 	"strconv"
 	"testing"
@@ -195,6 +196,73 @@ func TestIsMMSPredictor(t *testing.T) {
 	}
 }
 
+func TestIsActivatorEnabled(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	scenarios := map[string]struct {
+		annotations       map[string]string
+		update            func(spec *InferenceServiceSpec)
+		kubernetesIngress bool
+		expected          bool
+	}{
+		"ScaleToZero": {
+			expected: true,
+		},
+		"NotEnabled": {
+			annotations: map[string]string{constants.EnableActivatorAnnotationKey: "false"},
+			expected:    false,
+		},
+		"KubernetesIngress": {
+			kubernetesIngress: true,
+			expected:          false,
+		},
+		"ScaleToZeroWithKeda": {
+			annotations: map[string]string{constants.AutoscalerClass: string(constants.AutoscalerClassKeda)},
+			expected:    true,
+		},
+		"MinReplicasNotZero": {
+			update:   func(spec *InferenceServiceSpec) { spec.Predictor.MinReplicas = ptr.To(int32(1)) },
+			expected: false,
+		},
+		"MinReplicasNotSet": {
+			update:   func(spec *InferenceServiceSpec) { spec.Predictor.MinReplicas = nil },
+			expected: false,
+		},
+		"ExternalAutoscaler": {
+			annotations: map[string]string{constants.AutoscalerClass: string(constants.AutoscalerClassExternal)},
+			expected:    false,
+		},
+		"Transformer": {
+			update:   func(spec *InferenceServiceSpec) { spec.Transformer = &TransformerSpec{} },
+			expected: false,
+		},
+		"Canary": {
+			update:   func(spec *InferenceServiceSpec) { spec.Canary = []CanarySpec{{Predictor: PredictorSpec{Name: "v2"}}} },
+			expected: false,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			annotations := map[string]string{constants.EnableActivatorAnnotationKey: "true"}
+			maps.Copy(annotations, scenario.annotations)
+			isvc := &InferenceService{
+				ObjectMeta: metav1.ObjectMeta{Name: "model", Namespace: "default", Annotations: annotations},
+				Spec: InferenceServiceSpec{
+					Predictor: PredictorSpec{
+						ComponentExtensionSpec: ComponentExtensionSpec{MinReplicas: ptr.To(int32(0))},
+					},
+				},
+			}
+			if scenario.update != nil {
+				scenario.update(&isvc.Spec)
+			}
+			ingressConfig := &IngressConfig{EnableGatewayAPI: !scenario.kubernetesIngress}
+			g.Expect(IsActivatorEnabled(isvc, ingressConfig)).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
 func TestIsMemoryResourceAvailable(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+/*
+Azure Workload Identity, with the same service account annotations, token volume and env vars as the
+azure-workload-identity webhook: https://azure.github.io/azure-workload-identity/docs/topics/service-account-labels-and-annotations.html
+*/
+const (
+	AzureWorkloadIdentityClientIdAnnotationKey = "azure.workload.identity/client-id"
+	AzureWorkloadIdentityTenantIdAnnotationKey = "azure.workload.identity/tenant-id"
+	AzureFederatedTokenFile                    = "AZURE_FEDERATED_TOKEN_FILE"
+	AzureAuthorityHost                         = "AZURE_AUTHORITY_HOST"
+	AzureDefaultAuthorityHost                  = "https://login.microsoftonline.com/"
+	AzureIdentityTokenVolume                   = "azure-identity-token"
+	AzureIdentityTokenMountPath                = "/var/run/secrets/azure/tokens"
+	AzureIdentityTokenPath                     = "azure-identity-token"
+	AzureIdentityTokenAudience                 = "api://AzureADTokenExchange"
+	AzureIdentityTokenExpiry                   = 3600
+)
+
+// BuildWorkloadIdentityVolumeAndEnvs returns the projected token volume and the env vars authenticating as the
+// client of the client-id annotation of the service account, or false if the service account has no client annotation
+func BuildWorkloadIdentityVolumeAndEnvs(serviceAccount *corev1.ServiceAccount) (corev1.Volume, corev1.VolumeMount, []corev1.EnvVar, bool) {
+	clientId, ok := serviceAccount.Annotations[AzureWorkloadIdentityClientIdAnnotationKey]
+	if !ok || clientId == "" {
+		return corev1.Volume{}, corev1.VolumeMount{}, nil, false
+	}
+
+	volume := corev1.Volume{
+		Name: AzureIdentityTokenVolume,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          AzureIdentityTokenAudience,
+							ExpirationSeconds: ptr.To(int64(AzureIdentityTokenExpiry)),
+							Path:              AzureIdentityTokenPath,
+						},
+					},
+				},
+			},
+		},
+	}
+	volumeMount := corev1.VolumeMount{
+		Name:      AzureIdentityTokenVolume,
+		MountPath: AzureIdentityTokenMountPath,
+		ReadOnly:  true,
+	}
+	envs := []corev1.EnvVar{
+		{
+			Name:  AzureClientId,
+			Value: clientId,
+		},
+		{
+			Name:  AzureFederatedTokenFile,
+			Value: AzureIdentityTokenMountPath + "/" + AzureIdentityTokenPath,
+		},
+		{
+			Name:  AzureAuthorityHost,
+			Value: AzureDefaultAuthorityHost,
+		},
+	}
+	// Without tenant annotation, the tenant is the one of the AZURE_TENANT_ID env var set on the container
+	if tenantId, ok := serviceAccount.Annotations[AzureWorkloadIdentityTenantIdAnnotationKey]; ok && tenantId != "" {
+		envs = append(envs, corev1.EnvVar{
+			Name:  AzureTenantId,
+			Value: tenantId,
+		})
+	}
+	return volume, volumeMount, envs, true
+}
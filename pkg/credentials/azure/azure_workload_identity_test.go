@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAzureWorkloadIdentity(t *testing.T) {
+	scenarios := map[string]struct {
+		annotations map[string]string
+		expected    []corev1.EnvVar
+	}{
+		"NoClientId": {
+			annotations: map[string]string{AzureWorkloadIdentityTenantIdAnnotationKey: "tenant"},
+		},
+		"ClientId": {
+			annotations: map[string]string{AzureWorkloadIdentityClientIdAnnotationKey: "client"},
+			expected: []corev1.EnvVar{
+				{Name: AzureClientId, Value: "client"},
+				{Name: AzureFederatedTokenFile, Value: "/var/run/secrets/azure/tokens/azure-identity-token"},
+				{Name: AzureAuthorityHost, Value: AzureDefaultAuthorityHost},
+			},
+		},
+		"ClientIdAndTenantId": {
+			annotations: map[string]string{
+				AzureWorkloadIdentityClientIdAnnotationKey: "client",
+				AzureWorkloadIdentityTenantIdAnnotationKey: "tenant",
+			},
+			expected: []corev1.EnvVar{
+				{Name: AzureClientId, Value: "client"},
+				{Name: AzureFederatedTokenFile, Value: "/var/run/secrets/azure/tokens/azure-identity-token"},
+				{Name: AzureAuthorityHost, Value: AzureDefaultAuthorityHost},
+				{Name: AzureTenantId, Value: "tenant"},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "models", Namespace: "default", Annotations: scenario.annotations},
+		}
+		volume, volumeMount, envs, ok := BuildWorkloadIdentityVolumeAndEnvs(serviceAccount)
+		if ok != (scenario.expected != nil) {
+			t.Errorf("Test %q unexpected result %t", name, ok)
+			continue
+		}
+		if diff := cmp.Diff(scenario.expected, envs); diff != "" {
+			t.Errorf("Test %q unexpected result (-want +got): %v", name, diff)
+		}
+		if !ok {
+			continue
+		}
+		if volume.Name != volumeMount.Name || volumeMount.MountPath != AzureIdentityTokenMountPath {
+			t.Errorf("Test %q volume %s is not mounted at %s", name, volume.Name, AzureIdentityTokenMountPath)
+		}
+		if audience := volume.Projected.Sources[0].ServiceAccountToken.Audience; audience != AzureIdentityTokenAudience {
+			t.Errorf("Test %q token audience is %s, expected %s", name, audience, AzureIdentityTokenAudience)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kserve/kserve/pkg/credentials/file"
+	"github.com/kserve/kserve/pkg/credentials/vault"
+	"github.com/kserve/kserve/pkg/utils"
+)
+
+// CredentialSource provides the storage credentials of the service accounts from an external secret store. The
+// source injects the configuration to read the credentials into the container, which reads them when it starts so
+// that the credentials are never copied to Kubernetes secrets.
+type CredentialSource interface {
+	// Name of the credential source
+	Name() string
+	// InjectCredentials injects the volumes and env vars to read the credentials of the service account, and returns
+	// false if the service account does not use the credential source
+	InjectCredentials(serviceAccount *corev1.ServiceAccount, container *corev1.Container, volumes *[]corev1.Volume) bool
+}
+
+// getCredentialSources returns the credential sources enabled by the credential config
+func getCredentialSources(config CredentialConfig) []CredentialSource {
+	sources := []CredentialSource{&fileCredentialSource{config: config.File}}
+	if config.Vault.Address != "" {
+		sources = append(sources, &vaultCredentialSource{config: config.Vault})
+	}
+	return sources
+}
+
+type vaultCredentialSource struct {
+	config vault.VaultConfig
+}
+
+func (s *vaultCredentialSource) Name() string {
+	return "vault"
+}
+
+func (s *vaultCredentialSource) InjectCredentials(serviceAccount *corev1.ServiceAccount, container *corev1.Container,
+	volumes *[]corev1.Volume,
+) bool {
+	volume, volumeMount, envs, ok := vault.BuildVolumeAndEnvs(serviceAccount, &s.config)
+	if ok {
+		injectVolumeAndEnvs(volume, volumeMount, envs, container, volumes)
+	}
+	return ok
+}
+
+type fileCredentialSource struct {
+	config file.FileConfig
+}
+
+func (s *fileCredentialSource) Name() string {
+	return "file"
+}
+
+func (s *fileCredentialSource) InjectCredentials(serviceAccount *corev1.ServiceAccount, container *corev1.Container,
+	volumes *[]corev1.Volume,
+) bool {
+	volume, volumeMount, envs, ok := file.BuildVolumeAndEnvs(serviceAccount, &s.config)
+	if ok {
+		injectVolumeAndEnvs(volume, volumeMount, envs, container, volumes)
+	}
+	return ok
+}
+
+func injectVolumeAndEnvs(volume corev1.Volume, volumeMount corev1.VolumeMount, envs []corev1.EnvVar,
+	container *corev1.Container, volumes *[]corev1.Volume,
+) {
+	*volumes = utils.AppendVolumeIfNotExists(*volumes, volume)
+	utils.AddVolumeMountIfNotPresent(container, volumeMount.Name, volumeMount.MountPath, volumeMount.ReadOnly)
+	container.Env = utils.MergeEnvs(container.Env, envs)
+}
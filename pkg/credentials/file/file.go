@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/credentials/gcs"
+)
+
+/*
+The storage credentials are the files of a folder mounted by the Secrets Store CSI driver from an external secret
+store: https://secrets-store-csi-driver.sigs.k8s.io/. Each file named as an env var is exported as this env var by
+the containers downloading the models, and the GCS credential file is used as application credentials.
+*/
+const (
+	StorageCredentialsDir        = "STORAGE_CREDENTIALS_DIR"
+	CredentialsVolume            = "kserve-storage-credentials"
+	CredentialsMountPath         = "/var/run/secrets/kserve/storage-credentials"
+	DefaultCSIDriver             = "secrets-store.csi.k8s.io"
+	SecretProviderClassAttribute = "secretProviderClass"
+)
+
+var (
+	SecretProviderClassAnnotation = constants.KServeAPIGroupName + "/" + "secret-provider-class"
+	envNamePattern                = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+type FileConfig struct {
+	// CSI driver mounting the secrets, the secrets store CSI driver if empty
+	CSIDriver string `json:"csiDriver,omitempty"`
+}
+
+// BuildVolumeAndEnvs returns the CSI volume mounting the secrets of the SecretProviderClass of the
+// secret-provider-class annotation of the service account, or false if the service account has no annotation
+func BuildVolumeAndEnvs(serviceAccount *corev1.ServiceAccount, config *FileConfig) (corev1.Volume, corev1.VolumeMount, []corev1.EnvVar, bool) {
+	secretProviderClass, ok := serviceAccount.Annotations[SecretProviderClassAnnotation]
+	if !ok || secretProviderClass == "" {
+		return corev1.Volume{}, corev1.VolumeMount{}, nil, false
+	}
+	driver := config.CSIDriver
+	if driver == "" {
+		driver = DefaultCSIDriver
+	}
+	volume := corev1.Volume{
+		Name: CredentialsVolume,
+		VolumeSource: corev1.VolumeSource{
+			CSI: &corev1.CSIVolumeSource{
+				Driver:           driver,
+				ReadOnly:         ptr.To(true),
+				VolumeAttributes: map[string]string{SecretProviderClassAttribute: secretProviderClass},
+			},
+		},
+	}
+	volumeMount := corev1.VolumeMount{
+		Name:      CredentialsVolume,
+		MountPath: CredentialsMountPath,
+		ReadOnly:  true,
+	}
+	envs := []corev1.EnvVar{
+		{Name: StorageCredentialsDir, Value: CredentialsMountPath},
+	}
+	return volume, volumeMount, envs, true
+}
+
+// LoadCredentials returns the env vars of the credential files of the folder
+func LoadCredentials(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	envs := map[string]string{}
+	for _, entry := range entries {
+		// Skip the hidden folders of the atomic writer of Kubernetes volumes
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		if entry.Name() == gcs.GCSCredentialFileName {
+			envs[gcs.GCSCredentialEnvKey] = path
+			continue
+		}
+		if !envNamePattern.MatchString(entry.Name()) {
+			continue
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		envs[entry.Name()] = strings.TrimRight(string(content), "\r\n")
+	}
+	return envs, nil
+}
+
+// ExportCredentialEnvs sets the credentials of the folder of the STORAGE_CREDENTIALS_DIR env var as env vars of the
+// process, unless they are already set. Does nothing if the env var is not set.
+func ExportCredentialEnvs() error {
+	dir, ok := os.LookupEnv(StorageCredentialsDir)
+	if !ok || dir == "" {
+		return nil
+	}
+	envs, err := LoadCredentials(dir)
+	if err != nil {
+		return err
+	}
+	for key, value := range envs {
+		if _, ok := os.LookupEnv(key); ok {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
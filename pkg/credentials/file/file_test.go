@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kserve/kserve/pkg/credentials/gcs"
+)
+
+func TestBuildVolumeAndEnvs(t *testing.T) {
+	scenarios := map[string]struct {
+		config         FileConfig
+		annotations    map[string]string
+		expectedVolume corev1.Volume
+		expectedOk     bool
+	}{
+		"NoAnnotation": {},
+		"DefaultDriver": {
+			annotations: map[string]string{SecretProviderClassAnnotation: "models"},
+			expectedVolume: corev1.Volume{
+				Name: CredentialsVolume,
+				VolumeSource: corev1.VolumeSource{
+					CSI: &corev1.CSIVolumeSource{
+						Driver:           DefaultCSIDriver,
+						ReadOnly:         ptr.To(true),
+						VolumeAttributes: map[string]string{SecretProviderClassAttribute: "models"},
+					},
+				},
+			},
+			expectedOk: true,
+		},
+		"CustomDriver": {
+			config:      FileConfig{CSIDriver: "secrets.example.com"},
+			annotations: map[string]string{SecretProviderClassAnnotation: "models"},
+			expectedVolume: corev1.Volume{
+				Name: CredentialsVolume,
+				VolumeSource: corev1.VolumeSource{
+					CSI: &corev1.CSIVolumeSource{
+						Driver:           "secrets.example.com",
+						ReadOnly:         ptr.To(true),
+						VolumeAttributes: map[string]string{SecretProviderClassAttribute: "models"},
+					},
+				},
+			},
+			expectedOk: true,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "models", Namespace: "default", Annotations: scenario.annotations},
+		}
+		volume, volumeMount, envs, ok := BuildVolumeAndEnvs(serviceAccount, &scenario.config)
+		if ok != scenario.expectedOk {
+			t.Errorf("Test %q unexpected result %t", name, ok)
+			continue
+		}
+		if diff := cmp.Diff(scenario.expectedVolume, volume); diff != "" {
+			t.Errorf("Test %q unexpected volume (-want +got): %v", name, diff)
+		}
+		if ok && (volumeMount.MountPath != CredentialsMountPath ||
+			!cmp.Equal(envs, []corev1.EnvVar{{Name: StorageCredentialsDir, Value: CredentialsMountPath}})) {
+			t.Errorf("Test %q unexpected volume mount %v and envs %v", name, volumeMount, envs)
+		}
+	}
+}
+
+func TestLoadCredentials(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"AWS_ACCESS_KEY_ID":       "key\n",
+		"AWS_SECRET_ACCESS_KEY":   "secret",
+		gcs.GCSCredentialFileName: "{}",
+		"not-an-env-var":          "ignored",
+		".hidden":                 "ignored",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "..data"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	envs, err := LoadCredentials(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"AWS_ACCESS_KEY_ID":     "key",
+		"AWS_SECRET_ACCESS_KEY": "secret",
+		gcs.GCSCredentialEnvKey: filepath.Join(dir, gcs.GCSCredentialFileName),
+	}
+	if diff := cmp.Diff(expected, envs); diff != "" {
+		t.Errorf("unexpected credentials (-want +got): %v", diff)
+	}
+
+	if _, err := LoadCredentials(filepath.Join(dir, "missing")); err == nil {
+		t.Errorf("loading a missing folder succeeded")
+	}
+}
+
+func TestExportCredentialEnvs(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{"HF_TOKEN": "token", "AWS_DEFAULT_REGION": "eu-west-1"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv(StorageCredentialsDir, dir)
+	// Env vars already set take precedence
+	t.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	// Setenv restores the env var unset by the test
+	t.Setenv("HF_TOKEN", "")
+	os.Unsetenv("HF_TOKEN")
+
+	if err := ExportCredentialEnvs(); err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range map[string]string{"HF_TOKEN": "token", "AWS_DEFAULT_REGION": "us-east-1"} {
+		if actual := os.Getenv(key); actual != value {
+			t.Errorf("env var %s is %q, expected %q", key, actual, value)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+/*
+GKE Workload Identity, the GKE metadata server provides the credentials of the Google service account of the
+annotation to the pods of the service account: https://cloud.google.com/kubernetes-engine/docs/how-to/workload-identity
+*/
+const (
+	GKEWorkloadIdentityAnnotationKey = "iam.gke.io/gcp-service-account"
+	// GCSUseWorkloadIdentity makes the model agent use the application default credentials without credential file
+	GCSUseWorkloadIdentity = "GCS_USE_WORKLOAD_IDENTITY"
+)
+
+// BuildWorkloadIdentityEnvs returns the env vars using the Google service account of the GKE Workload Identity
+// annotation of the service account, or nil if the service account has no annotation
+func BuildWorkloadIdentityEnvs(serviceAccount *corev1.ServiceAccount) []corev1.EnvVar {
+	if gsa, ok := serviceAccount.Annotations[GKEWorkloadIdentityAnnotationKey]; !ok || gsa == "" {
+		return nil
+	}
+	return []corev1.EnvVar{
+		{
+			Name:  GCSUseWorkloadIdentity,
+			Value: "true",
+		},
+	}
+}
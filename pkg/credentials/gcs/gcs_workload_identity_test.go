@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGCSWorkloadIdentity(t *testing.T) {
+	scenarios := map[string]struct {
+		annotations map[string]string
+		expected    []corev1.EnvVar
+	}{
+		"NoAnnotation": {},
+		"GKEWorkloadIdentity": {
+			annotations: map[string]string{GKEWorkloadIdentityAnnotationKey: "models@project.iam.gserviceaccount.com"},
+			expected:    []corev1.EnvVar{{Name: GCSUseWorkloadIdentity, Value: "true"}},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "models", Namespace: "default", Annotations: scenario.annotations},
+		}
+		if diff := cmp.Diff(scenario.expected, BuildWorkloadIdentityEnvs(serviceAccount)); diff != "" {
+			t.Errorf("Test %q unexpected result (-want +got): %v", name, diff)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+/*
+Keyless access to S3 compatible storages exchanging the projected service account token for temporary credentials
+with AssumeRoleWithWebIdentity, which the AWS SDKs do when these variables are set:
+https://docs.aws.amazon.com/sdkref/latest/guide/feature-assume-role-credentials.html
+*/
+const (
+	AWSRoleArn                  = "AWS_ROLE_ARN"
+	AWSWebIdentityTokenFile     = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	AWSRoleSessionName          = "AWS_ROLE_SESSION_NAME"
+	AWSEndpointUrlSTS           = "AWS_ENDPOINT_URL_STS"
+	S3WebIdentityTokenVolume    = "kserve-s3-web-identity-token"
+	S3WebIdentityTokenMountPath = "/var/run/secrets/kserve/s3"
+	S3WebIdentityTokenPath      = "token"
+	S3WebIdentityTokenAudience  = "sts.amazonaws.com"
+	S3WebIdentityTokenExpiry    = 3600
+)
+
+var (
+	InferenceServiceS3RoleArnAnnotation       = constants.KServeAPIGroupName + "/" + "s3-role-arn"
+	InferenceServiceS3TokenAudienceAnnotation = constants.KServeAPIGroupName + "/" + "s3-token-audience"
+	InferenceServiceS3STSEndpointAnnotation   = constants.KServeAPIGroupName + "/" + "s3-sts-endpoint"
+)
+
+// BuildWebIdentityVolumeAndEnvs returns the projected token volume and the env vars assuming the role of the
+// s3-role-arn annotation of the service account, or false if the service account has no role annotation
+func BuildWebIdentityVolumeAndEnvs(serviceAccount *corev1.ServiceAccount) (corev1.Volume, corev1.VolumeMount, []corev1.EnvVar, bool) {
+	roleArn, ok := serviceAccount.Annotations[InferenceServiceS3RoleArnAnnotation]
+	if !ok || roleArn == "" {
+		return corev1.Volume{}, corev1.VolumeMount{}, nil, false
+	}
+	audience := S3WebIdentityTokenAudience
+	if value, ok := serviceAccount.Annotations[InferenceServiceS3TokenAudienceAnnotation]; ok && value != "" {
+		audience = value
+	}
+
+	volume := corev1.Volume{
+		Name: S3WebIdentityTokenVolume,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          audience,
+							ExpirationSeconds: ptr.To(int64(S3WebIdentityTokenExpiry)),
+							Path:              S3WebIdentityTokenPath,
+						},
+					},
+				},
+			},
+		},
+	}
+	volumeMount := corev1.VolumeMount{
+		Name:      S3WebIdentityTokenVolume,
+		MountPath: S3WebIdentityTokenMountPath,
+		ReadOnly:  true,
+	}
+	envs := []corev1.EnvVar{
+		{
+			Name:  AWSRoleArn,
+			Value: roleArn,
+		},
+		{
+			Name:  AWSWebIdentityTokenFile,
+			Value: S3WebIdentityTokenMountPath + "/" + S3WebIdentityTokenPath,
+		},
+		{
+			Name:  AWSRoleSessionName,
+			Value: serviceAccount.Namespace + "-" + serviceAccount.Name,
+		},
+	}
+	if stsEndpoint, ok := serviceAccount.Annotations[InferenceServiceS3STSEndpointAnnotation]; ok && stsEndpoint != "" {
+		envs = append(envs, corev1.EnvVar{
+			Name:  AWSEndpointUrlSTS,
+			Value: stsEndpoint,
+		})
+	}
+	return volume, volumeMount, envs, true
+}
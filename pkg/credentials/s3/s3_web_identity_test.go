@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestS3WebIdentity(t *testing.T) {
+	scenarios := map[string]struct {
+		annotations map[string]string
+		audience    string
+		expected    []corev1.EnvVar
+	}{
+		"NoRole": {
+			annotations: map[string]string{InferenceServiceS3SecretEndpointAnnotation: "s3.aws.com"},
+		},
+		"Role": {
+			annotations: map[string]string{InferenceServiceS3RoleArnAnnotation: "arn:aws:iam::123456789012:role/models"},
+			audience:    S3WebIdentityTokenAudience,
+			expected: []corev1.EnvVar{
+				{Name: AWSRoleArn, Value: "arn:aws:iam::123456789012:role/models"},
+				{Name: AWSWebIdentityTokenFile, Value: "/var/run/secrets/kserve/s3/token"},
+				{Name: AWSRoleSessionName, Value: "default-models"},
+			},
+		},
+		"RoleWithAudienceAndSTSEndpoint": {
+			annotations: map[string]string{
+				InferenceServiceS3RoleArnAnnotation:       "arn:minio:iam:::role/models",
+				InferenceServiceS3TokenAudienceAnnotation: "minio",
+				InferenceServiceS3STSEndpointAnnotation:   "https://minio.example.com",
+			},
+			audience: "minio",
+			expected: []corev1.EnvVar{
+				{Name: AWSRoleArn, Value: "arn:minio:iam:::role/models"},
+				{Name: AWSWebIdentityTokenFile, Value: "/var/run/secrets/kserve/s3/token"},
+				{Name: AWSRoleSessionName, Value: "default-models"},
+				{Name: AWSEndpointUrlSTS, Value: "https://minio.example.com"},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "models", Namespace: "default", Annotations: scenario.annotations},
+		}
+		volume, volumeMount, envs, ok := BuildWebIdentityVolumeAndEnvs(serviceAccount)
+		if ok != (scenario.expected != nil) {
+			t.Errorf("Test %q unexpected result %t", name, ok)
+			continue
+		}
+		if diff := cmp.Diff(scenario.expected, envs); diff != "" {
+			t.Errorf("Test %q unexpected result (-want +got): %v", name, diff)
+		}
+		if !ok {
+			continue
+		}
+		if volume.Name != volumeMount.Name || volumeMount.MountPath != S3WebIdentityTokenMountPath {
+			t.Errorf("Test %q volume %s is not mounted at %s", name, volume.Name, S3WebIdentityTokenMountPath)
+		}
+		if audience := volume.Projected.Sources[0].ServiceAccountToken.Audience; audience != scenario.audience {
+			t.Errorf("Test %q token audience is %s, expected %s", name, audience, scenario.audience)
+		}
+	}
+}
@@ -32,12 +32,14 @@ import (
 
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/credentials/azure"
+	"github.com/kserve/kserve/pkg/credentials/file"
 	"github.com/kserve/kserve/pkg/credentials/gcs"
 	"github.com/kserve/kserve/pkg/credentials/hdfs"
 	"github.com/kserve/kserve/pkg/credentials/hf"
 	"github.com/kserve/kserve/pkg/credentials/https"
 	"github.com/kserve/kserve/pkg/credentials/ms"
 	"github.com/kserve/kserve/pkg/credentials/s3"
+	"github.com/kserve/kserve/pkg/credentials/vault"
 	"github.com/kserve/kserve/pkg/utils"
 )
 
@@ -49,25 +51,29 @@ const (
 	DefaultStorageSecretKey     = "default"
 	UnsupportedStorageSpecType  = "storage type must be one of [%s]. storage type [%s] is not supported"
 	MissingBucket               = "format [%s] requires a bucket but one wasn't found in storage data or parameters"
+	MissingAzureContainer       = "format [%s] requires an account_name and a container but they weren't found in storage data or parameters"
 	AwsIrsaAnnotationKey        = "eks.amazonaws.com/role-arn"
 )
 
 var (
-	SupportedStorageSpecTypes = []string{"s3", "hdfs", "webhdfs"}
-	StorageBucketTypes        = []string{"s3"}
+	SupportedStorageSpecTypes = []string{"s3", "hdfs", "webhdfs", "gs", "azure", "hf", "https"}
+	StorageBucketTypes        = []string{"s3", "gs"}
 )
 
 type CredentialConfig struct {
-	S3                          s3.S3Config   `json:"s3,omitempty"`
-	GCS                         gcs.GCSConfig `json:"gcs,omitempty"`
-	StorageSpecSecretName       string        `json:"storageSpecSecretName,omitempty"`
-	StorageSecretNameAnnotation string        `json:"storageSecretNameAnnotation,omitempty"`
+	S3                          s3.S3Config       `json:"s3,omitempty"`
+	GCS                         gcs.GCSConfig     `json:"gcs,omitempty"`
+	Vault                       vault.VaultConfig `json:"vault,omitempty"`
+	File                        file.FileConfig   `json:"file,omitempty"`
+	StorageSpecSecretName       string            `json:"storageSpecSecretName,omitempty"`
+	StorageSecretNameAnnotation string            `json:"storageSecretNameAnnotation,omitempty"`
 }
 
 type CredentialBuilder struct {
 	client    client.Client
 	clientset kubernetes.Interface
 	config    CredentialConfig
+	sources   []CredentialSource
 }
 
 var log = logf.Log.WithName("CredentialBuilder")
@@ -96,6 +102,7 @@ func NewCredentialBuilderFromConfig(client client.Client, clientset kubernetes.I
 		client:    client,
 		clientset: clientset,
 		config:    config,
+		sources:   getCredentialSources(config),
 	}
 }
 
@@ -103,7 +110,8 @@ func (c *CredentialBuilder) CreateStorageSpecSecretEnvs(ctx context.Context, nam
 	overrideParams map[string]string, container *corev1.Container,
 ) error {
 	stype := overrideParams["type"]
-	bucket := overrideParams["bucket"]
+	// Storage data parameters used to build the storage uri, the override params take precedence
+	storageParams := map[string]string{}
 
 	storageSecretName := constants.DefaultStorageSpecSecret
 	if c.config.StorageSpecSecretName != "" {
@@ -150,9 +158,10 @@ func (c *CredentialBuilder) CreateStorageSpecSecretEnvs(ctx context.Context, nam
 					return fmt.Errorf(UnsupportedStorageSpecType, strings.Join(SupportedStorageSpecTypes, ", "), stype)
 				}
 			}
-			// Get bucket from storage-config if not provided in override params
-			if _, ok := storageDataJson["bucket"]; ok && bucket == "" {
-				bucket = storageDataJson["bucket"]
+			for _, key := range []string{"bucket", "account_name", "container", "host"} {
+				if value, ok := storageDataJson[key]; ok {
+					storageParams[key] = value
+				}
 			}
 			if cabundle_configmap, ok := storageDataJson["cabundle_configmap"]; ok {
 				container.Env = append(container.Env, corev1.EnvVar{
@@ -181,18 +190,18 @@ func (c *CredentialBuilder) CreateStorageSpecSecretEnvs(ctx context.Context, nam
 	}
 
 	if strings.HasPrefix(container.Args[0], UriSchemePlaceholder+"://") {
+		for key, value := range overrideParams {
+			if value != "" {
+				storageParams[key] = value
+			}
+		}
 		for i := 0; i < len(container.Args); i += 2 {
 			path := container.Args[i][len(UriSchemePlaceholder+"://"):]
-
-			if utils.Includes(StorageBucketTypes, stype) {
-				if bucket == "" {
-					return fmt.Errorf(MissingBucket, stype)
-				}
-
-				container.Args[i] = fmt.Sprintf("%s://%s/%s", stype, bucket, path)
-			} else {
-				container.Args[i] = fmt.Sprintf("%s://%s", stype, path)
+			uri, err := buildStorageSpecUri(stype, storageParams, path)
+			if err != nil {
+				return err
 			}
+			container.Args[i] = uri
 		}
 	}
 
@@ -209,6 +218,28 @@ func (c *CredentialBuilder) CreateStorageSpecSecretEnvs(ctx context.Context, nam
 	return nil
 }
 
+// buildStorageSpecUri returns the uri of the path of a storage spec for the storage type
+func buildStorageSpecUri(stype string, storageParams map[string]string, path string) (string, error) {
+	switch {
+	case utils.Includes(StorageBucketTypes, stype):
+		bucket := storageParams["bucket"]
+		if bucket == "" {
+			return "", fmt.Errorf(MissingBucket, stype)
+		}
+		return fmt.Sprintf("%s://%s/%s", stype, bucket, path), nil
+	case stype == "azure":
+		accountName, container := storageParams["account_name"], storageParams["container"]
+		if accountName == "" || container == "" {
+			return "", fmt.Errorf(MissingAzureContainer, stype)
+		}
+		return fmt.Sprintf("https://%s.blob.core.windows.net/%s/%s", accountName, container, path), nil
+	case stype == "https" && storageParams["host"] != "":
+		return fmt.Sprintf("https://%s/%s", storageParams["host"], path), nil
+	default:
+		return fmt.Sprintf("%s://%s", stype, path), nil
+	}
+}
+
 func (c *CredentialBuilder) CreateSecretVolumeAndEnv(ctx context.Context, namespace string, annotations map[string]string, serviceAccountName string,
 	container *corev1.Container, volumes *[]corev1.Volume,
 ) error {
@@ -245,6 +276,13 @@ func (c *CredentialBuilder) CreateSecretVolumeAndEnvFromServiceAccount(ctx conte
 			container.Env = append(container.Env, envs...)
 		}
 	}
+	c.injectWorkloadIdentity(serviceAccount, container, volumes)
+	for _, source := range c.sources {
+		if source.InjectCredentials(serviceAccount, container, volumes) {
+			log.Info("Credential source annotations found, setting credential source envs", "source", source.Name(),
+				"ServiceAccountName", serviceAccount.Name)
+		}
+	}
 
 	// secret name annotation takes precedence
 	if annotations != nil && c.config.StorageSecretNameAnnotation != "" {
@@ -269,6 +307,30 @@ func (c *CredentialBuilder) CreateSecretVolumeAndEnvFromServiceAccount(ctx conte
 	return nil
 }
 
+// injectWorkloadIdentity injects the projected service account token volumes and the env vars of the keyless
+// authentications configured by the service account annotations. The credentials of the secrets take precedence.
+func (c *CredentialBuilder) injectWorkloadIdentity(serviceAccount *corev1.ServiceAccount, container *corev1.Container,
+	volumes *[]corev1.Volume,
+) {
+	if volume, volumeMount, envs, ok := s3.BuildWebIdentityVolumeAndEnvs(serviceAccount); ok {
+		log.Info("S3 role annotation found, setting web identity token for s3", "ServiceAccountName", serviceAccount.Name)
+		*volumes = utils.AppendVolumeIfNotExists(*volumes, volume)
+		utils.AddVolumeMountIfNotPresent(container, volumeMount.Name, volumeMount.MountPath, volumeMount.ReadOnly)
+		envs = append(envs, s3.BuildServiceAccountEnvs(serviceAccount, &c.config.S3)...)
+		container.Env = utils.MergeEnvs(container.Env, envs)
+	}
+	if volume, volumeMount, envs, ok := azure.BuildWorkloadIdentityVolumeAndEnvs(serviceAccount); ok {
+		log.Info("Azure Workload Identity annotation found, setting federated token for azure", "ServiceAccountName", serviceAccount.Name)
+		*volumes = utils.AppendVolumeIfNotExists(*volumes, volume)
+		utils.AddVolumeMountIfNotPresent(container, volumeMount.Name, volumeMount.MountPath, volumeMount.ReadOnly)
+		container.Env = utils.MergeEnvs(container.Env, envs)
+	}
+	if envs := gcs.BuildWorkloadIdentityEnvs(serviceAccount); envs != nil {
+		log.Info("GKE Workload Identity annotation found, setting service account envs for gcs", "ServiceAccountName", serviceAccount.Name)
+		container.Env = utils.MergeEnvs(container.Env, envs)
+	}
+}
+
 func (c *CredentialBuilder) mountSecretCredential(ctx context.Context, secretName string, namespace string,
 	container *corev1.Container, volumes *[]corev1.Volume,
 ) error {
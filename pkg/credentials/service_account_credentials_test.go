@@ -17,19 +17,23 @@ limitations under the License.
 package credentials
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/onsi/gomega/types"
 
 	"github.com/kserve/kserve/pkg/credentials/azure"
+	"github.com/kserve/kserve/pkg/credentials/file"
 	"github.com/kserve/kserve/pkg/credentials/gcs"
 	"github.com/kserve/kserve/pkg/credentials/hdfs"
 	"github.com/kserve/kserve/pkg/credentials/s3"
+	"github.com/kserve/kserve/pkg/credentials/vault"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
@@ -2338,6 +2342,83 @@ func TestCredentialBuilder_CreateStorageSpecSecretEnvs(t *testing.T) {
 			shouldFail: true,
 			matcher:    gomega.HaveOccurred(),
 		},
+		"gcs storage spec": {
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "storage-secret",
+					Namespace: namespace,
+				},
+				StringData: map[string]string{"gcs": "{\"type\": \"gs\", \"bucket\": \"test-bucket\", \"service_account_json\": \"{}\"}"},
+			},
+			storageKey:        "gcs",
+			storageSecretName: "storage-secret",
+			overrideParams:    map[string]string{"type": "", "bucket": ""},
+			container: &corev1.Container{
+				Args: []string{
+					"<scheme-placeholder>://models/example-model/",
+					"/mnt/models/",
+				},
+			},
+			matcher: gomega.HaveField("Args", []string{"gs://test-bucket/models/example-model/", "/mnt/models/"}),
+		},
+		"azure storage spec": {
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "storage-secret",
+					Namespace: namespace,
+				},
+				StringData: map[string]string{"azure": "{\"type\": \"azure\", \"account_name\": \"models\", \"container\": \"test\", \"client_id\": \"client\"}"},
+			},
+			storageKey:        "azure",
+			storageSecretName: "storage-secret",
+			overrideParams:    map[string]string{"type": "", "container": "override"},
+			container: &corev1.Container{
+				Args: []string{
+					"<scheme-placeholder>://models/example-model/",
+					"/mnt/models/",
+				},
+			},
+			matcher: gomega.HaveField("Args", []string{"https://models.blob.core.windows.net/override/models/example-model/", "/mnt/models/"}),
+		},
+		"hugging face storage spec": {
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "storage-secret",
+					Namespace: namespace,
+				},
+				StringData: map[string]string{"hf": "{\"type\": \"hf\", \"token\": \"hf_token\"}"},
+			},
+			storageKey:        "hf",
+			storageSecretName: "storage-secret",
+			overrideParams:    map[string]string{"type": ""},
+			container: &corev1.Container{
+				Args: []string{
+					"<scheme-placeholder>://meta-llama/Llama-3.1-8B",
+					"/mnt/models/",
+				},
+			},
+			matcher: gomega.HaveField("Args", []string{"hf://meta-llama/Llama-3.1-8B", "/mnt/models/"}),
+		},
+		"fail on container is empty on azure storage": {
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "storage-secret",
+					Namespace: namespace,
+				},
+				StringData: map[string]string{"azure": "{\"type\": \"azure\", \"account_name\": \"models\"}"},
+			},
+			storageKey:        "azure",
+			storageSecretName: "storage-secret",
+			overrideParams:    map[string]string{"type": ""},
+			container: &corev1.Container{
+				Args: []string{
+					"<scheme-placeholder>://models/example-model/",
+					"/mnt/models/",
+				},
+			},
+			shouldFail: true,
+			matcher:    gomega.MatchError(fmt.Sprintf(MissingAzureContainer, "azure")),
+		},
 	}
 
 	for _, tc := range scenarios {
@@ -2356,3 +2437,155 @@ func TestCredentialBuilder_CreateStorageSpecSecretEnvs(t *testing.T) {
 		}
 	}
 }
+
+func TestWorkloadIdentityCredentialBuilder(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "models",
+			Namespace: "default",
+			Annotations: map[string]string{
+				s3.InferenceServiceS3RoleArnAnnotation:           "arn:aws:iam::123456789012:role/models",
+				s3.InferenceServiceS3SecretRegionAnnotation:      "us-west-2",
+				azure.AzureWorkloadIdentityClientIdAnnotationKey: "client",
+				gcs.GKEWorkloadIdentityAnnotationKey:             "models@project.iam.gserviceaccount.com",
+			},
+		},
+	}
+	builder := NewCredentialBuilder(nil, fakeclientset.NewSimpleClientset(serviceAccount), configMap)
+
+	container := &corev1.Container{}
+	volumes := []corev1.Volume{}
+	// The credentials are injected once when the builder is called for several service accounts of the pod
+	for range 2 {
+		g.Expect(builder.CreateSecretVolumeAndEnv(t.Context(), "default", nil, "models", container, &volumes)).To(gomega.Succeed())
+	}
+
+	g.Expect(volumes).To(gomega.HaveLen(2))
+	g.Expect(volumes[0].Name).To(gomega.Equal(s3.S3WebIdentityTokenVolume))
+	g.Expect(volumes[1].Name).To(gomega.Equal(azure.AzureIdentityTokenVolume))
+	g.Expect(container.VolumeMounts).To(gomega.Equal([]corev1.VolumeMount{
+		{Name: s3.S3WebIdentityTokenVolume, MountPath: s3.S3WebIdentityTokenMountPath, ReadOnly: true},
+		{Name: azure.AzureIdentityTokenVolume, MountPath: azure.AzureIdentityTokenMountPath, ReadOnly: true},
+	}))
+	g.Expect(container.Env).To(gomega.ContainElements(
+		corev1.EnvVar{Name: s3.AWSRoleArn, Value: "arn:aws:iam::123456789012:role/models"},
+		corev1.EnvVar{Name: s3.AWSWebIdentityTokenFile, Value: "/var/run/secrets/kserve/s3/token"},
+		corev1.EnvVar{Name: s3.AWSRegion, Value: "us-west-2"},
+		corev1.EnvVar{Name: azure.AzureClientId, Value: "client"},
+		corev1.EnvVar{Name: azure.AzureFederatedTokenFile, Value: "/var/run/secrets/azure/tokens/azure-identity-token"},
+		corev1.EnvVar{Name: gcs.GCSUseWorkloadIdentity, Value: "true"},
+	))
+	envNames := map[string]bool{}
+	for _, env := range container.Env {
+		g.Expect(envNames).NotTo(gomega.HaveKey(env.Name))
+		envNames[env.Name] = true
+	}
+}
+
+func TestCredentialSourceBuilder(t *testing.T) {
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "models",
+			Namespace: "default",
+			Annotations: map[string]string{
+				vault.VaultRoleAnnotation:          "models",
+				vault.VaultSecretPathAnnotation:    "models/s3",
+				file.SecretProviderClassAnnotation: "models",
+			},
+		},
+	}
+
+	scenarios := map[string]struct {
+		config          CredentialConfig
+		expectedVolumes []string
+	}{
+		"VaultDisabled": {
+			expectedVolumes: []string{file.CredentialsVolume},
+		},
+		"VaultEnabled": {
+			config:          CredentialConfig{Vault: vault.VaultConfig{Address: "https://vault:8200"}},
+			expectedVolumes: []string{file.CredentialsVolume, vault.VaultTokenVolume},
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			builder := NewCredentialBuilderFromConfig(nil, fakeclientset.NewSimpleClientset(serviceAccount), scenario.config)
+			container := &corev1.Container{}
+			volumes := []corev1.Volume{}
+			g.Expect(builder.CreateSecretVolumeAndEnv(t.Context(), "default", nil, "models", container, &volumes)).To(gomega.Succeed())
+
+			volumeNames := []string{}
+			for _, volume := range volumes {
+				volumeNames = append(volumeNames, volume.Name)
+			}
+			g.Expect(volumeNames).To(gomega.Equal(scenario.expectedVolumes))
+			g.Expect(container.VolumeMounts).To(gomega.HaveLen(len(scenario.expectedVolumes)))
+			g.Expect(container.Env).To(gomega.ContainElement(
+				corev1.EnvVar{Name: file.StorageCredentialsDir, Value: file.CredentialsMountPath}))
+			// The secrets are read by the container and never copied to the pod spec
+			for _, env := range container.Env {
+				g.Expect(env.ValueFrom).To(gomega.BeNil())
+			}
+		})
+	}
+}
+
+func TestBuildStorageSpecUri(t *testing.T) {
+	scenarios := map[string]struct {
+		stype         string
+		storageParams map[string]string
+		expected      string
+		expectedErr   string
+	}{
+		"S3": {
+			stype:         "s3",
+			storageParams: map[string]string{"bucket": "models"},
+			expected:      "s3://models/llama",
+		},
+		"GCSWithoutBucket": {
+			stype:       "gs",
+			expectedErr: fmt.Sprintf(MissingBucket, "gs"),
+		},
+		"Azure": {
+			stype:         "azure",
+			storageParams: map[string]string{"account_name": "account", "container": "models"},
+			expected:      "https://account.blob.core.windows.net/models/llama",
+		},
+		"HTTPSWithHost": {
+			stype:         "https",
+			storageParams: map[string]string{"host": "models.example.com"},
+			expected:      "https://models.example.com/llama",
+		},
+		"HTTPSWithoutHost": {
+			stype:    "https",
+			expected: "https://llama",
+		},
+		"HuggingFace": {
+			stype:    "hf",
+			expected: "hf://llama",
+		},
+		"WebHDFS": {
+			stype:    "webhdfs",
+			expected: "webhdfs://llama",
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			uri, err := buildStorageSpecUri(scenario.stype, scenario.storageParams, "llama")
+			if scenario.expectedErr != "" {
+				if err == nil || err.Error() != scenario.expectedErr {
+					t.Errorf("expected error %q, got %v", scenario.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if uri != scenario.expected {
+				t.Errorf("expected uri %q, got %q", scenario.expected, uri)
+			}
+		})
+	}
+}
@@ -0,0 +1,442 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/credentials/azure"
+	"github.com/kserve/kserve/pkg/credentials/hf"
+	"github.com/kserve/kserve/pkg/credentials/s3"
+)
+
+var log = logf.Log.WithName("vault")
+
+/*
+The storage credentials are the keys of a HashiCorp Vault KV v2 secret, exported as env vars by the containers
+downloading the models. The containers log in to Vault with the Kubernetes auth method and the projected token of
+their service account: https://developer.hashicorp.com/vault/docs/auth/kubernetes
+*/
+const (
+	VaultAddr            = "VAULT_ADDR"
+	VaultAuthMount       = "VAULT_AUTH_MOUNT"
+	VaultAuthRole        = "VAULT_AUTH_ROLE"
+	VaultKVMount         = "VAULT_KV_MOUNT"
+	VaultSecretPath      = "VAULT_SECRET_PATH"
+	VaultJWTFile         = "VAULT_JWT_FILE"
+	VaultAllowedKeys     = "VAULT_ALLOWED_KEYS"
+	VaultTokenVolume     = "kserve-vault-token"
+	VaultTokenMountPath  = "/var/run/secrets/kserve/vault"
+	VaultTokenPath       = "token"
+	VaultTokenExpiry     = 600
+	DefaultAuthMount     = "kubernetes"
+	DefaultKVMount       = "secret"
+	DefaultTokenAudience = "vault"
+)
+
+var (
+	VaultRoleAnnotation       = constants.KServeAPIGroupName + "/" + "vault-role"
+	VaultSecretPathAnnotation = constants.KServeAPIGroupName + "/" + "vault-secret-path"
+	envNamePattern            = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// DefaultAllowedKeys are the keys of the secret exported as env vars, the other keys are ignored so that the
+	// secret cannot change the behaviour of the containers through arbitrary env vars like PATH or LD_PRELOAD
+	DefaultAllowedKeys = []string{
+		s3.AWSAccessKeyId,
+		s3.AWSSecretAccessKey,
+		"AWS_SESSION_TOKEN",
+		s3.AWSRegion,
+		s3.AWSEndpointUrl,
+		azure.AzureStorageAccessKey,
+		azure.AzureTenantId,
+		azure.AzureClientId,
+		azure.AzureClientSecret,
+		hf.HFTokenKey,
+	}
+)
+
+const (
+	// refreshFraction is the fraction of the lease of the Vault token after which the credentials are refreshed
+	refreshFraction = 2.0 / 3.0
+	// defaultRefreshInterval is the refresh interval of the credentials of tokens without lease
+	defaultRefreshInterval = 5 * time.Minute
+	// minRefreshInterval bounds the refreshes of the credentials of short leases and retries of failed refreshes
+	minRefreshInterval = 10 * time.Second
+)
+
+type VaultConfig struct {
+	// Address of the Vault server, the Vault credential source is disabled if empty
+	Address       string `json:"address,omitempty"`
+	AuthMount     string `json:"authMount,omitempty"`
+	KVMount       string `json:"kvMount,omitempty"`
+	TokenAudience string `json:"tokenAudience,omitempty"`
+	// Keys of the secrets exported as env vars in addition to DefaultAllowedKeys
+	AllowedKeys []string `json:"allowedKeys,omitempty"`
+}
+
+// BuildVolumeAndEnvs returns the projected token volume and the env vars to read the Vault secret of the
+// vault-secret-path annotation of the service account with the role of its vault-role annotation, or false if the
+// service account has no Vault annotations
+func BuildVolumeAndEnvs(serviceAccount *corev1.ServiceAccount, config *VaultConfig) (corev1.Volume, corev1.VolumeMount, []corev1.EnvVar, bool) {
+	role := serviceAccount.Annotations[VaultRoleAnnotation]
+	secretPath := serviceAccount.Annotations[VaultSecretPathAnnotation]
+	if config.Address == "" || role == "" || secretPath == "" {
+		return corev1.Volume{}, corev1.VolumeMount{}, nil, false
+	}
+	audience := config.TokenAudience
+	if audience == "" {
+		audience = DefaultTokenAudience
+	}
+	authMount := config.AuthMount
+	if authMount == "" {
+		authMount = DefaultAuthMount
+	}
+	kvMount := config.KVMount
+	if kvMount == "" {
+		kvMount = DefaultKVMount
+	}
+
+	volume := corev1.Volume{
+		Name: VaultTokenVolume,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          audience,
+							ExpirationSeconds: ptr.To(int64(VaultTokenExpiry)),
+							Path:              VaultTokenPath,
+						},
+					},
+				},
+			},
+		},
+	}
+	volumeMount := corev1.VolumeMount{
+		Name:      VaultTokenVolume,
+		MountPath: VaultTokenMountPath,
+		ReadOnly:  true,
+	}
+	envs := []corev1.EnvVar{
+		{Name: VaultAddr, Value: config.Address},
+		{Name: VaultAuthMount, Value: authMount},
+		{Name: VaultAuthRole, Value: role},
+		{Name: VaultKVMount, Value: kvMount},
+		{Name: VaultSecretPath, Value: secretPath},
+		{Name: VaultJWTFile, Value: VaultTokenMountPath + "/" + VaultTokenPath},
+	}
+	if len(config.AllowedKeys) > 0 {
+		envs = append(envs, corev1.EnvVar{Name: VaultAllowedKeys, Value: strings.Join(config.AllowedKeys, ",")})
+	}
+	return volume, volumeMount, envs, true
+}
+
+// Client reads the KV v2 secrets of a Vault server
+type Client struct {
+	Address    string
+	HTTPClient *http.Client
+}
+
+// Login returns a Vault token of the role for the service account token with the Kubernetes auth method
+// Auth is a Vault token and its lease
+type Auth struct {
+	ClientToken string
+	// LeaseDuration is the validity of the token from its creation or its last renewal, zero if it does not expire
+	LeaseDuration time.Duration
+	Renewable     bool
+}
+
+type authResponse struct {
+	Auth *struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int64  `json:"lease_duration"`
+		Renewable     bool   `json:"renewable"`
+	} `json:"auth"`
+}
+
+func (r *authResponse) toAuth() (*Auth, bool) {
+	if r.Auth == nil || r.Auth.ClientToken == "" {
+		return nil, false
+	}
+	return &Auth{
+		ClientToken:   r.Auth.ClientToken,
+		LeaseDuration: time.Duration(r.Auth.LeaseDuration) * time.Second,
+		Renewable:     r.Auth.Renewable,
+	}, true
+}
+
+func (c *Client) Login(ctx context.Context, authMount string, role string, jwt string) (*Auth, error) {
+	body, err := json.Marshal(map[string]string{"role": role, "jwt": jwt})
+	if err != nil {
+		return nil, err
+	}
+	response := authResponse{}
+	if err := c.do(ctx, http.MethodPost, "/v1/auth/"+authMount+"/login", "", body, &response); err != nil {
+		return nil, fmt.Errorf("failed to log in to vault with role %s: %w", role, err)
+	}
+	auth, ok := response.toAuth()
+	if !ok {
+		return nil, fmt.Errorf("vault login with role %s returned no token", role)
+	}
+	return auth, nil
+}
+
+// RenewSelf extends the lease of the token, which may be shorter than requested when the token reaches its max TTL
+func (c *Client) RenewSelf(ctx context.Context, token string) (*Auth, error) {
+	response := authResponse{}
+	if err := c.do(ctx, http.MethodPost, "/v1/auth/token/renew-self", token, []byte("{}"), &response); err != nil {
+		return nil, fmt.Errorf("failed to renew vault token: %w", err)
+	}
+	auth, ok := response.toAuth()
+	if !ok {
+		return nil, errors.New("vault token renewal returned no token")
+	}
+	return auth, nil
+}
+
+// ReadSecret returns the data of the latest version of the KV v2 secret
+func (c *Client) ReadSecret(ctx context.Context, token string, kvMount string, secretPath string) (map[string]string, error) {
+	response := struct {
+		Data *struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}{}
+	if err := c.do(ctx, http.MethodGet, "/v1/"+kvMount+"/data/"+strings.TrimPrefix(secretPath, "/"), token, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to read vault secret %s/%s: %w", kvMount, secretPath, err)
+	}
+	if response.Data == nil {
+		return nil, fmt.Errorf("vault secret %s/%s has no data", kvMount, secretPath)
+	}
+	data := make(map[string]string, len(response.Data.Data))
+	for key, value := range response.Data.Data {
+		if s, ok := value.(string); ok {
+			data[key] = s
+		} else {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			data[key] = string(encoded)
+		}
+	}
+	return data, nil
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, token string, body []byte, response interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.Address, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		request.Header.Set("X-Vault-Token", token)
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vault returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, response)
+}
+
+// ExportSecretEnvs sets the keys of the Vault secret configured by the env vars as env vars of the process, unless
+// they are already set. Does nothing if the Vault credential source is not configured.
+// SecretExporter exports the allowed keys of the Vault secret configured by the env vars of the container as env
+// vars of the process, and keeps them up to date while the Vault token is renewed.
+type SecretExporter struct {
+	client      *Client
+	authMount   string
+	role        string
+	kvMount     string
+	secretPath  string
+	jwtFile     string
+	allowedKeys map[string]bool
+	auth        *Auth
+	// exported are the env vars set by the exporter, the env vars set otherwise take precedence
+	exported map[string]string
+	// OnChange is called after the exported env vars changed
+	OnChange func()
+}
+
+// NewSecretExporter returns the exporter of the Vault secret of the env vars, or nil if no secret is configured
+func NewSecretExporter() *SecretExporter {
+	address, ok := os.LookupEnv(VaultAddr)
+	if !ok || os.Getenv(VaultSecretPath) == "" {
+		return nil
+	}
+	allowedKeys := map[string]bool{}
+	for _, key := range DefaultAllowedKeys {
+		allowedKeys[key] = true
+	}
+	for _, key := range strings.Split(os.Getenv(VaultAllowedKeys), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			allowedKeys[key] = true
+		}
+	}
+	return &SecretExporter{
+		client:      &Client{Address: address},
+		authMount:   os.Getenv(VaultAuthMount),
+		role:        os.Getenv(VaultAuthRole),
+		kvMount:     os.Getenv(VaultKVMount),
+		secretPath:  os.Getenv(VaultSecretPath),
+		jwtFile:     os.Getenv(VaultJWTFile),
+		allowedKeys: allowedKeys,
+		exported:    map[string]string{},
+	}
+}
+
+// Export logs in to Vault and exports the allowed keys of the secret, unless their env vars are already set
+func (e *SecretExporter) Export(ctx context.Context) error {
+	if err := e.login(ctx); err != nil {
+		return err
+	}
+	_, err := e.export(ctx)
+	return err
+}
+
+// Run refreshes the exported env vars before the lease of the Vault token expires until the context is done. The
+// token is renewed while it is renewable, and the exporter logs in again with the rotated service account token
+// otherwise.
+func (e *SecretExporter) Run(ctx context.Context) {
+	for {
+		interval := e.refreshInterval()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+		if err := e.refresh(ctx); err != nil {
+			log.Error(err, "Failed to refresh the storage credentials from vault", "secretPath", e.secretPath)
+		}
+	}
+}
+
+func (e *SecretExporter) refresh(ctx context.Context) error {
+	renewed := false
+	if e.auth != nil && e.auth.Renewable {
+		auth, err := e.client.RenewSelf(ctx, e.auth.ClientToken)
+		if err != nil {
+			log.Error(err, "Failed to renew vault token, logging in again")
+		} else if auth.LeaseDuration >= 2*minRefreshInterval {
+			// The token is replaced once its max TTL leaves too short leases
+			e.auth = auth
+			renewed = true
+		}
+	}
+	if !renewed {
+		if err := e.login(ctx); err != nil {
+			e.auth = nil
+			return err
+		}
+	}
+	changed, err := e.export(ctx)
+	if err != nil {
+		return err
+	}
+	if changed && e.OnChange != nil {
+		e.OnChange()
+	}
+	return nil
+}
+
+func (e *SecretExporter) refreshInterval() time.Duration {
+	if e.auth == nil {
+		return minRefreshInterval
+	}
+	if e.auth.LeaseDuration <= 0 {
+		return defaultRefreshInterval
+	}
+	return max(time.Duration(float64(e.auth.LeaseDuration)*refreshFraction), minRefreshInterval)
+}
+
+func (e *SecretExporter) login(ctx context.Context) error {
+	// The projected service account token is rotated by the kubelet, it is read again for each login
+	jwt, err := os.ReadFile(e.jwtFile)
+	if err != nil {
+		return fmt.Errorf("failed to read service account token for vault: %w", err)
+	}
+	auth, err := e.client.Login(ctx, e.authMount, e.role, strings.TrimSpace(string(jwt)))
+	if err != nil {
+		return err
+	}
+	e.auth = auth
+	return nil
+}
+
+// export sets the allowed keys of the secret as env vars and returns whether the env vars changed
+func (e *SecretExporter) export(ctx context.Context) (bool, error) {
+	data, err := e.client.ReadSecret(ctx, e.auth.ClientToken, e.kvMount, e.secretPath)
+	if err != nil {
+		return false, err
+	}
+	changed := false
+	for key, value := range data {
+		if !e.allowedKeys[key] || !envNamePattern.MatchString(key) {
+			log.V(1).Info("Ignoring key of vault secret not allowed as env var", "key", key)
+			continue
+		}
+		exported, ok := e.exported[key]
+		if !ok {
+			if _, set := os.LookupEnv(key); set {
+				continue
+			}
+		}
+		if ok && exported == value {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return false, err
+		}
+		e.exported[key] = value
+		changed = true
+	}
+	for key := range e.exported {
+		if _, ok := data[key]; !ok {
+			if err := os.Unsetenv(key); err != nil {
+				return false, err
+			}
+			delete(e.exported, key)
+			changed = true
+		}
+	}
+	return changed, nil
+}
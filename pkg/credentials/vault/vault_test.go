@@ -0,0 +1,279 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newFakeVault returns a Vault server with the Kubernetes auth method and a KV v2 secret for the role and the token
+func newFakeVault(t *testing.T, role string, jwt string, secretPath string, data map[string]interface{}) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/auth/kubernetes/login":
+			login := map[string]string{}
+			if err := json.NewDecoder(r.Body).Decode(&login); err != nil || login["role"] != role || login["jwt"] != jwt {
+				http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+				return
+			}
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"vault-token","lease_duration":3600,"renewable":true}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/auth/token/renew-self":
+			if r.Header.Get("X-Vault-Token") != "vault-token" {
+				http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+				return
+			}
+			// The token is close to its max TTL
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"vault-token","lease_duration":5,"renewable":true}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/secret/data/"+secretPath:
+			if r.Header.Get("X-Vault-Token") != "vault-token" {
+				http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"data": data}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestBuildVolumeAndEnvs(t *testing.T) {
+	scenarios := map[string]struct {
+		config      VaultConfig
+		annotations map[string]string
+		expected    []corev1.EnvVar
+	}{
+		"Disabled": {
+			annotations: map[string]string{VaultRoleAnnotation: "models", VaultSecretPathAnnotation: "models/s3"},
+		},
+		"NoSecretPath": {
+			config:      VaultConfig{Address: "https://vault:8200"},
+			annotations: map[string]string{VaultRoleAnnotation: "models"},
+		},
+		"Defaults": {
+			config:      VaultConfig{Address: "https://vault:8200"},
+			annotations: map[string]string{VaultRoleAnnotation: "models", VaultSecretPathAnnotation: "models/s3"},
+			expected: []corev1.EnvVar{
+				{Name: VaultAddr, Value: "https://vault:8200"},
+				{Name: VaultAuthMount, Value: DefaultAuthMount},
+				{Name: VaultAuthRole, Value: "models"},
+				{Name: VaultKVMount, Value: DefaultKVMount},
+				{Name: VaultSecretPath, Value: "models/s3"},
+				{Name: VaultJWTFile, Value: "/var/run/secrets/kserve/vault/token"},
+			},
+		},
+		"Mounts": {
+			config:      VaultConfig{Address: "https://vault:8200", AuthMount: "k8s-prod", KVMount: "kv"},
+			annotations: map[string]string{VaultRoleAnnotation: "models", VaultSecretPathAnnotation: "models/s3"},
+			expected: []corev1.EnvVar{
+				{Name: VaultAddr, Value: "https://vault:8200"},
+				{Name: VaultAuthMount, Value: "k8s-prod"},
+				{Name: VaultAuthRole, Value: "models"},
+				{Name: VaultKVMount, Value: "kv"},
+				{Name: VaultSecretPath, Value: "models/s3"},
+				{Name: VaultJWTFile, Value: "/var/run/secrets/kserve/vault/token"},
+			},
+		},
+		"AllowedKeys": {
+			config:      VaultConfig{Address: "https://vault:8200", AllowedKeys: []string{"S3_ENDPOINT", "S3_USE_HTTPS"}},
+			annotations: map[string]string{VaultRoleAnnotation: "models", VaultSecretPathAnnotation: "models/s3"},
+			expected: []corev1.EnvVar{
+				{Name: VaultAddr, Value: "https://vault:8200"},
+				{Name: VaultAuthMount, Value: DefaultAuthMount},
+				{Name: VaultAuthRole, Value: "models"},
+				{Name: VaultKVMount, Value: DefaultKVMount},
+				{Name: VaultSecretPath, Value: "models/s3"},
+				{Name: VaultJWTFile, Value: "/var/run/secrets/kserve/vault/token"},
+				{Name: VaultAllowedKeys, Value: "S3_ENDPOINT,S3_USE_HTTPS"},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "models", Namespace: "default", Annotations: scenario.annotations},
+		}
+		volume, volumeMount, envs, ok := BuildVolumeAndEnvs(serviceAccount, &scenario.config)
+		if ok != (scenario.expected != nil) {
+			t.Errorf("Test %q unexpected result %t", name, ok)
+			continue
+		}
+		if diff := cmp.Diff(scenario.expected, envs); diff != "" {
+			t.Errorf("Test %q unexpected result (-want +got): %v", name, diff)
+		}
+		if ok && (volume.Name != volumeMount.Name || volume.Projected.Sources[0].ServiceAccountToken.Audience != DefaultTokenAudience) {
+			t.Errorf("Test %q unexpected token volume %v", name, volume)
+		}
+	}
+}
+
+func TestClient(t *testing.T) {
+	server := newFakeVault(t, "models", "jwt", "models/s3", map[string]interface{}{
+		"AWS_ACCESS_KEY_ID": "key",
+		"options":           map[string]interface{}{"retries": 3},
+	})
+	client := &Client{Address: server.URL}
+
+	if _, err := client.Login(t.Context(), DefaultAuthMount, "other", "jwt"); err == nil {
+		t.Errorf("login with another role succeeded")
+	}
+	auth, err := client.Login(t.Context(), DefaultAuthMount, "models", "jwt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (Auth{ClientToken: "vault-token", LeaseDuration: time.Hour, Renewable: true}); *auth != expected {
+		t.Errorf("unexpected login %v", auth)
+	}
+	renewed, err := client.RenewSelf(t.Context(), auth.ClientToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renewed.LeaseDuration != 5*time.Second {
+		t.Errorf("unexpected renewal %v", renewed)
+	}
+	token := auth.ClientToken
+	data, err := client.ReadSecret(t.Context(), token, DefaultKVMount, "/models/s3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"AWS_ACCESS_KEY_ID": "key", "options": `{"retries":3}`}
+	if diff := cmp.Diff(expected, data); diff != "" {
+		t.Errorf("unexpected secret data (-want +got): %v", diff)
+	}
+	if _, err := client.ReadSecret(t.Context(), token, DefaultKVMount, "models/gcs"); err == nil {
+		t.Errorf("reading a missing secret succeeded")
+	}
+}
+
+func setVaultEnvs(t *testing.T, address string) {
+	jwtFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(jwtFile, []byte("jwt\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(VaultAddr, address)
+	t.Setenv(VaultAuthMount, DefaultAuthMount)
+	t.Setenv(VaultAuthRole, "models")
+	t.Setenv(VaultKVMount, DefaultKVMount)
+	t.Setenv(VaultSecretPath, "models/s3")
+	t.Setenv(VaultJWTFile, jwtFile)
+}
+
+// unsetEnvs unsets the env vars for the test, Setenv restores them after the test
+func unsetEnvs(t *testing.T, keys ...string) {
+	for _, key := range keys {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+}
+
+func TestSecretExporterExport(t *testing.T) {
+	server := newFakeVault(t, "models", "jwt", "models/s3", map[string]interface{}{
+		"AWS_ACCESS_KEY_ID":     "key",
+		"AWS_SECRET_ACCESS_KEY": "secret",
+		"AWS_DEFAULT_REGION":    "eu-west-1",
+		"CUSTOM_ENDPOINT":       "https://minio:9000",
+		"LD_PRELOAD":            "/tmp/evil.so",
+	})
+	setVaultEnvs(t, server.URL)
+	t.Setenv(VaultAllowedKeys, "CUSTOM_ENDPOINT")
+	// Env vars already set take precedence
+	t.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	unsetEnvs(t, "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "CUSTOM_ENDPOINT", "LD_PRELOAD")
+
+	exporter := NewSecretExporter()
+	if exporter == nil {
+		t.Fatal("expected the vault secret to be configured")
+	}
+	if err := exporter.Export(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range map[string]string{
+		"AWS_ACCESS_KEY_ID":     "key",
+		"AWS_SECRET_ACCESS_KEY": "secret",
+		"AWS_DEFAULT_REGION":    "us-east-1",
+		"CUSTOM_ENDPOINT":       "https://minio:9000",
+	} {
+		if actual := os.Getenv(key); actual != value {
+			t.Errorf("env var %s is %q, expected %q", key, actual, value)
+		}
+	}
+	// The keys which are not allowed are not exported
+	if _, ok := os.LookupEnv("LD_PRELOAD"); ok {
+		t.Error("expected LD_PRELOAD not to be exported")
+	}
+}
+
+func TestSecretExporterRefresh(t *testing.T) {
+	data := map[string]interface{}{"AWS_ACCESS_KEY_ID": "key", "AWS_SECRET_ACCESS_KEY": "secret"}
+	server := newFakeVault(t, "models", "jwt", "models/s3", data)
+	setVaultEnvs(t, server.URL)
+	unsetEnvs(t, "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY")
+
+	exporter := NewSecretExporter()
+	changes := 0
+	exporter.OnChange = func() { changes++ }
+	if err := exporter.Export(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if interval := exporter.refreshInterval(); interval != 40*time.Minute {
+		t.Errorf("expected the credentials to be refreshed after 2/3 of the lease, got %s", interval)
+	}
+
+	// The renewed lease is too short, so the exporter logs in again
+	data["AWS_ACCESS_KEY_ID"] = "rotated-key"
+	delete(data, "AWS_SECRET_ACCESS_KEY")
+	if err := exporter.refresh(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if exporter.auth.LeaseDuration != time.Hour {
+		t.Errorf("expected a new token, got %v", exporter.auth)
+	}
+	if changes != 1 || os.Getenv("AWS_ACCESS_KEY_ID") != "rotated-key" {
+		t.Errorf("expected the rotated key to be exported, got %d changes", changes)
+	}
+	if _, ok := os.LookupEnv("AWS_SECRET_ACCESS_KEY"); ok {
+		t.Error("expected the key removed from the secret to be unset")
+	}
+
+	// Unchanged credentials are not reported
+	if err := exporter.refresh(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if changes != 1 {
+		t.Errorf("expected no change, got %d changes", changes)
+	}
+
+	// The exporter retries soon when the refresh fails
+	server.Close()
+	if err := exporter.refresh(t.Context()); err == nil {
+		t.Error("expected the refresh to fail")
+	}
+	if interval := exporter.refreshInterval(); interval != minRefreshInterval {
+		t.Errorf("expected a retry after %s, got %s", minRefreshInterval, interval)
+	}
+}
@@ -18,6 +18,7 @@ package logger
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -31,11 +32,15 @@ type HTTPMarshaller struct {
 	client *http.Client
 }
 
-// Compile-time check to ensure HTTPMarshaller implements Marshaller
-var _ Marshaller = &HTTPMarshaller{}
+// Compile-time check to ensure HTTPMarshaller implements Marshaller and StreamMarshaller
+var (
+	_ Marshaller       = &HTTPMarshaller{}
+	_ StreamMarshaller = &HTTPMarshaller{}
+)
 
 // NewHTTPMarshaller creates a new HTTPMarshaller with the given URL and HTTP client.
-// The client should be configured with an appropriate timeout.
+// The client should bound the wait for the response headers rather than the whole request, the streamed
+// responses are read for as long as their upload takes. MarshalStream is bounded by its context instead.
 func NewHTTPMarshaller(url string, client *http.Client) *HTTPMarshaller {
 	return &HTTPMarshaller{
 		url:    url,
@@ -49,6 +54,27 @@ func NewHTTPMarshaller(url string, client *http.Client) *HTTPMarshaller {
 // If the X-Log-Marshal-Extension header is missing, it defaults to "json".
 // HTTP errors (non-2xx status) are propagated as errors.
 func (h *HTTPMarshaller) Marshal(batch []LogRequest) (*MarshalResponse, error) {
+	stream, err := h.MarshalStream(context.Background(), batch)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Body.Close()
+
+	// Read the response body
+	responseData, err := io.ReadAll(stream.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return &MarshalResponse{
+		Data:      responseData,
+		Extension: stream.Extension,
+	}, nil
+}
+
+// MarshalStream sends a batch of LogRequests to the configured HTTP endpoint like Marshal, but returns the
+// response body unread so that it is streamed into the storage. The caller must close the body.
+func (h *HTTPMarshaller) MarshalStream(ctx context.Context, batch []LogRequest) (*MarshalStream, error) {
 	// Marshal the batch to JSON
 	requestBody, err := json.Marshal(batch)
 	if err != nil {
@@ -56,7 +82,7 @@ func (h *HTTPMarshaller) Marshal(batch []LogRequest) (*MarshalResponse, error) {
 	}
 
 	// Create the HTTP request
-	req, err := http.NewRequest(http.MethodPost, h.url, bytes.NewReader(requestBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(requestBody))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -67,28 +93,23 @@ func (h *HTTPMarshaller) Marshal(batch []LogRequest) (*MarshalResponse, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to send HTTP request: %w", err)
 	}
-	defer resp.Body.Close()
 
 	// Check for HTTP errors
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
 		bodyBytes, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("HTTP request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
 	}
 
-	// Read the response body
-	responseData, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
 	// Read the extension header, defaulting to "json" if missing
 	extension := resp.Header.Get("X-Log-Marshal-Extension")
 	if extension == "" {
 		extension = "json"
 	}
 
-	return &MarshalResponse{
-		Data:      responseData,
-		Extension: extension,
+	return &MarshalStream{
+		Body:        resp.Body,
+		Extension:   extension,
+		ContentType: resp.Header.Get("Content-Type"),
 	}, nil
 }
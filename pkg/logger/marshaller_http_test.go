@@ -17,6 +17,7 @@ limitations under the License.
 package logger
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -236,6 +237,22 @@ func TestHTTPMarshallerTimeout(t *testing.T) {
 	g.Expect(err.Error()).To(Or(ContainSubstring("timeout"), ContainSubstring("deadline exceeded")))
 }
 
+func TestHTTPMarshallerStreamDeadline(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(createTestMarshallerHandler("json", http.StatusOK, true))
+	defer server.Close()
+
+	// The client does not bound the request, the context of the upload does
+	marshaller := NewHTTPMarshaller(server.URL, &http.Client{})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	stream, err := marshaller.MarshalStream(ctx, []LogRequest{{Id: "test-id-1"}})
+	g.Expect(err).To(MatchError(context.DeadlineExceeded))
+	g.Expect(stream).To(BeNil())
+}
+
 func TestHTTPMarshallerEmptyBatch(t *testing.T) {
 	g := NewGomegaWithT(t)
 
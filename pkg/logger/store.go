@@ -14,12 +14,16 @@ limitations under the License.
 package logger
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"path"
 	"strings"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -71,6 +75,20 @@ type Marshaller interface {
 	Marshal(batch []LogRequest) (*MarshalResponse, error)
 }
 
+// MarshalStream contains the marshalled output for a batch, read as it is marshalled.
+type MarshalStream struct {
+	Body        io.ReadCloser
+	Extension   string
+	ContentType string
+}
+
+// StreamMarshaller is a Marshaller streaming the marshalled batch, so that it is uploaded as it is received rather
+// than being held in memory. The marshalling is canceled along with ctx.
+type StreamMarshaller interface {
+	Marshaller
+	MarshalStream(ctx context.Context, batch []LogRequest) (*MarshalStream, error)
+}
+
 // BatchStrategy accumulates individual log requests and emits batches.
 // Run reads from in, batches records according to its policy, and writes
 // batches to out. Run MUST close out when in is closed and all remaining
@@ -84,42 +102,90 @@ type Store interface {
 }
 
 type BlobStore struct {
-	storePath  string
-	log        *zap.SugaredLogger
-	marshaller Marshaller
-	provider   storage.Provider
+	storePath     string
+	log           *zap.SugaredLogger
+	marshaller    Marshaller
+	store         storage.Store
+	uploadOptions storage.UploadOptions
+	// uploadTimeout bounds the marshalling and the upload of a batch
+	uploadTimeout time.Duration
+	// protocol of the storage provider of the store, if created for a scheme
+	protocol storage.Protocol
+	mu       sync.RWMutex
 }
 
+// DefaultUploadTimeout is the time the marshalling and the upload of a batch may take by default.
+const DefaultUploadTimeout = 10 * time.Minute
+
 var _ Store = &BlobStore{}
 
-func NewBlobStore(logStorePath string, marshaller Marshaller, provider storage.Provider, log *zap.SugaredLogger) *BlobStore {
+// NewBlobStore creates a BlobStore uploading the marshalled batches to the store with the upload options. The
+// content type of the objects is the one of the marshaller, when it reports it. Each batch is marshalled and
+// uploaded within DefaultUploadTimeout.
+func NewBlobStore(logStorePath string, marshaller Marshaller, store storage.Store, uploadOptions storage.UploadOptions, log *zap.SugaredLogger) *BlobStore {
 	return &BlobStore{
-		storePath:  logStorePath,
-		marshaller: marshaller,
-		log:        log,
-		provider:   provider,
+		storePath:     logStorePath,
+		marshaller:    marshaller,
+		log:           log,
+		store:         store,
+		uploadOptions: uploadOptions,
+		uploadTimeout: DefaultUploadTimeout,
 	}
 }
 
-func NewStoreForScheme(scheme string, logStorePath string, marshaller Marshaller, log *zap.SugaredLogger) (Store, error) {
+// NewStoreForScheme creates a BlobStore uploading to the storage of the scheme, each batch is marshalled and
+// uploaded within uploadTimeout, DefaultUploadTimeout if zero.
+func NewStoreForScheme(scheme string, logStorePath string, marshaller Marshaller, uploadOptions storage.UploadOptions,
+	uploadTimeout time.Duration, log *zap.SugaredLogger,
+) (Store, error) {
 	// Convert to a Protocol to reuse existing types
 	if !strings.HasSuffix(scheme, "://") {
 		scheme += "://"
 	}
 	protocol := storage.Protocol(scheme)
+	store, err := newProviderStore(protocol)
+	if err != nil {
+		return nil, err
+	}
+	blobStore := NewBlobStore(logStorePath, marshaller, store, uploadOptions, log)
+	blobStore.protocol = protocol
+	if uploadTimeout > 0 {
+		blobStore.uploadTimeout = uploadTimeout
+	}
+	return blobStore, nil
+}
+
+func newProviderStore(protocol storage.Protocol) (storage.Store, error) {
+	switch protocol {
+	case storage.AZURE, storage.GCS, storage.S3:
+	default:
+		return nil, fmt.Errorf("unsupported protocol %s", protocol)
+	}
 	provider, err := storage.GetProvider(map[storage.Protocol]storage.Provider{}, protocol)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create storage provider: %w", err)
 	}
-	switch protocol {
-	case storage.AZURE:
-		fallthrough
-	case storage.GCS:
-		fallthrough
-	case storage.S3:
-		return NewBlobStore(logStorePath, marshaller, provider, log), nil
+	store, ok := provider.(storage.Store)
+	if !ok {
+		return nil, fmt.Errorf("storage provider of protocol %s does not support uploads", protocol)
 	}
-	return nil, fmt.Errorf("unsupported protocol %s", protocol)
+	return store, nil
+}
+
+// ResetClient creates a new client of the storage provider of the store, which uploads with the credentials
+// exported since the store was created. Does nothing for stores not created for a scheme.
+func (s *BlobStore) ResetClient() error {
+	if s.protocol == "" {
+		return nil
+	}
+	store, err := newProviderStore(s.protocol)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store = store
+	return nil
 }
 
 func (s *BlobStore) Store(logUrl *url.URL, batch []LogRequest) error {
@@ -130,12 +196,6 @@ func (s *BlobStore) Store(logUrl *url.URL, batch []LogRequest) error {
 		return errors.New("empty batch")
 	}
 
-	response, err := s.marshaller.Marshal(batch)
-	if err != nil {
-		s.log.Error(err)
-		return err
-	}
-
 	bucket, configPrefix, err := parseBlobStoreURL(logUrl.String(), s.log)
 	if err != nil {
 		s.log.Error(err)
@@ -146,6 +206,15 @@ func (s *BlobStore) Store(logUrl *url.URL, batch []LogRequest) error {
 		return errors.New("no bucket specified in url")
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), s.uploadTimeout)
+	defer cancel()
+	response, err := s.marshal(ctx, batch)
+	if err != nil {
+		s.log.Error(err)
+		return err
+	}
+	defer response.Body.Close()
+
 	// Use the first record for object key generation (prefix, id, type).
 	objectKey, err := s.getObjectKey(configPrefix, &batch[0], response.Extension)
 	if err != nil {
@@ -153,7 +222,14 @@ func (s *BlobStore) Store(logUrl *url.URL, batch []LogRequest) error {
 		return err
 	}
 
-	err = s.provider.UploadObject(bucket, objectKey, response.Data)
+	options := s.uploadOptions
+	if response.ContentType != "" {
+		options.ContentType = response.ContentType
+	}
+	s.mu.RLock()
+	store := s.store
+	s.mu.RUnlock()
+	err = store.Upload(ctx, bucket, objectKey, response.Body, options)
 	if err != nil {
 		s.log.Error(err)
 		return err
@@ -162,6 +238,21 @@ func (s *BlobStore) Store(logUrl *url.URL, batch []LogRequest) error {
 	return nil
 }
 
+// marshal streams the marshalled batch when the marshaller supports it, and wraps the marshalled bytes otherwise.
+func (s *BlobStore) marshal(ctx context.Context, batch []LogRequest) (*MarshalStream, error) {
+	if streamMarshaller, ok := s.marshaller.(StreamMarshaller); ok {
+		return streamMarshaller.MarshalStream(ctx, batch)
+	}
+	response, err := s.marshaller.Marshal(batch)
+	if err != nil {
+		return nil, err
+	}
+	return &MarshalStream{
+		Body:      io.NopCloser(bytes.NewReader(response.Data)),
+		Extension: response.Extension,
+	}, nil
+}
+
 func (s *BlobStore) getObjectPrefix(configPrefix string, request *LogRequest) (string, error) {
 	if request == nil {
 		return "", errors.New("log request is invalid")
@@ -14,6 +14,7 @@ limitations under the License.
 package logger
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -27,18 +28,29 @@ import (
 )
 
 func mockStore() (*BlobStore, *MockS3Uploader, *httptest.Server) {
+	return mockStoreWithOptions(NewJSONMarshallerHandler(), storage.UploadOptions{})
+}
+
+func mockStoreWithOptions(handler http.Handler, uploadOptions storage.UploadOptions) (*BlobStore, *MockS3Uploader, *httptest.Server) {
 	uploader := &MockS3Uploader{
 		ReceivedUploadObjectsChan: make(chan *transfermanager.UploadObjectInput),
 	}
 
-	server := httptest.NewServer(NewJSONMarshallerHandler())
+	server := httptest.NewServer(handler)
 	marshaller := NewHTTPMarshaller(server.URL+"/marshal", &http.Client{})
 
 	log, _ := pkglogging.NewLogger("", "INFO")
-	store := NewBlobStore("/logger", marshaller, &storage.S3Provider{TransferClient: uploader}, log)
+	store := NewBlobStore("/logger", marshaller, &storage.S3Provider{TransferClient: uploader}, uploadOptions, log)
 	return store, uploader, server
 }
 
+// bytesMarshaller is a Marshaller which does not stream the marshalled batches.
+type bytesMarshaller struct{}
+
+func (bytesMarshaller) Marshal(batch []LogRequest) (*MarshalResponse, error) {
+	return &MarshalResponse{Data: []byte(batch[0].Id), Extension: "txt"}, nil
+}
+
 func TestNilUrl(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	store, _, server := mockStore()
@@ -84,3 +96,48 @@ func TestConfiguredPrefix(t *testing.T) {
 	g.Expect(*req.Bucket).To(gomega.Equal("bucket"))
 	g.Expect(*req.Key).To(gomega.MatchRegexp("prefix/ns/inference/predictor/logger/0123-request.json"))
 }
+
+func TestUploadOptions(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	store, uploader, server := mockStoreWithOptions(NewParquetMarshallerHandler(), storage.UploadOptions{
+		StorageClass: "GLACIER_IR",
+		KMSKeyID:     "logs-key",
+		Tags:         map[string]string{"team": "ml"},
+	})
+	defer server.Close()
+
+	logUrl, err := url.Parse("s3://bucket")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	err = store.Store(logUrl, []LogRequest{{Id: "0123", ReqType: CEInferenceRequest}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	req := <-uploader.ReceivedUploadObjectsChan
+	g.Expect(*req.Key).To(gomega.HaveSuffix("0123-request.parquet"))
+	// The content type is the one of the marshaller
+	g.Expect(*req.ContentType).To(gomega.Equal("application/octet-stream"))
+	g.Expect(string(req.StorageClass)).To(gomega.Equal("GLACIER_IR"))
+	g.Expect(*req.SSEKMSKeyID).To(gomega.Equal("logs-key"))
+	g.Expect(*req.Tagging).To(gomega.Equal("team=ml"))
+}
+
+func TestNonStreamMarshaller(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	uploader := &MockS3Uploader{
+		ReceivedUploadObjectsChan: make(chan *transfermanager.UploadObjectInput),
+	}
+	log, _ := pkglogging.NewLogger("", "INFO")
+	store := NewBlobStore("", bytesMarshaller{}, &storage.S3Provider{TransferClient: uploader}, storage.UploadOptions{}, log)
+
+	logUrl, err := url.Parse("s3://bucket")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	err = store.Store(logUrl, []LogRequest{{Id: "0123", ReqType: CEInferenceRequest}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	req := <-uploader.ReceivedUploadObjectsChan
+	g.Expect(*req.Key).To(gomega.HaveSuffix("0123-request.txt"))
+	g.Expect(req.ContentType).To(gomega.BeNil())
+	body, err := io.ReadAll(req.Body)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(string(body)).To(gomega.Equal("0123"))
+}
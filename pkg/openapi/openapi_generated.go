@@ -39,7 +39,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceGraphSpec":            schema_pkg_apis_serving_v1alpha1_InferenceGraphSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceGraphStatus":          schema_pkg_apis_serving_v1alpha1_InferenceGraphStatus(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceRouter":               schema_pkg_apis_serving_v1alpha1_InferenceRouter(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceRouterStatus":         schema_pkg_apis_serving_v1alpha1_InferenceRouterStatus(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceStep":                 schema_pkg_apis_serving_v1alpha1_InferenceStep(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceStepStatus":           schema_pkg_apis_serving_v1alpha1_InferenceStepStatus(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceTarget":               schema_pkg_apis_serving_v1alpha1_InferenceTarget(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LLMInferenceService":           schema_pkg_apis_serving_v1alpha1_LLMInferenceService(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LLMInferenceServiceConfig":     schema_pkg_apis_serving_v1alpha1_LLMInferenceServiceConfig(ref),
@@ -48,6 +50,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelCache":               schema_pkg_apis_serving_v1alpha1_LocalModelCache(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelCacheList":           schema_pkg_apis_serving_v1alpha1_LocalModelCacheList(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelCacheSpec":           schema_pkg_apis_serving_v1alpha1_LocalModelCacheSpec(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelEvictionPolicy":      schema_pkg_apis_serving_v1alpha1_LocalModelEvictionPolicy(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelNamespaceCache":      schema_pkg_apis_serving_v1alpha1_LocalModelNamespaceCache(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelNamespaceCacheList":  schema_pkg_apis_serving_v1alpha1_LocalModelNamespaceCacheList(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelNamespaceCacheSpec":  schema_pkg_apis_serving_v1alpha1_LocalModelNamespaceCacheSpec(ref),
@@ -57,6 +60,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelNodeGroupSpec":       schema_pkg_apis_serving_v1alpha1_LocalModelNodeGroupSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelNodeList":            schema_pkg_apis_serving_v1alpha1_LocalModelNodeList(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelNodeSpec":            schema_pkg_apis_serving_v1alpha1_LocalModelNodeSpec(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelRefreshPolicy":       schema_pkg_apis_serving_v1alpha1_LocalModelRefreshPolicy(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelStorageSpec":         schema_pkg_apis_serving_v1alpha1_LocalModelStorageSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.ModelSpec":                     schema_pkg_apis_serving_v1alpha1_ModelSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.ServingRuntime":                schema_pkg_apis_serving_v1alpha1_ServingRuntime(ref),
@@ -76,6 +80,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha2.LLMInferenceServiceConfigList": schema_pkg_apis_serving_v1alpha2_LLMInferenceServiceConfigList(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1alpha2.LLMInferenceServiceList":       schema_pkg_apis_serving_v1alpha2_LLMInferenceServiceList(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.ARTExplainerSpec":               schema_pkg_apis_serving_v1beta1_ARTExplainerSpec(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.AnalysisMetric":                 schema_pkg_apis_serving_v1beta1_AnalysisMetric(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.AuthenticationRef":              schema_pkg_apis_serving_v1beta1_AuthenticationRef(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.AutoScalingSpec":                schema_pkg_apis_serving_v1beta1_AutoScalingSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.AutoscalerConfig":               schema_pkg_apis_serving_v1beta1_AutoscalerConfig(ref),
@@ -109,6 +114,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.IngressConfig":                  schema_pkg_apis_serving_v1beta1_IngressConfig(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.LightGBMSpec":                   schema_pkg_apis_serving_v1beta1_LightGBMSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.LocalModelConfig":               schema_pkg_apis_serving_v1beta1_LocalModelConfig(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.LocalModelDownloadRetryConfig":  schema_pkg_apis_serving_v1beta1_LocalModelDownloadRetryConfig(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.LocalModelPeerDownloadConfig":   schema_pkg_apis_serving_v1beta1_LocalModelPeerDownloadConfig(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.LoggerSpec":                     schema_pkg_apis_serving_v1beta1_LoggerSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.LoggerStorageSpec":              schema_pkg_apis_serving_v1beta1_LoggerStorageSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.MetricTarget":                   schema_pkg_apis_serving_v1beta1_MetricTarget(ref),
@@ -129,18 +136,29 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.PodSpec":                        schema_pkg_apis_serving_v1beta1_PodSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.PredictorExtensionSpec":         schema_pkg_apis_serving_v1beta1_PredictorExtensionSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.PredictorSpec":                  schema_pkg_apis_serving_v1beta1_PredictorSpec(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.ProgressiveRolloutSpec":         schema_pkg_apis_serving_v1beta1_ProgressiveRolloutSpec(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.PrometheusAnalysisSpec":         schema_pkg_apis_serving_v1beta1_PrometheusAnalysisSpec(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.ResolvedModelSource":            schema_pkg_apis_serving_v1beta1_ResolvedModelSource(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.ResourceConfig":                 schema_pkg_apis_serving_v1beta1_ResourceConfig(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.ResourceMetricSource":           schema_pkg_apis_serving_v1beta1_ResourceMetricSource(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutAnalysisSpec":            schema_pkg_apis_serving_v1beta1_RolloutAnalysisSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutSpec":                    schema_pkg_apis_serving_v1beta1_RolloutSpec(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutStatus":                  schema_pkg_apis_serving_v1beta1_RolloutStatus(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutStep":                    schema_pkg_apis_serving_v1beta1_RolloutStep(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutStepStatus":              schema_pkg_apis_serving_v1beta1_RolloutStepStatus(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.RuntimeCandidate":               schema_pkg_apis_serving_v1beta1_RuntimeCandidate(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.RuntimeSelection":               schema_pkg_apis_serving_v1beta1_RuntimeSelection(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.SKLearnSpec":                    schema_pkg_apis_serving_v1beta1_SKLearnSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.SecurityConfig":                 schema_pkg_apis_serving_v1beta1_SecurityConfig(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.ServiceConfig":                  schema_pkg_apis_serving_v1beta1_ServiceConfig(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.ShadowSpec":                     schema_pkg_apis_serving_v1beta1_ShadowSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.StorageSpec":                    schema_pkg_apis_serving_v1beta1_StorageSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.StorageUri":                     schema_pkg_apis_serving_v1beta1_StorageUri(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.TFServingSpec":                  schema_pkg_apis_serving_v1beta1_TFServingSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.TorchServeSpec":                 schema_pkg_apis_serving_v1beta1_TorchServeSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.TransformerSpec":                schema_pkg_apis_serving_v1beta1_TransformerSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.TritonSpec":                     schema_pkg_apis_serving_v1beta1_TritonSpec(ref),
+		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.WebhookAnalysisSpec":            schema_pkg_apis_serving_v1beta1_WebhookAnalysisSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.WorkerSpec":                     schema_pkg_apis_serving_v1beta1_WorkerSpec(ref),
 		"github.com/kserve/kserve/pkg/apis/serving/v1beta1.XGBoostSpec":                    schema_pkg_apis_serving_v1beta1_XGBoostSpec(ref),
 	}
@@ -722,11 +740,26 @@ func schema_pkg_apis_serving_v1alpha1_InferenceGraphStatus(ref common.ReferenceC
 							Format:      "",
 						},
 					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes reports the readiness of each router node and the targets resolved for its steps",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceRouterStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"knative.dev/pkg/apis.Condition", "knative.dev/pkg/apis.URL"},
+			"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceRouterStatus", "knative.dev/pkg/apis.Condition", "knative.dev/pkg/apis.URL"},
 	}
 }
 
@@ -768,6 +801,49 @@ func schema_pkg_apis_serving_v1alpha1_InferenceRouter(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_serving_v1alpha1_InferenceRouterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InferenceRouterStatus defines the observed state of an InferenceGraph router node",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is true when all the hard dependency steps of the node are ready",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"steps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps reports the resolved target of each step, in the same order as the node steps",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceStepStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ready"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.InferenceStepStatus"},
+	}
+}
+
 func schema_pkg_apis_serving_v1alpha1_InferenceStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -844,6 +920,78 @@ func schema_pkg_apis_serving_v1alpha1_InferenceStep(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_serving_v1alpha1_InferenceStepStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InferenceStepStatus defines the observed state of the target of an InferenceGraph step",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the step, if set",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The node name the step routes to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The InferenceService the step routes to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL resolved for the step target",
+							Ref:         ref("knative.dev/pkg/apis.URL"),
+						},
+					},
+					"dependency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependency type of the step",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is true when the step target exists and is ready",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason for the step target not being ready",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describing why the step target is not ready",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"ready"},
+			},
+		},
+		Dependencies: []string{
+			"knative.dev/pkg/apis.URL"},
+	}
+}
+
 func schema_pkg_apis_serving_v1alpha1_InferenceTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1214,12 +1362,67 @@ func schema_pkg_apis_serving_v1alpha1_LocalModelCacheSpec(ref common.ReferenceCa
 							Ref: ref("github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelStorageSpec"),
 						},
 					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Models with a lower priority are evicted first from the node groups with an eviction policy",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pinned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pinned models are never evicted from the node groups with an eviction policy",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"refreshPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Periodically checks the revision of the source model and downloads the new revisions. Without a refresh policy the model is downloaded once.",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelRefreshPolicy"),
+						},
+					},
 				},
 				Required: []string{"sourceModelUri", "modelSize", "nodeGroups"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelStorageSpec", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelRefreshPolicy", "github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelStorageSpec", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_serving_v1alpha1_LocalModelEvictionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalModelEvictionPolicy evicts the least recently used models from a node once the models downloaded on it use more than the high watermark of the node group storage limit, until they use less than the low watermark. Pinned models and the models used by inference services are never evicted. The other models are evicted by priority first, and then by the last time they were referenced by an inference service.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"highWatermarkPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Percentage of the storage limit used on a node above which models are evicted",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lowWatermarkPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Percentage of the storage limit used on a node below which models stop being evicted",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"minIdleDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Minimum time since a model was last referenced by an inference service before it can be evicted",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1367,6 +1570,20 @@ func schema_pkg_apis_serving_v1alpha1_LocalModelNamespaceCacheSpec(ref common.Re
 							Ref: ref("github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelStorageSpec"),
 						},
 					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Models with a lower priority are evicted first from the node groups with an eviction policy",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pinned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pinned models are never evicted from the node groups with an eviction policy",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"sourceModelUri", "modelSize", "nodeGroups"},
 			},
@@ -1543,12 +1760,18 @@ func schema_pkg_apis_serving_v1alpha1_LocalModelNodeGroupSpec(ref common.Referen
 							Ref:         ref("k8s.io/api/core/v1.PersistentVolumeClaimSpec"),
 						},
 					},
+					"evictionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Evicts unused models from the nodes running out of storage. Models are kept until their model cache is deleted if not set.",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelEvictionPolicy"),
+						},
+					},
 				},
 				Required: []string{"storageLimit", "persistentVolumeSpec", "persistentVolumeClaimSpec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.PersistentVolumeClaimSpec", "k8s.io/api/core/v1.PersistentVolumeSpec", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.LocalModelEvictionPolicy", "k8s.io/api/core/v1.PersistentVolumeClaimSpec", "k8s.io/api/core/v1.PersistentVolumeSpec", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -1629,6 +1852,27 @@ func schema_pkg_apis_serving_v1alpha1_LocalModelNodeSpec(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_serving_v1alpha1_LocalModelRefreshPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalModelRefreshPolicy periodically checks the revision of a mutable model source, like the commit of a Hugging Face repository, the ETags of the objects under an S3 prefix or the digest of an OCI tag. A changed revision is downloaded into a new folder and served once the download succeeded.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval between two checks of the revision of the source model",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_serving_v1alpha1_LocalModelStorageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2257,8 +2501,75 @@ func schema_pkg_apis_serving_v1alpha1_ServingRuntimeStatus(ref common.ReferenceC
 			SchemaProps: spec.SchemaProps{
 				Description: "ServingRuntimeStatus defines the observed state of ServingRuntime",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the 'Generation' of the Service that was last processed by the controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions the latest available observations of a resource's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("knative.dev/pkg/apis.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is additional Status fields for the Resource to save some additional State as well as convey more information to the user. This is roughly akin to Annotations on any k8s resource, just the reconciler conveying richer information outwards.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"inferenceServices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inference services using the runtime",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kserve/kserve/pkg/apis/serving/v1alpha1.NamespacedName"),
+									},
+								},
+							},
+						},
+					},
+					"lastSelectedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time an inference service selected the runtime",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kserve/kserve/pkg/apis/serving/v1alpha1.NamespacedName", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "knative.dev/pkg/apis.Condition"},
 	}
 }
 
@@ -2345,7 +2656,7 @@ func schema_pkg_apis_serving_v1alpha1_SupportedModelFormat(ref common.ReferenceC
 					},
 					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version of the model format. Used in validating that a predictor is supported by a runtime. Can be \"major\", \"major.minor\" or \"major.minor.patch\".",
+							Description: "Version of the model format. Used in validating that a predictor is supported by a runtime. Can be \"major\", \"major.minor\" or \"major.minor.patch\", matching the versions it is a prefix of, or a semver constraint such as \">=2.1,<3\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -3082,33 +3393,77 @@ func schema_pkg_apis_serving_v1beta1_ARTExplainerSpec(ref common.ReferenceCallba
 	}
 }
 
-func schema_pkg_apis_serving_v1beta1_AuthenticationRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_serving_v1beta1_AnalysisMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AnalysisMetric defines a Prometheus query and the range its result must be within. The query is a Go template with the .Name, .Namespace, .Canary and .CanaryService fields available, e.g. histogram_quantile(0.99, sum(rate(request_duration_seconds_bucket{service=\"{{ .CanaryService }}\"}[1m])) by (le))",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the authentication secret",
+							Description: "Name of the metric, used in the rollout status.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_serving_v1beta1_AutoScalingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query is the PromQL query, it must evaluate to a single value.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max is the maximum accepted value of the query result, e.g. an error rate of \"0.01\".",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"min": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Min is the minimum accepted value of the query result, e.g. a success rate of \"0.99\".",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"name", "query"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_AuthenticationRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the authentication secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_AutoScalingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
 					"metrics": {
 						SchemaProps: spec.SchemaProps{
 							Description: "metrics is a list of metrics spec to be used for autoscaling",
@@ -3217,12 +3572,18 @@ func schema_pkg_apis_serving_v1beta1_CanarySpec(ref common.ReferenceCallback) co
 							Format:      "int32",
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout defines an optional progressive rollout policy for this canary. When set, the controller steps the canary traffic through the rollout steps, analyzing each step before moving forward, and rolls the canary traffic back to 0 when the analysis fails. TrafficPercent is then ignored.",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.ProgressiveRolloutSpec"),
+						},
+					},
 				},
 				Required: []string{"predictor", "trafficPercent"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.PredictorSpec"},
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.PredictorSpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ProgressiveRolloutSpec"},
 	}
 }
 
@@ -3264,12 +3625,18 @@ func schema_pkg_apis_serving_v1beta1_CanaryStatus(ref common.ReferenceCallback)
 							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.ModelStatus"),
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout is the observed state of the canary progressive rollout, if any.",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutStatus"),
+						},
+					},
 				},
 				Required: []string{"name", "ready", "trafficPercent"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.ModelStatus"},
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.ModelStatus", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutStatus"},
 	}
 }
 
@@ -6717,12 +7084,18 @@ func schema_pkg_apis_serving_v1beta1_InferenceServiceSpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"shadow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shadow defines an optional predictor receiving a mirrored copy of the predictor traffic. The shadow responses are discarded, set the shadow predictor logger to capture them. The Deployment or Knative Service is named {isvc}-predictor-shadow.",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.ShadowSpec"),
+						},
+					},
 				},
 				Required: []string{"predictor"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.CanarySpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ExplainerSpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.PredictorSpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.TransformerSpec"},
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.CanarySpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ExplainerSpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.PredictorSpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ShadowSpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.TransformerSpec"},
 	}
 }
 
@@ -6850,11 +7223,17 @@ func schema_pkg_apis_serving_v1beta1_InferenceServiceStatus(ref common.Reference
 							Format:      "",
 						},
 					},
+					"runtimeSelection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeSelection explains the runtime selected for the model of the predictor",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.RuntimeSelection"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.CanaryStatus", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ComponentStatusSpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ModelStatus", "knative.dev/pkg/apis.Condition", "knative.dev/pkg/apis.URL", "knative.dev/pkg/apis/duck/v1.Addressable"},
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.CanaryStatus", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ComponentStatusSpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ModelStatus", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.RuntimeSelection", "knative.dev/pkg/apis.Condition", "knative.dev/pkg/apis.URL", "knative.dev/pkg/apis/duck/v1.Addressable"},
 	}
 }
 
@@ -7457,10 +7836,94 @@ func schema_pkg_apis_serving_v1beta1_LocalModelConfig(ref common.ReferenceCallba
 							Format: "",
 						},
 					},
+					"peerDownload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PeerDownload lets the nodes download the models cached on the other nodes of their node group",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.LocalModelPeerDownloadConfig"),
+						},
+					},
+					"downloadRetry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DownloadRetry relaunches failed download jobs with an exponential backoff. Failed jobs are not relaunched if unset.",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.LocalModelDownloadRetryConfig"),
+						},
+					},
 				},
 				Required: []string{"enabled", "jobNamespace"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.LocalModelDownloadRetryConfig", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.LocalModelPeerDownloadConfig"},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_LocalModelDownloadRetryConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum number of times a failed download job is relaunched",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"initialBackoffSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff before relaunching a failed download job for the first time, doubled for each retry. Defaults to 60.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxBackoffSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum backoff before relaunching a failed download job. Defaults to 3600.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"maxRetries"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_LocalModelPeerDownloadConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled makes the local model agents serve their downloaded models to peer nodes, and download jobs fetch models from a peer before falling back to the source model URI",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port of the local model agents serving the downloaded models",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image of the jobs downloading models from peers, the local model agent image",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
 	}
 }
 
@@ -7726,7 +8189,7 @@ func schema_pkg_apis_serving_v1beta1_ModelFormat(ref common.ReferenceCallback) c
 					},
 					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version of the model format. Used in validating that a predictor is supported by a runtime. Can be \"major\", \"major.minor\" or \"major.minor.patch\".",
+							Description: "Version of the model format. Used in validating that a predictor is supported by a runtime. Can be \"major\", \"major.minor\" or \"major.minor.patch\", matching the versions it is a prefix of, or a semver constraint such as \">=2.1,<3\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -8161,12 +8624,34 @@ func schema_pkg_apis_serving_v1beta1_ModelStatus(ref common.ReferenceCallback) c
 							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.ModelCopies"),
 						},
 					},
+					"resolvedSources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"storageUri",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Immutable revisions of the model sources of the predictor, resolved when their storage URIs are first deployed so that all the replicas download the same model. Only set when the pinModelRevisions storage initializer config is enabled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.ResolvedModelSource"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"transitionStatus"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.FailureInfo", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ModelCopies", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ModelRevisionStates"},
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.FailureInfo", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ModelCopies", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ModelRevisionStates", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.ResolvedModelSource"},
 	}
 }
 
@@ -10914,6 +11399,128 @@ func schema_pkg_apis_serving_v1beta1_PredictorSpec(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_serving_v1beta1_ProgressiveRolloutSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProgressiveRolloutSpec defines how the traffic of a canary is progressively increased.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"steps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps defines the traffic percentage of each rollout step, applied in order.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutStep"),
+									},
+								},
+							},
+						},
+					},
+					"analysis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Analysis defines the success criteria evaluated at the end of each step. When not set, the rollout moves to the next step once the hold duration has elapsed.",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutAnalysisSpec"),
+						},
+					},
+				},
+				Required: []string{"steps"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutAnalysisSpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutStep"},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_PrometheusAnalysisSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusAnalysisSpec defines success criteria evaluated against a Prometheus server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Address of the Prometheus server, e.g. http://prometheus.monitoring.svc:9090",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metrics": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics evaluated at the end of each step.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.AnalysisMetric"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"address", "metrics"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.AnalysisMetric"},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_ResolvedModelSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedModelSource is the immutable revision a model source was resolved to",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageUri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage URI of the model source, as specified in the predictor",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Immutable revision of the model source: the commit sha for Hugging Face, the manifest digest for OCI, and the digest of the keys and ETags of the objects for S3 or of the names and generations of the objects for GCS",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolvedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time at which the revision was resolved",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"storageUri", "revision"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_serving_v1beta1_ResourceConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10980,33 +11587,326 @@ func schema_pkg_apis_serving_v1beta1_ResourceMetricSource(ref common.ReferenceCa
 	}
 }
 
-func schema_pkg_apis_serving_v1beta1_RolloutSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_serving_v1beta1_RolloutAnalysisSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RolloutSpec defines the rollout strategy configuration using Kubernetes deployment strategy",
+				Description: "RolloutAnalysisSpec defines the success criteria of a rollout step. All the configured criteria must pass.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"maxSurge": {
+					"prometheus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge specifies the maximum number of pods that can be created above the desired replica count. Can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Prometheus defines metric based success criteria.",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.PrometheusAnalysisSpec"),
 						},
 					},
-					"maxUnavailable": {
+					"webhook": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable specifies the maximum number of pods that can be unavailable during the update. Can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Webhook defines an external endpoint judging the step.",
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.WebhookAnalysisSpec"),
 						},
 					},
 				},
-				Required: []string{"maxSurge", "maxUnavailable"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.PrometheusAnalysisSpec", "github.com/kserve/kserve/pkg/apis/serving/v1beta1.WebhookAnalysisSpec"},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_RolloutSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutSpec defines the rollout strategy configuration using Kubernetes deployment strategy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge specifies the maximum number of pods that can be created above the desired replica count. Can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable specifies the maximum number of pods that can be unavailable during the update. Can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"maxSurge", "maxUnavailable"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStatus represents the observed state of a canary progressive rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase of the rollout.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentStep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentStep is the index of the rollout step currently applied.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"stepStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StepStartTime is when the hold of the current step started, it is unset until the canary is ready.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"specHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SpecHash identifies the canary spec the rollout was started for, a new rollout starts when it changes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes the last transition of the rollout.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"history": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "History records the outcome of every completed rollout step.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutStepStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"phase", "currentStep"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.RolloutStepStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_RolloutStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStep defines a single step of a progressive rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"trafficPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrafficPercent is the percentage of inference traffic routed to the canary during this step.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"hold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hold is how long the step is held, once the canary is ready, before it is analyzed. e.g. \"5m\"",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"trafficPercent"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_RolloutStepStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStepStatus records the outcome of a rollout step.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Step is the index of the rollout step.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"trafficPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrafficPercent routed to the canary during the step.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Result of the step analysis.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime of the step hold.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime of the step analysis.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message details the analysis outcome, e.g. the measured metric values.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"step", "trafficPercent", "result"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_RuntimeCandidate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeCandidate is a runtime considered for the auto-selection of the runtime of the predictor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the runtime",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the runtime",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority of the model format in the runtime, if set",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason the runtime was not selected, empty for the selected runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message detailing the reason the runtime was not selected",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "kind"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_serving_v1beta1_RuntimeSelection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeSelection describes the runtime used by the predictor and, when it was auto-selected, the runtimes considered for the model format",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the selected runtime, empty if no runtime supports the model",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the selected runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"autoSelected": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoSelected is true when the runtime is not set in the model spec and was selected by model format",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"candidates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Candidates are the auto-selection candidates, the selected runtime first, followed by the runtimes supporting the model in order of preference and the rejected runtimes",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.RuntimeCandidate"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.RuntimeCandidate"},
 	}
 }
 
@@ -11400,6 +12300,36 @@ func schema_pkg_apis_serving_v1beta1_ServiceConfig(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_serving_v1beta1_ShadowSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShadowSpec defines a shadow deployment validating a new model version against production traffic without affecting the responses. The shadow predictor spec is merged over the stable predictor spec. The shadow uses fixed replicas (no autoscaling). Requests are mirrored by the ingress, so only requests routed by the ingress to the predictor are mirrored.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"predictor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Predictor spec for the shadow variant.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kserve/kserve/pkg/apis/serving/v1beta1.PredictorSpec"),
+						},
+					},
+					"mirrorPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MirrorPercent is the percentage of the predictor requests mirrored to the shadow.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"predictor"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kserve/kserve/pkg/apis/serving/v1beta1.PredictorSpec"},
+	}
+}
+
 func schema_pkg_apis_serving_v1beta1_StorageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13117,6 +14047,35 @@ func schema_pkg_apis_serving_v1beta1_TritonSpec(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_serving_v1beta1_WebhookAnalysisSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookAnalysisSpec defines an endpoint that judges a rollout step. The endpoint receives a POST request describing the step and passes the step by returning a 2xx status code. 4xx status codes fail the step, 5xx status codes and connection errors are retried until the analysis deadline, after which the step fails.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the webhook.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds of the webhook request, defaults to 10 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_serving_v1beta1_WorkerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
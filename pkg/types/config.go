@@ -23,6 +23,13 @@ const (
 	OciModelModeFetch    = "fetch"
 )
 
+// Multiple storage URI download modes for MultiStorageUriMode field.
+const (
+	MultiStorageUriModeSequential = "sequential"
+	MultiStorageUriModeParallel   = "parallel"
+	MultiStorageUriModePerUri     = "perUri"
+)
+
 type StorageInitializerConfig struct {
 	Image                   string `json:"image"`
 	CpuRequest              string `json:"cpuRequest"`
@@ -47,6 +54,53 @@ type StorageInitializerConfig struct {
 	// opt-in, never inferred from the registry host. Wired to the init container via
 	// the KSERVE_OCI_INSECURE_REGISTRY env var (see ConfigureOciFetchToContainer).
 	OciInsecureRegistry bool `json:"ociInsecureRegistry"`
+	// OciVerification verifies the cosign signatures of the OCI model images when pods are admitted and pins
+	// the images by digest. Verification is disabled when not set.
+	OciVerification *OciVerificationPolicy `json:"ociVerification,omitempty"`
+	// PinModelRevisions resolves the immutable revision of the model sources when their storage URIs are deployed,
+	// records it in the model status of the InferenceService and pins the downloads of all the replicas to it.
+	PinModelRevisions bool `json:"pinModelRevisions,omitempty"`
+	// NodeCacheDir is the node directory the models of the InferenceServices with model pre-pulling enabled are
+	// downloaded to before they are rolled out, and which their storage initializers download the models through.
+	// Only the OCI model images are pre-pulled when not set.
+	NodeCacheDir string `json:"nodeCacheDir,omitempty"`
+	// MultiStorageUriMode selects how the storage URIs of the storageUris field and the LoRA adapters are
+	// downloaded. Valid values: "sequential" (default) downloads them one after another in one storage
+	// initializer, "parallel" downloads them concurrently in one storage initializer, and "perUri" downloads each
+	// of them in its own storage initializer with its own credentials and volume, so that the pod events and the
+	// model status name the storage initializer of the failed storage URI. Kubernetes runs the init containers
+	// one after another.
+	MultiStorageUriMode string `json:"multiStorageUriMode,omitempty"`
+	// MaxParallelDownloads is the maximum number of storage URIs downloaded concurrently in the parallel mode.
+	// All the storage URIs are downloaded concurrently when not set.
+	MaxParallelDownloads int `json:"maxParallelDownloads,omitempty"`
+}
+
+// OciVerificationPolicy configures the signatures and attestations the OCI model images must have.
+// An image is verified when one of its signatures is verified by one of the public keys or keyless identities.
+type OciVerificationPolicy struct {
+	// PublicKeys are the PEM encoded public keys of the cosign key pairs signing the images.
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// Identities are the identities of the keyless signatures, certified by the Fulcio roots
+	// and logged in the Rekor transparency log.
+	Identities []OciKeylessIdentity `json:"identities,omitempty"`
+	// FulcioRoots are the PEM encoded certificates of the certificate authorities of the keyless signatures.
+	FulcioRoots []string `json:"fulcioRoots,omitempty"`
+	// RekorPublicKeys are the PEM encoded public keys of the transparency logs of the keyless signatures.
+	RekorPublicKeys []string `json:"rekorPublicKeys,omitempty"`
+	// RequiredAttestations are the predicate types of the in-toto attestations the images must have,
+	// signed by one of the public keys or keyless identities, e.g. https://slsa.dev/provenance/v1.
+	RequiredAttestations []string `json:"requiredAttestations,omitempty"`
+}
+
+// OciKeylessIdentity is the identity of the certificate of a keyless signature.
+type OciKeylessIdentity struct {
+	// Issuer is the OIDC issuer of the identity, e.g. https://token.actions.githubusercontent.com.
+	Issuer string `json:"issuer"`
+	// Subject is the email or URI of the identity. SubjectRegExp is used when empty.
+	Subject string `json:"subject,omitempty"`
+	// SubjectRegExp is a regular expression matching the email or URI of the identity.
+	SubjectRegExp string `json:"subjectRegExp,omitempty"`
 }
 
 // ResolveOciModelMode returns the effective OCI model mode for the given config.
@@ -19,6 +19,8 @@ package utils
 import (
 	"fmt"
 	"path"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -178,6 +180,45 @@ func AddEmptyDirVolumeIfNotPresent(podSpec *corev1.PodSpec, name string) {
 	})
 }
 
+// AddModelCacheToContainer mounts the node-local model cache directory of the InferenceService into the storage
+// initializer container and sets the cache directory of its downloads to cacheSubDir. cacheSubDir must be a local
+// relative path within the directory of the InferenceService in nodeCacheDir, only that directory is mounted so
+// the container cannot reach the caches of the other InferenceServices of the node.
+func AddModelCacheToContainer(container *corev1.Container, podSpec *corev1.PodSpec, nodeCacheDir string, cacheSubDir string, readOnly bool) error {
+	if !filepath.IsLocal(cacheSubDir) || path.Dir(path.Clean(cacheSubDir)) == "." {
+		return fmt.Errorf("invalid model cache directory %q", cacheSubDir)
+	}
+	cacheSubDir = path.Clean(cacheSubDir)
+	volumeExists := false
+	for _, v := range podSpec.Volumes {
+		if v.Name == constants.ModelCacheVolumeName {
+			volumeExists = true
+			break
+		}
+	}
+	if !volumeExists {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: constants.ModelCacheVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: nodeCacheDir,
+					Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+				},
+			},
+		})
+	}
+	addVolumeMountToContainer(container, StorageMountParams{
+		MountPath:  constants.ModelCacheMountPath,
+		SubPath:    path.Dir(cacheSubDir),
+		VolumeName: constants.ModelCacheVolumeName,
+		ReadOnly:   readOnly,
+	})
+	container.Env = MergeEnvs(container.Env, []corev1.EnvVar{
+		{Name: constants.StorageCacheDirEnvVarKey, Value: path.Join(constants.ModelCacheMountPath, path.Base(cacheSubDir))},
+	})
+	return nil
+}
+
 // findCommonParentPath finds the common parent directory of multiple paths
 func FindCommonParentPath(paths []string) string {
 	if len(paths) == 0 {
@@ -333,6 +374,37 @@ func CreateInitContainerWithConfig(storageConfig *types.StorageInitializerConfig
 	}
 }
 
+// StorageInitializerContainerNameForIndex returns the name of the storage initializer downloading the storage URI
+// at the given index in the per URI mode. The first storage initializer keeps the storage initializer name.
+func StorageInitializerContainerNameForIndex(index int) string {
+	if index == 0 {
+		return constants.StorageInitializerContainerName
+	}
+	return constants.StorageInitializerContainerName + "-" + strconv.Itoa(index)
+}
+
+// IsStorageInitializerContainer reports whether the init container is a storage initializer, including the
+// storage initializers of the per URI mode.
+func IsStorageInitializerContainer(name string) bool {
+	return name == constants.StorageInitializerContainerName ||
+		strings.HasPrefix(name, constants.StorageInitializerContainerName+"-")
+}
+
+// AddParallelDownloadsEnvVar configures the storage initializer to download its storage URIs concurrently in the
+// parallel mode.
+func AddParallelDownloadsEnvVar(container *corev1.Container, storageConfig *types.StorageInitializerConfig, numStorageURIs int) {
+	if storageConfig.MultiStorageUriMode != types.MultiStorageUriModeParallel || numStorageURIs < 2 {
+		return
+	}
+	parallelDownloads := numStorageURIs
+	if storageConfig.MaxParallelDownloads > 0 && storageConfig.MaxParallelDownloads < parallelDownloads {
+		parallelDownloads = storageConfig.MaxParallelDownloads
+	}
+	container.Env = MergeEnvs(container.Env, []corev1.EnvVar{
+		{Name: constants.StorageParallelDownloadsEnvVarKey, Value: strconv.Itoa(parallelDownloads)},
+	})
+}
+
 // ShellQuote returns s quoted for safe interpolation into a sh -c command
 // string. Strings that consist entirely of shell-safe characters (letters,
 // digits, '/', '.', '_', '-') are returned unchanged to avoid altering
@@ -802,3 +802,95 @@ func TestConfigureOciNativeToContainer(t *testing.T) {
 
 	_ = g // suppress unused warning from outer scope
 }
+
+func TestStorageInitializerContainerNames(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	g.Expect(StorageInitializerContainerNameForIndex(0)).To(gomega.Equal(constants.StorageInitializerContainerName))
+	g.Expect(StorageInitializerContainerNameForIndex(2)).To(gomega.Equal("storage-initializer-2"))
+	g.Expect(IsStorageInitializerContainer(StorageInitializerContainerNameForIndex(0))).To(gomega.BeTrue())
+	g.Expect(IsStorageInitializerContainer(StorageInitializerContainerNameForIndex(2))).To(gomega.BeTrue())
+	g.Expect(IsStorageInitializerContainer("storage-initializers")).To(gomega.BeFalse())
+	g.Expect(IsStorageInitializerContainer(constants.InferenceServiceContainerName)).To(gomega.BeFalse())
+}
+
+func TestAddParallelDownloadsEnvVar(t *testing.T) {
+	scenarios := map[string]struct {
+		config         types.StorageInitializerConfig
+		numStorageURIs int
+		expectedEnv    []corev1.EnvVar
+	}{
+		"sequential mode": {
+			config:         types.StorageInitializerConfig{},
+			numStorageURIs: 3,
+		},
+		"parallel mode": {
+			config:         types.StorageInitializerConfig{MultiStorageUriMode: types.MultiStorageUriModeParallel},
+			numStorageURIs: 3,
+			expectedEnv:    []corev1.EnvVar{{Name: constants.StorageParallelDownloadsEnvVarKey, Value: "3"}},
+		},
+		"parallel mode with max parallel downloads": {
+			config: types.StorageInitializerConfig{
+				MultiStorageUriMode:  types.MultiStorageUriModeParallel,
+				MaxParallelDownloads: 2,
+			},
+			numStorageURIs: 3,
+			expectedEnv:    []corev1.EnvVar{{Name: constants.StorageParallelDownloadsEnvVarKey, Value: "2"}},
+		},
+		"parallel mode with a single storage URI": {
+			config:         types.StorageInitializerConfig{MultiStorageUriMode: types.MultiStorageUriModeParallel},
+			numStorageURIs: 1,
+		},
+		"per URI mode": {
+			config:         types.StorageInitializerConfig{MultiStorageUriMode: types.MultiStorageUriModePerUri},
+			numStorageURIs: 3,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			container := &corev1.Container{Name: constants.StorageInitializerContainerName}
+			AddParallelDownloadsEnvVar(container, &scenario.config, scenario.numStorageURIs)
+			g.Expect(container.Env).To(gomega.Equal(scenario.expectedEnv))
+		})
+	}
+}
+
+func TestAddModelCacheToContainer(t *testing.T) {
+	t.Run("cache volume is mounted once", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		podSpec := &corev1.PodSpec{}
+		first := &corev1.Container{Name: "storage-initializer"}
+		second := &corev1.Container{Name: "model-prepull"}
+		g.Expect(AddModelCacheToContainer(first, podSpec, "/var/cache/models", "default/my-model/abc", true)).To(gomega.Succeed())
+		g.Expect(AddModelCacheToContainer(second, podSpec, "/var/cache/models", "default/my-model/abc", false)).To(gomega.Succeed())
+
+		g.Expect(podSpec.Volumes).To(gomega.HaveLen(1))
+		g.Expect(podSpec.Volumes[0].Name).To(gomega.Equal(constants.ModelCacheVolumeName))
+		g.Expect(podSpec.Volumes[0].HostPath.Path).To(gomega.Equal("/var/cache/models"))
+		g.Expect(*podSpec.Volumes[0].HostPath.Type).To(gomega.Equal(corev1.HostPathDirectoryOrCreate))
+		for _, container := range []*corev1.Container{first, second} {
+			g.Expect(container.VolumeMounts).To(gomega.ConsistOf(corev1.VolumeMount{
+				Name:      constants.ModelCacheVolumeName,
+				MountPath: constants.ModelCacheMountPath,
+				SubPath:   "default/my-model",
+				ReadOnly:  container == first,
+			}))
+			g.Expect(container.Env).To(gomega.ConsistOf(corev1.EnvVar{
+				Name:  constants.StorageCacheDirEnvVarKey,
+				Value: constants.ModelCacheMountPath + "/abc",
+			}))
+		}
+	})
+
+	t.Run("cache directory must stay within the node cache", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		for _, cacheSubDir := range []string{"../etc", "/etc", "", "abc", "default/../abc"} {
+			podSpec := &corev1.PodSpec{}
+			err := AddModelCacheToContainer(&corev1.Container{}, podSpec, "/var/cache/models", cacheSubDir, true)
+			g.Expect(err).To(gomega.HaveOccurred())
+			g.Expect(podSpec.Volumes).To(gomega.BeEmpty())
+		}
+	})
+}
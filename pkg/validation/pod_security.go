@@ -0,0 +1,360 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+// PodSecurityConfigMapKeyName is the key of the pod security policy in the inferenceservice-config ConfigMap.
+const PodSecurityConfigMapKeyName = "podSecurity"
+
+const (
+	// InjectedTmpDir is the writable temporary directory of the injected containers given a read-only root
+	// filesystem
+	InjectedTmpDir = "/tmp"
+	// InjectedHFCacheDir is the writable Hugging Face cache directory of the injected containers given a read-only
+	// root filesystem, unless they set HF_HOME
+	InjectedHFCacheDir = "/var/cache/huggingface"
+	hfHomeEnvVarKey    = "HF_HOME"
+)
+
+// PodSecurityMode selects how the violations of the pod security policy are reported at admission.
+type PodSecurityMode string
+
+const (
+	// PodSecurityModeWarn admits the violating objects with an admission warning per violation.
+	PodSecurityModeWarn PodSecurityMode = "warn"
+	// PodSecurityModeEnforce denies the admission of the violating objects.
+	PodSecurityModeEnforce PodSecurityMode = "enforce"
+)
+
+// PodSecurityPolicy is the admission policy of the containers of the InferenceService and LLMInferenceService
+// pods. It is enforced by the pod mutating webhook on the final pods, and by the InferenceService and
+// LLMInferenceService validators on the containers of their specs.
+type PodSecurityPolicy struct {
+	// Mode reports the violations as admission warnings (warn) or denials (enforce).
+	// The policy is disabled when not set.
+	Mode PodSecurityMode `json:"mode,omitempty"`
+	// BlockedEnvVars are the environment variables no container may set, on top of DefaultBlockedEnvVars.
+	BlockedEnvVars []string `json:"blockedEnvVars,omitempty"`
+	// ContainerEnvVars restricts the environment variables of the containers by container name.
+	ContainerEnvVars map[string]ContainerEnvVarPolicy `json:"containerEnvVars,omitempty"`
+	// InjectedContainers is the security context required from the containers injected into the pods by KServe,
+	// e.g. the storage initializer, the agent, the batcher and the modelcars. The pod mutating webhook sets the
+	// required fields the injected containers leave unset.
+	InjectedContainers InjectedContainerPolicy `json:"injectedContainers,omitempty"`
+	// AllowedRegistries are the registries, or registry repository prefixes, the predictor and runtime images may
+	// be pulled from, e.g. docker.io/kserve or quay.io. Images without registry are pulled from docker.io.
+	// All registries are allowed when empty.
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+}
+
+// ContainerEnvVarPolicy restricts the environment variables of a container.
+type ContainerEnvVarPolicy struct {
+	// AllowedEnvVars are the only environment variables the container may set. All are allowed when empty.
+	AllowedEnvVars []string `json:"allowedEnvVars,omitempty"`
+	// BlockedEnvVars are the environment variables the container may not set.
+	BlockedEnvVars []string `json:"blockedEnvVars,omitempty"`
+}
+
+// InjectedContainerPolicy is the security context required from the injected containers.
+type InjectedContainerPolicy struct {
+	// RunAsNonRoot requires the injected containers to run as a non-root user. The modelcars are exempt: they run
+	// the OCI model images as the user of the images, which the predictor reads the models through.
+	RunAsNonRoot bool `json:"runAsNonRoot,omitempty"`
+	// ReadOnlyRootFilesystem requires the injected containers to mount their root filesystem as read-only.
+	// The injected containers can then only write to their volumes, the pod mutating webhook gives the containers
+	// it sets a read-only root filesystem to writable emptyDir volumes for their temporary files and Hugging Face
+	// cache.
+	ReadOnlyRootFilesystem bool `json:"readOnlyRootFilesystem,omitempty"`
+	// DropAllCapabilities requires the injected containers to drop all the capabilities, without adding any,
+	// and to disallow privilege escalation.
+	DropAllCapabilities bool `json:"dropAllCapabilities,omitempty"`
+}
+
+// NewPodSecurityPolicy reads the pod security policy from the inferenceservice-config ConfigMap.
+// The policy is disabled when the ConfigMap does not set it.
+func NewPodSecurityPolicy(configMap *corev1.ConfigMap) (*PodSecurityPolicy, error) {
+	policy := &PodSecurityPolicy{}
+	if configMap == nil {
+		return policy, nil
+	}
+	if value, ok := configMap.Data[PodSecurityConfigMapKeyName]; ok {
+		if err := json.Unmarshal([]byte(value), policy); err != nil {
+			return nil, fmt.Errorf("unable to parse %q config %q: %w", PodSecurityConfigMapKeyName, value, err)
+		}
+	}
+	switch policy.Mode {
+	case "", PodSecurityModeWarn, PodSecurityModeEnforce:
+	default:
+		return nil, fmt.Errorf("invalid %q mode %q, must be %q or %q", PodSecurityConfigMapKeyName, policy.Mode,
+			PodSecurityModeWarn, PodSecurityModeEnforce)
+	}
+	return policy, nil
+}
+
+// Enabled reports whether the policy is enforced.
+func (p *PodSecurityPolicy) Enabled() bool {
+	return p != nil && p.Mode != ""
+}
+
+// ValidateContainers validates the environment variables and the images of the containers of an InferenceService
+// or LLMInferenceService spec. Containers without image are resolved from their runtime, which images are
+// validated when the pods are admitted.
+func (p *PodSecurityPolicy) ValidateContainers(containers []corev1.Container, path *field.Path) field.ErrorList {
+	if !p.Enabled() {
+		return nil
+	}
+	var allErrs field.ErrorList
+	for i := range containers {
+		allErrs = append(allErrs, p.ValidateContainer(&containers[i], path.Index(i))...)
+	}
+	return allErrs
+}
+
+// ValidateContainer validates the environment variables and the image of a container of an InferenceService or
+// LLMInferenceService spec.
+func (p *PodSecurityPolicy) ValidateContainer(container *corev1.Container, path *field.Path) field.ErrorList {
+	if !p.Enabled() {
+		return nil
+	}
+	return append(p.validateEnvVars(container, path), p.validateImage(container, path)...)
+}
+
+// ValidatePod validates the containers of a mutated pod. The injected containers are validated against the
+// required security context, the other containers against the allowed registries.
+func (p *PodSecurityPolicy) ValidatePod(pod *corev1.Pod, isInjected func(containerName string) bool) field.ErrorList {
+	if !p.Enabled() {
+		return nil
+	}
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	for _, group := range []struct {
+		containers []corev1.Container
+		path       *field.Path
+	}{
+		{pod.Spec.InitContainers, specPath.Child("initContainers")},
+		{pod.Spec.Containers, specPath.Child("containers")},
+	} {
+		for i := range group.containers {
+			container := &group.containers[i]
+			containerPath := group.path.Index(i)
+			allErrs = append(allErrs, p.validateEnvVars(container, containerPath)...)
+			if isInjected(container.Name) {
+				allErrs = append(allErrs, p.validateSecurityContext(pod, container, containerPath)...)
+			} else {
+				allErrs = append(allErrs, p.validateImage(container, containerPath)...)
+			}
+		}
+	}
+	return allErrs
+}
+
+// HardenInjectedContainer sets the fields of the security context required by the policy that the injected
+// container leaves unset. The fields set explicitly are left for ValidatePod to report. The containers given a
+// read-only root filesystem mount writable emptyDir volumes of the pod for their temporary files and Hugging Face
+// cache.
+func (p *PodSecurityPolicy) HardenInjectedContainer(podSpec *corev1.PodSpec, container *corev1.Container) {
+	if !p.Enabled() {
+		return
+	}
+	required := p.InjectedContainers
+	if !required.RunAsNonRoot && !required.ReadOnlyRootFilesystem && !required.DropAllCapabilities {
+		return
+	}
+	if container.SecurityContext == nil {
+		container.SecurityContext = &corev1.SecurityContext{}
+	}
+	securityContext := container.SecurityContext
+	if required.RunAsNonRoot && securityContext.RunAsNonRoot == nil && !isModelcarContainer(container.Name) {
+		securityContext.RunAsNonRoot = ptr.To(true)
+	}
+	if required.ReadOnlyRootFilesystem && securityContext.ReadOnlyRootFilesystem == nil {
+		securityContext.ReadOnlyRootFilesystem = ptr.To(true)
+		addWritableDir(podSpec, container, container.Name+"-tmp", InjectedTmpDir)
+		if !isModelcarContainer(container.Name) {
+			hfHome := InjectedHFCacheDir
+			if i := slices.IndexFunc(container.Env, func(env corev1.EnvVar) bool { return env.Name == hfHomeEnvVarKey }); i >= 0 {
+				hfHome = container.Env[i].Value
+			} else {
+				container.Env = append(container.Env, corev1.EnvVar{Name: hfHomeEnvVarKey, Value: hfHome})
+			}
+			if path.IsAbs(hfHome) {
+				addWritableDir(podSpec, container, container.Name+"-hf-cache", hfHome)
+			}
+		}
+	}
+	if required.DropAllCapabilities {
+		if securityContext.AllowPrivilegeEscalation == nil {
+			securityContext.AllowPrivilegeEscalation = ptr.To(false)
+		}
+		if securityContext.Capabilities == nil {
+			securityContext.Capabilities = &corev1.Capabilities{}
+		}
+		if !slices.Contains(securityContext.Capabilities.Drop, "ALL") {
+			securityContext.Capabilities.Drop = append(securityContext.Capabilities.Drop, "ALL")
+		}
+	}
+}
+
+// Report splits the violations into admission warnings or errors depending on the mode of the policy.
+func (p *PodSecurityPolicy) Report(violations field.ErrorList) (admission.Warnings, field.ErrorList) {
+	if !p.Enabled() || len(violations) == 0 {
+		return nil, nil
+	}
+	if p.Mode == PodSecurityModeEnforce {
+		return nil, violations
+	}
+	warnings := make(admission.Warnings, 0, len(violations))
+	for _, violation := range violations {
+		warnings = append(warnings, "pod security policy violation: "+violation.Error())
+	}
+	return warnings, nil
+}
+
+func (p *PodSecurityPolicy) validateEnvVars(container *corev1.Container, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	containerPolicy := p.ContainerEnvVars[container.Name]
+	for i, env := range container.Env {
+		envPath := path.Child("env").Index(i).Child("name")
+		switch {
+		case slices.Contains(p.BlockedEnvVars, env.Name), slices.Contains(containerPolicy.BlockedEnvVars, env.Name):
+			allErrs = append(allErrs, field.Forbidden(envPath,
+				fmt.Sprintf("setting %s in container %q is blocked by the pod security policy", env.Name, container.Name)))
+		case len(containerPolicy.AllowedEnvVars) > 0 && !slices.Contains(containerPolicy.AllowedEnvVars, env.Name):
+			allErrs = append(allErrs, field.Forbidden(envPath,
+				fmt.Sprintf("setting %s in container %q is not allowed by the pod security policy", env.Name, container.Name)))
+		}
+	}
+	return allErrs
+}
+
+func (p *PodSecurityPolicy) validateImage(container *corev1.Container, path *field.Path) field.ErrorList {
+	if len(p.AllowedRegistries) == 0 || container.Image == "" {
+		return nil
+	}
+	repository := imageRepository(container.Image)
+	for _, registry := range p.AllowedRegistries {
+		registry = strings.TrimSuffix(registry, "/")
+		if repository == registry || strings.HasPrefix(repository, registry+"/") {
+			return nil
+		}
+	}
+	return field.ErrorList{field.Forbidden(path.Child("image"),
+		fmt.Sprintf("image %q of container %q is not pulled from an allowed registry %v",
+			container.Image, container.Name, p.AllowedRegistries))}
+}
+
+func (p *PodSecurityPolicy) validateSecurityContext(pod *corev1.Pod, container *corev1.Container, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	required := p.InjectedContainers
+	securityContext := container.SecurityContext
+	if securityContext == nil {
+		securityContext = &corev1.SecurityContext{}
+	}
+	securityContextPath := path.Child("securityContext")
+
+	if required.RunAsNonRoot && !isModelcarContainer(container.Name) {
+		runAsNonRoot := securityContext.RunAsNonRoot
+		runAsUser := securityContext.RunAsUser
+		if podSecurityContext := pod.Spec.SecurityContext; podSecurityContext != nil {
+			if runAsNonRoot == nil {
+				runAsNonRoot = podSecurityContext.RunAsNonRoot
+			}
+			if runAsUser == nil {
+				runAsUser = podSecurityContext.RunAsUser
+			}
+		}
+		if !ptr.Deref(runAsNonRoot, false) {
+			allErrs = append(allErrs, field.Required(securityContextPath.Child("runAsNonRoot"),
+				fmt.Sprintf("injected container %q must run as non-root", container.Name)))
+		} else if runAsUser != nil && *runAsUser == 0 {
+			allErrs = append(allErrs, field.Forbidden(securityContextPath.Child("runAsUser"),
+				fmt.Sprintf("injected container %q must run as non-root", container.Name)))
+		}
+	}
+	if required.ReadOnlyRootFilesystem && !ptr.Deref(securityContext.ReadOnlyRootFilesystem, false) {
+		allErrs = append(allErrs, field.Required(securityContextPath.Child("readOnlyRootFilesystem"),
+			fmt.Sprintf("injected container %q must mount its root filesystem as read-only", container.Name)))
+	}
+	if required.DropAllCapabilities {
+		capabilities := securityContext.Capabilities
+		if capabilities == nil || !slices.Contains(capabilities.Drop, "ALL") || len(capabilities.Add) > 0 {
+			allErrs = append(allErrs, field.Forbidden(securityContextPath.Child("capabilities"),
+				fmt.Sprintf("injected container %q must drop all capabilities", container.Name)))
+		}
+		if ptr.Deref(securityContext.AllowPrivilegeEscalation, true) || ptr.Deref(securityContext.Privileged, false) {
+			allErrs = append(allErrs, field.Forbidden(securityContextPath.Child("allowPrivilegeEscalation"),
+				fmt.Sprintf("injected container %q must not allow privilege escalation", container.Name)))
+		}
+	}
+	return allErrs
+}
+
+// isModelcarContainer reports whether the container runs an OCI model image.
+func isModelcarContainer(name string) bool {
+	return strings.HasPrefix(name, constants.ModelcarContainerName)
+}
+
+// addWritableDir mounts an emptyDir volume of the pod at dir in the container, unless the container already
+// mounts a volume there.
+func addWritableDir(podSpec *corev1.PodSpec, container *corev1.Container, volumeName string, dir string) {
+	if slices.ContainsFunc(container.VolumeMounts, func(mount corev1.VolumeMount) bool {
+		return path.Clean(mount.MountPath) == path.Clean(dir)
+	}) {
+		return
+	}
+	if !slices.ContainsFunc(podSpec.Volumes, func(volume corev1.Volume) bool { return volume.Name == volumeName }) {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name:         volumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+	}
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: volumeName, MountPath: dir})
+}
+
+// imageRepository returns the repository of the image including its registry, without tag nor digest.
+// Images without registry are pulled from docker.io, and their official images from docker.io/library.
+func imageRepository(image string) string {
+	repository, _, _ := strings.Cut(image, "@")
+	if slash := strings.LastIndex(repository, "/"); strings.LastIndex(repository, ":") > slash {
+		repository = repository[:strings.LastIndex(repository, ":")]
+	}
+	first, _, found := strings.Cut(repository, "/")
+	if !found {
+		return "docker.io/library/" + repository
+	}
+	if !strings.ContainsAny(first, ".:") && first != "localhost" {
+		return "docker.io/" + repository
+	}
+	if first == "index.docker.io" {
+		return "docker.io/" + strings.TrimPrefix(repository, first+"/")
+	}
+	return repository
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+func TestNewPodSecurityPolicy(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	tests := map[string]struct {
+		data        map[string]string
+		enabled     bool
+		errContains string
+	}{
+		"no ConfigMap key": {
+			data:    map[string]string{},
+			enabled: false,
+		},
+		"no mode": {
+			data:    map[string]string{PodSecurityConfigMapKeyName: `{"blockedEnvVars": ["LD_PRELOAD"]}`},
+			enabled: false,
+		},
+		"warn mode": {
+			data:    map[string]string{PodSecurityConfigMapKeyName: `{"mode": "warn"}`},
+			enabled: true,
+		},
+		"enforce mode": {
+			data:    map[string]string{PodSecurityConfigMapKeyName: `{"mode": "enforce"}`},
+			enabled: true,
+		},
+		"invalid mode": {
+			data:        map[string]string{PodSecurityConfigMapKeyName: `{"mode": "audit"}`},
+			errContains: `invalid "podSecurity" mode "audit"`,
+		},
+		"invalid json": {
+			data:        map[string]string{PodSecurityConfigMapKeyName: `{"mode": `},
+			errContains: `unable to parse "podSecurity" config`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy, err := NewPodSecurityPolicy(&corev1.ConfigMap{Data: tt.data})
+			if tt.errContains != "" {
+				g.Expect(err).To(gomega.HaveOccurred())
+				g.Expect(err.Error()).To(gomega.ContainSubstring(tt.errContains))
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(policy.Enabled()).To(gomega.Equal(tt.enabled))
+		})
+	}
+}
+
+func TestPodSecurityPolicyValidateContainers(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	policy := &PodSecurityPolicy{
+		Mode:           PodSecurityModeEnforce,
+		BlockedEnvVars: []string{"LD_PRELOAD"},
+		ContainerEnvVars: map[string]ContainerEnvVarPolicy{
+			"kserve-container": {AllowedEnvVars: []string{"MODEL_NAME", "LD_PRELOAD"}},
+			"transformer":      {BlockedEnvVars: []string{"HF_TOKEN"}},
+		},
+		AllowedRegistries: []string{"docker.io/kserve", "quay.io/"},
+	}
+
+	tests := map[string]struct {
+		containers []corev1.Container
+		fields     []string
+	}{
+		"allowed containers": {
+			containers: []corev1.Container{
+				{Name: "kserve-container", Image: "kserve/sklearnserver:latest", Env: []corev1.EnvVar{{Name: "MODEL_NAME"}}},
+				{Name: "transformer", Image: "quay.io/org/transformer@sha256:abcd", Env: []corev1.EnvVar{{Name: "MODEL_NAME"}}},
+				{Name: "runtime-image", Env: []corev1.EnvVar{{Name: "MODEL_NAME"}}},
+			},
+		},
+		"globally blocked env var": {
+			containers: []corev1.Container{
+				{Name: "kserve-container", Env: []corev1.EnvVar{{Name: "MODEL_NAME"}, {Name: "LD_PRELOAD"}}},
+			},
+			fields: []string{"spec.containers[0].env[1].name"},
+		},
+		"env var blocked in the container": {
+			containers: []corev1.Container{
+				{Name: "kserve-container"},
+				{Name: "transformer", Env: []corev1.EnvVar{{Name: "HF_TOKEN"}}},
+			},
+			fields: []string{"spec.containers[1].env[0].name"},
+		},
+		"env var not allowed in the container": {
+			containers: []corev1.Container{
+				{Name: "kserve-container", Env: []corev1.EnvVar{{Name: "STORAGE_URI"}}},
+			},
+			fields: []string{"spec.containers[0].env[0].name"},
+		},
+		"registry not allowed": {
+			containers: []corev1.Container{
+				{Name: "kserve-container", Image: "python:3.11"},
+				{Name: "transformer", Image: "docker.io/kserve-fork/transformer:latest"},
+				{Name: "sidecar", Image: "quay.io.evil.com/org/sidecar:latest"},
+			},
+			fields: []string{"spec.containers[0].image", "spec.containers[1].image", "spec.containers[2].image"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := policy.ValidateContainers(tt.containers, field.NewPath("spec", "containers"))
+			fields := []string{}
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			g.Expect(fields).To(gomega.ConsistOf(tt.fields))
+		})
+	}
+
+	disabled := &PodSecurityPolicy{BlockedEnvVars: []string{"LD_PRELOAD"}}
+	g.Expect(disabled.ValidateContainers([]corev1.Container{{Name: "c", Env: []corev1.EnvVar{{Name: "LD_PRELOAD"}}}},
+		field.NewPath("spec", "containers"))).To(gomega.BeEmpty())
+}
+
+func TestPodSecurityPolicyValidatePod(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	policy := &PodSecurityPolicy{
+		Mode: PodSecurityModeWarn,
+		InjectedContainers: InjectedContainerPolicy{
+			RunAsNonRoot:           true,
+			ReadOnlyRootFilesystem: true,
+			DropAllCapabilities:    true,
+		},
+		AllowedRegistries: []string{"docker.io/kserve"},
+	}
+	isInjected := func(name string) bool { return name == "storage-initializer" || name == "agent" }
+
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			SecurityContext: &corev1.PodSecurityContext{RunAsNonRoot: ptr.To(true)},
+			InitContainers: []corev1.Container{
+				{Name: "storage-initializer", Image: "registry.io/storage-initializer:latest"},
+			},
+			Containers: []corev1.Container{
+				{Name: "kserve-container", Image: "kserve/sklearnserver:latest"},
+				{
+					Name:  "agent",
+					Image: "kserve/agent:latest",
+					SecurityContext: &corev1.SecurityContext{
+						RunAsUser:    ptr.To(int64(0)),
+						Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}},
+					},
+				},
+			},
+		},
+	}
+
+	fields := []string{}
+	for _, err := range policy.ValidatePod(pod, isInjected) {
+		fields = append(fields, err.Field)
+	}
+	// The images of the injected containers are not validated
+	g.Expect(fields).To(gomega.ConsistOf(
+		"spec.initContainers[0].securityContext.readOnlyRootFilesystem",
+		"spec.initContainers[0].securityContext.capabilities",
+		"spec.initContainers[0].securityContext.allowPrivilegeEscalation",
+		"spec.containers[1].securityContext.runAsUser",
+		"spec.containers[1].securityContext.readOnlyRootFilesystem",
+		"spec.containers[1].securityContext.capabilities",
+		"spec.containers[1].securityContext.allowPrivilegeEscalation",
+	))
+
+	for i := range pod.Spec.InitContainers {
+		policy.HardenInjectedContainer(&pod.Spec, &pod.Spec.InitContainers[i])
+	}
+	pod.Spec.Containers[1].SecurityContext = nil
+	policy.HardenInjectedContainer(&pod.Spec, &pod.Spec.Containers[1])
+	g.Expect(policy.ValidatePod(pod, isInjected)).To(gomega.BeEmpty())
+	g.Expect(pod.Spec.Containers[1].SecurityContext).To(gomega.Equal(&corev1.SecurityContext{
+		RunAsNonRoot:             ptr.To(true),
+		ReadOnlyRootFilesystem:   ptr.To(true),
+		AllowPrivilegeEscalation: ptr.To(false),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+	}))
+	// The containers given a read-only root filesystem write their temporary files and caches to emptyDir volumes
+	g.Expect(pod.Spec.Containers[1].VolumeMounts).To(gomega.Equal([]corev1.VolumeMount{
+		{Name: "agent-tmp", MountPath: InjectedTmpDir},
+		{Name: "agent-hf-cache", MountPath: InjectedHFCacheDir},
+	}))
+	g.Expect(pod.Spec.Containers[1].Env).To(gomega.ContainElement(corev1.EnvVar{Name: "HF_HOME", Value: InjectedHFCacheDir}))
+	volumeNames := []string{}
+	for _, volume := range pod.Spec.Volumes {
+		g.Expect(volume.EmptyDir).ToNot(gomega.BeNil())
+		volumeNames = append(volumeNames, volume.Name)
+	}
+	g.Expect(volumeNames).To(gomega.ConsistOf(
+		"storage-initializer-tmp", "storage-initializer-hf-cache", "agent-tmp", "agent-hf-cache"))
+}
+
+func TestPodSecurityPolicyHardenInjectedContainerWritableDirs(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	policy := &PodSecurityPolicy{
+		Mode:               PodSecurityModeEnforce,
+		InjectedContainers: InjectedContainerPolicy{RunAsNonRoot: true, ReadOnlyRootFilesystem: true},
+	}
+	podSpec := &corev1.PodSpec{}
+	// The Hugging Face cache set by the container and its existing mounts are kept
+	container := &corev1.Container{
+		Name:         "storage-initializer",
+		Env:          []corev1.EnvVar{{Name: "HF_HOME", Value: "/mnt/hf"}},
+		VolumeMounts: []corev1.VolumeMount{{Name: "scratch", MountPath: "/tmp/"}},
+	}
+	policy.HardenInjectedContainer(podSpec, container)
+	g.Expect(container.Env).To(gomega.Equal([]corev1.EnvVar{{Name: "HF_HOME", Value: "/mnt/hf"}}))
+	g.Expect(container.VolumeMounts).To(gomega.Equal([]corev1.VolumeMount{
+		{Name: "scratch", MountPath: "/tmp/"},
+		{Name: "storage-initializer-hf-cache", MountPath: "/mnt/hf"},
+	}))
+	g.Expect(podSpec.Volumes).To(gomega.HaveLen(1))
+
+	// The root filesystem set explicitly is left as is
+	container = &corev1.Container{
+		Name:            "agent",
+		SecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)},
+	}
+	policy.HardenInjectedContainer(podSpec, container)
+	g.Expect(container.VolumeMounts).To(gomega.BeEmpty())
+	g.Expect(podSpec.Volumes).To(gomega.HaveLen(1))
+}
+
+func TestPodSecurityPolicyModelcarsRunAsImageUser(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	policy := &PodSecurityPolicy{
+		Mode:               PodSecurityModeEnforce,
+		InjectedContainers: InjectedContainerPolicy{RunAsNonRoot: true, ReadOnlyRootFilesystem: true},
+	}
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: constants.ModelcarInitContainerName, Image: "registry.io/model:v1"}},
+			Containers:     []corev1.Container{{Name: constants.ModelcarContainerName, Image: "registry.io/model:v1"}},
+		},
+	}
+	policy.HardenInjectedContainer(&pod.Spec, &pod.Spec.InitContainers[0])
+	policy.HardenInjectedContainer(&pod.Spec, &pod.Spec.Containers[0])
+	for _, container := range []corev1.Container{pod.Spec.InitContainers[0], pod.Spec.Containers[0]} {
+		g.Expect(container.SecurityContext).To(gomega.Equal(&corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)}))
+		g.Expect(container.Env).To(gomega.BeEmpty())
+		g.Expect(container.VolumeMounts).To(gomega.Equal([]corev1.VolumeMount{
+			{Name: container.Name + "-tmp", MountPath: InjectedTmpDir},
+		}))
+	}
+	g.Expect(policy.ValidatePod(pod, func(string) bool { return true })).To(gomega.BeEmpty())
+}
+
+func TestPodSecurityPolicyHardenInjectedContainerKeepsExplicitFields(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	policy := &PodSecurityPolicy{
+		Mode:               PodSecurityModeEnforce,
+		InjectedContainers: InjectedContainerPolicy{RunAsNonRoot: true, ReadOnlyRootFilesystem: true},
+	}
+	container := &corev1.Container{
+		Name:            "storage-initializer",
+		SecurityContext: &corev1.SecurityContext{RunAsNonRoot: ptr.To(false)},
+	}
+	policy.HardenInjectedContainer(&corev1.PodSpec{}, container)
+	g.Expect(container.SecurityContext).To(gomega.Equal(&corev1.SecurityContext{
+		RunAsNonRoot:           ptr.To(false),
+		ReadOnlyRootFilesystem: ptr.To(true),
+	}))
+}
+
+func TestPodSecurityPolicyReport(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	violations := field.ErrorList{field.Forbidden(field.NewPath("spec", "containers").Index(0).Child("image"), "not allowed")}
+
+	warnings, errs := (&PodSecurityPolicy{Mode: PodSecurityModeWarn}).Report(violations)
+	g.Expect(errs).To(gomega.BeEmpty())
+	g.Expect(warnings).To(gomega.ConsistOf("pod security policy violation: spec.containers[0].image: Forbidden: not allowed"))
+
+	warnings, errs = (&PodSecurityPolicy{Mode: PodSecurityModeEnforce}).Report(violations)
+	g.Expect(warnings).To(gomega.BeEmpty())
+	g.Expect(errs).To(gomega.Equal(violations))
+
+	warnings, errs = (&PodSecurityPolicy{}).Report(violations)
+	g.Expect(warnings).To(gomega.BeEmpty())
+	g.Expect(errs).To(gomega.BeEmpty())
+}
+
+func TestImageRepository(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	for image, repository := range map[string]string{
+		"python":                                  "docker.io/library/python",
+		"python:3.11":                             "docker.io/library/python",
+		"kserve/agent:latest":                     "docker.io/kserve/agent",
+		"index.docker.io/kserve/agent":            "docker.io/kserve/agent",
+		"quay.io/org/model@sha256:abcd":           "quay.io/org/model",
+		"localhost:5000/model:v1":                 "localhost:5000/model",
+		"localhost/model":                         "localhost/model",
+		"registry.io:443/org/model:v1@sha256:abc": "registry.io:443/org/model",
+	} {
+		g.Expect(imageRepository(image)).To(gomega.Equal(repository), image)
+	}
+}
@@ -19,6 +19,7 @@ package localmodelcache
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/kserve/kserve/pkg/utils"
 
@@ -68,6 +69,9 @@ func (v *LocalModelCacheValidator) ValidateCreate(ctx context.Context, obj runti
 	if localModelCacheWithSameStorageURI != nil {
 		return admission.Warnings{}, fmt.Errorf("LocalModelCache %s has the same StorageURI %s", localModelCacheWithSameStorageURI.Name, localModelCacheWithSameStorageURI.Spec.SourceModelUri)
 	}
+	if err := v.validateStorageLimit(ctx, localModelCache); err != nil {
+		return admission.Warnings{}, err
+	}
 	return nil, nil
 }
 
@@ -90,6 +94,19 @@ func (v *LocalModelCacheValidator) ValidateUpdate(ctx context.Context, oldObj, n
 	if localModelCacheWithSameStorageURI != nil {
 		return admission.Warnings{}, fmt.Errorf("LocalModelCache %s has the same StorageURI %s", localModelCacheWithSameStorageURI.Name, localModelCacheWithSameStorageURI.Spec.SourceModelUri)
 	}
+	// Only the updates growing the model cache are checked, so the model caches of node groups whose limit
+	// was lowered can still be updated.
+	oldLocalModelCache, err := utils.Convert[*v1alpha1.LocalModelCache](oldObj)
+	if err != nil {
+		localModelCacheValidatorLogger.Error(err, "Unable to convert object to LocalModelCache")
+		return nil, err
+	}
+	if !oldLocalModelCache.Spec.ModelSize.Equal(localModelCache.Spec.ModelSize) ||
+		!slices.Equal(oldLocalModelCache.Spec.NodeGroups, localModelCache.Spec.NodeGroups) {
+		if err := v.validateStorageLimit(ctx, localModelCache); err != nil {
+			return admission.Warnings{}, err
+		}
+	}
 	return nil, nil
 }
 
@@ -159,3 +176,47 @@ func (v *LocalModelCacheValidator) validateUniqueStorageURI(ctx context.Context,
 	}
 	return nil, nil
 }
+
+// Checks that the model caches of each node group of the LocalModelCache fit in the node group storage limit
+func (v *LocalModelCacheValidator) validateStorageLimit(ctx context.Context, currentLocalModelCache *v1alpha1.LocalModelCache) error {
+	localModelCacheList := &v1alpha1.LocalModelCacheList{}
+	if err := v.List(ctx, localModelCacheList); err != nil {
+		localModelCacheValidatorLogger.Error(err, "Unable to list LocalModelCaches")
+		return err
+	}
+	localModelNamespaceCacheList := &v1alpha1.LocalModelNamespaceCacheList{}
+	if err := v.List(ctx, localModelNamespaceCacheList); err != nil {
+		localModelCacheValidatorLogger.Error(err, "Unable to list LocalModelNamespaceCaches")
+		return err
+	}
+	localModelNodeList := &v1alpha1.LocalModelNodeList{}
+	if err := v.List(ctx, localModelNodeList); err != nil {
+		localModelCacheValidatorLogger.Error(err, "Unable to list LocalModelNodes")
+		return err
+	}
+	// Replace the stored version of the current LocalModelCache with the one being validated
+	localModelCaches := []v1alpha1.LocalModelCache{*currentLocalModelCache}
+	for _, localModelCache := range localModelCacheList.Items {
+		if localModelCache.Name != currentLocalModelCache.Name {
+			localModelCaches = append(localModelCaches, localModelCache)
+		}
+	}
+
+	for _, nodeGroupName := range currentLocalModelCache.Spec.NodeGroups {
+		nodeGroup := &v1alpha1.LocalModelNodeGroup{}
+		if err := v.Get(ctx, client.ObjectKey{Name: nodeGroupName}, nodeGroup); err != nil {
+			// The LocalModelCache is reconciled once the node group is created
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			localModelCacheValidatorLogger.Error(err, "Unable to get LocalModelNodeGroup", "name", nodeGroupName)
+			return err
+		}
+		used := nodeGroup.GetStorageUsage(localModelCaches, localModelNamespaceCacheList.Items, localModelNodeList.Items)
+		if used.Cmp(nodeGroup.Spec.StorageLimit) > 0 {
+			return fmt.Errorf("LocalModelCache %s of size %s exceeds the storage limit %s of node group %s, %s would be used",
+				currentLocalModelCache.Name, currentLocalModelCache.Spec.ModelSize.String(), nodeGroup.Spec.StorageLimit.String(), nodeGroupName, used.String())
+		}
+	}
+	return nil
+}
@@ -248,3 +248,71 @@ func TestValidateUpdate_InvalidObjectType(t *testing.T) {
 	g.Expect(err).To(gomega.HaveOccurred())
 	g.Expect(err.Error()).To(gomega.ContainSubstring("expected *v1alpha1.LocalModelCache"))
 }
+
+func makeTestLocalModelNodeGroup() v1alpha1.LocalModelNodeGroup {
+	return v1alpha1.LocalModelNodeGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "gpu1",
+		},
+		Spec: v1alpha1.LocalModelNodeGroupSpec{
+			StorageLimit: resource.MustParse("2Gi"),
+		},
+	}
+}
+
+func TestValidateCreate_LocalModelCacheStorageLimit(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	existingLmc := makeTestLocalModelCache()
+	nodeGroup := makeTestLocalModelNodeGroup()
+	s := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(s)
+	if err != nil {
+		t.Errorf("unable to add scheme : %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(&existingLmc, &nodeGroup).WithScheme(s).Build()
+	validator := LocalModelCacheValidator{fakeClient}
+
+	// The node group has 1Gi left
+	newLmc := makeTestLocalModelCacheWithDifferentStorageURI()
+	warnings, err := validator.ValidateCreate(t.Context(), &newLmc)
+	g.Expect(warnings).To(gomega.BeNil())
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	newLmc.Spec.ModelSize = resource.MustParse("2Gi")
+	warnings, err = validator.ValidateCreate(t.Context(), &newLmc)
+	g.Expect(warnings).NotTo(gomega.BeNil())
+	g.Expect(err).To(gomega.MatchError(fmt.Errorf("LocalModelCache %s of size 2Gi exceeds the storage limit 2Gi of node group gpu1, 3Gi would be used", newLmc.Name)))
+}
+
+func TestValidateUpdate_LocalModelCacheStorageLimit(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	existingLmc := makeTestLocalModelCache()
+	nodeGroup := makeTestLocalModelNodeGroup()
+	nodeGroup.Spec.StorageLimit = resource.MustParse("1536Mi")
+	s := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(s)
+	if err != nil {
+		t.Errorf("unable to add scheme : %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(&existingLmc, &nodeGroup).WithScheme(s).Build()
+	validator := LocalModelCacheValidator{fakeClient}
+
+	// Updates not changing the model size are allowed
+	oldLmc := makeTestLocalModelCache()
+	newLmc := makeTestLocalModelCache()
+	newLmc.Labels = map[string]string{"app": "iris"}
+	warnings, err := validator.ValidateUpdate(t.Context(), &oldLmc, &newLmc)
+	g.Expect(warnings).To(gomega.BeNil())
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	// The model cache replaces its stored version in the accounting
+	newLmc.Spec.ModelSize = resource.MustParse("1536Mi")
+	warnings, err = validator.ValidateUpdate(t.Context(), &oldLmc, &newLmc)
+	g.Expect(warnings).To(gomega.BeNil())
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	newLmc.Spec.ModelSize = resource.MustParse("2Gi")
+	warnings, err = validator.ValidateUpdate(t.Context(), &oldLmc, &newLmc)
+	g.Expect(warnings).NotTo(gomega.BeNil())
+	g.Expect(err).To(gomega.HaveOccurred())
+}
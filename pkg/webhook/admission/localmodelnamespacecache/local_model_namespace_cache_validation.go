@@ -19,6 +19,7 @@ package localmodelnamespacecache
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/kserve/kserve/pkg/utils"
 
@@ -64,6 +65,9 @@ func (v *LocalModelNamespaceCacheValidator) ValidateCreate(ctx context.Context,
 	if err := v.validateNodeGroups(ctx, localModelNamespaceCache); err != nil {
 		return nil, err
 	}
+	if err := v.validateStorageLimit(ctx, localModelNamespaceCache); err != nil {
+		return nil, err
+	}
 
 	return nil, nil
 }
@@ -75,6 +79,11 @@ func (v *LocalModelNamespaceCacheValidator) ValidateUpdate(ctx context.Context,
 		localModelNamespaceCacheValidatorLogger.Error(err, "Unable to convert object to LocalModelNamespaceCache")
 		return nil, err
 	}
+	oldLocalModelNamespaceCache, err := utils.Convert[*v1alpha1.LocalModelNamespaceCache](oldObj)
+	if err != nil {
+		localModelNamespaceCacheValidatorLogger.Error(err, "Unable to convert object to LocalModelNamespaceCache")
+		return nil, err
+	}
 	if localModelNamespaceCache.GetDeletionTimestamp() != nil {
 		return nil, nil
 	}
@@ -83,6 +92,13 @@ func (v *LocalModelNamespaceCacheValidator) ValidateUpdate(ctx context.Context,
 	if err := v.validateNodeGroups(ctx, localModelNamespaceCache); err != nil {
 		return nil, err
 	}
+	// The storage limit is only checked when the model cache grows or moves to other node groups
+	if !oldLocalModelNamespaceCache.Spec.ModelSize.Equal(localModelNamespaceCache.Spec.ModelSize) ||
+		!slices.Equal(oldLocalModelNamespaceCache.Spec.NodeGroups, localModelNamespaceCache.Spec.NodeGroups) {
+		if err := v.validateStorageLimit(ctx, localModelNamespaceCache); err != nil {
+			return nil, err
+		}
+	}
 
 	return nil, nil
 }
@@ -147,3 +163,45 @@ func (v *LocalModelNamespaceCacheValidator) validateNodeGroups(ctx context.Conte
 	}
 	return nil
 }
+
+// validateStorageLimit checks that the model caches of each node group of the LocalModelNamespaceCache fit in the
+// node group storage limit
+func (v *LocalModelNamespaceCacheValidator) validateStorageLimit(ctx context.Context, currentCache *v1alpha1.LocalModelNamespaceCache) error {
+	localModelCacheList := &v1alpha1.LocalModelCacheList{}
+	if err := v.List(ctx, localModelCacheList); err != nil {
+		localModelNamespaceCacheValidatorLogger.Error(err, "Unable to list LocalModelCaches")
+		return err
+	}
+	localModelNamespaceCacheList := &v1alpha1.LocalModelNamespaceCacheList{}
+	if err := v.List(ctx, localModelNamespaceCacheList); err != nil {
+		localModelNamespaceCacheValidatorLogger.Error(err, "Unable to list LocalModelNamespaceCaches")
+		return err
+	}
+	localModelNodeList := &v1alpha1.LocalModelNodeList{}
+	if err := v.List(ctx, localModelNodeList); err != nil {
+		localModelNamespaceCacheValidatorLogger.Error(err, "Unable to list LocalModelNodes")
+		return err
+	}
+	// Replace the stored version of the current LocalModelNamespaceCache with the one being validated
+	namespaceCaches := []v1alpha1.LocalModelNamespaceCache{*currentCache}
+	for _, namespaceCache := range localModelNamespaceCacheList.Items {
+		if namespaceCache.Name != currentCache.Name || namespaceCache.Namespace != currentCache.Namespace {
+			namespaceCaches = append(namespaceCaches, namespaceCache)
+		}
+	}
+
+	for _, nodeGroupName := range currentCache.Spec.NodeGroups {
+		nodeGroup := &v1alpha1.LocalModelNodeGroup{}
+		if err := v.Get(ctx, client.ObjectKey{Name: nodeGroupName}, nodeGroup); err != nil {
+			localModelNamespaceCacheValidatorLogger.Error(err, "Unable to get LocalModelNodeGroup", "name", nodeGroupName)
+			return err
+		}
+		used := nodeGroup.GetStorageUsage(localModelCacheList.Items, namespaceCaches, localModelNodeList.Items)
+		if used.Cmp(nodeGroup.Spec.StorageLimit) > 0 {
+			return fmt.Errorf("LocalModelNamespaceCache %s/%s of size %s exceeds the storage limit %s of node group %s, %s would be used",
+				currentCache.Namespace, currentCache.Name, currentCache.Spec.ModelSize.String(), nodeGroup.Spec.StorageLimit.String(),
+				nodeGroupName, used.String())
+		}
+	}
+	return nil
+}
@@ -261,3 +261,63 @@ func TestValidateUpdate_LocalModelNamespaceCacheInvalidObjectType(t *testing.T)
 	g.Expect(err).To(gomega.HaveOccurred())
 	g.Expect(err.Error()).To(gomega.ContainSubstring("expected *v1alpha1.LocalModelNamespaceCache"))
 }
+
+func TestValidateCreate_LocalModelNamespaceCacheStorageLimit(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	nodeGroup := makeTestLocalModelNodeGroup("gpu1")
+	nodeGroup.Spec.StorageLimit = resource.MustParse("2Gi")
+	// A cluster model cache uses 1Gi of the node group
+	existingLmc := v1alpha1.LocalModelCache{
+		ObjectMeta: metav1.ObjectMeta{Name: "iris-cluster"},
+		Spec: v1alpha1.LocalModelCacheSpec{
+			ModelSize:      resource.MustParse("1Gi"),
+			NodeGroups:     []string{"gpu1"},
+			SourceModelUri: "gs://testbucket/othermodel",
+		},
+	}
+	s := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(s)
+	if err != nil {
+		t.Errorf("unable to add scheme : %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(&existingLmc, &nodeGroup).WithScheme(s).Build()
+	validator := LocalModelNamespaceCacheValidator{Client: fakeClient}
+
+	lmnc := makeTestLocalModelNamespaceCache()
+	warnings, err := validator.ValidateCreate(t.Context(), &lmnc)
+	g.Expect(warnings).To(gomega.BeNil())
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	lmnc.Spec.ModelSize = resource.MustParse("2Gi")
+	warnings, err = validator.ValidateCreate(t.Context(), &lmnc)
+	g.Expect(warnings).To(gomega.BeNil())
+	g.Expect(err).To(gomega.MatchError(fmt.Errorf("LocalModelNamespaceCache %s/%s of size 2Gi exceeds the storage limit 2Gi of node group gpu1, 3Gi would be used",
+		lmnc.Namespace, lmnc.Name)))
+}
+
+func TestValidateUpdate_LocalModelNamespaceCacheStorageLimit(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	nodeGroup := makeTestLocalModelNodeGroup("gpu1")
+	nodeGroup.Spec.StorageLimit = resource.MustParse("1536Mi")
+	existingLmnc := makeTestLocalModelNamespaceCache()
+	s := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(s)
+	if err != nil {
+		t.Errorf("unable to add scheme : %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(&existingLmnc, &nodeGroup).WithScheme(s).Build()
+	validator := LocalModelNamespaceCacheValidator{Client: fakeClient}
+
+	// The namespace model cache replaces its stored version in the accounting
+	oldLmnc := makeTestLocalModelNamespaceCache()
+	newLmnc := makeTestLocalModelNamespaceCache()
+	newLmnc.Spec.ModelSize = resource.MustParse("1536Mi")
+	warnings, err := validator.ValidateUpdate(t.Context(), &oldLmnc, &newLmnc)
+	g.Expect(warnings).To(gomega.BeNil())
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	newLmnc.Spec.ModelSize = resource.MustParse("2Gi")
+	warnings, err = validator.ValidateUpdate(t.Context(), &oldLmnc, &newLmnc)
+	g.Expect(warnings).To(gomega.BeNil())
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -41,6 +41,9 @@ const (
 	LoggerArgumentMode                = "--log-mode"
 	LoggerArgumentStorePath           = "--log-store-path"
 	LoggerArgumentStoreFormat         = "--log-store-format"
+	LoggerArgumentStoreStorageClass   = "--log-store-storage-class"
+	LoggerArgumentStoreKmsKeyId       = "--log-store-kms-key-id"
+	LoggerArgumentStoreTags           = "--log-store-tags"
 	LoggerArgumentMarshallerUrl       = "--log-marshaller-url"
 	LoggerArgumentMarshallerPort      = "--log-marshaller-port"
 	LoggerArgumentBatchSize           = "--log-batch-size"
@@ -276,6 +279,18 @@ func (ag *AgentInjector) InjectAgent(pod *corev1.Pod) error {
 			loggerArgs = append(loggerArgs, LoggerArgumentStoreFormat)
 			loggerArgs = append(loggerArgs, storageFormat)
 		}
+		if ag.loggerConfig.Store != nil && ag.loggerConfig.Store.Parameters != nil {
+			// The upload options of the stored log objects
+			for _, param := range []struct{ key, argument string }{
+				{constants.LoggerStorageClassKey, LoggerArgumentStoreStorageClass},
+				{constants.LoggerKmsKeyIdKey, LoggerArgumentStoreKmsKeyId},
+				{constants.LoggerTagsKey, LoggerArgumentStoreTags},
+			} {
+				if value := (*ag.loggerConfig.Store.Parameters)[param.key]; value != "" {
+					loggerArgs = append(loggerArgs, param.argument, value)
+				}
+			}
+		}
 		if ag.loggerConfig.MarshallerURL != "" {
 			loggerArgs = append(loggerArgs, LoggerArgumentMarshallerUrl, ag.loggerConfig.MarshallerURL)
 		}
@@ -18,6 +18,7 @@ package pod
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	corev1 "k8s.io/api/core/v1"
@@ -31,11 +32,15 @@ import (
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/credentials"
 	kservetypes "github.com/kserve/kserve/pkg/types"
+	"github.com/kserve/kserve/pkg/validation"
 )
 
 // +kubebuilder:webhook:path=/mutate-pods,mutating=true,failurePolicy=fail,groups="",resources=pods,verbs=create,versions=v1,name=inferenceservice.kserve-webhook-server.pod-mutator,reinvocationPolicy=IfNeeded
 var log = logf.Log.WithName(constants.PodMutatorWebhookName)
 
+// OciVerificationMutationName is the name of the mutation verifying the OCI model images against their registry
+const OciVerificationMutationName = "VerifyOciModels"
+
 // Mutator is a webhook that injects incoming pods
 type Mutator struct {
 	Client    client.Client
@@ -43,6 +48,12 @@ type Mutator struct {
 	Decoder   admission.Decoder
 }
 
+// PodMutation is a named step of the mutation chain of the pods
+type PodMutation struct {
+	Name   string
+	Mutate func(pod *corev1.Pod) error
+}
+
 // Handle decodes the incoming Pod and executes mutation logic.
 func (mutator *Mutator) Handle(ctx context.Context, req admission.Request) admission.Response {
 	pod := &corev1.Pod{}
@@ -76,8 +87,17 @@ func (mutator *Mutator) Handle(ctx context.Context, req admission.Request) admis
 	// For some reason pod namespace is always empty when coming to pod mutator, need to set from admission request
 	pod.Namespace = req.Namespace
 
-	if err := mutator.mutate(ctx, pod, configMap, isvc); err != nil {
+	warnings, err := mutator.mutate(ctx, pod, configMap, isvc)
+	if err != nil {
 		log.Error(err, "Failed to mutate pod", "name", pod.Labels[constants.InferenceServicePodLabelKey])
+		var verificationErr *OciVerificationError
+		if errors.As(err, &verificationErr) {
+			return admission.Denied(verificationErr.Error())
+		}
+		var violationErr *PodSecurityViolationError
+		if errors.As(err, &violationErr) {
+			return admission.Denied(violationErr.Error()).WithWarnings(warnings...)
+		}
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
@@ -87,15 +107,36 @@ func (mutator *Mutator) Handle(ctx context.Context, req admission.Request) admis
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
-	return admission.PatchResponseFromRaw(req.Object.Raw, patch)
+	return admission.PatchResponseFromRaw(req.Object.Raw, patch).WithWarnings(warnings...)
 }
 
-func (mutator *Mutator) mutate(ctx context.Context, pod *corev1.Pod, configMap *corev1.ConfigMap, isvc *v1beta1.InferenceService) error {
+func (mutator *Mutator) mutate(ctx context.Context, pod *corev1.Pod, configMap *corev1.ConfigMap, isvc *v1beta1.InferenceService) (admission.Warnings, error) {
+	mutations, err := mutator.Mutations(ctx, pod, configMap, isvc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mutation := range mutations {
+		if err := mutation.Mutate(pod); err != nil {
+			return nil, err
+		}
+	}
+
+	// The mutated pod is validated against the pod security policy once all the containers are injected
+	podSecurityPolicy, err := validation.NewPodSecurityPolicy(configMap)
+	if err != nil {
+		return nil, err
+	}
+	return ValidatePodSecurity(podSecurityPolicy, pod)
+}
+
+// Mutations returns the chain of mutations applied in order to the pod of the InferenceService.
+func (mutator *Mutator) Mutations(ctx context.Context, pod *corev1.Pod, configMap *corev1.ConfigMap, isvc *v1beta1.InferenceService) ([]PodMutation, error) {
 	credentialBuilder := credentials.NewCredentialBuilder(mutator.Client, mutator.Clientset, configMap)
 
 	storageInitializerConfig, err := v1beta1.GetStorageInitializerConfigs(configMap)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	storageInitializer := &StorageInitializerInjector{
@@ -106,17 +147,17 @@ func (mutator *Mutator) mutate(ctx context.Context, pod *corev1.Pod, configMap *
 
 	loggerConfig, err := getLoggerConfigs(pod, configMap, isvc)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	batcherConfig, err := getBatcherConfigs(configMap)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	agentConfig, err := getAgentConfigs(configMap)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	agentInjector := &AgentInjector{
@@ -126,29 +167,54 @@ func (mutator *Mutator) mutate(ctx context.Context, pod *corev1.Pod, configMap *
 		batcherConfig:     batcherConfig,
 	}
 
+	storageHelperInjector := &StorageHelperInjector{
+		client:            mutator.Client,
+		credentialBuilder: credentialBuilder,
+		agentConfig:       agentConfig,
+	}
+
 	metricsAggregator := newMetricsAggregator(configMap)
 
-	mutators := []func(pod *corev1.Pod) error{
-		InjectGKEAcceleratorSelector,
-		func(pod *corev1.Pod) error {
+	mutations := []PodMutation{
+		{Name: "InjectGKEAcceleratorSelector", Mutate: InjectGKEAcceleratorSelector},
+		{Name: "InjectStorageInitializer", Mutate: func(pod *corev1.Pod) error {
 			return storageInitializer.InjectStorageInitializer(ctx, pod)
-		},
-		storageInitializer.SetIstioCniSecurityContext,
-		agentInjector.InjectAgent,
-		metricsAggregator.InjectMetricsAggregator,
+		}},
+		{Name: "SetIstioCniSecurityContext", Mutate: storageInitializer.SetIstioCniSecurityContext},
+		{Name: "InjectAgent", Mutate: agentInjector.InjectAgent},
+		{Name: "InjectStorageHelpers", Mutate: func(pod *corev1.Pod) error {
+			return storageHelperInjector.InjectStorageHelpers(ctx, pod)
+		}},
+		{Name: "InjectMetricsAggregator", Mutate: metricsAggregator.InjectMetricsAggregator},
 	}
 
 	if kservetypes.ResolveOciModelMode(storageInitializer.config) != "" {
-		mutators = append(mutators, storageInitializer.InjectModelcar)
+		mutations = append(mutations, PodMutation{Name: "InjectModelcar", Mutate: storageInitializer.InjectModelcar})
+	}
+
+	podSecurityPolicy, err := validation.NewPodSecurityPolicy(configMap)
+	if err != nil {
+		return nil, err
+	}
+	if podSecurityPolicy.Enabled() {
+		mutations = append(mutations, PodMutation{Name: "HardenInjectedContainers", Mutate: func(pod *corev1.Pod) error {
+			HardenInjectedContainers(podSecurityPolicy, pod)
+			return nil
+		}})
 	}
 
-	for _, mutator := range mutators {
-		if err := mutator(pod); err != nil {
-			return err
+	// The OCI model images are verified once all of them are injected
+	if policy := storageInitializer.config.OciVerification; policy != nil {
+		ociVerifier, err := NewOciVerifier(policy, storageInitializer.config.OciInsecureRegistry, mutator.Clientset)
+		if err != nil {
+			return nil, err
 		}
+		mutations = append(mutations, PodMutation{Name: OciVerificationMutationName, Mutate: func(pod *corev1.Pod) error {
+			return ociVerifier.VerifyOciModels(ctx, pod)
+		}})
 	}
 
-	return nil
+	return mutations, nil
 }
 
 func needMutate(pod *corev1.Pod) bool {
@@ -360,6 +360,60 @@ func TestInjectModelcarGate(t *testing.T) {
 	}
 }
 
+func TestMutatorMutations(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	publicKey, err := json.Marshal(publicKeyPEM(t, generateKey(t)))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	resources := `"memoryRequest": "100Mi", "memoryLimit": "1Gi", "cpuRequest": "100m", "cpuLimit": "1"`
+	baseMutations := []string{
+		"InjectGKEAcceleratorSelector", "InjectStorageInitializer", "SetIstioCniSecurityContext",
+		"InjectAgent", "InjectStorageHelpers", "InjectMetricsAggregator",
+	}
+
+	tests := []struct {
+		name              string
+		storageConfig     string
+		expectedMutations []string
+	}{
+		{
+			name:              "OCI support disabled",
+			storageConfig:     `{` + resources + `}`,
+			expectedMutations: baseMutations,
+		},
+		{
+			name:              "OCI support enabled",
+			storageConfig:     `{` + resources + `, "ociModelMode": "modelcar"}`,
+			expectedMutations: append(append([]string{}, baseMutations...), "InjectModelcar"),
+		},
+		{
+			name:              "OCI models verified once injected",
+			storageConfig:     `{` + resources + `, "ociModelMode": "modelcar", "ociVerification": {"publicKeys": [` + string(publicKey) + `]}}`,
+			expectedMutations: append(append([]string{}, baseMutations...), "InjectModelcar", OciVerificationMutationName),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			configMap := &corev1.ConfigMap{
+				Data: map[string]string{
+					v1beta1.StorageInitializerConfigMapKeyName: tc.storageConfig,
+					constants.AgentConfigMapKeyName:            `{` + resources + `}`,
+					BatcherConfigMapKeyName:                    `{` + resources + `}`,
+					LoggerConfigMapKeyName:                     `{` + resources + `}`,
+				},
+			}
+			mutator := &Mutator{}
+			mutations, err := mutator.Mutations(t.Context(), &corev1.Pod{}, configMap, &v1beta1.InferenceService{})
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			names := []string{}
+			for _, mutation := range mutations {
+				names = append(names, mutation.Name)
+			}
+			g.Expect(names).To(gomega.Equal(tc.expectedMutations))
+		})
+	}
+}
+
 // sortPatches sorts the slice of patches by Path so that the comparison works
 // when there are > 1 patches. Note: make sure the matcher Patches are sorted.
 func sortPatches(patches []jsonpatch.JsonPatchOperation) {
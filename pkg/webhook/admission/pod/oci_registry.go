@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	ociManifestAcceptTypes = "application/vnd.oci.image.index.v1+json, application/vnd.oci.image.manifest.v1+json, " +
+		"application/vnd.docker.distribution.manifest.list.v2+json, application/vnd.docker.distribution.manifest.v2+json"
+	// Maximum size of the manifests and of the signature and attestation blobs read from the registries
+	ociMaxContentSize = 16 << 20
+	// Registry of the images without registry, as named by go-containerregistry
+	dockerHubRegistry = "index.docker.io"
+)
+
+var (
+	errOciManifestNotFound = errors.New("manifest not found")
+	authChallengeParam     = regexp.MustCompile(`(\w+)="([^"]*)"`)
+)
+
+type ociRegistryCredential struct {
+	Username string
+	Password string
+}
+
+// ociRegistryClient reads the manifests and blobs of OCI registries with the distribution API, authenticating
+// with the credentials of the image pull secrets of the pod
+type ociRegistryClient struct {
+	httpClient  *http.Client
+	credentials map[string]ociRegistryCredential
+	// Bearer tokens of the repositories
+	tokens map[string]string
+}
+
+func newOciRegistryClient(httpClient *http.Client, credentials map[string]ociRegistryCredential) *ociRegistryClient {
+	return &ociRegistryClient{
+		httpClient:  httpClient,
+		credentials: credentials,
+		tokens:      map[string]string{},
+	}
+}
+
+// getManifest returns the manifest of the tag or digest and its digest
+func (c *ociRegistryClient) getManifest(ctx context.Context, repo name.Repository, reference string) ([]byte, string, error) {
+	manifestURL := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", repo.Scheme(), repo.RegistryStr(), repo.RepositoryStr(), reference)
+	body, err := c.get(ctx, repo, manifestURL, ociManifestAcceptTypes)
+	if err != nil {
+		return nil, "", err
+	}
+	digest := sha256Digest(body)
+	if strings.HasPrefix(reference, "sha256:") && reference != digest {
+		return nil, "", fmt.Errorf("manifest %s of %s has digest %s", reference, repo.Name(), digest)
+	}
+	return body, digest, nil
+}
+
+// getBlob returns the blob of the digest
+func (c *ociRegistryClient) getBlob(ctx context.Context, repo name.Repository, digest string) ([]byte, error) {
+	blobURL := fmt.Sprintf("%s://%s/v2/%s/blobs/%s", repo.Scheme(), repo.RegistryStr(), repo.RepositoryStr(), digest)
+	body, err := c.get(ctx, repo, blobURL, "")
+	if err != nil {
+		return nil, err
+	}
+	if actual := sha256Digest(body); actual != digest {
+		return nil, fmt.Errorf("blob %s of %s has digest %s", digest, repo.Name(), actual)
+	}
+	return body, nil
+}
+
+func (c *ociRegistryClient) get(ctx context.Context, repo name.Repository, resourceURL string, accept string) ([]byte, error) {
+	resp, err := c.do(ctx, repo, resourceURL, accept)
+	if err != nil {
+		return nil, err
+	}
+	// Authenticate with the challenge of the registry and retry
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := c.authenticate(ctx, repo, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = c.do(ctx, repo, resourceURL, accept); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errOciManifestNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("registry %s returned status %d for %s", repo.RegistryStr(), resp.StatusCode, resourceURL)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, ociMaxContentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > ociMaxContentSize {
+		return nil, fmt.Errorf("%s exceeds the maximum size of %d bytes", resourceURL, ociMaxContentSize)
+	}
+	return body, nil
+}
+
+func (c *ociRegistryClient) do(ctx context.Context, repo name.Repository, resourceURL string, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resourceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if token, ok := c.tokens[repo.Name()]; ok {
+		req.Header.Set("Authorization", token)
+	}
+	return c.httpClient.Do(req)
+}
+
+// authenticate sets the authorization of the repository for the Basic or Bearer challenge of the registry
+func (c *ociRegistryClient) authenticate(ctx context.Context, repo name.Repository, challenge string) error {
+	credential, hasCredential := c.credentials[repo.RegistryStr()]
+	scheme, params, _ := strings.Cut(challenge, " ")
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if !hasCredential {
+			return fmt.Errorf("registry %s requires credentials but the pod has no image pull secret for it", repo.RegistryStr())
+		}
+		c.tokens[repo.Name()] = "Basic " + basicAuth(credential)
+		return nil
+	case "bearer":
+	default:
+		return fmt.Errorf("registry %s returned unsupported authentication challenge %q", repo.RegistryStr(), challenge)
+	}
+
+	challengeParams := map[string]string{}
+	for _, match := range authChallengeParam.FindAllStringSubmatch(params, -1) {
+		challengeParams[match[1]] = match[2]
+	}
+	realm, err := url.Parse(challengeParams["realm"])
+	if err != nil || realm.Host == "" {
+		return fmt.Errorf("registry %s returned invalid authentication realm %q", repo.RegistryStr(), challengeParams["realm"])
+	}
+	query := realm.Query()
+	if service, ok := challengeParams["service"]; ok {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", repo.RepositoryStr()))
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	if hasCredential {
+		req.Header.Set("Authorization", "Basic "+basicAuth(credential))
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get token of registry %s: status %d", repo.RegistryStr(), resp.StatusCode)
+	}
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, ociMaxContentSize)).Decode(&token); err != nil {
+		return fmt.Errorf("failed to decode token of registry %s: %w", repo.RegistryStr(), err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	c.tokens[repo.Name()] = "Bearer " + token.Token
+	return nil
+}
+
+// getOciRegistryCredentials returns the registry credentials of the docker config image pull secrets of the pod
+func getOciRegistryCredentials(ctx context.Context, clientset kubernetes.Interface, namespace string,
+	pullSecrets []corev1.LocalObjectReference,
+) (map[string]ociRegistryCredential, error) {
+	credentials := map[string]ociRegistryCredential{}
+	if clientset == nil {
+		return credentials, nil
+	}
+	for _, pullSecret := range pullSecrets {
+		secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, pullSecret.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			// Like the kubelet, missing image pull secrets are ignored
+			log.Info("Image pull secret not found", "name", pullSecret.Name, "namespace", namespace)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		auths := map[string]dockerConfigAuth{}
+		if data, ok := secret.Data[corev1.DockerConfigJsonKey]; ok {
+			config := struct {
+				Auths map[string]dockerConfigAuth `json:"auths"`
+			}{}
+			if err := json.Unmarshal(data, &config); err != nil {
+				return nil, fmt.Errorf("invalid docker config of image pull secret %s: %w", pullSecret.Name, err)
+			}
+			auths = config.Auths
+		} else if data, ok := secret.Data[corev1.DockerConfigKey]; ok {
+			if err := json.Unmarshal(data, &auths); err != nil {
+				return nil, fmt.Errorf("invalid docker config of image pull secret %s: %w", pullSecret.Name, err)
+			}
+		}
+		for server, auth := range auths {
+			registry := normalizeRegistry(server)
+			if _, ok := credentials[registry]; ok {
+				continue
+			}
+			if credential, ok := auth.credential(); ok {
+				credentials[registry] = credential
+			}
+		}
+	}
+	return credentials, nil
+}
+
+type dockerConfigAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+func (a dockerConfigAuth) credential() (ociRegistryCredential, bool) {
+	if a.Auth != "" {
+		decoded, err := base64.StdEncoding.DecodeString(a.Auth)
+		if err != nil {
+			return ociRegistryCredential{}, false
+		}
+		username, password, ok := strings.Cut(string(decoded), ":")
+		return ociRegistryCredential{Username: username, Password: password}, ok
+	}
+	return ociRegistryCredential{Username: a.Username, Password: a.Password}, a.Username != ""
+}
+
+// normalizeRegistry returns the registry host of a docker config server, e.g. https://index.docker.io/v1/
+func normalizeRegistry(server string) string {
+	registry := strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	registry, _, _ = strings.Cut(registry, "/")
+	switch registry {
+	case "docker.io", "registry-1.docker.io":
+		return dockerHubRegistry
+	}
+	return registry
+}
+
+func basicAuth(credential ociRegistryCredential) string {
+	return base64.StdEncoding.EncodeToString([]byte(credential.Username + ":" + credential.Password))
+}
+
+func sha256Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,710 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/types"
+	"github.com/kserve/kserve/pkg/utils"
+)
+
+/*
+The OCI model images are verified with the signatures and attestations stored by cosign in the repository of the
+image: https://github.com/sigstore/cosign/blob/main/specs/SIGNATURE_SPEC.md. Keyless signatures are verified with
+the Fulcio certificate of the signature and the signed entry timestamp of the Rekor transparency log, whose entry
+must record the signature: a hashedrekord entry for the signatures, an intoto or dsse entry for the attestations.
+*/
+const (
+	cosignSimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	dsseEnvelopeMediaType        = "application/vnd.dsse.envelope.v1+json"
+	inTotoPayloadType            = "application/vnd.in-toto+json"
+	cosignSignatureAnnotation    = "dev.cosignproject.cosign/signature"
+	cosignCertificateAnnotation  = "dev.sigstore.cosign/certificate"
+	cosignChainAnnotation        = "dev.sigstore.cosign/chain"
+	cosignBundleAnnotation       = "dev.sigstore.cosign/bundle"
+	// Timeout of the verification of the images of a pod, shorter than the timeout of the webhook
+	ociVerificationTimeout = 8 * time.Second
+)
+
+var (
+	// Extensions of the OIDC issuer of the Fulcio certificates
+	fulcioIssuerV1OID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	fulcioIssuerV2OID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// OciVerificationError is returned when an OCI model image does not satisfy the verification policy,
+// the admission of the pod is denied
+type OciVerificationError struct {
+	Image string
+	Err   error
+}
+
+func (e *OciVerificationError) Error() string {
+	return fmt.Sprintf("OCI model image %s failed verification: %v", e.Image, e.Err)
+}
+
+func (e *OciVerificationError) Unwrap() error {
+	return e.Err
+}
+
+// OciVerifier verifies the OCI model images of the pods with the verification policy of the storage initializer
+// config, and pins the images by digest
+type OciVerifier struct {
+	clientset            kubernetes.Interface
+	httpClient           *http.Client
+	insecure             bool
+	publicKeys           []crypto.PublicKey
+	identities           []ociIdentityMatcher
+	fulcioRoots          *x509.CertPool
+	rekorKeys            []crypto.PublicKey
+	requiredAttestations []string
+}
+
+type ociIdentityMatcher struct {
+	issuer  string
+	subject string
+	regexp  *regexp.Regexp
+}
+
+func NewOciVerifier(policy *types.OciVerificationPolicy, insecure bool, clientset kubernetes.Interface) (*OciVerifier, error) {
+	verifier := &OciVerifier{
+		clientset:            clientset,
+		httpClient:           &http.Client{},
+		insecure:             insecure,
+		fulcioRoots:          x509.NewCertPool(),
+		requiredAttestations: policy.RequiredAttestations,
+	}
+	for _, key := range policy.PublicKeys {
+		publicKey, err := parsePublicKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid OCI verification public key: %w", err)
+		}
+		verifier.publicKeys = append(verifier.publicKeys, publicKey)
+	}
+	for _, key := range policy.RekorPublicKeys {
+		publicKey, err := parsePublicKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid OCI verification Rekor public key: %w", err)
+		}
+		verifier.rekorKeys = append(verifier.rekorKeys, publicKey)
+	}
+	for _, root := range policy.FulcioRoots {
+		if !verifier.fulcioRoots.AppendCertsFromPEM([]byte(root)) {
+			return nil, errors.New("invalid OCI verification Fulcio root certificate")
+		}
+	}
+	for _, identity := range policy.Identities {
+		matcher := ociIdentityMatcher{issuer: identity.Issuer, subject: identity.Subject}
+		if identity.Subject == "" {
+			subjectRegexp, err := regexp.Compile(identity.SubjectRegExp)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OCI verification subject regular expression: %w", err)
+			}
+			matcher.regexp = subjectRegexp
+		}
+		verifier.identities = append(verifier.identities, matcher)
+	}
+	return verifier, nil
+}
+
+// VerifyOciModels verifies the OCI model images of the modelcar containers, the image volumes and the OCI
+// storage initializer args injected into the pod, and replaces their tags by the verified digests. It runs after
+// the storage injectors so that the pod is denied before any of the model containers and volumes is created.
+func (v *OciVerifier) VerifyOciModels(ctx context.Context, pod *corev1.Pod) error {
+	references := ociModelReferences(pod)
+	if len(references) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, ociVerificationTimeout)
+	defer cancel()
+
+	credentials, err := getOciRegistryCredentials(ctx, v.clientset, pod.Namespace, pod.Spec.ImagePullSecrets)
+	if err != nil {
+		return err
+	}
+	registry := newOciRegistryClient(v.httpClient, credentials)
+
+	pinned := map[string]string{}
+	for _, reference := range references {
+		image := strings.TrimPrefix(*reference, constants.OciURIPrefix)
+		pinnedImage, ok := pinned[image]
+		if !ok {
+			if pinnedImage, err = v.verifyImage(ctx, registry, image); err != nil {
+				return &OciVerificationError{Image: image, Err: err}
+			}
+			log.Info("Verified OCI model image", "image", image, "pinned", pinnedImage)
+			pinned[image] = pinnedImage
+		}
+		if strings.HasPrefix(*reference, constants.OciURIPrefix) {
+			*reference = constants.OciURIPrefix + pinnedImage
+		} else {
+			*reference = pinnedImage
+		}
+	}
+	return nil
+}
+
+// ociModelReferences returns the references of the OCI model images of the pod
+func ociModelReferences(pod *corev1.Pod) []*string {
+	references := []*string{}
+	for i := range utils.MaxOCISourcesPerPod {
+		sidecarName, initName, _ := utils.ModelcarNames(i)
+		for j := range pod.Spec.Containers {
+			if pod.Spec.Containers[j].Name == sidecarName {
+				references = append(references, &pod.Spec.Containers[j].Image)
+			}
+		}
+		for j := range pod.Spec.InitContainers {
+			if pod.Spec.InitContainers[j].Name == initName {
+				references = append(references, &pod.Spec.InitContainers[j].Image)
+			}
+		}
+	}
+	for i := range pod.Spec.Volumes {
+		if pod.Spec.Volumes[i].Image != nil {
+			references = append(references, &pod.Spec.Volumes[i].Image.Reference)
+		}
+	}
+	if initContainer := getStorageInitializerInitContainer(&pod.Spec); initContainer != nil {
+		for i := range initContainer.Args {
+			if strings.HasPrefix(initContainer.Args[i], constants.OciURIPrefix) {
+				references = append(references, &initContainer.Args[i])
+			}
+		}
+	}
+	return references
+}
+
+// verifyImage resolves the digest of the image, verifies its signatures and attestations, and returns the image
+// reference pinned by digest
+func (v *OciVerifier) verifyImage(ctx context.Context, registry *ociRegistryClient, image string) (string, error) {
+	var options []name.Option
+	if v.insecure {
+		options = append(options, name.Insecure)
+	}
+	ref, err := name.ParseReference(image, options...)
+	if err != nil {
+		return "", err
+	}
+	repo := ref.Context()
+	_, digest, err := registry.getManifest(ctx, repo, ref.Identifier())
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve digest: %w", err)
+	}
+	if err := v.verifySignatures(ctx, registry, repo, digest); err != nil {
+		return "", err
+	}
+	for _, predicateType := range v.requiredAttestations {
+		if err := v.verifyAttestation(ctx, registry, repo, digest, predicateType); err != nil {
+			return "", err
+		}
+	}
+
+	if _, ok := ref.(name.Digest); ok {
+		return image, nil
+	}
+	return strings.TrimSuffix(image, ":"+ref.Identifier()) + "@" + digest, nil
+}
+
+// verifySignatures verifies that one of the cosign signatures of the digest is signed by the policy
+func (v *OciVerifier) verifySignatures(ctx context.Context, registry *ociRegistryClient, repo name.Repository, digest string) error {
+	layers, err := v.getCosignLayers(ctx, registry, repo, digest, ".sig")
+	if err != nil {
+		return fmt.Errorf("no signature found: %w", err)
+	}
+	var errs []error
+	for _, layer := range layers {
+		if layer.MediaType != cosignSimpleSigningMediaType {
+			continue
+		}
+		err := v.verifySignatureLayer(ctx, registry, repo, digest, layer)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return errors.New("no signature found")
+	}
+	return fmt.Errorf("no valid signature found: %w", errors.Join(errs...))
+}
+
+func (v *OciVerifier) verifySignatureLayer(ctx context.Context, registry *ociRegistryClient, repo name.Repository,
+	digest string, layer ggcrv1.Descriptor,
+) error {
+	payload, err := registry.getBlob(ctx, repo, layer.Digest.String())
+	if err != nil {
+		return err
+	}
+	signature, err := base64.StdEncoding.DecodeString(layer.Annotations[cosignSignatureAnnotation])
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	simpleSigning := struct {
+		Critical struct {
+			Image struct {
+				DockerManifestDigest string `json:"docker-manifest-digest"`
+			} `json:"image"`
+		} `json:"critical"`
+	}{}
+	if err := json.Unmarshal(payload, &simpleSigning); err != nil {
+		return fmt.Errorf("invalid signature payload: %w", err)
+	}
+	if simpleSigning.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature is for digest %s", simpleSigning.Critical.Image.DockerManifestDigest)
+	}
+	return v.verifyBlobSignature(payload, signature, layer.Annotations, func(body []byte, _ *x509.Certificate) error {
+		return verifyHashedRecord(body, payload, signature)
+	})
+}
+
+// verifyAttestation verifies that one of the in-toto attestations of the digest has the predicate type and is
+// signed by the policy
+func (v *OciVerifier) verifyAttestation(ctx context.Context, registry *ociRegistryClient, repo name.Repository,
+	digest string, predicateType string,
+) error {
+	layers, err := v.getCosignLayers(ctx, registry, repo, digest, ".att")
+	if err != nil {
+		return fmt.Errorf("no attestation found for predicate type %s: %w", predicateType, err)
+	}
+	var errs []error
+	for _, layer := range layers {
+		if layer.MediaType != dsseEnvelopeMediaType {
+			continue
+		}
+		err := v.verifyAttestationLayer(ctx, registry, repo, digest, predicateType, layer)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return fmt.Errorf("no valid attestation found for predicate type %s: %w", predicateType, errors.Join(errs...))
+}
+
+func (v *OciVerifier) verifyAttestationLayer(ctx context.Context, registry *ociRegistryClient, repo name.Repository,
+	digest string, predicateType string, layer ggcrv1.Descriptor,
+) error {
+	blob, err := registry.getBlob(ctx, repo, layer.Digest.String())
+	if err != nil {
+		return err
+	}
+	envelope := struct {
+		PayloadType string `json:"payloadType"`
+		Payload     string `json:"payload"`
+		Signatures  []struct {
+			Sig string `json:"sig"`
+		} `json:"signatures"`
+	}{}
+	if err := json.Unmarshal(blob, &envelope); err != nil {
+		return fmt.Errorf("invalid attestation envelope: %w", err)
+	}
+	if envelope.PayloadType != inTotoPayloadType {
+		return fmt.Errorf("unsupported attestation payload type %s", envelope.PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return fmt.Errorf("invalid attestation payload encoding: %w", err)
+	}
+	statement := struct {
+		PredicateType string `json:"predicateType"`
+		Subject       []struct {
+			Digest map[string]string `json:"digest"`
+		} `json:"subject"`
+	}{}
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return fmt.Errorf("invalid attestation statement: %w", err)
+	}
+	if statement.PredicateType != predicateType {
+		return fmt.Errorf("attestation has predicate type %s", statement.PredicateType)
+	}
+	subjectFound := false
+	for _, subject := range statement.Subject {
+		if "sha256:"+subject.Digest["sha256"] == digest {
+			subjectFound = true
+		}
+	}
+	if !subjectFound {
+		return errors.New("attestation subject does not match the image digest")
+	}
+
+	// The envelope is signed with the pre-authentication encoding of DSSE
+	pae := fmt.Sprintf("DSSEv1 %d %s %d %s", len(envelope.PayloadType), envelope.PayloadType, len(payload), payload)
+	var errs []error
+	for _, envelopeSignature := range envelope.Signatures {
+		signature, err := base64.StdEncoding.DecodeString(envelopeSignature.Sig)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid attestation signature encoding: %w", err))
+			continue
+		}
+		verifyRecord := func(body []byte, certificate *x509.Certificate) error {
+			return verifyAttestationRecord(body, blob, payload, signature, certificate)
+		}
+		if err := v.verifyBlobSignature([]byte(pae), signature, layer.Annotations, verifyRecord); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return nil
+	}
+	return errors.Join(errs...)
+}
+
+// getCosignLayers returns the layers of the cosign signature or attestation manifest of the digest
+func (v *OciVerifier) getCosignLayers(ctx context.Context, registry *ociRegistryClient, repo name.Repository,
+	digest string, suffix string,
+) ([]ggcrv1.Descriptor, error) {
+	tag := strings.Replace(digest, ":", "-", 1) + suffix
+	body, _, err := registry.getManifest(ctx, repo, tag)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := ggcrv1.ParseManifest(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return manifest.Layers, nil
+}
+
+// recordVerifier verifies that the body of the transparency log entry of a keyless signature records the signature
+// and its signing certificate
+type recordVerifier func(body []byte, certificate *x509.Certificate) error
+
+// verifyBlobSignature verifies the signature of the payload with the public keys, or with the certificate and
+// transparency log bundle of the annotations for keyless signatures
+func (v *OciVerifier) verifyBlobSignature(payload []byte, signature []byte, annotations map[string]string, verifyRecord recordVerifier) error {
+	if certificate, ok := annotations[cosignCertificateAnnotation]; ok && certificate != "" {
+		if len(v.identities) == 0 {
+			return errors.New("keyless signature but no keyless identities are trusted")
+		}
+		return v.verifyKeylessSignature(payload, signature, annotations, verifyRecord)
+	}
+	for _, publicKey := range v.publicKeys {
+		if verifySignature(publicKey, payload, signature) == nil {
+			return nil
+		}
+	}
+	return errors.New("signature is not signed by a trusted public key")
+}
+
+func (v *OciVerifier) verifyKeylessSignature(payload []byte, signature []byte, annotations map[string]string, verifyRecord recordVerifier) error {
+	certificate, err := parseCertificate(annotations[cosignCertificateAnnotation])
+	if err != nil {
+		return err
+	}
+	intermediates := x509.NewCertPool()
+	if chain, ok := annotations[cosignChainAnnotation]; ok {
+		intermediates.AppendCertsFromPEM([]byte(chain))
+	}
+
+	// The short-lived certificate is verified at the time the signature was logged in the transparency log
+	integratedTime, body, err := v.verifyRekorBundle(annotations[cosignBundleAnnotation])
+	if err != nil {
+		return err
+	}
+	if _, err := certificate.Verify(x509.VerifyOptions{
+		Roots:         v.fulcioRoots,
+		Intermediates: intermediates,
+		CurrentTime:   integratedTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("untrusted signing certificate: %w", err)
+	}
+	if err := v.verifyIdentity(certificate); err != nil {
+		return err
+	}
+	if err := verifySignature(certificate.PublicKey, payload, signature); err != nil {
+		return err
+	}
+	return verifyRecord(body, certificate)
+}
+
+// verifyRekorBundle verifies the signed entry timestamp of the transparency log bundle, and returns the time the
+// entry was logged and the body of the entry
+func (v *OciVerifier) verifyRekorBundle(encoded string) (time.Time, []byte, error) {
+	if encoded == "" {
+		return time.Time{}, nil, errors.New("keyless signature has no transparency log bundle")
+	}
+	bundle := struct {
+		SignedEntryTimestamp string `json:"SignedEntryTimestamp"`
+		Payload              struct {
+			Body           string `json:"body"`
+			IntegratedTime int64  `json:"integratedTime"`
+			LogIndex       int64  `json:"logIndex"`
+			LogID          string `json:"logID"`
+		} `json:"Payload"`
+	}{}
+	if err := json.Unmarshal([]byte(encoded), &bundle); err != nil {
+		return time.Time{}, nil, fmt.Errorf("invalid transparency log bundle: %w", err)
+	}
+	signedEntryTimestamp, err := base64.StdEncoding.DecodeString(bundle.SignedEntryTimestamp)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("invalid signed entry timestamp: %w", err)
+	}
+	// The signed entry timestamp signs the canonical JSON of the payload, with the keys sorted
+	canonical, err := json.Marshal(struct {
+		Body           string `json:"body"`
+		IntegratedTime int64  `json:"integratedTime"`
+		LogID          string `json:"logID"`
+		LogIndex       int64  `json:"logIndex"`
+	}{bundle.Payload.Body, bundle.Payload.IntegratedTime, bundle.Payload.LogID, bundle.Payload.LogIndex})
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	verified := false
+	for _, rekorKey := range v.rekorKeys {
+		if verifySignature(rekorKey, canonical, signedEntryTimestamp) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return time.Time{}, nil, errors.New("transparency log bundle is not signed by a trusted Rekor key")
+	}
+	body, err := base64.StdEncoding.DecodeString(bundle.Payload.Body)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("invalid transparency log entry: %w", err)
+	}
+	return time.Unix(bundle.Payload.IntegratedTime, 0), body, nil
+}
+
+// verifyIdentity verifies that the certificate is issued to one of the keyless identities of the policy
+func (v *OciVerifier) verifyIdentity(certificate *x509.Certificate) error {
+	issuer := ""
+	for _, extension := range certificate.Extensions {
+		switch {
+		case extension.Id.Equal(fulcioIssuerV2OID):
+			if _, err := asn1.Unmarshal(extension.Value, &issuer); err != nil {
+				return fmt.Errorf("invalid certificate issuer extension: %w", err)
+			}
+		case extension.Id.Equal(fulcioIssuerV1OID) && issuer == "":
+			issuer = string(extension.Value)
+		}
+	}
+	subjects := append([]string{}, certificate.EmailAddresses...)
+	for _, uri := range certificate.URIs {
+		subjects = append(subjects, uri.String())
+	}
+	for _, identity := range v.identities {
+		if identity.issuer != issuer {
+			continue
+		}
+		for _, subject := range subjects {
+			if (identity.regexp == nil && identity.subject == subject) || (identity.regexp != nil && identity.regexp.MatchString(subject)) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("signing certificate identity %v issued by %q is not trusted", subjects, issuer)
+}
+
+// verifyHashedRecord verifies that the transparency log entry records the payload and the signature
+func verifyHashedRecord(body []byte, payload []byte, signature []byte) error {
+	record := struct {
+		Kind string `json:"kind"`
+		Spec struct {
+			Data struct {
+				Hash struct {
+					Algorithm string `json:"algorithm"`
+					Value     string `json:"value"`
+				} `json:"hash"`
+			} `json:"data"`
+			Signature struct {
+				Content string `json:"content"`
+			} `json:"signature"`
+		} `json:"spec"`
+	}{}
+	if err := json.Unmarshal(body, &record); err != nil {
+		return fmt.Errorf("invalid transparency log entry: %w", err)
+	}
+	payloadHash := sha256.Sum256(payload)
+	if record.Kind != "hashedrekord" || record.Spec.Data.Hash.Algorithm != "sha256" ||
+		record.Spec.Data.Hash.Value != hex.EncodeToString(payloadHash[:]) ||
+		record.Spec.Signature.Content != base64.StdEncoding.EncodeToString(signature) {
+		return errors.New("transparency log entry does not match the signature")
+	}
+	return nil
+}
+
+// rekorHash is a hash of a transparency log entry
+type rekorHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
+
+func (h rekorHash) matches(content []byte) bool {
+	hash := sha256.Sum256(content)
+	return h.Algorithm == "sha256" && h.Value == hex.EncodeToString(hash[:])
+}
+
+// verifyAttestationRecord verifies that the intoto or dsse transparency log entry records the attestation envelope,
+// its payload, its signature and the signing certificate
+func verifyAttestationRecord(body []byte, envelope []byte, payload []byte, signature []byte, certificate *x509.Certificate) error {
+	record := struct {
+		Kind string `json:"kind"`
+		Spec struct {
+			// Spec of the dsse entries
+			EnvelopeHash rekorHash `json:"envelopeHash"`
+			PayloadHash  rekorHash `json:"payloadHash"`
+			Signatures   []struct {
+				Signature string `json:"signature"`
+				Verifier  string `json:"verifier"`
+			} `json:"signatures"`
+			// Spec of the intoto entries, the signatures are only recorded since v0.0.2
+			Content struct {
+				Hash        rekorHash `json:"hash"`
+				PayloadHash rekorHash `json:"payloadHash"`
+				Envelope    struct {
+					Signatures []struct {
+						Sig       string `json:"sig"`
+						PublicKey string `json:"publicKey"`
+					} `json:"signatures"`
+				} `json:"envelope"`
+			} `json:"content"`
+			PublicKey string `json:"publicKey"`
+		} `json:"spec"`
+	}{}
+	if err := json.Unmarshal(body, &record); err != nil {
+		return fmt.Errorf("invalid transparency log entry: %w", err)
+	}
+	mismatch := errors.New("transparency log entry does not match the attestation")
+	switch record.Kind {
+	case "dsse":
+		if !record.Spec.EnvelopeHash.matches(envelope) || !record.Spec.PayloadHash.matches(payload) {
+			return mismatch
+		}
+		for _, recorded := range record.Spec.Signatures {
+			if recordedSignatureMatches(recorded.Signature, signature) && recordedKeyMatches(recorded.Verifier, certificate) {
+				return nil
+			}
+		}
+		return mismatch
+	case "intoto":
+		content := record.Spec.Content
+		if !content.Hash.matches(envelope) || !content.PayloadHash.matches(payload) {
+			return mismatch
+		}
+		if len(content.Envelope.Signatures) == 0 {
+			// The signature is part of the envelope of the recorded hash
+			if recordedKeyMatches(record.Spec.PublicKey, certificate) {
+				return nil
+			}
+			return mismatch
+		}
+		for _, recorded := range content.Envelope.Signatures {
+			if recordedSignatureMatches(recorded.Sig, signature) && recordedKeyMatches(recorded.PublicKey, certificate) {
+				return nil
+			}
+		}
+		return mismatch
+	}
+	return fmt.Errorf("unsupported transparency log entry kind %q for attestations", record.Kind)
+}
+
+// recordedSignatureMatches returns whether the base64 signature of the entry is the signature, intoto v0.0.2 entries
+// encode the base64 signature of the envelope again
+func recordedSignatureMatches(recorded string, signature []byte) bool {
+	decoded, err := base64.StdEncoding.DecodeString(recorded)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(decoded, signature) || string(decoded) == base64.StdEncoding.EncodeToString(signature)
+}
+
+// recordedKeyMatches returns whether the base64 PEM certificate or public key of the entry is the certificate or its
+// public key
+func recordedKeyMatches(recorded string, certificate *x509.Certificate) bool {
+	decoded, err := base64.StdEncoding.DecodeString(recorded)
+	if err != nil {
+		return false
+	}
+	block, _ := pem.Decode(decoded)
+	if block == nil {
+		return false
+	}
+	if recordedCertificate, err := x509.ParseCertificate(block.Bytes); err == nil {
+		return recordedCertificate.Equal(certificate)
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return false
+	}
+	key, ok := publicKey.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && key.Equal(certificate.PublicKey)
+}
+
+// verifySignature verifies the signature of the SHA-256 digest of the payload, or of the payload for Ed25519
+func verifySignature(publicKey crypto.PublicKey, payload []byte, signature []byte) error {
+	hash := sha256.Sum256(payload)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if ecdsa.VerifyASN1(key, hash[:], signature) {
+			return nil
+		}
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil ||
+			rsa.VerifyPSS(key, crypto.SHA256, hash[:], signature, nil) == nil {
+			return nil
+		}
+	case ed25519.PublicKey:
+		if ed25519.Verify(key, payload, signature) {
+			return nil
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	return errors.New("invalid signature")
+}
+
+func parsePublicKey(encoded string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+func parseCertificate(encoded string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, errors.New("invalid signing certificate: no PEM block found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
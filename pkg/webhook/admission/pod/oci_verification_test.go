@@ -0,0 +1,652 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/types"
+)
+
+const (
+	testProvenancePredicate = "https://slsa.dev/provenance/v1"
+	testOidcIssuer          = "https://token.actions.githubusercontent.com"
+	testSigningIdentity     = "https://github.com/kserve/models/.github/workflows/release.yaml@refs/heads/main"
+)
+
+// testOciRegistry is a local registry storing images with their cosign signatures and attestations
+type testOciRegistry struct {
+	t      *testing.T
+	server *httptest.Server
+}
+
+func newTestOciRegistry(t *testing.T, wrap func(http.Handler) http.Handler) *testOciRegistry {
+	handler := registry.New()
+	if wrap != nil {
+		handler = wrap(handler)
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &testOciRegistry{t: t, server: server}
+}
+
+func (r *testOciRegistry) host() string {
+	return strings.TrimPrefix(r.server.URL, "http://")
+}
+
+func (r *testOciRegistry) request(method string, path string, contentType string, body []byte) {
+	req, err := http.NewRequest(method, r.server.URL+path, bytes.NewReader(body))
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	req.SetBasicAuth("models", "secret")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := r.server.Client().Do(req)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		r.t.Fatalf("%s %s returned status %d", method, path, resp.StatusCode)
+	}
+}
+
+func (r *testOciRegistry) pushBlob(repo string, content []byte) string {
+	digest := sha256Digest(content)
+	r.request(http.MethodPost, fmt.Sprintf("/v2/%s/blobs/uploads/?digest=%s", repo, digest), "", content)
+	return digest
+}
+
+type testOciLayer struct {
+	mediaType   string
+	content     []byte
+	annotations map[string]string
+}
+
+// pushManifest pushes an image manifest with the layers and returns its digest
+func (r *testOciRegistry) pushManifest(repo string, tag string, layers ...testOciLayer) string {
+	config := []byte("{}")
+	manifest := map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"config": map[string]interface{}{
+			"mediaType": "application/vnd.oci.image.config.v1+json",
+			"digest":    r.pushBlob(repo, config),
+			"size":      len(config),
+		},
+	}
+	descriptors := []map[string]interface{}{}
+	for _, layer := range layers {
+		descriptors = append(descriptors, map[string]interface{}{
+			"mediaType":   layer.mediaType,
+			"digest":      r.pushBlob(repo, layer.content),
+			"size":        len(layer.content),
+			"annotations": layer.annotations,
+		})
+	}
+	manifest["layers"] = descriptors
+	body, err := json.Marshal(manifest)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	r.request(http.MethodPut, fmt.Sprintf("/v2/%s/manifests/%s", repo, tag), "application/vnd.oci.image.manifest.v1+json", body)
+	return sha256Digest(body)
+}
+
+func (r *testOciRegistry) pushModel(repo string, tag string) string {
+	return r.pushManifest(repo, tag, testOciLayer{mediaType: "application/vnd.oci.image.layer.v1.tar", content: []byte("model " + tag)})
+}
+
+func (r *testOciRegistry) pushSignatures(repo string, digest string, layers ...testOciLayer) {
+	r.pushManifest(repo, strings.Replace(digest, ":", "-", 1)+".sig", layers...)
+}
+
+func (r *testOciRegistry) pushAttestations(repo string, digest string, layers ...testOciLayer) {
+	r.pushManifest(repo, strings.Replace(digest, ":", "-", 1)+".att", layers...)
+}
+
+func signaturePayload(digest string) []byte {
+	return []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"models/llama"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, digest))
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, payload []byte) []byte {
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signature
+}
+
+// keySignature returns the cosign signature layer of the digest signed with the key
+func keySignature(t *testing.T, key *ecdsa.PrivateKey, digest string) testOciLayer {
+	payload := signaturePayload(digest)
+	return testOciLayer{
+		mediaType:   cosignSimpleSigningMediaType,
+		content:     payload,
+		annotations: map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sign(t, key, payload))},
+	}
+}
+
+// attestation returns the cosign attestation layer of the digest for the predicate type signed with the key
+func attestation(t *testing.T, key *ecdsa.PrivateKey, digest string, predicateType string) testOciLayer {
+	envelope, _, _ := attestationEnvelope(t, key, digest, predicateType)
+	return testOciLayer{mediaType: dsseEnvelopeMediaType, content: envelope, annotations: map[string]string{"predicateType": predicateType}}
+}
+
+// attestationEnvelope returns the DSSE envelope of the in-toto statement of the digest for the predicate type signed
+// with the key, the statement and the signature
+func attestationEnvelope(t *testing.T, key *ecdsa.PrivateKey, digest string, predicateType string) ([]byte, []byte, []byte) {
+	statement := fmt.Sprintf(`{"_type":"https://in-toto.io/Statement/v1","predicateType":%q,"subject":[{"name":"models/llama","digest":{"sha256":%q}}],"predicate":{}}`,
+		predicateType, strings.TrimPrefix(digest, "sha256:"))
+	pae := fmt.Sprintf("DSSEv1 %d %s %d %s", len(inTotoPayloadType), inTotoPayloadType, len(statement), statement)
+	signature := sign(t, key, []byte(pae))
+	envelope, err := json.Marshal(map[string]interface{}{
+		"payloadType": inTotoPayloadType,
+		"payload":     base64.StdEncoding.EncodeToString([]byte(statement)),
+		"signatures":  []map[string]string{{"sig": base64.StdEncoding.EncodeToString(signature)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return envelope, []byte(statement), signature
+}
+
+func generateKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func publicKeyPEM(t *testing.T, key *ecdsa.PrivateKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// testSigstore is a Fulcio certificate authority and a Rekor transparency log of keyless signatures
+type testSigstore struct {
+	t        *testing.T
+	rootKey  *ecdsa.PrivateKey
+	root     *x509.Certificate
+	rootPEM  string
+	rekorKey *ecdsa.PrivateKey
+}
+
+func newTestSigstore(t *testing.T) *testSigstore {
+	rootKey := generateKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fulcio"},
+		NotBefore:             time.Now().Add(-24 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, rootKey.Public(), rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testSigstore{
+		t:        t,
+		rootKey:  rootKey,
+		root:     root,
+		rootPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		rekorKey: generateKey(t),
+	}
+}
+
+// certificate returns a short-lived certificate of the identity and its key, expired at the time of the verification
+// but valid when the signature was logged
+func (s *testSigstore) certificate(identity string, signedAt time.Time) (*ecdsa.PrivateKey, string) {
+	key := generateKey(s.t)
+	issuer, err := asn1.Marshal(testOidcIssuer)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       signedAt.Add(-time.Minute),
+		NotAfter:        signedAt.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		ExtraExtensions: []pkix.Extension{{Id: fulcioIssuerV2OID, Value: issuer}},
+	}
+	if strings.Contains(identity, "@") && !strings.Contains(identity, "://") {
+		template.EmailAddresses = []string{identity}
+	} else {
+		uri, err := url.Parse(identity)
+		if err != nil {
+			s.t.Fatal(err)
+		}
+		template.URIs = append(template.URIs, uri)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, s.root, key.Public(), s.rootKey)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// bundle returns the transparency log bundle of the entry logged at the time
+func (s *testSigstore) bundle(entryBody map[string]interface{}, signedAt time.Time) string {
+	body, err := json.Marshal(entryBody)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	entry := fmt.Sprintf(`{"body":%q,"integratedTime":%d,"logID":"c0d23d6a","logIndex":42}`,
+		base64.StdEncoding.EncodeToString(body), signedAt.Unix())
+	return fmt.Sprintf(`{"SignedEntryTimestamp":%q,"Payload":%s}`,
+		base64.StdEncoding.EncodeToString(sign(s.t, s.rekorKey, []byte(entry))), entry)
+}
+
+func sha256Hash(content []byte) map[string]string {
+	hash := sha256.Sum256(content)
+	return map[string]string{"algorithm": "sha256", "value": hex.EncodeToString(hash[:])}
+}
+
+// keylessSignature returns the cosign signature layer of the digest signed with a short-lived certificate of the
+// identity, logged as a hashedrekord entry
+func (s *testSigstore) keylessSignature(digest string, identity string) testOciLayer {
+	signedAt := time.Now().Add(-time.Hour)
+	key, certificate := s.certificate(identity, signedAt)
+	payload := signaturePayload(digest)
+	signature := sign(s.t, key, payload)
+	bundle := s.bundle(map[string]interface{}{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]interface{}{
+			"data":      map[string]interface{}{"hash": sha256Hash(payload)},
+			"signature": map[string]interface{}{"content": base64.StdEncoding.EncodeToString(signature)},
+		},
+	}, signedAt)
+
+	return testOciLayer{
+		mediaType: cosignSimpleSigningMediaType,
+		content:   payload,
+		annotations: map[string]string{
+			cosignSignatureAnnotation:   base64.StdEncoding.EncodeToString(signature),
+			cosignCertificateAnnotation: certificate,
+			cosignBundleAnnotation:      bundle,
+		},
+	}
+}
+
+// keylessAttestation returns the cosign attestation layer of the digest for the predicate type signed with a
+// short-lived certificate of the identity, logged as an entry of the kind, dsse or intoto
+func (s *testSigstore) keylessAttestation(digest string, identity string, predicateType string, kind string) testOciLayer {
+	signedAt := time.Now().Add(-time.Hour)
+	key, certificate := s.certificate(identity, signedAt)
+	envelope, statement, signature := attestationEnvelope(s.t, key, digest, predicateType)
+	verifier := base64.StdEncoding.EncodeToString([]byte(certificate))
+	entry := map[string]interface{}{
+		"apiVersion": "0.0.1",
+		"kind":       "dsse",
+		"spec": map[string]interface{}{
+			"envelopeHash": sha256Hash(envelope),
+			"payloadHash":  sha256Hash(statement),
+			"signatures": []map[string]string{
+				{"signature": base64.StdEncoding.EncodeToString(signature), "verifier": verifier},
+			},
+		},
+	}
+	if kind == "intoto" {
+		// The signatures of the envelope of intoto v0.0.2 entries are encoded again
+		entry = map[string]interface{}{
+			"apiVersion": "0.0.2",
+			"kind":       "intoto",
+			"spec": map[string]interface{}{
+				"content": map[string]interface{}{
+					"envelope": map[string]interface{}{
+						"payloadType": inTotoPayloadType,
+						"signatures": []map[string]string{{
+							"sig":       base64.StdEncoding.EncodeToString([]byte(base64.StdEncoding.EncodeToString(signature))),
+							"publicKey": verifier,
+						}},
+					},
+					"hash":        sha256Hash(envelope),
+					"payloadHash": sha256Hash(statement),
+				},
+			},
+		}
+	}
+
+	return testOciLayer{
+		mediaType: dsseEnvelopeMediaType,
+		content:   envelope,
+		annotations: map[string]string{
+			"predicateType":             predicateType,
+			cosignCertificateAnnotation: certificate,
+			cosignBundleAnnotation:      s.bundle(entry, signedAt),
+		},
+	}
+}
+
+func newModelcarPod(images ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: constants.InferenceServiceContainerName, Image: "kserve/huggingfaceserver:latest"}},
+		},
+	}
+	for i, image := range images {
+		sidecarName, initName := constants.ModelcarContainerName, constants.ModelcarInitContainerName
+		if i > 0 {
+			sidecarName, initName = fmt.Sprintf("%s-%d", sidecarName, i), fmt.Sprintf("%s-%d", initName, i)
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: sidecarName, Image: image})
+		pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{Name: initName, Image: image})
+	}
+	return pod
+}
+
+func TestVerifyOciModels(t *testing.T) {
+	key := generateKey(t)
+	otherKey := generateKey(t)
+	sigstore := newTestSigstore(t)
+	ociRegistry := newTestOciRegistry(t, nil)
+
+	signed := ociRegistry.pushModel("models/signed", "v1")
+	ociRegistry.pushSignatures("models/signed", signed, keySignature(t, key, signed))
+	ociRegistry.pushModel("models/unsigned", "v1")
+	otherSigned := ociRegistry.pushModel("models/other", "v1")
+	ociRegistry.pushSignatures("models/other", otherSigned, keySignature(t, otherKey, otherSigned))
+	keyless := ociRegistry.pushModel("models/keyless", "v1")
+	ociRegistry.pushSignatures("models/keyless", keyless, sigstore.keylessSignature(keyless, testSigningIdentity))
+	impostor := ociRegistry.pushModel("models/impostor", "v1")
+	ociRegistry.pushSignatures("models/impostor", impostor, sigstore.keylessSignature(impostor, "https://github.com/impostor/models/.github/workflows/release.yaml@refs/heads/main"))
+	attested := ociRegistry.pushModel("models/attested", "v1")
+	ociRegistry.pushSignatures("models/attested", attested, keySignature(t, key, attested))
+	ociRegistry.pushAttestations("models/attested", attested, attestation(t, key, attested, testProvenancePredicate))
+	keylessAttested := ociRegistry.pushModel("models/keyless-attested", "v1")
+	ociRegistry.pushSignatures("models/keyless-attested", keylessAttested, sigstore.keylessSignature(keylessAttested, testSigningIdentity))
+	ociRegistry.pushAttestations("models/keyless-attested", keylessAttested,
+		sigstore.keylessAttestation(keylessAttested, testSigningIdentity, testProvenancePredicate, "dsse"))
+	intotoAttested := ociRegistry.pushModel("models/intoto-attested", "v1")
+	ociRegistry.pushSignatures("models/intoto-attested", intotoAttested, sigstore.keylessSignature(intotoAttested, testSigningIdentity))
+	ociRegistry.pushAttestations("models/intoto-attested", intotoAttested,
+		sigstore.keylessAttestation(intotoAttested, testSigningIdentity, testProvenancePredicate, "intoto"))
+	// The attestation comes with the valid transparency log bundle of another attestation
+	mismatched := ociRegistry.pushModel("models/mismatched", "v1")
+	ociRegistry.pushSignatures("models/mismatched", mismatched, sigstore.keylessSignature(mismatched, testSigningIdentity))
+	mismatchedAttestation := sigstore.keylessAttestation(mismatched, testSigningIdentity, testProvenancePredicate, "dsse")
+	otherAttestation := sigstore.keylessAttestation(keylessAttested, testSigningIdentity, testProvenancePredicate, "dsse")
+	mismatchedAttestation.annotations[cosignBundleAnnotation] = otherAttestation.annotations[cosignBundleAnnotation]
+	ociRegistry.pushAttestations("models/mismatched", mismatched, mismatchedAttestation)
+
+	policy := &types.OciVerificationPolicy{
+		PublicKeys: []string{publicKeyPEM(t, key)},
+		Identities: []types.OciKeylessIdentity{
+			{Issuer: testOidcIssuer, SubjectRegExp: `^https://github\.com/kserve/models/`},
+		},
+		FulcioRoots:     []string{sigstore.rootPEM},
+		RekorPublicKeys: []string{publicKeyPEM(t, sigstore.rekorKey)},
+	}
+	host := ociRegistry.host()
+
+	scenarios := map[string]struct {
+		policy        *types.OciVerificationPolicy
+		pod           *corev1.Pod
+		expectedImage string
+		expectedErr   string
+	}{
+		"SignedWithKey": {
+			policy:        policy,
+			pod:           newModelcarPod(host + "/models/signed:v1"),
+			expectedImage: host + "/models/signed@" + signed,
+		},
+		"SignedWithKeyByDigest": {
+			policy:        policy,
+			pod:           newModelcarPod(host + "/models/signed@" + signed),
+			expectedImage: host + "/models/signed@" + signed,
+		},
+		"Unsigned": {
+			policy:      policy,
+			pod:         newModelcarPod(host + "/models/unsigned:v1"),
+			expectedErr: "no signature found",
+		},
+		"SignedWithUntrustedKey": {
+			policy:      policy,
+			pod:         newModelcarPod(host + "/models/other:v1"),
+			expectedErr: "not signed by a trusted public key",
+		},
+		"MissingTag": {
+			policy:      policy,
+			pod:         newModelcarPod(host + "/models/signed:v2"),
+			expectedErr: "failed to resolve digest",
+		},
+		"Keyless": {
+			policy:        policy,
+			pod:           newModelcarPod(host + "/models/keyless:v1"),
+			expectedImage: host + "/models/keyless@" + keyless,
+		},
+		"KeylessUntrustedIdentity": {
+			policy:      policy,
+			pod:         newModelcarPod(host + "/models/impostor:v1"),
+			expectedErr: "identity [https://github.com/impostor/models/.github/workflows/release.yaml@refs/heads/main]",
+		},
+		"KeylessUntrustedRekor": {
+			policy: &types.OciVerificationPolicy{
+				Identities:      policy.Identities,
+				FulcioRoots:     policy.FulcioRoots,
+				RekorPublicKeys: []string{publicKeyPEM(t, otherKey)},
+			},
+			pod:         newModelcarPod(host + "/models/keyless:v1"),
+			expectedErr: "not signed by a trusted Rekor key",
+		},
+		"RequiredAttestation": {
+			policy: &types.OciVerificationPolicy{
+				PublicKeys:           policy.PublicKeys,
+				RequiredAttestations: []string{testProvenancePredicate},
+			},
+			pod:           newModelcarPod(host + "/models/attested:v1"),
+			expectedImage: host + "/models/attested@" + attested,
+		},
+		"KeylessAttestation": {
+			policy: &types.OciVerificationPolicy{
+				Identities:           policy.Identities,
+				FulcioRoots:          policy.FulcioRoots,
+				RekorPublicKeys:      policy.RekorPublicKeys,
+				RequiredAttestations: []string{testProvenancePredicate},
+			},
+			pod:           newModelcarPod(host + "/models/keyless-attested:v1"),
+			expectedImage: host + "/models/keyless-attested@" + keylessAttested,
+		},
+		"KeylessIntotoAttestation": {
+			policy: &types.OciVerificationPolicy{
+				Identities:           policy.Identities,
+				FulcioRoots:          policy.FulcioRoots,
+				RekorPublicKeys:      policy.RekorPublicKeys,
+				RequiredAttestations: []string{testProvenancePredicate},
+			},
+			pod:           newModelcarPod(host + "/models/intoto-attested:v1"),
+			expectedImage: host + "/models/intoto-attested@" + intotoAttested,
+		},
+		"KeylessAttestationMismatchedBundle": {
+			policy: &types.OciVerificationPolicy{
+				Identities:           policy.Identities,
+				FulcioRoots:          policy.FulcioRoots,
+				RekorPublicKeys:      policy.RekorPublicKeys,
+				RequiredAttestations: []string{testProvenancePredicate},
+			},
+			pod:         newModelcarPod(host + "/models/mismatched:v1"),
+			expectedErr: "transparency log entry does not match the attestation",
+		},
+		"MissingAttestation": {
+			policy: &types.OciVerificationPolicy{
+				PublicKeys:           policy.PublicKeys,
+				RequiredAttestations: []string{testProvenancePredicate},
+			},
+			pod:         newModelcarPod(host + "/models/signed:v1"),
+			expectedErr: "no attestation found for predicate type " + testProvenancePredicate,
+		},
+		"OtherAttestation": {
+			policy: &types.OciVerificationPolicy{
+				PublicKeys:           policy.PublicKeys,
+				RequiredAttestations: []string{"https://spdx.dev/Document"},
+			},
+			pod:         newModelcarPod(host + "/models/attested:v1"),
+			expectedErr: "attestation has predicate type " + testProvenancePredicate,
+		},
+		"UnverifiedSecondModel": {
+			policy:      policy,
+			pod:         newModelcarPod(host+"/models/signed:v1", host+"/models/unsigned:v1"),
+			expectedErr: host + "/models/unsigned:v1 failed verification",
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			verifier, err := NewOciVerifier(scenario.policy, false, fakeclientset.NewSimpleClientset())
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			err = verifier.VerifyOciModels(t.Context(), scenario.pod)
+			if scenario.expectedErr != "" {
+				var verificationErr *OciVerificationError
+				g.Expect(errors.As(err, &verificationErr)).To(gomega.BeTrue())
+				g.Expect(err.Error()).To(gomega.ContainSubstring(scenario.expectedErr))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(scenario.pod.Spec.Containers[1].Image).To(gomega.Equal(scenario.expectedImage))
+			g.Expect(scenario.pod.Spec.InitContainers[0].Image).To(gomega.Equal(scenario.expectedImage))
+		})
+	}
+}
+
+func TestVerifyOciModelsPinsAllReferences(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	key := generateKey(t)
+	ociRegistry := newTestOciRegistry(t, nil)
+	digest := ociRegistry.pushModel("models/llama", "v1")
+	ociRegistry.pushSignatures("models/llama", digest, keySignature(t, key, digest))
+	image := ociRegistry.host() + "/models/llama:v1"
+	pinned := ociRegistry.host() + "/models/llama@" + digest
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: constants.StorageInitializerContainerName, Args: []string{constants.OciURIPrefix + image, constants.DefaultModelLocalMountPath}},
+			},
+			Containers: []corev1.Container{{Name: constants.InferenceServiceContainerName, Image: "kserve/huggingfaceserver:latest"}},
+			Volumes: []corev1.Volume{
+				{Name: "oci-model", VolumeSource: corev1.VolumeSource{Image: &corev1.ImageVolumeSource{Reference: image}}},
+			},
+		},
+	}
+	verifier, err := NewOciVerifier(&types.OciVerificationPolicy{PublicKeys: []string{publicKeyPEM(t, key)}}, false, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	g.Expect(verifier.VerifyOciModels(t.Context(), pod)).To(gomega.Succeed())
+	g.Expect(pod.Spec.InitContainers[0].Args).To(gomega.Equal([]string{constants.OciURIPrefix + pinned, constants.DefaultModelLocalMountPath}))
+	g.Expect(pod.Spec.Volumes[0].Image.Reference).To(gomega.Equal(pinned))
+	// The images which are not models are not verified
+	g.Expect(pod.Spec.Containers[0].Image).To(gomega.Equal("kserve/huggingfaceserver:latest"))
+}
+
+func TestVerifyOciModelsWithRegistryToken(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	key := generateKey(t)
+	// The registry requires the bearer token issued for the credentials of the image pull secret
+	ociRegistry := newTestOciRegistry(t, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if username, password, ok := r.BasicAuth(); ok && username == "models" && password == "secret" {
+				if r.URL.Path == "/token" {
+					g.Expect(r.URL.Query().Get("scope")).To(gomega.Equal("repository:models/llama:pull"))
+					_, _ = w.Write([]byte(`{"token":"registry-token"}`))
+					return
+				}
+				if r.Method != http.MethodGet {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			if r.Header.Get("Authorization") != "Bearer registry-token" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/token",service="registry"`, r.Host))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	digest := ociRegistry.pushModel("models/llama", "v1")
+	ociRegistry.pushSignatures("models/llama", digest, keySignature(t, key, digest))
+
+	dockerConfig := fmt.Sprintf(`{"auths":{%q:{"auth":%q}}}`, ociRegistry.host(), base64.StdEncoding.EncodeToString([]byte("models:secret")))
+	clientset := fakeclientset.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-credentials", Namespace: "default"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(dockerConfig)},
+	})
+	verifier, err := NewOciVerifier(&types.OciVerificationPolicy{PublicKeys: []string{publicKeyPEM(t, key)}}, false, clientset)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	pod := newModelcarPod(ociRegistry.host() + "/models/llama:v1")
+	g.Expect(verifier.VerifyOciModels(t.Context(), pod)).To(gomega.MatchError(gomega.ContainSubstring("status 401")))
+
+	pod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "missing"}, {Name: "registry-credentials"}}
+	g.Expect(verifier.VerifyOciModels(t.Context(), pod)).To(gomega.Succeed())
+	g.Expect(pod.Spec.Containers[1].Image).To(gomega.Equal(ociRegistry.host() + "/models/llama@" + digest))
+}
+
+func TestNormalizeRegistry(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(normalizeRegistry("https://index.docker.io/v1/")).To(gomega.Equal(dockerHubRegistry))
+	g.Expect(normalizeRegistry("docker.io")).To(gomega.Equal(dockerHubRegistry))
+	g.Expect(normalizeRegistry("quay.io")).To(gomega.Equal("quay.io"))
+	g.Expect(normalizeRegistry("http://localhost:5000")).To(gomega.Equal("localhost:5000"))
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
+	"github.com/kserve/kserve/pkg/validation"
+)
+
+// PodSecurityViolationError reports the pod security policy violations of a pod in enforce mode.
+type PodSecurityViolationError struct {
+	err error
+}
+
+func (e *PodSecurityViolationError) Error() string {
+	return fmt.Sprintf("pod security policy violation: %v", e.err)
+}
+
+// isInjectedContainer reports whether the container is injected by the webhook rather than rendered from the
+// InferenceService and its runtime.
+func isInjectedContainer(name string) bool {
+	switch name {
+	case constants.AgentContainerName, BatcherContainerName, StorageHelperInstallerName:
+		return true
+	}
+	return utils.IsStorageInitializerContainer(name) || strings.HasPrefix(name, constants.ModelcarContainerName) || strings.HasPrefix(name, StorageHelperContainerPrefix)
+}
+
+// HardenInjectedContainers sets the security context required by the pod security policy on the injected
+// containers.
+func HardenInjectedContainers(policy *validation.PodSecurityPolicy, pod *corev1.Pod) {
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for i := range containers {
+			if isInjectedContainer(containers[i].Name) {
+				policy.HardenInjectedContainer(&pod.Spec, &containers[i])
+			}
+		}
+	}
+}
+
+// ValidatePodSecurity validates the mutated pod against the pod security policy. The violations are returned as
+// admission warnings in warn mode, and as a PodSecurityViolationError in enforce mode.
+func ValidatePodSecurity(policy *validation.PodSecurityPolicy, pod *corev1.Pod) (admission.Warnings, error) {
+	warnings, errs := policy.Report(policy.ValidatePod(pod, isInjectedContainer))
+	if len(errs) > 0 {
+		return warnings, &PodSecurityViolationError{err: errs.ToAggregate()}
+	}
+	return warnings, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
+	"github.com/kserve/kserve/pkg/validation"
+)
+
+func TestIsInjectedContainer(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	for name, injected := range map[string]bool{
+		constants.StorageInitializerContainerName:        true,
+		utils.StorageInitializerContainerNameForIndex(1): true,
+		constants.AgentContainerName:                     true,
+		BatcherContainerName:                             true,
+		StorageHelperInstallerName:                       true,
+		constants.ModelcarContainerName:                  true,
+		constants.ModelcarInitContainerName:              true,
+		constants.ModelcarContainerName + "-1":           true,
+		StorageHelperContainerPrefix + "0":               true,
+		constants.InferenceServiceContainerName:          false,
+		constants.TransformerContainerName:               false,
+		"queue-proxy":                                    false,
+	} {
+		g.Expect(isInjectedContainer(name)).To(gomega.Equal(injected), name)
+	}
+}
+
+func TestValidatePodSecurity(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	newPod := func() *corev1.Pod {
+		return &corev1.Pod{
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{
+					{Name: constants.StorageInitializerContainerName, Image: "kserve/storage-initializer:latest"},
+				},
+				Containers: []corev1.Container{
+					{Name: constants.InferenceServiceContainerName, Image: "kserve/sklearnserver:latest"},
+					{
+						Name:            constants.AgentContainerName,
+						Image:           "kserve/agent:latest",
+						SecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(false)},
+					},
+				},
+			},
+		}
+	}
+	policy := &validation.PodSecurityPolicy{
+		Mode:               validation.PodSecurityModeEnforce,
+		InjectedContainers: validation.InjectedContainerPolicy{RunAsNonRoot: true, ReadOnlyRootFilesystem: true},
+		AllowedRegistries:  []string{"docker.io/kserve"},
+	}
+
+	pod := newPod()
+	HardenInjectedContainers(policy, pod)
+	g.Expect(pod.Spec.InitContainers[0].SecurityContext).To(gomega.Equal(&corev1.SecurityContext{
+		RunAsNonRoot:           ptr.To(true),
+		ReadOnlyRootFilesystem: ptr.To(true),
+	}))
+	g.Expect(pod.Spec.Containers[0].SecurityContext).To(gomega.BeNil())
+
+	// The read-only root filesystem explicitly disabled by the agent is reported
+	warnings, err := ValidatePodSecurity(policy, pod)
+	g.Expect(warnings).To(gomega.BeEmpty())
+	var violationErr *PodSecurityViolationError
+	g.Expect(errors.As(err, &violationErr)).To(gomega.BeTrue())
+	g.Expect(err.Error()).To(gomega.ContainSubstring("spec.containers[1].securityContext.readOnlyRootFilesystem"))
+
+	policy.Mode = validation.PodSecurityModeWarn
+	warnings, err = ValidatePodSecurity(policy, pod)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(warnings).To(gomega.HaveLen(1))
+
+	pod.Spec.Containers[1].SecurityContext.ReadOnlyRootFilesystem = nil
+	HardenInjectedContainers(policy, pod)
+	warnings, err = ValidatePodSecurity(policy, pod)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(warnings).To(gomega.BeEmpty())
+
+	pod = newPod()
+	HardenInjectedContainers(&validation.PodSecurityPolicy{}, pod)
+	g.Expect(pod.Spec.InitContainers[0].SecurityContext).To(gomega.BeNil())
+}
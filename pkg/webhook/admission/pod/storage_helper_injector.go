@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kserve/kserve/pkg/agent/storage"
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/credentials"
+)
+
+const (
+	StorageHelperArgumentConfig    = "--storage-helper-config"
+	StorageHelperArgumentInstall   = "--install-storage-helper"
+	StorageHelperArgumentDir       = "--storage-helper-dir"
+	StorageHelperVolumeName        = "storage-helper"
+	StorageHelperMountPath         = "/mnt/storage-helper"
+	StorageHelperInstallerName     = "storage-helper-installer"
+	StorageHelperContainerPrefix   = "storage-helper-"
+	storageHelperBinaryRelativeDir = "bin/agent"
+)
+
+// StorageHelperInjector injects the containers of the ClusterStorageContainers supporting custom storage URIs as
+// sidecars of the model agent, which downloads the models with these URIs through them
+type StorageHelperInjector struct {
+	client            client.Client
+	credentialBuilder *credentials.CredentialBuilder
+	agentConfig       *AgentConfig
+}
+
+// InjectStorageHelpers injects a storage helper sidecar for each enabled ClusterStorageContainer supporting storage
+// URIs the model agent does not download itself and used by a LocalModelCache or a TrainedModel of the pod. The agent
+// binary is copied by an init container to a shared volume and wraps the command of the ClusterStorageContainer to
+// serve the download requests of the agent.
+func (sh *StorageHelperInjector) InjectStorageHelpers(ctx context.Context, pod *corev1.Pod) error {
+	if _, ok := pod.Annotations[constants.AgentShouldInjectAnnotationKey]; !ok || sh.client == nil {
+		return nil
+	}
+	agentIndex := -1
+	for i, container := range pod.Spec.Containers {
+		if container.Name == constants.AgentContainerName {
+			agentIndex = i
+		}
+		// Don't inject if the storage helpers are already injected
+		if strings.HasPrefix(container.Name, StorageHelperContainerPrefix) {
+			return nil
+		}
+	}
+	if agentIndex < 0 {
+		return nil
+	}
+
+	storageContainers := &v1alpha1.ClusterStorageContainerList{}
+	if err := sh.client.List(ctx, storageContainers); err != nil {
+		return err
+	}
+	storageUris, err := sh.getStorageUris(ctx, pod)
+	if err != nil {
+		return err
+	}
+	helperVolume := corev1.Volume{
+		Name: StorageHelperVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+	helperBinary := filepath.Join(StorageHelperMountPath, storageHelperBinaryRelativeDir)
+	helperConfigs := []storage.HelperConfig{}
+	for _, sc := range storageContainers.Items {
+		if sc.IsDisabled() || sc.Spec.WorkloadType != v1alpha1.InitContainer || !hasCustomUriFormat(sc.Spec.SupportedUriFormats) {
+			continue
+		}
+		used, err := isStorageContainerUsed(&sc, storageUris)
+		if err != nil {
+			return err
+		}
+		if !used {
+			continue
+		}
+		// The entrypoint of the image cannot be known to be wrapped, the container must set its command
+		if len(sc.Spec.Container.Command) == 0 {
+			log.Info("Skipping storage helper of ClusterStorageContainer without command", "name", sc.Name)
+			continue
+		}
+		helperDir := filepath.Join(StorageHelperMountPath, sc.Name)
+		helperContainer := sc.Spec.Container.DeepCopy()
+		helperContainer.Name = StorageHelperContainerPrefix + sc.Name
+		helperContainer.Command = append([]string{helperBinary, StorageHelperArgumentDir, helperDir, "--"}, helperContainer.Command...)
+		if err := sh.credentialBuilder.CreateSecretVolumeAndEnv(
+			ctx,
+			pod.Namespace,
+			pod.Annotations,
+			pod.Spec.ServiceAccountName,
+			helperContainer,
+			&pod.Spec.Volumes,
+		); err != nil {
+			return err
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, *helperContainer)
+		// The helper writes the models to the model dir of the agent
+		if modelDirVolume := getVolume(pod, constants.ModelDirVolumeName); modelDirVolume != nil {
+			mountVolumeToContainer(helperContainer.Name, pod, *modelDirVolume, constants.ModelDir)
+		}
+		mountVolumeToContainer(helperContainer.Name, pod, helperVolume, StorageHelperMountPath)
+
+		formats := make([]storage.HelperUriFormat, 0, len(sc.Spec.SupportedUriFormats))
+		for _, format := range sc.Spec.SupportedUriFormats {
+			formats = append(formats, storage.HelperUriFormat{Prefix: format.Prefix, Regex: format.Regex})
+		}
+		helperConfigs = append(helperConfigs, storage.HelperConfig{Name: sc.Name, Dir: helperDir, SupportedUriFormats: formats})
+	}
+	if len(helperConfigs) == 0 {
+		return nil
+	}
+
+	helperConfigJson, err := json.Marshal(helperConfigs)
+	if err != nil {
+		return err
+	}
+	pod.Spec.Containers[agentIndex].Args = append(pod.Spec.Containers[agentIndex].Args, StorageHelperArgumentConfig, string(helperConfigJson))
+	mountVolumeToContainer(constants.AgentContainerName, pod, helperVolume, StorageHelperMountPath)
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
+		Name:            StorageHelperInstallerName,
+		Image:           sh.agentConfig.Image,
+		Args:            []string{StorageHelperArgumentInstall, helperBinary},
+		SecurityContext: pod.Spec.Containers[agentIndex].SecurityContext.DeepCopy(),
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      StorageHelperVolumeName,
+				MountPath: StorageHelperMountPath,
+			},
+		},
+	})
+	return nil
+}
+
+// getStorageUris returns the source URIs of the LocalModelCaches and the storage URIs of the TrainedModels of the
+// inference service of the pod
+func (sh *StorageHelperInjector) getStorageUris(ctx context.Context, pod *corev1.Pod) ([]string, error) {
+	storageUris := []string{}
+	localModels := &v1alpha1.LocalModelCacheList{}
+	if err := sh.client.List(ctx, localModels); err != nil {
+		return nil, err
+	}
+	for _, localModel := range localModels.Items {
+		storageUris = append(storageUris, localModel.Spec.SourceModelUri)
+	}
+	isvcName, ok := pod.Labels[constants.InferenceServicePodLabelKey]
+	if !ok {
+		return storageUris, nil
+	}
+	trainedModels := &v1alpha1.TrainedModelList{}
+	if err := sh.client.List(ctx, trainedModels, client.InNamespace(pod.Namespace)); err != nil {
+		return nil, err
+	}
+	for _, trainedModel := range trainedModels.Items {
+		if trainedModel.Spec.InferenceService == isvcName {
+			storageUris = append(storageUris, trainedModel.Spec.Model.StorageURI)
+		}
+	}
+	return storageUris, nil
+}
+
+// isStorageContainerUsed returns whether the ClusterStorageContainer supports one of the storage URIs
+func isStorageContainerUsed(sc *v1alpha1.ClusterStorageContainer, storageUris []string) (bool, error) {
+	for _, storageUri := range storageUris {
+		supported, err := sc.Spec.IsStorageUriSupported(storageUri)
+		if err != nil {
+			return false, err
+		}
+		if supported {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// hasCustomUriFormat returns whether the URI formats may match storage URIs the model agent does not download itself.
+// Regex formats are considered custom as the URIs they match cannot be known.
+func hasCustomUriFormat(formats []v1alpha1.SupportedUriFormat) bool {
+	for _, format := range formats {
+		if format.Prefix == "" {
+			if format.Regex != "" {
+				return true
+			}
+			continue
+		}
+		native := false
+		for _, protocol := range storage.SupportedProtocols {
+			if strings.HasPrefix(format.Prefix, string(protocol)) {
+				native = true
+				break
+			}
+		}
+		if !native {
+			return true
+		}
+	}
+	return false
+}
+
+func getVolume(pod *corev1.Pod, name string) *corev1.Volume {
+	for i := range pod.Spec.Volumes {
+		if pod.Spec.Volumes[i].Name == name {
+			return &pod.Spec.Volumes[i]
+		}
+	}
+	return nil
+}
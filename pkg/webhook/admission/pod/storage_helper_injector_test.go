@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kserve/kserve/pkg/agent/storage"
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/credentials"
+)
+
+func newStorageHelperInjector(g *gomega.WithT, objects ...client.Object) *StorageHelperInjector {
+	s := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(s)).To(gomega.Succeed())
+	builder := fake.NewClientBuilder().WithScheme(s).WithObjects(objects...)
+	return &StorageHelperInjector{
+		client:            builder.Build(),
+		credentialBuilder: credentials.NewCredentialBuilder(nil, fakeclientset.NewSimpleClientset(), &corev1.ConfigMap{Data: map[string]string{}}),
+		agentConfig:       agentConfig,
+	}
+}
+
+func newStorageContainer(name string, workloadType v1alpha1.WorkloadType, formats ...v1alpha1.SupportedUriFormat) *v1alpha1.ClusterStorageContainer {
+	return &v1alpha1.ClusterStorageContainer{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.StorageContainerSpec{
+			Container: corev1.Container{
+				Name:    "storage-initializer",
+				Image:   "custom/storage:latest",
+				Command: []string{"/entrypoint"},
+				Args:    []string{"--verbose"},
+			},
+			SupportedUriFormats: formats,
+			WorkloadType:        workloadType,
+		},
+	}
+}
+
+func newLocalModelCache(name string, sourceModelUri string) *v1alpha1.LocalModelCache {
+	return &v1alpha1.LocalModelCache{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       v1alpha1.LocalModelCacheSpec{SourceModelUri: sourceModelUri},
+	}
+}
+
+func newPullerPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deployment",
+			Namespace: "default",
+			Annotations: map[string]string{
+				constants.AgentShouldInjectAnnotationKey: "true",
+				constants.AgentModelDirAnnotationKey:     constants.ModelDir,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: constants.InferenceServiceContainerName},
+				{Name: constants.AgentContainerName, Args: []string{constants.AgentEnableFlag}},
+			},
+			Volumes: []corev1.Volume{
+				{Name: constants.ModelDirVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			},
+		},
+	}
+}
+
+func TestInjectStorageHelpers(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	disabled := newStorageContainer("disabled", v1alpha1.InitContainer, v1alpha1.SupportedUriFormat{Prefix: "other://"})
+	disabled.Disabled = ptr.To(true)
+	injector := newStorageHelperInjector(g,
+		newStorageContainer("custom", v1alpha1.InitContainer, v1alpha1.SupportedUriFormat{Prefix: "custom://"}),
+		newStorageContainer("unused", v1alpha1.InitContainer, v1alpha1.SupportedUriFormat{Prefix: "unused://"}),
+		newStorageContainer("native", v1alpha1.InitContainer, v1alpha1.SupportedUriFormat{Prefix: "s3://"}),
+		newStorageContainer("job", v1alpha1.LocalModelDownloadJob, v1alpha1.SupportedUriFormat{Prefix: "job://"}),
+		disabled,
+		newLocalModelCache("custom", "custom://models/llama"),
+		newLocalModelCache("native", "s3://models/llama"),
+		&v1alpha1.TrainedModel{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-isvc-model", Namespace: "default"},
+			Spec: v1alpha1.TrainedModelSpec{
+				InferenceService: "other",
+				Model:            v1alpha1.ModelSpec{StorageURI: "unused://models/llama"},
+			},
+		},
+	)
+
+	pod := newPullerPod()
+	g.Expect(injector.InjectStorageHelpers(context.Background(), pod)).To(gomega.Succeed())
+
+	g.Expect(pod.Spec.Containers).To(gomega.HaveLen(3))
+	helper := pod.Spec.Containers[2]
+	g.Expect(helper.Name).To(gomega.Equal("storage-helper-custom"))
+	g.Expect(helper.Image).To(gomega.Equal("custom/storage:latest"))
+	g.Expect(helper.Command).To(gomega.Equal([]string{
+		"/mnt/storage-helper/bin/agent", StorageHelperArgumentDir, "/mnt/storage-helper/custom", "--", "/entrypoint",
+	}))
+	g.Expect(helper.Args).To(gomega.Equal([]string{"--verbose"}))
+	g.Expect(helper.VolumeMounts).To(gomega.ConsistOf(
+		corev1.VolumeMount{Name: constants.ModelDirVolumeName, MountPath: constants.ModelDir},
+		corev1.VolumeMount{Name: StorageHelperVolumeName, MountPath: StorageHelperMountPath},
+	))
+
+	agent := pod.Spec.Containers[1]
+	g.Expect(agent.Args).To(gomega.HaveLen(3))
+	g.Expect(agent.Args[1]).To(gomega.Equal(StorageHelperArgumentConfig))
+	helperConfigs := []storage.HelperConfig{}
+	g.Expect(json.Unmarshal([]byte(agent.Args[2]), &helperConfigs)).To(gomega.Succeed())
+	g.Expect(helperConfigs).To(gomega.Equal([]storage.HelperConfig{{
+		Name:                "custom",
+		Dir:                 "/mnt/storage-helper/custom",
+		SupportedUriFormats: []storage.HelperUriFormat{{Prefix: "custom://"}},
+	}}))
+	g.Expect(agent.VolumeMounts).To(gomega.ContainElement(corev1.VolumeMount{Name: StorageHelperVolumeName, MountPath: StorageHelperMountPath}))
+
+	g.Expect(pod.Spec.InitContainers).To(gomega.HaveLen(1))
+	g.Expect(pod.Spec.InitContainers[0].Image).To(gomega.Equal(agentConfig.Image))
+	g.Expect(pod.Spec.InitContainers[0].Args).To(gomega.Equal([]string{StorageHelperArgumentInstall, "/mnt/storage-helper/bin/agent"}))
+
+	// The storage helpers are only injected once
+	g.Expect(injector.InjectStorageHelpers(context.Background(), pod)).To(gomega.Succeed())
+	g.Expect(pod.Spec.Containers).To(gomega.HaveLen(3))
+	g.Expect(pod.Spec.InitContainers).To(gomega.HaveLen(1))
+}
+
+func TestInjectStorageHelpersForTrainedModels(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	injector := newStorageHelperInjector(g,
+		newStorageContainer("custom", v1alpha1.InitContainer, v1alpha1.SupportedUriFormat{Regex: "^custom://"}),
+		&v1alpha1.TrainedModel{
+			ObjectMeta: metav1.ObjectMeta{Name: "model", Namespace: "default"},
+			Spec: v1alpha1.TrainedModelSpec{
+				InferenceService: "isvc",
+				Model:            v1alpha1.ModelSpec{StorageURI: "custom://models/llama"},
+			},
+		},
+	)
+
+	pod := newPullerPod()
+	pod.Labels = map[string]string{constants.InferenceServicePodLabelKey: "isvc"}
+	g.Expect(injector.InjectStorageHelpers(context.Background(), pod)).To(gomega.Succeed())
+	g.Expect(pod.Spec.Containers).To(gomega.HaveLen(3))
+	g.Expect(pod.Spec.Containers[2].Name).To(gomega.Equal("storage-helper-custom"))
+}
+
+func TestInjectStorageHelpersSkipped(t *testing.T) {
+	noCommand := newStorageContainer("custom", v1alpha1.InitContainer, v1alpha1.SupportedUriFormat{Prefix: "custom://"})
+	noCommand.Spec.Container.Command = nil
+	scenarios := map[string]struct {
+		objects []client.Object
+		pod     func() *corev1.Pod
+	}{
+		"no puller": {
+			objects: []client.Object{
+				newStorageContainer("custom", v1alpha1.InitContainer, v1alpha1.SupportedUriFormat{Prefix: "custom://"}),
+				newLocalModelCache("custom", "custom://models/llama"),
+			},
+			pod: func() *corev1.Pod {
+				pod := newPullerPod()
+				delete(pod.Annotations, constants.AgentShouldInjectAnnotationKey)
+				return pod
+			},
+		},
+		"only native storage containers": {
+			objects: []client.Object{
+				newStorageContainer("native", v1alpha1.InitContainer,
+					v1alpha1.SupportedUriFormat{Prefix: "gs://"}, v1alpha1.SupportedUriFormat{Prefix: "https://"}),
+				newLocalModelCache("native", "gs://models/llama"),
+			},
+			pod: newPullerPod,
+		},
+		"storage container not used": {
+			objects: []client.Object{
+				newStorageContainer("custom", v1alpha1.InitContainer, v1alpha1.SupportedUriFormat{Prefix: "custom://"}),
+				newLocalModelCache("other", "other://models/llama"),
+			},
+			pod: newPullerPod,
+		},
+		"storage container without command": {
+			objects: []client.Object{
+				noCommand,
+				newLocalModelCache("custom", "custom://models/llama"),
+			},
+			pod: newPullerPod,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			injector := newStorageHelperInjector(g, scenario.objects...)
+			pod := scenario.pod()
+			expected := pod.DeepCopy()
+			g.Expect(injector.InjectStorageHelpers(context.Background(), pod)).To(gomega.Succeed())
+			g.Expect(pod).To(gomega.Equal(expected))
+		})
+	}
+}
+
+func TestHasCustomUriFormat(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(hasCustomUriFormat([]v1alpha1.SupportedUriFormat{{Prefix: "s3://"}, {Prefix: "http://"}})).To(gomega.BeFalse())
+	g.Expect(hasCustomUriFormat([]v1alpha1.SupportedUriFormat{{Prefix: "s3://"}, {Prefix: "hf://"}})).To(gomega.BeTrue())
+	g.Expect(hasCustomUriFormat([]v1alpha1.SupportedUriFormat{{Regex: "^lakefs://"}})).To(gomega.BeTrue())
+	g.Expect(hasCustomUriFormat(nil)).To(gomega.BeFalse())
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1alpha1/localmodel/revision"
 	"github.com/kserve/kserve/pkg/credentials"
 	"github.com/kserve/kserve/pkg/credentials/s3"
 	"github.com/kserve/kserve/pkg/types"
@@ -203,6 +205,11 @@ func (mi *StorageInitializerInjector) InjectModelcar(pod *corev1.Pod) error {
 	if !ok {
 		return nil
 	}
+	storageURIs, _, err := PinStorageURIs([]v1beta1.StorageUri{{Uri: srcURI}}, pod.Annotations)
+	if err != nil {
+		return err
+	}
+	srcURI = storageURIs[0].Uri
 
 	parsedMode, normalizedURI, isOci := utils.ParseOciScheme(srcURI)
 	if !isOci {
@@ -296,6 +303,12 @@ func CommonStorageInitialization(ctx context.Context, params *StorageInitializer
 		return nil
 	}
 
+	storageURIs, storageRevisions, err := PinStorageURIs(params.StorageURIs, params.IsvcAnnotations)
+	if err != nil {
+		return err
+	}
+	params.StorageURIs = storageURIs
+
 	// Handle OCI URIs via OCI-mode injection instead of init-containers.
 	// Covers both oci+native:// (explicit native mode) and oci:// (mode from config).
 	if len(params.StorageURIs) > 0 {
@@ -410,7 +423,7 @@ func CommonStorageInitialization(ctx context.Context, params *StorageInitializer
 		return nil
 	}
 
-	var initContainer *corev1.Container
+	var initContainerNames []string // Storage initializers downloading the non-PVC storage URIs
 	numStorageURIs := len(params.StorageURIs)
 	initContainerArgs := make([]string, 0, numStorageURIs*2) // Each URI needs 2 args: URI and path
 	mountContainerNames := make([]string, 0, 3)              // Containers that need volume mounts (userContainer, transformerContainer, initContainer)
@@ -486,7 +499,26 @@ func CommonStorageInitialization(ctx context.Context, params *StorageInitializer
 			}
 		}
 
-		if len(nonPVCStorageURIs) > 0 {
+		if len(nonPVCStorageURIs) > 1 && params.Config.MultiStorageUriMode == types.MultiStorageUriModePerUri {
+			// Download each storage URI in its own storage initializer to its own volume
+			for i, storageUri := range nonPVCStorageURIs {
+				initContainer := utils.CreateInitContainerWithConfig(params.Config, []string{storageUri.Uri, storageUri.MountPath})
+				initContainer.Name = utils.StorageInitializerContainerNameForIndex(i)
+				params.PodSpec.InitContainers = append(params.PodSpec.InitContainers, *initContainer)
+				initContainerNames = append(initContainerNames, initContainer.Name)
+
+				storageMountParams := utils.StorageMountParams{
+					MountPath:  storageUri.MountPath,
+					VolumeName: utils.GetVolumeNameFromPath(storageUri.MountPath),
+					ReadOnly:   params.IsReadOnly,
+				}
+				for _, containerName := range append(slices.Clone(mountContainerNames), initContainer.Name) {
+					if mountErr := utils.AddModelMount(storageMountParams, containerName, params.PodSpec); mountErr != nil {
+						return fmt.Errorf("failed to add volume mount for container %q: %w", containerName, mountErr)
+					}
+				}
+			}
+		} else if len(nonPVCStorageURIs) > 0 {
 			// Find common parent path for non-PVC storage URIs
 			nonPVCMountPath := utils.FindCommonParentPath(nonPVCMountPaths)
 
@@ -495,12 +527,12 @@ func CommonStorageInitialization(ctx context.Context, params *StorageInitializer
 				initContainerArgs = append(initContainerArgs, storageUri.Uri, storageUri.MountPath)
 			}
 
-			initContainer = utils.CreateInitContainerWithConfig(params.Config, initContainerArgs)
+			initContainer := utils.CreateInitContainerWithConfig(params.Config, initContainerArgs)
+			utils.AddParallelDownloadsEnvVar(initContainer, params.Config, len(nonPVCStorageURIs))
 
 			// Append the init container to the pod spec
 			params.PodSpec.InitContainers = append(params.PodSpec.InitContainers, *initContainer)
-			// Get a pointer to the actual container in the slice for subsequent modifications
-			initContainer = &params.PodSpec.InitContainers[len(params.PodSpec.InitContainers)-1]
+			initContainerNames = append(initContainerNames, initContainer.Name)
 			mountContainerNames = append(mountContainerNames, initContainer.Name)
 
 			// Create shared volume mount for non-PVC storage URIs
@@ -553,13 +585,11 @@ func CommonStorageInitialization(ctx context.Context, params *StorageInitializer
 			storageMountParams.VolumeName = constants.PvcSourceMountName
 		} else {
 			initContainerArgs = append(initContainerArgs, storageURI.Uri, storageURI.MountPath)
-			initContainer = utils.CreateInitContainerWithConfig(params.Config, initContainerArgs)
+			initContainer := utils.CreateInitContainerWithConfig(params.Config, initContainerArgs)
 
 			// Append the init container to the pod spec
 			params.PodSpec.InitContainers = append(params.PodSpec.InitContainers, *initContainer)
-			// Get a pointer to the actual container in the slice for subsequent modifications
-			initContainer = &params.PodSpec.InitContainers[len(params.PodSpec.InitContainers)-1]
-
+			initContainerNames = append(initContainerNames, initContainer.Name)
 			mountContainerNames = append(mountContainerNames, initContainer.Name)
 		}
 
@@ -579,133 +609,192 @@ func CommonStorageInitialization(ctx context.Context, params *StorageInitializer
 		}
 	}
 
-	// Inject credentials only if we have an init container (not for PVC only sources)
-	if initContainer != nil {
-		if params.StorageSpec != nil && params.StorageSpec.StorageKey != nil && params.StorageSpec.Parameters != nil {
-			// initContainer.Args (storageURI.URI) is modified up in CreateStorageSpecSecretEnvs
-			if err := params.CredentialBuilder.CreateStorageSpecSecretEnvs(
-				ctx,
-				params.Namespace,
-				params.IsvcAnnotations,
-				*params.StorageSpec.StorageKey,
-				*params.StorageSpec.Parameters,
-				initContainer,
-			); err != nil {
-				return err
-			}
-		} else {
-			// Inject service account credentials if storage spec doesn't exist
-			err := params.CredentialBuilder.CreateSecretVolumeAndEnv(
-				ctx,
-				params.Namespace,
-				params.IsvcAnnotations,
-				params.PodSpec.ServiceAccountName,
-				initContainer,
-				&params.PodSpec.Volumes,
-			)
-			if err != nil {
-				return err
-			}
+	// Inject credentials only if we have init containers (not for PVC only sources)
+	for _, name := range initContainerNames {
+		if err := configureStorageInitializerContainer(ctx, params, utils.GetInitContainerWithName(params.PodSpec, name), storageRevisions); err != nil {
+			return err
 		}
+	}
 
-		// Inject CA bundle configMap if caBundleConfigMapName or constants.DefaultGlobalCaBundleConfigMapName annotation is set
-		// Store the CA bundle configuration to be applied after merge to avoid conflicts
-		var caBundleConfigMapName string
-		var caBundleVolumeMountPath string
-		var needsCABundle bool
-		if ok := needCaBundleMount(params.Config.CaBundleConfigMapName, initContainer); ok {
-			needsCABundle = true
-			caBundleConfigMapName = params.Config.CaBundleConfigMapName
-			if params.Namespace != constants.KServeNamespace {
-				caBundleConfigMapName = constants.DefaultGlobalCaBundleConfigMapName
-			}
+	return nil
+}
 
-			caBundleVolumeMountPath = params.Config.CaBundleVolumeMountPath
-			if caBundleVolumeMountPath == "" {
-				caBundleVolumeMountPath = constants.DefaultCaBundleVolumeMountPath
-			}
+// configureStorageInitializerContainer injects the credentials of the storage URIs downloaded by the storage
+// initializer and applies the storage initializer customizations.
+func configureStorageInitializerContainer(ctx context.Context, params *StorageInitializerParams, initContainer *corev1.Container, storageRevisions map[string]string) error {
+	if params.StorageSpec != nil && params.StorageSpec.StorageKey != nil && params.StorageSpec.Parameters != nil {
+		// initContainer.Args (storageURI.URI) is modified up in CreateStorageSpecSecretEnvs
+		if err := params.CredentialBuilder.CreateStorageSpecSecretEnvs(
+			ctx,
+			params.Namespace,
+			params.IsvcAnnotations,
+			*params.StorageSpec.StorageKey,
+			*params.StorageSpec.Parameters,
+			initContainer,
+		); err != nil {
+			return err
+		}
+	} else {
+		// Inject service account credentials if storage spec doesn't exist
+		err := params.CredentialBuilder.CreateSecretVolumeAndEnv(
+			ctx,
+			params.Namespace,
+			params.IsvcAnnotations,
+			params.PodSpec.ServiceAccountName,
+			initContainer,
+			&params.PodSpec.Volumes,
+		)
+		if err != nil {
+			return err
+		}
+	}
 
-			for _, envVar := range initContainer.Env {
-				if envVar.Name == s3.AWSCABundleConfigMap {
-					caBundleConfigMapName = envVar.Value
-				}
-				if envVar.Name == s3.AWSCABundle {
-					caBundleVolumeMountPath = filepath.Dir(envVar.Value)
-				}
-			}
+	// Inject CA bundle configMap if caBundleConfigMapName or constants.DefaultGlobalCaBundleConfigMapName annotation is set
+	// Store the CA bundle configuration to be applied after merge to avoid conflicts
+	var caBundleConfigMapName string
+	var caBundleVolumeMountPath string
+	var needsCABundle bool
+	if ok := needCaBundleMount(params.Config.CaBundleConfigMapName, initContainer); ok {
+		needsCABundle = true
+		caBundleConfigMapName = params.Config.CaBundleConfigMapName
+		if params.Namespace != constants.KServeNamespace {
+			caBundleConfigMapName = constants.DefaultGlobalCaBundleConfigMapName
 		}
 
-		// Merge any customizations from the storage container spec into the init container
-		if params.StorageContainerSpec != nil {
-			merged, err := utils.MergeContainerWithPatch(*initContainer, params.StorageContainerSpec.Container)
-			if err != nil {
-				return err
+		caBundleVolumeMountPath = params.Config.CaBundleVolumeMountPath
+		if caBundleVolumeMountPath == "" {
+			caBundleVolumeMountPath = constants.DefaultCaBundleVolumeMountPath
+		}
+
+		for _, envVar := range initContainer.Env {
+			if envVar.Name == s3.AWSCABundleConfigMap {
+				caBundleConfigMapName = envVar.Value
 			}
-			*initContainer = merged
-		}
-
-		// Add CA bundle env vars and volume mount after merge to avoid conflicts with user-defined env vars
-		// This applies the same defensive pattern as HF env vars (issue #4761)
-		if needsCABundle {
-			// Only add CA bundle env vars if they don't already exist (could be customized by user)
-			caBundleEnvVarExists := false
-			caBundleMountPathEnvVarExists := false
-			for _, envVar := range initContainer.Env {
-				if envVar.Name == constants.CaBundleConfigMapNameEnvVarKey {
-					caBundleEnvVarExists = true
-				}
-				if envVar.Name == constants.CaBundleVolumeMountPathEnvVarKey {
-					caBundleMountPathEnvVarExists = true
-				}
+			if envVar.Name == s3.AWSCABundle {
+				caBundleVolumeMountPath = filepath.Dir(envVar.Value)
 			}
+		}
+	}
 
-			if !caBundleEnvVarExists {
-				initContainer.Env = append(initContainer.Env, corev1.EnvVar{
-					Name:  constants.CaBundleConfigMapNameEnvVarKey,
-					Value: caBundleConfigMapName,
-				})
-			}
+	// Merge any customizations from the storage container spec into the init container
+	if params.StorageContainerSpec != nil {
+		merged, err := utils.MergeContainerWithPatch(*initContainer, params.StorageContainerSpec.Container)
+		if err != nil {
+			return err
+		}
+		// Keep the names of the storage initializers of the per URI mode
+		merged.Name = initContainer.Name
+		*initContainer = merged
+	}
 
-			if !caBundleMountPathEnvVarExists {
-				initContainer.Env = append(initContainer.Env, corev1.EnvVar{
-					Name:  constants.CaBundleVolumeMountPathEnvVarKey,
-					Value: caBundleVolumeMountPath,
-				})
+	// Add CA bundle env vars and volume mount after merge to avoid conflicts with user-defined env vars
+	// This applies the same defensive pattern as HF env vars (issue #4761)
+	if needsCABundle {
+		// Only add CA bundle env vars if they don't already exist (could be customized by user)
+		caBundleEnvVarExists := false
+		caBundleMountPathEnvVarExists := false
+		for _, envVar := range initContainer.Env {
+			if envVar.Name == constants.CaBundleConfigMapNameEnvVarKey {
+				caBundleEnvVarExists = true
+			}
+			if envVar.Name == constants.CaBundleVolumeMountPathEnvVarKey {
+				caBundleMountPathEnvVarExists = true
 			}
+		}
+
+		if !caBundleEnvVarExists {
+			initContainer.Env = append(initContainer.Env, corev1.EnvVar{
+				Name:  constants.CaBundleConfigMapNameEnvVarKey,
+				Value: caBundleConfigMapName,
+			})
+		}
 
-			caBundleVolume := corev1.Volume{
-				Name: CaBundleVolumeName,
-				VolumeSource: corev1.VolumeSource{
-					ConfigMap: &corev1.ConfigMapVolumeSource{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: caBundleConfigMapName,
-						},
+		if !caBundleMountPathEnvVarExists {
+			initContainer.Env = append(initContainer.Env, corev1.EnvVar{
+				Name:  constants.CaBundleVolumeMountPathEnvVarKey,
+				Value: caBundleVolumeMountPath,
+			})
+		}
+
+		caBundleVolume := corev1.Volume{
+			Name: CaBundleVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: caBundleConfigMapName,
 					},
 				},
-			}
-
-			caBundleVolumeMount := corev1.VolumeMount{
-				Name:      CaBundleVolumeName,
-				MountPath: caBundleVolumeMountPath,
-				ReadOnly:  true,
-			}
+			},
+		}
 
-			params.PodSpec.Volumes = append(params.PodSpec.Volumes, caBundleVolume)
-			initContainer.VolumeMounts = append(initContainer.VolumeMounts, caBundleVolumeMount)
+		caBundleVolumeMount := corev1.VolumeMount{
+			Name:      CaBundleVolumeName,
+			MountPath: caBundleVolumeMountPath,
+			ReadOnly:  true,
 		}
 
-		// Add default HuggingFace optimization environment variables if they don't already exist.
-		// This is done after merging to avoid conflicts with user-defined environment variables.
-		// See https://github.com/kserve/kserve/issues/4761
-		utils.AddDefaultHuggingFaceEnvVars(initContainer)
+		params.PodSpec.Volumes = utils.AppendVolumeIfNotExists(params.PodSpec.Volumes, caBundleVolume)
+		initContainer.VolumeMounts = append(initContainer.VolumeMounts, caBundleVolumeMount)
+	}
+
+	// Add default HuggingFace optimization environment variables if they don't already exist.
+	// This is done after merging to avoid conflicts with user-defined environment variables.
+	// See https://github.com/kserve/kserve/issues/4761
+	utils.AddDefaultHuggingFaceEnvVars(initContainer)
 
-		// Apply confidential model serving configuration if enabled via annotations
-		applyConfidentialConfig(initContainer, params.IsvcAnnotations)
+	// The storage initializer verifies the revision of the objects downloaded from the sources which cannot
+	// be pinned by their storage URI
+	if len(storageRevisions) > 0 {
+		storageRevisionsJSON, err := json.Marshal(storageRevisions)
+		if err != nil {
+			return err
+		}
+		initContainer.Env = utils.MergeEnvs(initContainer.Env, []corev1.EnvVar{
+			{Name: constants.StorageRevisionsEnvVarKey, Value: string(storageRevisionsJSON)},
+		})
+	}
+
+	// The storage initializer copies the models from the node-local cache they were pre-pulled to. The cache is
+	// only written by the pre-pull jobs, the models missing from it are downloaded directly.
+	if cacheSubDir, ok := params.IsvcAnnotations[constants.ModelPrepullInternalAnnotationKey]; ok && params.Config.NodeCacheDir != "" {
+		if err := utils.AddModelCacheToContainer(initContainer, params.PodSpec, params.Config.NodeCacheDir, cacheSubDir, true); err != nil {
+			return err
+		}
 	}
 
+	// Apply confidential model serving configuration if enabled via annotations
+	applyConfidentialConfig(initContainer, params.IsvcAnnotations)
+
 	return nil
 }
 
+// PinStorageURIs pins the storage URIs to the revisions of the storage revisions annotation set by the controller.
+// Hugging Face and OCI storage URIs are rewritten to download their revision, and the revisions of the other
+// storage URIs are returned for the storage initializer to verify the downloaded objects.
+func PinStorageURIs(storageURIs []v1beta1.StorageUri, annotations map[string]string) ([]v1beta1.StorageUri, map[string]string, error) {
+	revisionsJSON, ok := annotations[constants.StorageRevisionsInternalAnnotationKey]
+	if !ok {
+		return storageURIs, nil, nil
+	}
+	revisions := map[string]string{}
+	if err := json.Unmarshal([]byte(revisionsJSON), &revisions); err != nil {
+		return nil, nil, fmt.Errorf("invalid annotation %s: %w", constants.StorageRevisionsInternalAnnotationKey, err)
+	}
+	pinnedURIs := make([]v1beta1.StorageUri, 0, len(storageURIs))
+	unpinnedRevisions := map[string]string{}
+	for _, storageURI := range storageURIs {
+		if rev, ok := revisions[storageURI.Uri]; ok {
+			pinnedURI, pinned := revision.PinURI(storageURI.Uri, rev)
+			if !pinned {
+				unpinnedRevisions[storageURI.Uri] = rev
+			}
+			storageURI.Uri = pinnedURI
+		}
+		pinnedURIs = append(pinnedURIs, storageURI)
+	}
+	return pinnedURIs, unpinnedRevisions, nil
+}
+
 // applyConfidentialConfig injects environment variables for confidential model
 // serving when enabled via pod annotations.
 func applyConfidentialConfig(initContainer *corev1.Container, annotations map[string]string) {
@@ -797,30 +886,35 @@ func (mi *StorageInitializerInjector) InjectStorageInitializer(ctx context.Conte
 }
 
 // SetIstioCniSecurityContext determines if Istio is installed in using the CNI plugin. If so,
-// the UserID of the storage initializer is changed to match the UserID of the Istio sidecar.
-// This is to ensure that the storage initializer can access the network.
+// the UserID of the storage initializers is changed to match the UserID of the Istio sidecar.
+// This is to ensure that the storage initializers can access the network.
 func (mi *StorageInitializerInjector) SetIstioCniSecurityContext(pod *corev1.Pod) error {
-	// Find storage initializer container
-	var storageInitializerContainer *corev1.Container
+	// Find storage initializer containers, one per storage URI in the per URI mode
+	var storageInitializerContainers []*corev1.Container
 	for idx, c := range pod.Spec.InitContainers {
-		if c.Name == constants.StorageInitializerContainerName {
-			storageInitializerContainer = &pod.Spec.InitContainers[idx]
+		if utils.IsStorageInitializerContainer(c.Name) {
+			storageInitializerContainers = append(storageInitializerContainers, &pod.Spec.InitContainers[idx])
 		}
 	}
 
 	// If the storage initializer is not injected, there is no action to do
-	if storageInitializerContainer == nil {
+	if len(storageInitializerContainers) == 0 {
 		return nil
 	}
+	setRunAsUser := func(uid int64) {
+		for _, storageInitializerContainer := range storageInitializerContainers {
+			if storageInitializerContainer.SecurityContext == nil {
+				storageInitializerContainer.SecurityContext = &corev1.SecurityContext{}
+			}
+			storageInitializerContainer.SecurityContext.RunAsUser = ptr.Int64(uid)
+		}
+	}
 
 	// Allow to override the uid for the case where ISTIO CNI with DNS proxy is enabled
 	// See for more: https://istio.io/latest/docs/setup/additional-setup/cni/#compatibility-with-application-init-containers.
 	if value, ok := pod.GetAnnotations()[constants.IstioSidecarUIDAnnotationKey]; ok {
 		if uid, err := strconv.ParseInt(value, 10, 64); err == nil {
-			if storageInitializerContainer.SecurityContext == nil {
-				storageInitializerContainer.SecurityContext = &corev1.SecurityContext{}
-			}
-			storageInitializerContainer.SecurityContext.RunAsUser = ptr.Int64(uid)
+			setRunAsUser(uid)
 		}
 	} else {
 		// When Istio CNI is disabled, the istio-init container would be present.
@@ -887,19 +981,15 @@ func (mi *StorageInitializerInjector) SetIstioCniSecurityContext(pod *corev1.Pod
 
 		// Set the UserID of the storage initializer to the same as the Istio sidecar
 		if istioSidecarContainer != nil {
-			if storageInitializerContainer.SecurityContext == nil {
-				storageInitializerContainer.SecurityContext = &corev1.SecurityContext{}
-			}
 			if istioSidecarContainer.SecurityContext == nil || istioSidecarContainer.SecurityContext.RunAsUser == nil {
 				// If the Istio sidecar does not explicitly have a UID set, use 1337 which is the
 				// UID hardcoded in Istio. This would require privileges to run with AnyUID, which should
 				// be OK because, otherwise, the Istio sidecar also would not work correctly.
-				storageInitializerContainer.SecurityContext.RunAsUser = ptr.Int64(constants.DefaultIstioSidecarUID)
+				setRunAsUser(constants.DefaultIstioSidecarUID)
 			} else {
 				// If the Istio sidecar has a UID copy it to the storage initializer because this
 				// would be the UID that allows access the network.
-				sidecarUID := *istioSidecarContainer.SecurityContext.RunAsUser
-				storageInitializerContainer.SecurityContext.RunAsUser = ptr.Int64(sidecarUID)
+				setRunAsUser(*istioSidecarContainer.SecurityContext.RunAsUser)
 
 				// Notice that despite in standard Istio the 1337 UID is hardcoded, there exist
 				// other flavors, like Maistra, that allow using arbitrary UIDs on the sidecar.
@@ -908,7 +998,7 @@ func (mi *StorageInitializerInjector) SetIstioCniSecurityContext(pod *corev1.Pod
 				// preferred over using the default UID of 1337.
 			}
 
-			log.V(1).Info("Storage initializer UID is set", "pod", pod.Name, "uid", storageInitializerContainer.SecurityContext.RunAsUser)
+			log.V(1).Info("Storage initializer UID is set", "pod", pod.Name, "uid", storageInitializerContainers[0].SecurityContext.RunAsUser)
 		}
 	}
 
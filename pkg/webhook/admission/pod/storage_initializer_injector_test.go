@@ -28,6 +28,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	"knative.dev/pkg/kmp"
 	"knative.dev/pkg/ptr"
 
@@ -4567,6 +4568,80 @@ func TestCommonStorageInitialization(t *testing.T) {
 	}
 }
 
+func TestCommonStorageInitializationMultiStorageUriModes(t *testing.T) {
+	storageURIs := []v1beta1.StorageUri{
+		{Uri: "pvc://base-model/model", MountPath: "/mnt/models/base"},
+		{Uri: "s3://bucket/lora-adapter", MountPath: "/mnt/models/lora"},
+		{Uri: "gs://bucket/tokenizer", MountPath: "/mnt/models/tokenizer"},
+	}
+	newParams := func(mode string, maxParallelDownloads int) *StorageInitializerParams {
+		config := *storageInitializerConfig
+		config.MultiStorageUriMode = mode
+		config.MaxParallelDownloads = maxParallelDownloads
+		return &StorageInitializerParams{
+			Namespace:   "default",
+			StorageURIs: storageURIs,
+			IsReadOnly:  true,
+			PodSpec: &corev1.PodSpec{
+				Containers: []corev1.Container{{Name: constants.InferenceServiceContainerName}},
+			},
+			CredentialBuilder: credentials.NewCredentialBuilder(nil, fakeclientset.NewSimpleClientset(), &corev1.ConfigMap{Data: map[string]string{}}),
+			Config:            &config,
+			IsvcAnnotations:   map[string]string{},
+		}
+	}
+
+	t.Run("per URI", func(t *testing.T) {
+		params := newParams(kserveTypes.MultiStorageUriModePerUri, 0)
+		require.NoError(t, CommonStorageInitialization(t.Context(), params))
+
+		podSpec := params.PodSpec
+		require.Len(t, podSpec.InitContainers, 2)
+		for i, storageURI := range storageURIs[1:] {
+			initContainer := podSpec.InitContainers[i]
+			assert.Equal(t, utils.StorageInitializerContainerNameForIndex(i), initContainer.Name)
+			assert.Equal(t, []string{storageURI.Uri, storageURI.MountPath}, initContainer.Args)
+			assert.Equal(t, []corev1.VolumeMount{{
+				Name:      utils.GetVolumeNameFromPath(storageURI.MountPath),
+				MountPath: storageURI.MountPath,
+			}}, initContainer.VolumeMounts)
+		}
+		mountPaths := []string{}
+		for _, volumeMount := range podSpec.Containers[0].VolumeMounts {
+			assert.True(t, volumeMount.ReadOnly)
+			mountPaths = append(mountPaths, volumeMount.MountPath)
+		}
+		assert.Equal(t, []string{"/mnt/models/base", "/mnt/models/lora", "/mnt/models/tokenizer"}, mountPaths)
+		assert.Len(t, podSpec.Volumes, 3)
+	})
+
+	t.Run("parallel", func(t *testing.T) {
+		params := newParams(kserveTypes.MultiStorageUriModeParallel, 0)
+		require.NoError(t, CommonStorageInitialization(t.Context(), params))
+
+		require.Len(t, params.PodSpec.InitContainers, 1)
+		initContainer := params.PodSpec.InitContainers[0]
+		assert.Equal(t, []string{"s3://bucket/lora-adapter", "/mnt/models/lora", "gs://bucket/tokenizer", "/mnt/models/tokenizer"}, initContainer.Args)
+		value, found := utils.GetEnvVarValue(initContainer.Env, constants.StorageParallelDownloadsEnvVarKey)
+		assert.True(t, found)
+		assert.Equal(t, "2", value)
+
+		params = newParams(kserveTypes.MultiStorageUriModeParallel, 1)
+		require.NoError(t, CommonStorageInitialization(t.Context(), params))
+		value, _ = utils.GetEnvVarValue(params.PodSpec.InitContainers[0].Env, constants.StorageParallelDownloadsEnvVarKey)
+		assert.Equal(t, "1", value)
+	})
+
+	t.Run("sequential", func(t *testing.T) {
+		params := newParams("", 0)
+		require.NoError(t, CommonStorageInitialization(t.Context(), params))
+
+		require.Len(t, params.PodSpec.InitContainers, 1)
+		_, found := utils.GetEnvVarValue(params.PodSpec.InitContainers[0].Env, constants.StorageParallelDownloadsEnvVarKey)
+		assert.False(t, found)
+	})
+}
+
 func TestCommonStorageInitializationWithCustomStorageContainer(t *testing.T) {
 	// Setup custom storage container
 	customStorageContainer := &v1alpha1.StorageContainerSpec{
@@ -5822,3 +5897,57 @@ func TestCommonStorageInitializationSkipsOciNativeURI(t *testing.T) {
 	}
 	require.NotNil(t, imgVol, "oci+native:// must produce an ImageVolume on the pod spec")
 }
+
+func TestPinStorageURIs(t *testing.T) {
+	revisions := `{"hf://org/model":"abc123","oci://registry.io/model:v1":"sha256:1234","s3://bucket/model":"sha256:5678"}`
+	scenarios := map[string]struct {
+		storageURIs       []v1beta1.StorageUri
+		annotations       map[string]string
+		expectedURIs      []v1beta1.StorageUri
+		expectedRevisions map[string]string
+		expectErr         bool
+	}{
+		"NoRevisionsAnnotation": {
+			storageURIs:  []v1beta1.StorageUri{{Uri: "hf://org/model"}},
+			annotations:  map[string]string{},
+			expectedURIs: []v1beta1.StorageUri{{Uri: "hf://org/model"}},
+		},
+		"PinsHuggingFaceAndOciURIs": {
+			storageURIs: []v1beta1.StorageUri{
+				{Uri: "hf://org/model", MountPath: "/mnt/models/llm"},
+				{Uri: "oci://registry.io/model:v1", MountPath: "/mnt/models/adapter"},
+			},
+			annotations: map[string]string{constants.StorageRevisionsInternalAnnotationKey: revisions},
+			expectedURIs: []v1beta1.StorageUri{
+				{Uri: "hf://org/model:abc123", MountPath: "/mnt/models/llm"},
+				{Uri: "oci://registry.io/model@sha256:1234", MountPath: "/mnt/models/adapter"},
+			},
+			expectedRevisions: map[string]string{},
+		},
+		"ReturnsRevisionsOfUnpinnableURIs": {
+			storageURIs:       []v1beta1.StorageUri{{Uri: "s3://bucket/model"}, {Uri: "gs://bucket/other"}},
+			annotations:       map[string]string{constants.StorageRevisionsInternalAnnotationKey: revisions},
+			expectedURIs:      []v1beta1.StorageUri{{Uri: "s3://bucket/model"}, {Uri: "gs://bucket/other"}},
+			expectedRevisions: map[string]string{"s3://bucket/model": "sha256:5678"},
+		},
+		"InvalidRevisionsAnnotation": {
+			storageURIs: []v1beta1.StorageUri{{Uri: "hf://org/model"}},
+			annotations: map[string]string{constants.StorageRevisionsInternalAnnotationKey: "not-json"},
+			expectErr:   true,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			uris, unpinned, err := PinStorageURIs(scenario.storageURIs, scenario.annotations)
+			if scenario.expectErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(uris).To(gomega.Equal(scenario.expectedURIs))
+			g.Expect(unpinned).To(gomega.Equal(scenario.expectedRevisions))
+		})
+	}
+}
@@ -21,9 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"slices"
 	"strconv"
-	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -81,6 +79,10 @@ func (sr *ServingRuntimeValidator) Handle(ctx context.Context, req admission.Req
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
+	if err := validateModelFormatVersions(&servingRuntime.Spec); err != nil {
+		return admission.Denied(fmt.Sprintf("the %s %q is invalid: %s", servingRuntime.Kind, servingRuntime.Name, err.Error()))
+	}
+
 	// Only validate for priority if the new serving runtime is not disabled
 	if servingRuntime.Spec.IsDisabled() {
 		return admission.Allowed("")
@@ -124,6 +126,10 @@ func (csr *ClusterServingRuntimeValidator) Handle(ctx context.Context, req admis
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
+	if err := validateModelFormatVersions(&clusterServingRuntime.Spec); err != nil {
+		return admission.Denied(fmt.Sprintf("the %s %q is invalid: %s", clusterServingRuntime.Kind, clusterServingRuntime.Name, err.Error()))
+	}
+
 	// Only validate for priority if the new cluster serving runtime is not disabled
 	if clusterServingRuntime.Spec.IsDisabled() {
 		return admission.Allowed("")
@@ -151,58 +157,35 @@ func (csr *ClusterServingRuntimeValidator) Handle(ctx context.Context, req admis
 	return admission.Allowed("")
 }
 
-func areSupportedModelFormatsEqual(m1 v1alpha1.SupportedModelFormat, m2 v1alpha1.SupportedModelFormat) bool {
-	if strings.EqualFold(m1.Name, m2.Name) && ((m1.Version == nil && m2.Version == nil) ||
-		(m1.Version != nil && m2.Version != nil && *m1.Version == *m2.Version)) {
-		return true
-	}
-	return false
-}
-
 func validateModelFormatPrioritySame(newSpec *v1alpha1.ServingRuntimeSpec) error {
-	nameToPriority := make(map[string]*int32)
-
 	// Validate when same model format has same priority under same runtime.
 	// If the same model format has different priority value then throws the error
-	for _, newModelFormat := range newSpec.SupportedModelFormats {
-		// Only validate priority if autoselect is true
-		if newModelFormat.IsAutoSelectEnabled() {
-			if existingPriority, ok := nameToPriority[newModelFormat.Name]; ok {
-				if existingPriority != nil && newModelFormat.Priority != nil && (*existingPriority != *newModelFormat.Priority) {
-					return fmt.Errorf(ProrityIsNotSameError, newModelFormat.Name)
-				}
-			} else {
-				nameToPriority[newModelFormat.Name] = newModelFormat.Priority
-			}
+	if modelFormat := newSpec.GetInconsistentPriorityModelFormat(); modelFormat != "" {
+		return fmt.Errorf(ProrityIsNotSameError, modelFormat)
+	}
+	return nil
+}
+
+// validateModelFormatVersions validates the semver constraints of the supported model format versions
+func validateModelFormatVersions(spec *v1alpha1.ServingRuntimeSpec) error {
+	for _, modelFormat := range spec.SupportedModelFormats {
+		if modelFormat.Version == nil {
+			continue
+		}
+		if err := v1alpha1.ValidateModelFormatVersion(*modelFormat.Version); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
 func validateServingRuntimePriority(newSpec *v1alpha1.ServingRuntimeSpec, existingSpec *v1alpha1.ServingRuntimeSpec, existingRuntimeName string, newRuntimeName string) error {
-	// Skip the runtime if it is disabled or both are not multi model runtime and in update scenario skip the existing runtime if it is same as the new runtime
-	if (newSpec.IsMultiModelRuntime() != existingSpec.IsMultiModelRuntime()) || (existingSpec.IsDisabled()) || (existingRuntimeName == newRuntimeName) {
+	// In update scenario skip the existing runtime if it is same as the new runtime
+	if existingRuntimeName == newRuntimeName {
 		return nil
 	}
-	// Only validate for priority if both servingruntimes supports the same protocol version
-	isTheProtocolSame := false
-	for _, protocolVersion := range existingSpec.ProtocolVersions {
-		if slices.Contains(newSpec.ProtocolVersions, protocolVersion) {
-			isTheProtocolSame = true
-			break
-		}
-	}
-	if isTheProtocolSame {
-		for _, existingModelFormat := range existingSpec.SupportedModelFormats {
-			for _, newModelFormat := range newSpec.SupportedModelFormats {
-				// Only validate priority if autoselect is true
-				if existingModelFormat.IsAutoSelectEnabled() && newModelFormat.IsAutoSelectEnabled() && areSupportedModelFormatsEqual(existingModelFormat, newModelFormat) {
-					if existingModelFormat.Priority != nil && newModelFormat.Priority != nil && *existingModelFormat.Priority == *newModelFormat.Priority {
-						return fmt.Errorf(InvalidPriorityError, newModelFormat.Name)
-					}
-				}
-			}
-		}
+	if modelFormat := newSpec.GetConflictingPriorityModelFormat(existingSpec); modelFormat != "" {
+		return fmt.Errorf(InvalidPriorityError, modelFormat)
 	}
 	return nil
 }
@@ -839,7 +839,7 @@ func TestValidateServingRuntimePriority(t *testing.T) {
 			},
 			expected: gomega.BeNil(),
 		},
-		"When model version is nil in new serving runtime and priority is same then it should return nil": {
+		"When model version is nil in new serving runtime and priority is same then it should return error": {
 			newServingRuntime: &v1alpha1.ServingRuntime{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "example-runtime-1",
@@ -909,9 +909,9 @@ func TestValidateServingRuntimePriority(t *testing.T) {
 					},
 				},
 			},
-			expected: gomega.BeNil(),
+			expected: gomega.HaveOccurred(),
 		},
-		"When model version is nil in existing serving runtime and priority is same then it should return nil": {
+		"When model version is nil in existing serving runtime and priority is same then it should return error": {
 			newServingRuntime: &v1alpha1.ServingRuntime{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "example-runtime-1",
@@ -981,7 +981,7 @@ func TestValidateServingRuntimePriority(t *testing.T) {
 					},
 				},
 			},
-			expected: gomega.BeNil(),
+			expected: gomega.HaveOccurred(),
 		},
 		"When model version is same in both serving runtime and priority is same it should return error": {
 			newServingRuntime: &v1alpha1.ServingRuntime{
@@ -2025,3 +2025,83 @@ func TestValidateBlockedEnvVars(t *testing.T) {
 func intPtr(i int) *int {
 	return &i
 }
+
+func TestValidateServingRuntimePriorityVersionRanges(t *testing.T) {
+	newSpec := func(version string, priority int32) *v1alpha1.ServingRuntimeSpec {
+		return &v1alpha1.ServingRuntimeSpec{
+			SupportedModelFormats: []v1alpha1.SupportedModelFormat{
+				{Name: "sklearn", Version: proto.String(version), AutoSelect: proto.Bool(true), Priority: proto.Int32(priority)},
+			},
+			ProtocolVersions: []constants.InferenceServiceProtocol{constants.ProtocolV1},
+		}
+	}
+	versionlessSpec := func(priority int32) *v1alpha1.ServingRuntimeSpec {
+		spec := newSpec("", priority)
+		spec.SupportedModelFormats[0].Version = nil
+		return spec
+	}
+	scenarios := map[string]struct {
+		newSpec      *v1alpha1.ServingRuntimeSpec
+		existingSpec *v1alpha1.ServingRuntimeSpec
+		expected     gomega.OmegaMatcher
+	}{
+		"overlapping ranges with the same priority": {
+			newSpec:      newSpec(">=2.1,<3", 1),
+			existingSpec: newSpec(">=2.5", 1),
+			expected:     gomega.HaveOccurred(),
+		},
+		"range containing the existing version with the same priority": {
+			newSpec:      newSpec(">=2.1,<3", 1),
+			existingSpec: newSpec("2", 1),
+			expected:     gomega.HaveOccurred(),
+		},
+		"disjoint ranges with the same priority": {
+			newSpec:      newSpec(">=2.1,<3", 1),
+			existingSpec: newSpec(">=3", 1),
+			expected:     gomega.BeNil(),
+		},
+		"overlapping ranges with different priorities": {
+			newSpec:      newSpec(">=2.1,<3", 1),
+			existingSpec: newSpec(">=2.5", 2),
+			expected:     gomega.BeNil(),
+		},
+		"range and versionless model format with the same priority": {
+			newSpec:      newSpec(">=2.1,<3", 1),
+			existingSpec: versionlessSpec(1),
+			expected:     gomega.HaveOccurred(),
+		},
+		"versionless and versioned model format with the same priority": {
+			newSpec:      versionlessSpec(1),
+			existingSpec: newSpec("1.0.0", 1),
+			expected:     gomega.HaveOccurred(),
+		},
+		"range and versionless model format with different priorities": {
+			newSpec:      newSpec(">=2.1,<3", 1),
+			existingSpec: versionlessSpec(2),
+			expected:     gomega.BeNil(),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			err := validateServingRuntimePriority(scenario.newSpec, scenario.existingSpec, "new-runtime", "existing-runtime")
+			g.Expect(err).To(scenario.expected)
+		})
+	}
+}
+
+func TestValidateModelFormatVersions(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	spec := &v1alpha1.ServingRuntimeSpec{
+		SupportedModelFormats: []v1alpha1.SupportedModelFormat{
+			{Name: "sklearn", Version: proto.String("1")},
+			{Name: "xgboost", Version: proto.String(">=2.1,<3")},
+			{Name: "lightgbm"},
+		},
+	}
+	g.Expect(validateModelFormatVersions(spec)).To(gomega.Succeed())
+
+	spec.SupportedModelFormats[1].Version = proto.String(">=two")
+	g.Expect(validateModelFormatVersions(spec)).NotTo(gomega.Succeed())
+}
@@ -0,0 +1,140 @@
+# Copyright 2026 The KServe Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Storage credentials read from external secret stores.
+
+The credential sources of the KServe controller configure the storage initializer with env vars, and the
+credentials are exported as env vars before the models are downloaded. Env vars already set take precedence.
+"""
+
+import json
+import os
+import re
+from typing import Dict, Set
+
+import requests
+
+from kserve_storage.logging import logger
+
+STORAGE_CREDENTIALS_DIR = "STORAGE_CREDENTIALS_DIR"
+GCS_CREDENTIAL_FILE_NAME = "gcloud-application-credentials.json"
+GCS_CREDENTIAL_ENV_KEY = "GOOGLE_APPLICATION_CREDENTIALS"
+
+VAULT_ADDR = "VAULT_ADDR"
+VAULT_AUTH_MOUNT = "VAULT_AUTH_MOUNT"
+VAULT_AUTH_ROLE = "VAULT_AUTH_ROLE"
+VAULT_KV_MOUNT = "VAULT_KV_MOUNT"
+VAULT_SECRET_PATH = "VAULT_SECRET_PATH"
+VAULT_JWT_FILE = "VAULT_JWT_FILE"
+VAULT_ALLOWED_KEYS = "VAULT_ALLOWED_KEYS"
+
+# The keys of the Vault secret exported as env vars in addition to the keys of
+# VAULT_ALLOWED_KEYS, the other keys are ignored so that the secret cannot change the
+# behaviour of the container through env vars like LD_PRELOAD.
+DEFAULT_VAULT_ALLOWED_KEYS = (
+    "AWS_ACCESS_KEY_ID",
+    "AWS_SECRET_ACCESS_KEY",
+    "AWS_SESSION_TOKEN",
+    "AWS_DEFAULT_REGION",
+    "AWS_ENDPOINT_URL",
+    "AZURE_STORAGE_ACCESS_KEY",
+    "AZURE_TENANT_ID",
+    "AZURE_CLIENT_ID",
+    "AZURE_CLIENT_SECRET",
+    "HF_TOKEN",
+)
+
+_ENV_NAME_RE = re.compile(r"^[A-Za-z_][A-Za-z0-9_]*$")
+_VAULT_TIMEOUT_SECONDS = 30
+
+
+def load_file_credentials(credentials_dir: str) -> Dict[str, str]:
+    """Returns the env vars of the credential files of the folder, usually mounted by the Secrets Store CSI driver."""
+    envs = {}
+    for name in sorted(os.listdir(credentials_dir)):
+        path = os.path.join(credentials_dir, name)
+        # Skip the hidden folders of the atomic writer of Kubernetes volumes
+        if name.startswith(".") or os.path.isdir(path):
+            continue
+        if name == GCS_CREDENTIAL_FILE_NAME:
+            envs[GCS_CREDENTIAL_ENV_KEY] = path
+            continue
+        if not _ENV_NAME_RE.match(name):
+            continue
+        with open(path) as f:
+            envs[name] = f.read().rstrip("\r\n")
+    return envs
+
+
+def vault_allowed_keys() -> Set[str]:
+    """Returns the keys of the Vault secret exported as env vars."""
+    allowed_keys = set(DEFAULT_VAULT_ALLOWED_KEYS)
+    for key in os.environ.get(VAULT_ALLOWED_KEYS, "").split(","):
+        if key.strip():
+            allowed_keys.add(key.strip())
+    return allowed_keys
+
+
+def load_vault_credentials() -> Dict[str, str]:
+    """Returns the allowed keys of the Vault KV v2 secret.
+
+    Vault is logged in to with the Kubernetes auth method.
+    """
+    address = os.environ[VAULT_ADDR].rstrip("/")
+    role = os.environ.get(VAULT_AUTH_ROLE, "")
+    with open(os.environ[VAULT_JWT_FILE]) as f:
+        jwt = f.read().strip()
+    response = requests.post(
+        f"{address}/v1/auth/{os.environ.get(VAULT_AUTH_MOUNT, 'kubernetes')}/login",
+        json={"role": role, "jwt": jwt},
+        timeout=_VAULT_TIMEOUT_SECONDS,
+    )
+    response.raise_for_status()
+    token = response.json()["auth"]["client_token"]
+
+    kv_mount = os.environ.get(VAULT_KV_MOUNT, "secret")
+    secret_path = os.environ[VAULT_SECRET_PATH].lstrip("/")
+    response = requests.get(
+        f"{address}/v1/{kv_mount}/data/{secret_path}",
+        headers={"X-Vault-Token": token},
+        timeout=_VAULT_TIMEOUT_SECONDS,
+    )
+    response.raise_for_status()
+    data = response.json()["data"]["data"]
+    allowed_keys = vault_allowed_keys()
+    for key in data.keys() - allowed_keys:
+        logger.info("Ignoring key %s of vault secret not allowed as env var", key)
+    return {
+        key: value if isinstance(value, str) else json.dumps(value)
+        for key, value in data.items()
+        if key in allowed_keys
+    }
+
+
+def export_credential_sources():
+    """Exports the credentials of the configured credential sources as env vars, unless they are already set."""
+    envs = {}
+    credentials_dir = os.environ.get(STORAGE_CREDENTIALS_DIR)
+    if credentials_dir:
+        logger.info("Reading storage credentials files of %s", credentials_dir)
+        envs.update(load_file_credentials(credentials_dir))
+    if os.environ.get(VAULT_ADDR) and os.environ.get(VAULT_SECRET_PATH):
+        logger.info(
+            "Reading storage credentials from vault secret %s",
+            os.environ[VAULT_SECRET_PATH],
+        )
+        envs.update(load_vault_credentials())
+    for key, value in envs.items():
+        if _ENV_NAME_RE.match(key) and key not in os.environ:
+            os.environ[key] = value
@@ -14,10 +14,12 @@
 
 import asyncio
 import base64
+import concurrent.futures
 import fnmatch
 from functools import partial
 import glob
 import gzip
+import hashlib
 import json
 import mimetypes
 import multiprocessing
@@ -29,7 +31,8 @@ import ssl
 import tarfile
 import tempfile
 import time
-from typing import List, Optional, TYPE_CHECKING
+from datetime import datetime
+from typing import List, NamedTuple, Optional, TYPE_CHECKING
 import zipfile
 from pathlib import Path
 from typing import Tuple
@@ -44,6 +47,7 @@ if TYPE_CHECKING:
     import oras.client
 
 from kserve_storage.logging import logger
+from kserve_storage.progress import DownloadProgressReporter
 from kserve_storage.storage_errors import (
     raise_storage_error,
     check_http_response,
@@ -126,6 +130,16 @@ _LAYER_MEDIA_TYPE_MODES = {
 _HDFS_SECRET_DIRECTORY = "/var/secrets/kserve-hdfscreds"
 _HDFS_FILE_SECRETS = ["KERBEROS_KEYTAB", "TLS_CERT", "TLS_KEY", "TLS_CA"]
 
+# JSON map of the storage URIs to the revisions pinned by the controller, verified for the sources
+# which cannot be downloaded at a given revision
+_STORAGE_REVISIONS_ENV = "STORAGE_REVISIONS"
+# Node-local cache directory the models are downloaded through, set when they are pre-pulled onto the nodes
+_STORAGE_CACHE_DIR_ENV = "STORAGE_CACHE_DIR"
+# Removes the other cache directories of the InferenceService once the models are downloaded
+_STORAGE_CACHE_PRUNE_ENV = "STORAGE_CACHE_PRUNE"
+# Number of storage URIs downloaded concurrently, set in the parallel multiple storage URI mode
+_STORAGE_PARALLEL_DOWNLOADS_ENV = "STORAGE_PARALLEL_DOWNLOADS"
+
 # S3 parallel download configuration
 _S3_MAX_FILE_CONCURRENCY = int(os.getenv("S3_MAX_FILE_CONCURRENCY", "4"))
 # Global variable for S3 resource in worker processes
@@ -164,6 +178,70 @@ def _should_download(
     return True
 
 
+def _get_storage_revision(uri: str) -> Optional[str]:
+    """Returns the revision the objects of the storage URI must match, if it is pinned."""
+    revisions = os.getenv(_STORAGE_REVISIONS_ENV)
+    if not revisions:
+        return None
+    return json.loads(revisions).get(uri)
+
+
+class _ObjectVersion(NamedTuple):
+    """A version of an object of a S3 or GCS prefix and when it was the current one."""
+
+    name: str
+    # ETag for S3 and generation for GCS, as hashed in the revision by the controller
+    checksum: str
+    # S3 version ID or GCS generation to download, None when the bucket is not versioned
+    version: Optional[object]
+    size: int
+    created: datetime
+    # Time at which the version was overwritten or deleted, None for the current version
+    replaced: Optional[datetime]
+
+
+def _storage_revision(objects: List[Tuple[str, str]]) -> str:
+    """Returns the revision of the objects of a prefix, computed like the controller as
+    the digest of the names and versions (ETags or generations) of the objects in
+    ascending order of their names."""
+    manifest = hashlib.sha256()
+    for name, version in sorted(objects):
+        if name.endswith("/"):
+            continue
+        manifest.update(f"{name}\t{version}\n".encode())
+    return "sha256:" + manifest.hexdigest()
+
+
+def _select_pinned_versions(
+    uri: str, versions: List[_ObjectVersion], expected_revision: str
+) -> List[_ObjectVersion]:
+    """Returns the versions of the objects that were current when the prefix had the
+    expected revision, so the model is downloaded as it was deployed even if the objects
+    changed since. The states of the prefix are checked from the most recent one, at
+    each time an object was created, overwritten or deleted. Only the current objects are
+    listed when the bucket is not versioned, in which case the model can only be
+    downloaded if its objects did not change since."""
+    times = {v.created for v in versions}
+    times.update(v.replaced for v in versions if v.replaced is not None)
+    current_revision = None
+    for at in sorted(times, reverse=True):
+        live = [
+            v
+            for v in versions
+            if v.created <= at and (v.replaced is None or at < v.replaced)
+        ]
+        revision = _storage_revision([(v.name, v.checksum) for v in live])
+        if current_revision is None:
+            current_revision = revision
+        if revision == expected_revision:
+            logger.info("Verified revision %s of %s", revision, uri)
+            return live
+    raise RuntimeError(
+        f"Model source {uri} changed since it was deployed: "
+        f"expected revision {expected_revision}, found {current_revision}"
+    )
+
+
 def _parse_patterns_from_env(env_var_name: str) -> Optional[List[str]]:
     """Parse allow/ignore patterns from an environment variable.
 
@@ -306,12 +384,123 @@ class Storage(object):
             allow_patterns=allow_patterns,
             ignore_patterns=ignore_patterns,
         )
-        # Sequential: parallel snapshot_download of the same Hugging Face repo into
-        # different local_dir paths races on the process-wide HF cache (locks, temp files).
-        return [
-            download_fn(uri, out)
-            for uri, out in zip(source_uris, out_dirs, strict=True)
-        ]
+        cache_dir = os.getenv(_STORAGE_CACHE_DIR_ENV)
+        if cache_dir:
+            download_fn = partial(
+                Storage._download_cached, cache_dir=cache_dir, download_fn=download_fn
+            )
+        pairs = list(zip(source_uris, out_dirs, strict=True))
+        parallel_downloads = int(os.getenv(_STORAGE_PARALLEL_DOWNLOADS_ENV, "1"))
+        with DownloadProgressReporter(out_dirs):
+            if parallel_downloads > 1 and len(pairs) > 1:
+                model_dirs = Storage._download_parallel(
+                    pairs, download_fn, parallel_downloads
+                )
+            else:
+                model_dirs = [download_fn(uri, out) for uri, out in pairs]
+        if cache_dir and os.getenv(_STORAGE_CACHE_PRUNE_ENV, "").lower() == "true":
+            Storage._prune_cache(cache_dir)
+        return model_dirs
+
+    @staticmethod
+    def _download_parallel(
+        pairs: list[tuple[str, str]], download_fn, max_workers: int
+    ) -> list[str]:
+        """Downloads the storage URIs concurrently and reports all the storage URIs which failed to download.
+        The Hugging Face storage URIs of the same repository are downloaded one after another: parallel
+        snapshot_download of the same repo into different local_dir paths races on the process-wide HF cache
+        (locks, temp files)."""
+        groups: dict[str, list[int]] = {}
+        for index, (uri, _) in enumerate(pairs):
+            groups.setdefault(Storage._parallel_download_group(uri), []).append(index)
+
+        model_dirs: list[Optional[str]] = [None] * len(pairs)
+        failures: dict[str, Exception] = {}
+
+        def download_group(indexes: list[int]):
+            for index in indexes:
+                uri, out_dir = pairs[index]
+                try:
+                    model_dirs[index] = download_fn(uri, out_dir)
+                except Exception as e:
+                    logger.error("Failed to download %s: %s", uri, e)
+                    failures[uri] = e
+
+        logger.info(
+            "Downloading %d storage URIs with %d parallel downloads",
+            len(pairs),
+            max_workers,
+        )
+        with concurrent.futures.ThreadPoolExecutor(max_workers=max_workers) as executor:
+            list(executor.map(download_group, groups.values()))
+        if failures:
+            raise RuntimeError(
+                "Failed to download %d of %d storage URIs: %s"
+                % (
+                    len(failures),
+                    len(pairs),
+                    "; ".join(f"{uri}: {e}" for uri, e in failures.items()),
+                )
+            )
+        return model_dirs
+
+    @staticmethod
+    def _parallel_download_group(uri: str) -> str:
+        """Returns the group of the storage URIs downloaded one after another in the parallel mode."""
+        if uri.startswith(_HF_PREFIX):
+            repo = uri[len(_HF_PREFIX) :].split(":", 1)[0]
+            return _HF_PREFIX + "/".join(repo.split("/")[:2])
+        return uri
+
+    @staticmethod
+    def _download_cached(uri: str, out_dir: str, cache_dir: str, download_fn) -> str:
+        """Downloads the model of the storage URI through the node-local cache directory: the model is downloaded
+        into the cache once, and copied from the cache to the output directory, if set. The models missing
+        from a read-only cache are downloaded directly."""
+        entry = os.path.join(cache_dir, hashlib.sha256(uri.encode()).hexdigest())
+        if os.path.isdir(entry):
+            logger.info("Using the cached model of %s", uri)
+        elif not Storage._is_cache_writable(cache_dir):
+            logger.info("The model of %s is not cached, downloading it", uri)
+            return download_fn(uri, out_dir)
+        else:
+            os.makedirs(cache_dir, exist_ok=True)
+            # The model is renamed into the cache once complete, so concurrent
+            # downloads on the node keep the first one
+            tmp_dir = tempfile.mkdtemp(dir=cache_dir, prefix=".download-")
+            try:
+                download_fn(uri, tmp_dir)
+                try:
+                    os.rename(tmp_dir, entry)
+                except OSError:
+                    if not os.path.isdir(entry):
+                        raise
+            finally:
+                shutil.rmtree(tmp_dir, ignore_errors=True)
+            logger.info("Cached the model of %s", uri)
+        if not out_dir:
+            return entry
+        shutil.copytree(entry, out_dir, symlinks=True, dirs_exist_ok=True)
+        return out_dir
+
+    @staticmethod
+    def _is_cache_writable(cache_dir: str) -> bool:
+        """Returns whether the cache directory, or its closest existing parent, can be written to."""
+        path = os.path.abspath(cache_dir)
+        while not os.path.exists(path) and os.path.dirname(path) != path:
+            path = os.path.dirname(path)
+        return os.access(path, os.W_OK)
+
+    @staticmethod
+    def _prune_cache(cache_dir: str):
+        """Removes the sibling cache directories of the cache directory, which hold the previous models."""
+        cache_dir = os.path.abspath(cache_dir)
+        parent = os.path.dirname(cache_dir)
+        for name in os.listdir(parent):
+            path = os.path.join(parent, name)
+            if path != cache_dir and os.path.isdir(path):
+                logger.info("Removing the previous model cache %s", path)
+                shutil.rmtree(path, ignore_errors=True)
 
     @staticmethod
     def download(
@@ -462,6 +651,41 @@ class Storage(object):
                     f.write(value)
                     f.flush()
 
+        if storage_secret_json.get("type", "") == "gs":
+            if "service_account_json" in storage_secret_json:
+                temp_dir = tempfile.mkdtemp()
+                credentials_file = os.path.join(
+                    temp_dir, "gcloud-application-credentials.json"
+                )
+                with open(credentials_file, "w") as f:
+                    f.write(storage_secret_json["service_account_json"])
+                os.environ["GOOGLE_APPLICATION_CREDENTIALS"] = credentials_file
+
+        if storage_secret_json.get("type", "") == "azure":
+            for env_var, key in (
+                ("AZURE_CLIENT_ID", "client_id"),
+                ("AZURE_TENANT_ID", "tenant_id"),
+                ("AZURE_CLIENT_SECRET", "client_secret"),
+                ("AZURE_STORAGE_ACCESS_KEY", "access_key"),
+            ):
+                if key in storage_secret_json:
+                    os.environ[env_var] = storage_secret_json.get(key)
+
+        if storage_secret_json.get("type", "") == "hf":
+            for env_var, key in (
+                ("HF_TOKEN", "token"),
+                ("HF_ENDPOINT", "endpoint"),
+            ):
+                if key in storage_secret_json:
+                    os.environ[env_var] = storage_secret_json.get(key)
+
+        if storage_secret_json.get("type", "") == "https":
+            # Headers of the host, read by the http downloader like the ones of the https credentials secret
+            if "host" in storage_secret_json and "headers" in storage_secret_json:
+                os.environ[storage_secret_json["host"] + _HEADERS_SUFFIX] = (
+                    storage_secret_json["headers"]
+                )
+
     @staticmethod
     def get_S3_config():
         from botocore import UNSIGNED
@@ -650,6 +874,68 @@ class Storage(object):
             logger.error(f"Failed to initialize S3 worker: {e}")
             _worker_s3_resource = None
 
+    @staticmethod
+    def _list_s3_versions(
+        client, bucket_name: str, prefix: str
+    ) -> List[_ObjectVersion]:
+        """Lists the versions of the objects of the prefix. Only the current objects are
+        listed when the versions cannot be listed, e.g. by S3 compatible storages which
+        do not support versioning."""
+        from botocore.exceptions import ClientError
+
+        history = {}
+        try:
+            paginator = client.get_paginator("list_object_versions")
+            for page in paginator.paginate(Bucket=bucket_name, Prefix=prefix):
+                for entry in page.get("Versions", []) + page.get("DeleteMarkers", []):
+                    history.setdefault(entry["Key"], []).append(entry)
+        except ClientError as e:
+            logger.info(
+                "Unable to list the object versions of %s, only the current objects "
+                "are verified: %s",
+                f"s3://{bucket_name}/{prefix}",
+                e,
+            )
+            paginator = client.get_paginator("list_objects_v2")
+            return [
+                _ObjectVersion(
+                    obj["Key"],
+                    obj["ETag"],
+                    None,
+                    obj["Size"],
+                    obj["LastModified"],
+                    None,
+                )
+                for page in paginator.paginate(Bucket=bucket_name, Prefix=prefix)
+                for obj in page.get("Contents", [])
+            ]
+
+        versions = []
+        for key, entries in history.items():
+            entries.sort(key=lambda e: (e["LastModified"], e.get("IsLatest", False)))
+            for i, entry in enumerate(entries):
+                if "ETag" not in entry:
+                    # Delete marker
+                    continue
+                replaced = None
+                if i + 1 < len(entries):
+                    replaced = entries[i + 1]["LastModified"]
+                # Objects stored while the bucket was not versioned have the "null" ID
+                version_id = entry.get("VersionId")
+                if version_id == "null":
+                    version_id = None
+                versions.append(
+                    _ObjectVersion(
+                        key,
+                        entry["ETag"],
+                        version_id,
+                        entry["Size"],
+                        entry["LastModified"],
+                        replaced,
+                    )
+                )
+        return versions
+
     @staticmethod
     def _download_s3_object(args: Tuple) -> Tuple[bool, str, str]:
         """
@@ -657,7 +943,8 @@ class Storage(object):
         Uses pre-initialized S3 resource from _init_s3_worker().
 
         Args:
-            args: Tuple containing (bucket_name:str, obj_key:str, target_path:str)
+            args: Tuple containing (bucket_name:str, obj_key:str, target_path:str,
+                version_id:Optional[str])
 
         Returns:
             Tuple of (success: bool, obj_key: str, error_message: str)
@@ -666,11 +953,12 @@ class Storage(object):
             if _worker_s3_resource is None:
                 return False, args[1], "S3 resource not initialized in worker process"
 
-            bucket_name, obj_key, target_path = args
+            bucket_name, obj_key, target_path, version_id = args
             bucket = _worker_s3_resource.Bucket(bucket_name)
 
-            # Download the file
-            bucket.download_file(obj_key, target_path)
+            # Download the file, at its pinned version if any
+            extra_args = {"VersionId": version_id} if version_id else None
+            bucket.download_file(obj_key, target_path, ExtraArgs=extra_args)
 
             return True, obj_key, ""
 
@@ -702,29 +990,47 @@ class Storage(object):
         # Collect all objects to download
         download_tasks = []
         exact_obj_found = False
+        expected_revision = _get_storage_revision(uri)
 
         try:
             s3.meta.client.head_bucket(Bucket=bucket_name)
             bucket = s3.Bucket(bucket_name)
 
-            for obj in bucket.objects.filter(Prefix=bucket_path):
-                if obj.key.endswith("/") or obj.size == 0:
-                    logger.debug("Skipping: %s", obj.key)
+            if expected_revision is not None:
+                # Download the versions of the objects of the pinned revision
+                versions = Storage._list_s3_versions(
+                    s3.meta.client, bucket_name, bucket_path
+                )
+                objects = [
+                    (v.name, v.size, v.version)
+                    for v in sorted(
+                        _select_pinned_versions(uri, versions, expected_revision)
+                    )
+                ]
+            else:
+                objects = (
+                    (obj.key, obj.size, None)
+                    for obj in bucket.objects.filter(Prefix=bucket_path)
+                )
+
+            for key, size, version_id in objects:
+                if key.endswith("/") or size == 0:
+                    logger.debug("Skipping: %s", key)
                     continue
 
-                logger.info("Found S3 object: %s (%d bytes)", obj.key, obj.size)
+                logger.info("Found S3 object: %s (%d bytes)", key, size)
 
-                if bucket_path == obj.key:
-                    target_key = obj.key.rsplit("/", 1)[-1]
+                if bucket_path == key:
+                    target_key = key.rsplit("/", 1)[-1]
                     exact_obj_found = True
                 else:
-                    target_key = obj.key.removeprefix(bucket_path).lstrip("/")
+                    target_key = key.removeprefix(bucket_path).lstrip("/")
 
                 # Apply file filtering (skip for exact object match)
                 if not exact_obj_found and not _should_download(
                     target_key, allow_patterns, ignore_patterns
                 ):
-                    logger.info("Skipping %s due to file pattern filter", obj.key)
+                    logger.info("Skipping %s due to file pattern filter", key)
                     continue
 
                 target_path = f"{temp_dir}/{target_key}"
@@ -733,7 +1039,7 @@ class Storage(object):
                 if not os.path.exists(dir_path := os.path.dirname(target_path)):
                     os.makedirs(dir_path, exist_ok=True)
 
-                download_tasks.append((bucket_name, obj.key, target_path))
+                download_tasks.append((bucket_name, key, target_path, version_id))
 
                 # If the exact object is found, then it is sufficient to download that and break the loop
                 if exact_obj_found:
@@ -931,12 +1237,39 @@ class Storage(object):
             prefix = bucket_path
             if not prefix.endswith("/"):
                 prefix = prefix + "/"
-            blobs = bucket.list_blobs(prefix=prefix)
+            expected_revision = _get_storage_revision(uri)
+            if expected_revision is not None:
+                # Download the generations of the objects of the pinned revision
+                versions = [
+                    _ObjectVersion(
+                        b.name,
+                        str(b.generation),
+                        b.generation,
+                        b.size,
+                        b.time_created,
+                        b.time_deleted,
+                    )
+                    for b in bucket.list_blobs(prefix=bucket_path, versions=True)
+                ]
+                generations = {
+                    v.name: v.version
+                    for v in _select_pinned_versions(uri, versions, expected_revision)
+                }
+                blobs = [
+                    bucket.blob(name, generation=generation)
+                    for name, generation in sorted(generations.items())
+                    if name.startswith(prefix)
+                ]
+                blob = bucket.blob(
+                    bucket_path, generation=generations.get(bucket_path)
+                )
+            else:
+                blobs = bucket.list_blobs(prefix=prefix)
+                blob = bucket.blob(bucket_path)
             file_count = 0
 
             # Shallow copy, otherwise Iterator has already started
             shallow_blobs = copy.copy(blobs)
-            blob = bucket.blob(bucket_path)
             # checks if the blob is a file or a directory
             if blob.name == bucket_path and len(list(shallow_blobs)) == 0:
                 dest_path = os.path.join(temp_dir, os.path.basename(bucket_path))
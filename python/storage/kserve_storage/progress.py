@@ -0,0 +1,90 @@
+# Copyright 2026 The KServe Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import json
+import os
+import threading
+from typing import List, Optional
+
+from kserve_storage.logging import logger
+
+# File the local model agent sets on its download jobs to read the download progress
+# from, as a JSON document with the downloadedBytes and, if known, the totalBytes.
+# The agent computes the throughput from the successive reports.
+DOWNLOAD_PROGRESS_FILE_ENV = "KSERVE_DOWNLOAD_PROGRESS_FILE"
+DEFAULT_PROGRESS_INTERVAL = 5.0
+
+
+class DownloadProgressReporter:
+    """Reports the bytes written to the destination folders to the progress file
+    while the downloads run.
+
+    The storage backends do not share a download loop, so the progress is measured
+    from the size of the files in the destination folders. The progress file is
+    replaced atomically so the agent never reads a partial file. Failing to report
+    the progress does not fail the download."""
+
+    def __init__(
+        self,
+        out_dirs: List[str],
+        path: Optional[str] = None,
+        interval: float = DEFAULT_PROGRESS_INTERVAL,
+    ):
+        self.out_dirs = [d for d in out_dirs if d]
+        if path is None:
+            path = os.getenv(DOWNLOAD_PROGRESS_FILE_ENV, "")
+        self.path = path
+        self.interval = interval
+        self._stop = threading.Event()
+        self._thread: Optional[threading.Thread] = None
+
+    def __enter__(self):
+        if self.path:
+            self._thread = threading.Thread(target=self._run, daemon=True)
+            self._thread.start()
+        return self
+
+    def __exit__(self, exc_type, exc_value, traceback):
+        if self._thread is None:
+            return
+        self._stop.set()
+        self._thread.join()
+        self.report()
+
+    def _run(self):
+        while not self._stop.wait(self.interval):
+            self.report()
+
+    def downloaded_bytes(self) -> int:
+        total = 0
+        for out_dir in self.out_dirs:
+            for root, _, files in os.walk(out_dir):
+                for name in files:
+                    try:
+                        total += os.lstat(os.path.join(root, name)).st_size
+                    except OSError:
+                        # Temporary files are renamed or removed while the download runs
+                        continue
+        return total
+
+    def report(self):
+        if not self.path:
+            return
+        try:
+            tmp_path = self.path + ".tmp"
+            with open(tmp_path, "w") as f:
+                json.dump({"downloadedBytes": self.downloaded_bytes()}, f)
+            os.replace(tmp_path, self.path)
+        except OSError as e:
+            logger.warning("Failed to report the download progress: %s", e)
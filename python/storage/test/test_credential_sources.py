@@ -0,0 +1,78 @@
+# Copyright 2026 The KServe Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import os
+import unittest.mock as mock
+
+from kserve_storage import credential_sources
+
+
+def test_load_file_credentials(tmp_path):
+    (tmp_path / "AWS_ACCESS_KEY_ID").write_text("key\n")
+    (tmp_path / "gcloud-application-credentials.json").write_text("{}")
+    (tmp_path / "not-an-env-var").write_text("ignored")
+    (tmp_path / "..data").mkdir()
+
+    assert credential_sources.load_file_credentials(str(tmp_path)) == {
+        "AWS_ACCESS_KEY_ID": "key",
+        "GOOGLE_APPLICATION_CREDENTIALS": str(
+            tmp_path / "gcloud-application-credentials.json"
+        ),
+    }
+
+
+@mock.patch("kserve_storage.credential_sources.requests")
+def test_export_vault_credentials(mock_requests, tmp_path):
+    jwt_file = tmp_path / "token"
+    jwt_file.write_text("jwt")
+    mock_requests.post.return_value.json.return_value = {
+        "auth": {"client_token": "vault-token"}
+    }
+    mock_requests.get.return_value.json.return_value = {
+        "data": {
+            "data": {
+                "AWS_SECRET_ACCESS_KEY": "secret",
+                "AWS_DEFAULT_REGION": "eu",
+                "S3_ENDPOINT": "minio:9000",
+                "LD_PRELOAD": "/tmp/evil.so",
+            }
+        }
+    }
+    env = {
+        "VAULT_ADDR": "http://vault:8200/",
+        "VAULT_AUTH_ROLE": "models",
+        "VAULT_SECRET_PATH": "models/s3",
+        "VAULT_JWT_FILE": str(jwt_file),
+        "VAULT_ALLOWED_KEYS": "S3_ENDPOINT",
+        "AWS_DEFAULT_REGION": "us",
+    }
+    with mock.patch.dict(os.environ, env, clear=True):
+        credential_sources.export_credential_sources()
+        assert os.environ["AWS_SECRET_ACCESS_KEY"] == "secret"
+        assert os.environ["S3_ENDPOINT"] == "minio:9000"
+        # Env vars already set take precedence
+        assert os.environ["AWS_DEFAULT_REGION"] == "us"
+        # The keys which are not allowed are not exported
+        assert "LD_PRELOAD" not in os.environ
+
+    mock_requests.post.assert_called_once_with(
+        "http://vault:8200/v1/auth/kubernetes/login",
+        json={"role": "models", "jwt": "jwt"},
+        timeout=30,
+    )
+    mock_requests.get.assert_called_once_with(
+        "http://vault:8200/v1/secret/data/models/s3",
+        headers={"X-Vault-Token": "vault-token"},
+        timeout=30,
+    )
@@ -12,7 +12,11 @@
 # See the License for the specific language governing permissions and
 # limitations under the License.
 
+import hashlib
+import json
+import os
 import unittest.mock as mock
+from datetime import datetime, timezone
 import pytest
 from kserve_storage import Storage
 
@@ -105,6 +109,111 @@ def test_download_model_from_gcs(mock_client):
     assert "/mock.object" in arg_list[0][0]
 
 
+def gcs_revision(blobs):
+    manifest = hashlib.sha256()
+    for blob in blobs:
+        if not blob.name.endswith("/"):
+            manifest.update(f"{blob.name}\t{blob.generation}\n".encode())
+    return "sha256:" + manifest.hexdigest()
+
+
+def create_mock_generation(name, generation, created, deleted=None):
+    mock_obj = create_mock_dir(name)
+    mock_obj.generation = generation
+    mock_obj.size = 1024
+    mock_obj.time_created = datetime(2026, 1, 1, 0, created, tzinfo=timezone.utc)
+    mock_obj.time_deleted = None
+    if deleted is not None:
+        mock_obj.time_deleted = datetime(2026, 1, 1, 0, deleted, tzinfo=timezone.utc)
+    return mock_obj
+
+
+def create_mock_versioned_bucket(generations):
+    """Returns a bucket listing the generations, and the blobs it downloads by name and
+    generation."""
+    downloaded = {}
+
+    def blob(name, generation=None):
+        return downloaded.setdefault((name, generation), create_mock_dir(name))
+
+    mock_bucket = mock.MagicMock()
+    mock_bucket.list_blobs().__iter__.return_value = generations
+    mock_bucket.blob.side_effect = blob
+    return mock_bucket, downloaded
+
+
+def get_downloaded_generations(downloaded):
+    return sorted(
+        key for key, blob in downloaded.items() if blob.download_to_filename.called
+    )
+
+
+@mock.patch("google.cloud.storage.Client")
+def test_gcs_pinned_revision(mock_client):
+    gcs_path = "gs://foo/bar"
+
+    mock_dir = create_mock_generation("bar/", 1, 0)
+    mock_file = create_mock_generation("bar/mock.object", 1700000000000001, 0)
+    revision = gcs_revision([mock_dir, mock_file])
+
+    mock_bucket, downloaded = create_mock_versioned_bucket([mock_dir, mock_file])
+    mock_client.return_value.bucket.return_value = mock_bucket
+
+    with mock.patch.dict(
+        os.environ, {"STORAGE_REVISIONS": json.dumps({gcs_path: revision})}
+    ):
+        Storage.download(gcs_path)
+
+    mock_bucket.list_blobs.assert_called_with(prefix="bar", versions=True)
+    assert get_downloaded_generations(downloaded) == [
+        ("bar/mock.object", 1700000000000001)
+    ]
+
+
+@mock.patch("google.cloud.storage.Client")
+def test_gcs_pinned_revision_of_changed_objects(mock_client):
+    gcs_path = "gs://foo/bar"
+
+    # The object was overwritten and a new object added since the revision was resolved
+    previous = create_mock_generation("bar/mock.object", 1700000000000001, 0, 5)
+    current = create_mock_generation("bar/mock.object", 1700000000000002, 5)
+    added = create_mock_generation("bar/other.object", 1700000000000003, 6)
+    revision = gcs_revision([previous])
+
+    mock_bucket, downloaded = create_mock_versioned_bucket([previous, current, added])
+    mock_client.return_value.bucket.return_value = mock_bucket
+
+    with mock.patch.dict(
+        os.environ, {"STORAGE_REVISIONS": json.dumps({gcs_path: revision})}
+    ):
+        Storage.download(gcs_path)
+
+    assert get_downloaded_generations(downloaded) == [
+        ("bar/mock.object", 1700000000000001)
+    ]
+
+
+@mock.patch("google.cloud.storage.Client")
+def test_gcs_changed_revision(mock_client):
+    gcs_path = "gs://foo/bar"
+
+    # Without versioning, only the current generation of the object is listed
+    mock_file = create_mock_generation("bar/mock.object", 1700000000000001, 0)
+    revision = gcs_revision([mock_file])
+    mock_file.generation = 1700000000000002
+
+    mock_bucket, downloaded = create_mock_versioned_bucket([mock_file])
+    mock_client.return_value.bucket.return_value = mock_bucket
+
+    with mock.patch.dict(
+        os.environ, {"STORAGE_REVISIONS": json.dumps({gcs_path: revision})}
+    ):
+        with pytest.raises(RuntimeError, match="changed since it was deployed"):
+            Storage.download(gcs_path)
+
+    assert get_downloaded_generations(downloaded) == []
+
+
 @mock.patch("google.cloud.storage.Client")
 def test_download_model_from_gcs_as_single_file(mock_client):
     gcs_path = "gs://foo/bar/mock.object"
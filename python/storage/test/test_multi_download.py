@@ -14,6 +14,7 @@
 
 import os
 import tempfile
+import threading
 import unittest.mock as mock
 import pytest
 
@@ -210,3 +211,122 @@ class TestMultiDownload:
         results = Storage.download_files(uris, out_dirs)
 
         assert results == ["/result1", "/result2", "/result3"]
+
+    def test_download_through_node_cache(self):
+        """Test that the models are downloaded into the node cache once."""
+        with tempfile.TemporaryDirectory() as temp_dir:
+            cache_dir = os.path.join(temp_dir, "cache", "default", "isvc", "key2")
+            previous_cache_dir = os.path.join(
+                temp_dir, "cache", "default", "isvc", "key1"
+            )
+            os.makedirs(previous_cache_dir)
+
+            def download(uri, out_dir, **kwargs):
+                with open(os.path.join(out_dir, "model.pth"), "w") as f:
+                    f.write(uri)
+                return out_dir
+
+            env = {
+                "STORAGE_CACHE_DIR": cache_dir,
+                "STORAGE_CACHE_PRUNE": "true",
+            }
+            with mock.patch.dict(os.environ, env), mock.patch(
+                STORAGE_MODULE + ".Storage.download", side_effect=download
+            ) as mock_download:
+                # The pre-pull only downloads into the cache
+                Storage.download_files(["s3://bucket/model"], [""])
+                dest = os.path.join(temp_dir, "dest")
+                results = Storage.download_files(["s3://bucket/model"], [dest])
+
+            assert mock_download.call_count == 1
+            assert results == [dest]
+            with open(os.path.join(dest, "model.pth")) as f:
+                assert f.read() == "s3://bucket/model"
+            assert not os.path.exists(previous_cache_dir)
+            assert len(os.listdir(cache_dir)) == 1
+
+    def test_download_through_read_only_node_cache(self):
+        """Test that the models missing from a read-only node cache are downloaded."""
+        with tempfile.TemporaryDirectory() as temp_dir:
+            cache_dir = os.path.join(temp_dir, "cache")
+            dest = os.path.join(temp_dir, "dest")
+            env = {"STORAGE_CACHE_DIR": cache_dir}
+            with mock.patch.dict(os.environ, env), mock.patch(
+                STORAGE_MODULE + ".Storage.download", return_value=dest
+            ) as mock_download, mock.patch("os.access", return_value=False):
+                results = Storage.download_files(["s3://bucket/model"], [dest])
+
+            mock_download.assert_called_once()
+            assert mock_download.call_args.args == ("s3://bucket/model", dest)
+            assert results == [dest]
+            assert not os.path.exists(cache_dir)
+
+    def test_failed_download_is_not_cached(self):
+        """Test that a failed download leaves no model in the node cache."""
+        with tempfile.TemporaryDirectory() as temp_dir:
+            cache_dir = os.path.join(temp_dir, "cache")
+            env = {"STORAGE_CACHE_DIR": cache_dir}
+            with mock.patch.dict(os.environ, env), mock.patch(
+                STORAGE_MODULE + ".Storage.download",
+                side_effect=RuntimeError("Download failed"),
+            ):
+                with pytest.raises(RuntimeError, match="Download failed"):
+                    Storage.download_files(
+                        ["s3://bucket/model"], [os.path.join(temp_dir, "dest")]
+                    )
+
+            assert os.listdir(cache_dir) == []
+
+    @mock.patch("os.makedirs")
+    def test_parallel_download(self, mock_makedirs):
+        """Test that the storage URIs are downloaded concurrently in parallel mode."""
+        barrier = threading.Barrier(2, timeout=10)
+
+        def download(uri, out, **kwargs):
+            # Both downloads must run at the same time to pass the barrier
+            barrier.wait()
+            return out
+
+        env = {"STORAGE_PARALLEL_DOWNLOADS": "2"}
+        with mock.patch.dict(os.environ, env), mock.patch(
+            STORAGE_MODULE + ".Storage.download", side_effect=download
+        ):
+            results = Storage.download_files(
+                ["s3://bucket/model", "gs://bucket/adapter"], ["/dest1", "/dest2"]
+            )
+
+        assert results == ["/dest1", "/dest2"]
+
+    @mock.patch("os.makedirs")
+    def test_parallel_download_reports_failed_uris(self, mock_makedirs):
+        """Test that all the failed storage URIs are reported in parallel mode."""
+
+        def download(uri, out, **kwargs):
+            if uri != "s3://bucket/model":
+                raise RuntimeError("access denied")
+            return out
+
+        env = {"STORAGE_PARALLEL_DOWNLOADS": "3"}
+        with mock.patch.dict(os.environ, env), mock.patch(
+            STORAGE_MODULE + ".Storage.download", side_effect=download
+        ) as mock_download:
+            with pytest.raises(RuntimeError) as exc_info:
+                Storage.download_files(
+                    ["s3://bucket/model", "gs://bucket/adapter", "hf://org/adapter"],
+                    ["/dest1", "/dest2", "/dest3"],
+                )
+
+        assert mock_download.call_count == 3
+        message = str(exc_info.value)
+        assert message.startswith("Failed to download 2 of 3 storage URIs")
+        assert "gs://bucket/adapter: access denied" in message
+        assert "hf://org/adapter: access denied" in message
+        assert "s3://bucket/model:" not in message
+
+    def test_parallel_download_group(self):
+        """Test that the URIs of the same Hugging Face repo are downloaded in turn."""
+        group = Storage._parallel_download_group
+        assert group("hf://org/model:abc123") == group("hf://org/model")
+        assert group("hf://org/model/subfolder") == "hf://org/model"
+        assert group("hf://org/model") != group("hf://org/adapter")
+        assert group("s3://bucket/model") == "s3://bucket/model"
@@ -0,0 +1,55 @@
+# Copyright 2026 The KServe Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import json
+import os
+
+from kserve_storage import Storage
+from kserve_storage.progress import DOWNLOAD_PROGRESS_FILE_ENV, DownloadProgressReporter
+
+
+def test_reports_downloaded_bytes(tmp_path):
+    out_dir = tmp_path / "model"
+    (out_dir / "weights").mkdir(parents=True)
+    (out_dir / "config.json").write_text("{}")
+    (out_dir / "weights" / "model.bin").write_bytes(b"0" * 10)
+    progress_file = tmp_path / "progress.json"
+
+    with DownloadProgressReporter([str(out_dir)], path=str(progress_file), interval=60):
+        pass
+
+    assert json.loads(progress_file.read_text()) == {"downloadedBytes": 12}
+    assert not os.path.exists(str(progress_file) + ".tmp")
+
+
+def test_no_report_without_progress_file(tmp_path, monkeypatch):
+    monkeypatch.delenv(DOWNLOAD_PROGRESS_FILE_ENV, raising=False)
+    reporter = DownloadProgressReporter([str(tmp_path)])
+    with reporter:
+        pass
+    assert reporter.path == ""
+    assert os.listdir(tmp_path) == []
+
+
+def test_download_files_reports_progress(tmp_path, monkeypatch):
+    src = tmp_path / "src"
+    src.mkdir()
+    (src / "model.pth").write_bytes(b"0" * 5)
+    out_dir = tmp_path / "out"
+    progress_file = tmp_path / "progress.json"
+    monkeypatch.setenv(DOWNLOAD_PROGRESS_FILE_ENV, str(progress_file))
+
+    Storage.download_files([str(src)], [str(out_dir)])
+
+    assert json.loads(progress_file.read_text()) == {"downloadedBytes": 5}
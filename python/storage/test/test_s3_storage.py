@@ -14,10 +14,12 @@
 
 import os
 import json
+import hashlib
 import pytest
 import botocore
 import tempfile
 import unittest.mock as mock
+from datetime import datetime, timezone
 
 from botocore.client import Config
 from botocore import UNSIGNED
@@ -231,6 +233,161 @@ def test_multikey(mock_storage):
     mock_boto3_bucket.objects.filter.assert_called_with(Prefix="test/a")
 
 
+def s3_revision(objects):
+    manifest = hashlib.sha256()
+    for key, etag in objects:
+        manifest.update(f"{key}\t{etag}\n".encode())
+    return "sha256:" + manifest.hexdigest()
+
+
+def s3_version(key, etag, version_id, minute):
+    return {
+        "Key": key,
+        "ETag": etag,
+        "VersionId": version_id,
+        "Size": 1024,
+        "LastModified": datetime(2026, 1, 1, 0, minute, tzinfo=timezone.utc),
+    }
+
+
+def create_mock_s3_versions(mock_storage, versions, delete_markers=()):
+    mock_boto3_bucket = create_mock_boto3_bucket(mock_storage, [])
+    mock_client = mock_storage.return_value.meta.client
+    mock_client.get_paginator.return_value.paginate.return_value = [
+        {"Versions": versions, "DeleteMarkers": list(delete_markers)}
+    ]
+    return mock_boto3_bucket
+
+
+def get_download_versions(mock_boto3_bucket):
+    return [
+        (args[0], kwargs["ExtraArgs"])
+        for args, kwargs in mock_boto3_bucket.download_file.call_args_list
+    ]
+
+
+@mock.patch("boto3.resource")
+def test_pinned_revision(mock_storage):
+    # given
+    mock_boto3_bucket = create_mock_s3_versions(
+        mock_storage,
+        [
+            s3_version("model/config.json", '"1"', "v1", 0),
+            s3_version("model/weights.bin", '"2"', "v2", 0),
+        ],
+    )
+    revision = s3_revision([("model/config.json", '"1"'), ("model/weights.bin", '"2"')])
+
+    # when
+    with mock.patch.dict(
+        os.environ, {"STORAGE_REVISIONS": json.dumps({"s3://foo/model": revision})}
+    ):
+        Storage._download_s3("s3://foo/model", "dest_path")
+
+    # then
+    arg_list = get_call_args(mock_boto3_bucket.download_file.call_args_list)
+    assert arg_list == expected_call_args_list(
+        "model", "dest_path", ["config.json", "weights.bin"]
+    )
+    assert get_download_versions(mock_boto3_bucket) == [
+        ("model/config.json", {"VersionId": "v1"}),
+        ("model/weights.bin", {"VersionId": "v2"}),
+    ]
+
+
+@mock.patch("boto3.resource")
+def test_pinned_revision_of_changed_objects(mock_storage):
+    # given the weights were overwritten, a file added and the config deleted since
+    mock_boto3_bucket = create_mock_s3_versions(
+        mock_storage,
+        [
+            s3_version("model/config.json", '"1"', "v1", 0),
+            s3_version("model/weights.bin", '"2"', "v2", 0),
+            s3_version("model/weights.bin", '"3"', "v3", 5),
+            s3_version("model/tokenizer.json", '"4"', "v4", 6),
+        ],
+        [
+            {
+                "Key": "model/config.json",
+                "VersionId": "d1",
+                "LastModified": datetime(2026, 1, 1, 0, 7, tzinfo=timezone.utc),
+            }
+        ],
+    )
+    revision = s3_revision([("model/config.json", '"1"'), ("model/weights.bin", '"2"')])
+
+    # when
+    with mock.patch.dict(
+        os.environ, {"STORAGE_REVISIONS": json.dumps({"s3://foo/model": revision})}
+    ):
+        Storage._download_s3("s3://foo/model", "dest_path")
+
+    # then the objects are downloaded at the versions of the revision
+    assert get_download_versions(mock_boto3_bucket) == [
+        ("model/config.json", {"VersionId": "v1"}),
+        ("model/weights.bin", {"VersionId": "v2"}),
+    ]
+
+
+@mock.patch("boto3.resource")
+def test_changed_revision(mock_storage):
+    # given a bucket without versioning whose weights were overwritten
+    mock_boto3_bucket = create_mock_s3_versions(
+        mock_storage,
+        [
+            s3_version("model/config.json", '"1"', "null", 0),
+            s3_version("model/weights.bin", '"3"', "null", 5),
+        ],
+    )
+    revision = s3_revision([("model/config.json", '"1"'), ("model/weights.bin", '"2"')])
+
+    # when
+    with mock.patch.dict(
+        os.environ, {"STORAGE_REVISIONS": json.dumps({"s3://foo/model": revision})}
+    ):
+        with pytest.raises(RuntimeError, match="changed since it was deployed"):
+            Storage._download_s3("s3://foo/model", "dest_path")
+
+    # then
+    mock_boto3_bucket.download_file.assert_not_called()
+
+
+@mock.patch("boto3.resource")
+def test_pinned_revision_without_object_versions(mock_storage):
+    # given a storage which does not support listing the object versions
+    mock_boto3_bucket = create_mock_boto3_bucket(mock_storage, [])
+    versions_paginator = mock.MagicMock()
+    versions_paginator.paginate.side_effect = botocore.exceptions.ClientError(
+        {"Error": {"Code": "NotImplemented"}}, "ListObjectVersions"
+    )
+    objects_paginator = mock.MagicMock()
+    objects_paginator.paginate.return_value = [
+        {
+            "Contents": [
+                {
+                    "Key": "model/weights.bin",
+                    "ETag": '"2"',
+                    "Size": 1024,
+                    "LastModified": datetime(2026, 1, 1, tzinfo=timezone.utc),
+                }
+            ]
+        }
+    ]
+    mock_storage.return_value.meta.client.get_paginator.side_effect = lambda name: (
+        versions_paginator if name == "list_object_versions" else objects_paginator
+    )
+    revision = s3_revision([("model/weights.bin", '"2"')])
+
+    # when
+    with mock.patch.dict(
+        os.environ, {"STORAGE_REVISIONS": json.dumps({"s3://foo/model": revision})}
+    ):
+        Storage._download_s3("s3://foo/model", "dest_path")
+
+    # then the current objects are downloaded once verified
+    assert get_download_versions(mock_boto3_bucket) == [("model/weights.bin", None)]
+
+
 @mock.patch("boto3.resource")
 def test_files_with_no_extension(mock_storage):
     # given
@@ -13,6 +13,7 @@
 # limitations under the License.
 
 import io
+import json
 import os
 import tempfile
 import binascii
@@ -697,3 +698,81 @@ class TestParsePatterns:
         with mock.patch.dict(os.environ, {"STORAGE_ALLOW_PATTERNS": '"*.safetensors"'}):
             result = _parse_patterns_from_env("STORAGE_ALLOW_PATTERNS")
             assert result == ["*.safetensors"]
+
+
+@pytest.mark.parametrize(
+    "storage_config,expected_env",
+    [
+        (
+            {
+                "type": "azure",
+                "account_name": "models",
+                "container": "test",
+                "client_id": "client",
+                "tenant_id": "tenant",
+                "client_secret": "secret",
+            },
+            {
+                "AZURE_CLIENT_ID": "client",
+                "AZURE_TENANT_ID": "tenant",
+                "AZURE_CLIENT_SECRET": "secret",
+            },
+        ),
+        (
+            {"type": "azure", "access_key": "key"},
+            {"AZURE_STORAGE_ACCESS_KEY": "key"},
+        ),
+        (
+            {"type": "hf", "token": "hf_token", "endpoint": "https://hf.example.com"},
+            {"HF_TOKEN": "hf_token", "HF_ENDPOINT": "https://hf.example.com"},
+        ),
+        (
+            {
+                "type": "https",
+                "host": "models.example.com",
+                "headers": '{"Authorization": "Bearer token"}',
+            },
+            {"models.example.com-headers": '{"Authorization": "Bearer token"}'},
+        ),
+    ],
+)
+def test_update_with_storage_spec(monkeypatch, storage_config, expected_env):
+    # save the environment and restore it after the test to avoid mutating it
+    # since _update_with_storage_spec modifies it
+    previous_env = os.environ.copy()
+
+    monkeypatch.setenv("STORAGE_CONFIG", json.dumps(storage_config))
+    Storage._update_with_storage_spec()
+
+    for env_var, value in expected_env.items():
+        assert os.getenv(env_var) == value
+
+    # revert changes
+    os.environ.clear()
+    os.environ.update(previous_env)
+
+
+def test_update_with_storage_spec_gs(monkeypatch):
+    previous_env = os.environ.copy()
+
+    service_account = '{"type": "service_account", "project_id": "models"}'
+    monkeypatch.setenv(
+        "STORAGE_CONFIG",
+        json.dumps(
+            {
+                "type": "gs",
+                "bucket": "models",
+                "service_account_json": service_account,
+            }
+        ),
+    )
+    Storage._update_with_storage_spec()
+
+    credentials_file = os.getenv("GOOGLE_APPLICATION_CREDENTIALS")
+    assert os.path.basename(credentials_file) == "gcloud-application-credentials.json"
+    with open(credentials_file) as f:
+        assert f.read() == service_account
+
+    # revert changes
+    os.environ.clear()
+    os.environ.update(previous_env)